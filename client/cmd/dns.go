@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var dnsCmd = &cobra.Command{
+	Use:   "dns",
+	Short: "Inspect the DNS server of the client",
+	Long:  "Commands to inspect the queries answered by the DNS server of the client.",
+}
+
+var dnsQueriesCmd = &cobra.Command{
+	Use:     "queries",
+	Short:   "List recent DNS queries",
+	Long:    "List the queries recorded by the DNS query log, oldest first. The query log has to be enabled with 'netbird dns querylog on'.",
+	Example: "  netbird dns queries",
+	RunE:    dnsQueries,
+}
+
+var dnsQueryLogCmd = &cobra.Command{
+	Use:     "querylog [on|off]",
+	Short:   "Enable or disable the DNS query log",
+	Long:    "Configure whether the DNS server records the recent queries in memory. Disabling the query log drops the recorded queries.",
+	Example: "  netbird dns querylog on",
+	Args:    cobra.ExactArgs(1),
+	RunE:    setDNSQueryLog,
+}
+
+func dnsQueries(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.GetDNSQueries(cmd.Context(), &proto.GetDNSQueriesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get DNS queries: %v", status.Convert(err).Message())
+	}
+
+	if !resp.GetEnabled() {
+		cmd.Println("DNS query log is disabled. Enable it with 'netbird dns querylog on'.")
+		return nil
+	}
+
+	if len(resp.GetQueries()) == 0 {
+		cmd.Println("No DNS queries recorded.")
+		return nil
+	}
+
+	cmd.Print(formatDNSQueries(resp.GetQueries()))
+
+	return nil
+}
+
+func formatDNSQueries(queries []*proto.DNSQuery) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-12s %-6s %-40s %-11s %-24s %-8s %s\n",
+		"TIME", "TYPE", "NAME", "HANDLER", "UPSTREAM", "RCODE", "LATENCY"))
+
+	for _, query := range queries {
+		upstream := query.GetUpstream()
+		if upstream == "" {
+			upstream = "-"
+		}
+		if query.GetCached() {
+			upstream += " (cached)"
+		}
+
+		builder.WriteString(fmt.Sprintf("%-12s %-6s %-40s %-11s %-24s %-8s %s\n",
+			query.GetTime().AsTime().Local().Format("15:04:05.000"),
+			query.GetType(),
+			query.GetName(),
+			query.GetHandler(),
+			upstream,
+			query.GetRcode(),
+			query.GetLatency().AsDuration().Round(time.Microsecond),
+		))
+	}
+
+	return builder.String()
+}
+
+func setDNSQueryLog(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	queryLog := strings.ToLower(args[0])
+	if queryLog != "on" && queryLog != "off" {
+		return fmt.Errorf("invalid query log value: %s. Use 'on' or 'off'", args[0])
+	}
+
+	client := proto.NewDaemonServiceClient(conn)
+	if _, err := client.SetDNSQueryLog(cmd.Context(), &proto.SetDNSQueryLogRequest{
+		Enabled: queryLog == "on",
+	}); err != nil {
+		return fmt.Errorf("failed to set DNS query log: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("DNS query log set to: %s\n", queryLog)
+	return nil
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var exitNodeCmd = &cobra.Command{
+	Use:   "exit-node",
+	Short: "Manage exit nodes",
+	Long:  "Commands to list exit nodes and to choose the exit node to route all traffic through.",
+}
+
+var exitNodeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List exit nodes",
+	Example: "  netbird exit-node list",
+	Long:    "List all exit nodes available to this peer.",
+	RunE:    exitNodeList,
+}
+
+var exitNodeUseCmd = &cobra.Command{
+	Use:     "use exit-node",
+	Short:   "Use exit node",
+	Long:    "Route all traffic through the given exit node, replacing the previously used one.",
+	Example: "  netbird exit-node use office-exit",
+	Args:    cobra.ExactArgs(1),
+	RunE:    exitNodeUse,
+}
+
+var exitNodeOffCmd = &cobra.Command{
+	Use:     "off",
+	Short:   "Stop using exit node",
+	Long:    "Stop routing all traffic through the exit node currently in use.",
+	Example: "  netbird exit-node off",
+	RunE:    exitNodeOff,
+}
+
+func exitNodeList(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.ListExitNodes(cmd.Context(), &proto.ListExitNodesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list exit nodes: %v", status.Convert(err).Message())
+	}
+
+	if len(resp.GetExitNodes()) == 0 {
+		cmd.Println("No exit nodes available.")
+		return nil
+	}
+
+	cmd.Println("Available Exit Nodes:")
+	for _, exitNode := range resp.GetExitNodes() {
+		selectedStatus := "Not Selected"
+		if exitNode.GetSelected() {
+			selectedStatus = "Selected"
+		}
+		lanAccess := "Blocked"
+		if exitNode.GetAllowLanAccess() {
+			lanAccess = "Allowed"
+		}
+		cmd.Printf("\n  - ID: %s\n    Network: %s\n    LAN Access: %s\n    Status: %s\n", exitNode.GetID(), exitNode.GetRange(), lanAccess, selectedStatus)
+	}
+
+	return nil
+}
+
+func exitNodeUse(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	if _, err := client.SelectExitNode(cmd.Context(), &proto.SelectExitNodeRequest{ExitNodeID: args[0]}); err != nil {
+		return fmt.Errorf("failed to use exit node: %v", status.Convert(err).Message())
+	}
+
+	cmd.Printf("Using exit node %s.\n", args[0])
+
+	return nil
+}
+
+func exitNodeOff(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	if _, err := client.DeselectExitNode(cmd.Context(), &proto.DeselectExitNodeRequest{}); err != nil {
+		return fmt.Errorf("failed to stop using exit node: %v", status.Convert(err).Message())
+	}
+
+	cmd.Println("Exit node disabled.")
+
+	return nil
+}
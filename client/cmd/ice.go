@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var iceCmd = &cobra.Command{
+	Use:   "ice <peer>",
+	Short: "Show why a peer is connected peer-to-peer or relayed",
+	Long: `Shows the ICE candidates, the checked candidate pairs and the selected pair of the connection to a peer, probes the
+reachability of the STUN and TURN servers, classifies the NAT of this client and explains why the connection is not
+peer-to-peer. The peer is identified by its public key, NetBird IP, FQDN or hostname. Use --anonymize to hide the
+public addresses.`,
+	Example: "  netbird debug ice peer-a.netbird.cloud",
+	Args:    cobra.ExactArgs(1),
+	RunE:    debugICE,
+}
+
+func debugICE(cmd *cobra.Command, args []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.DebugICE(cmd.Context(), &proto.DebugICERequest{
+		Peer:      args[0],
+		Anonymize: anonymizeFlag,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get ICE diagnostics: %v", status.Convert(err).Message())
+	}
+
+	cmd.Print(formatICEDiagnostics(resp))
+	return nil
+}
+
+func formatICEDiagnostics(resp *proto.DebugICEResponse) string {
+	var builder strings.Builder
+
+	connectionType := resp.GetConnectionType()
+	if connectionType == "" {
+		connectionType = "Disconnected"
+	}
+	builder.WriteString(fmt.Sprintf("Peer: %s (%s, %s)\n", resp.GetFqdn(), resp.GetIp(), resp.GetPubKey()))
+	builder.WriteString(fmt.Sprintf("Connection: %s\n", connectionType))
+
+	iceState := resp.GetIceState()
+	if iceState == "" {
+		iceState = "not started"
+	}
+	agent := "stopped"
+	if resp.GetIceAgentRunning() {
+		agent = "running"
+	}
+	builder.WriteString(fmt.Sprintf("ICE state: %s (agent %s)", iceState, agent))
+	if resp.GetIceStartedAt() != nil {
+		builder.WriteString(fmt.Sprintf(", last attempt started at %s", resp.GetIceStartedAt().AsTime().Local().Format(time.DateTime)))
+	}
+	builder.WriteString("\n")
+	if resp.GetIceLastError() != "" {
+		builder.WriteString(fmt.Sprintf("ICE error: %s\n", resp.GetIceLastError()))
+	}
+
+	builder.WriteString("\nLocal candidates:\n")
+	writeICECandidates(&builder, resp.GetLocalCandidates())
+	builder.WriteString("\nRemote candidates:\n")
+	writeICECandidates(&builder, resp.GetRemoteCandidates())
+
+	builder.WriteString("\nCandidate pairs:\n")
+	if len(resp.GetPairs()) == 0 {
+		builder.WriteString("  none\n")
+	}
+	for _, pair := range resp.GetPairs() {
+		var flags []string
+		if pair.GetSelected() {
+			flags = append(flags, "selected")
+		}
+		if pair.GetNominated() {
+			flags = append(flags, "nominated")
+		}
+		line := fmt.Sprintf("  %-32s <-> %-32s %-12s %-8s",
+			formatICECandidate(pair.GetLocal()), formatICECandidate(pair.GetRemote()), pair.GetState(), formatICERTT(pair))
+		if len(flags) > 0 {
+			line += " [" + strings.Join(flags, ", ") + "]"
+		}
+		builder.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+
+	if selected := resp.GetSelectedPair(); selected != nil {
+		builder.WriteString(fmt.Sprintf("\nSelected pair: %s <-> %s, RTT %s\n",
+			formatICECandidate(selected.GetLocal()), formatICECandidate(selected.GetRemote()), formatICERTT(selected)))
+	} else {
+		builder.WriteString("\nSelected pair: none\n")
+	}
+
+	builder.WriteString("\nSTUN/TURN servers:\n")
+	if len(resp.GetStunServers()) == 0 {
+		builder.WriteString("  none\n")
+	}
+	for _, server := range resp.GetStunServers() {
+		if server.GetReachable() {
+			builder.WriteString(fmt.Sprintf("  %-40s reachable, mapped address %s\n", server.GetUri(), server.GetMappedAddress()))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("  %-40s unreachable: %s\n", server.GetUri(), server.GetError()))
+	}
+
+	builder.WriteString(fmt.Sprintf("\nNAT mapping: %s\n", resp.GetNatType()))
+	for _, mapping := range resp.GetNatMappings() {
+		if mapping.GetError() != "" {
+			builder.WriteString(fmt.Sprintf("  %-40s error: %s\n", mapping.GetUri(), mapping.GetError()))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("  %-40s mapped address %s\n", mapping.GetUri(), mapping.GetMappedAddress()))
+	}
+
+	if len(resp.GetP2PFailureReasons()) == 0 {
+		builder.WriteString("\nThe connection is peer-to-peer.\n")
+		return builder.String()
+	}
+	builder.WriteString("\nWhy not peer-to-peer:\n")
+	for _, reason := range resp.GetP2PFailureReasons() {
+		builder.WriteString(fmt.Sprintf("  - %s\n", reason))
+	}
+	return builder.String()
+}
+
+func writeICECandidates(builder *strings.Builder, candidates []*proto.ICECandidate) {
+	if len(candidates) == 0 {
+		builder.WriteString("  none\n")
+		return
+	}
+
+	for _, c := range candidates {
+		line := fmt.Sprintf("  %-6s %-5s %-40s", c.GetType(), c.GetNetwork(), net.JoinHostPort(c.GetAddress(), strconv.Itoa(int(c.GetPort()))))
+		if c.GetUrl() != "" {
+			line += " via " + c.GetUrl()
+			if c.GetRelayProtocol() != "" {
+				line += "/" + c.GetRelayProtocol()
+			}
+		}
+		if c.GetIgnored() {
+			line += " (ignored, routed network)"
+		}
+		builder.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+}
+
+func formatICECandidate(c *proto.ICECandidate) string {
+	return fmt.Sprintf("%s %s", c.GetType(), net.JoinHostPort(c.GetAddress(), strconv.Itoa(int(c.GetPort()))))
+}
+
+func formatICERTT(pair *proto.ICECandidatePair) string {
+	if pair.GetRtt() == nil {
+		return "-"
+	}
+	return pair.GetRtt().AsDuration().Round(time.Microsecond).String()
+}
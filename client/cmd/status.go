@@ -85,6 +85,29 @@ type nsServerGroupStateOutput struct {
 	Error   string   `json:"error" yaml:"error"`
 }
 
+type dnsUpstreamStatsOutput struct {
+	Upstream    string        `json:"upstream" yaml:"upstream"`
+	Queries     uint64        `json:"queries" yaml:"queries"`
+	Failures    uint64        `json:"failures" yaml:"failures"`
+	AvgLatency  time.Duration `json:"avgLatency" yaml:"avgLatency"`
+	LastLatency time.Duration `json:"lastLatency" yaml:"lastLatency"`
+}
+
+type dnsBlocklistStatsOutput struct {
+	Name    string `json:"name" yaml:"name"`
+	Blocked uint64 `json:"blocked" yaml:"blocked"`
+}
+
+type dnsStatsOutput struct {
+	CacheEntries    int64                     `json:"cacheEntries" yaml:"cacheEntries"`
+	CacheHits       uint64                    `json:"cacheHits" yaml:"cacheHits"`
+	CacheMisses     uint64                    `json:"cacheMisses" yaml:"cacheMisses"`
+	CachePrefetches uint64                    `json:"cachePrefetches" yaml:"cachePrefetches"`
+	CacheEvictions  uint64                    `json:"cacheEvictions" yaml:"cacheEvictions"`
+	Upstreams       []dnsUpstreamStatsOutput  `json:"upstreams" yaml:"upstreams"`
+	Blocklists      []dnsBlocklistStatsOutput `json:"blocklists" yaml:"blocklists"`
+}
+
 type statusOutputOverview struct {
 	Peers               peersStateOutput           `json:"peers" yaml:"peers"`
 	CliVersion          string                     `json:"cliVersion" yaml:"cliVersion"`
@@ -101,6 +124,7 @@ type statusOutputOverview struct {
 	Routes              []string                   `json:"routes" yaml:"routes"`
 	Networks            []string                   `json:"networks" yaml:"networks"`
 	NSServerGroups      []nsServerGroupStateOutput `json:"dnsServers" yaml:"dnsServers"`
+	DNSStats            dnsStatsOutput             `json:"dnsStats" yaml:"dnsStats"`
 }
 
 var (
@@ -287,6 +311,7 @@ func convertToStatusOutputOverview(resp *proto.StatusResponse) statusOutputOverv
 		Routes:              pbFullStatus.GetLocalPeerState().GetNetworks(),
 		Networks:            pbFullStatus.GetLocalPeerState().GetNetworks(),
 		NSServerGroups:      mapNSGroups(pbFullStatus.GetDnsServers()),
+		DNSStats:            mapDNSStats(pbFullStatus.GetDnsStats()),
 	}
 
 	if anonymizeFlag {
@@ -336,6 +361,37 @@ func mapNSGroups(servers []*proto.NSGroupState) []nsServerGroupStateOutput {
 	return mappedNSGroups
 }
 
+func mapDNSStats(stats *proto.DNSStats) dnsStatsOutput {
+	upstreams := make([]dnsUpstreamStatsOutput, 0, len(stats.GetUpstreams()))
+	for _, upstream := range stats.GetUpstreams() {
+		upstreams = append(upstreams, dnsUpstreamStatsOutput{
+			Upstream:    upstream.GetUpstream(),
+			Queries:     upstream.GetQueries(),
+			Failures:    upstream.GetFailures(),
+			AvgLatency:  upstream.GetAvgLatency().AsDuration(),
+			LastLatency: upstream.GetLastLatency().AsDuration(),
+		})
+	}
+
+	blocklists := make([]dnsBlocklistStatsOutput, 0, len(stats.GetBlocklists()))
+	for _, blocklist := range stats.GetBlocklists() {
+		blocklists = append(blocklists, dnsBlocklistStatsOutput{
+			Name:    blocklist.GetName(),
+			Blocked: blocklist.GetBlocked(),
+		})
+	}
+
+	return dnsStatsOutput{
+		CacheEntries:    stats.GetCacheEntries(),
+		CacheHits:       stats.GetCacheHits(),
+		CacheMisses:     stats.GetCacheMisses(),
+		CachePrefetches: stats.GetCachePrefetches(),
+		CacheEvictions:  stats.GetCacheEvictions(),
+		Upstreams:       upstreams,
+		Blocklists:      blocklists,
+	}
+}
+
 func mapPeers(peers []*proto.PeerState) peersStateOutput {
 	var peersStateDetail []peerStateDetailOutput
 	peersConnected := 0
@@ -583,16 +639,53 @@ func parseGeneralSummary(overview statusOutputOverview, showURL bool, showRelays
 func parseToFullDetailSummary(overview statusOutputOverview) string {
 	parsedPeersString := parsePeers(overview.Peers, overview.RosenpassEnabled, overview.RosenpassPermissive)
 	summary := parseGeneralSummary(overview, true, true, true)
+	dnsStatsString := parseDNSStats(overview.DNSStats)
 
 	return fmt.Sprintf(
 		"Peers detail:"+
 			"%s\n"+
+			"%s"+
 			"%s",
 		parsedPeersString,
 		summary,
+		dnsStatsString,
 	)
 }
 
+func parseDNSStats(stats dnsStatsOutput) string {
+	dnsStatsString := fmt.Sprintf(
+		"DNS cache: %d entries, %d hits, %d misses, %d prefetches, %d evictions\n",
+		stats.CacheEntries,
+		stats.CacheHits,
+		stats.CacheMisses,
+		stats.CachePrefetches,
+		stats.CacheEvictions,
+	)
+
+	if len(stats.Upstreams) > 0 {
+		dnsStatsString += "DNS upstreams:\n"
+	}
+	for _, upstream := range stats.Upstreams {
+		dnsStatsString += fmt.Sprintf(
+			"  [%s] %d queries, %d failures, avg latency %s, last latency %s\n",
+			upstream.Upstream,
+			upstream.Queries,
+			upstream.Failures,
+			upstream.AvgLatency.String(),
+			upstream.LastLatency.String(),
+		)
+	}
+
+	if len(stats.Blocklists) > 0 {
+		dnsStatsString += "DNS blocklists:\n"
+	}
+	for _, blocklist := range stats.Blocklists {
+		dnsStatsString += fmt.Sprintf("  [%s] %d blocked queries\n", blocklist.Name, blocklist.Blocked)
+	}
+
+	return dnsStatsString
+}
+
 func parsePeers(peers peersStateOutput, rosenpassEnabled, rosenpassPermissive bool) string {
 	var (
 		peersString = ""
@@ -866,6 +959,14 @@ func anonymizeOverview(a *anonymize.Anonymizer, overview *statusOutputOverview)
 		}
 	}
 
+	for i, upstream := range overview.DNSStats.Upstreams {
+		if host, port, err := net.SplitHostPort(upstream.Upstream); err == nil {
+			overview.DNSStats.Upstreams[i].Upstream = fmt.Sprintf("%s:%s", a.AnonymizeIPString(host), port)
+		} else {
+			overview.DNSStats.Upstreams[i].Upstream = a.AnonymizeURI(upstream.Upstream)
+		}
+	}
+
 	for i, route := range overview.Networks {
 		overview.Networks[i] = a.AnonymizeRoute(route)
 	}
@@ -119,6 +119,28 @@ var resp = &proto.StatusResponse{
 				Error:   "timeout",
 			},
 		},
+		DnsStats: &proto.DNSStats{
+			CacheEntries:    12,
+			CacheHits:       30,
+			CacheMisses:     10,
+			CachePrefetches: 2,
+			CacheEvictions:  1,
+			Upstreams: []*proto.DNSUpstreamStats{
+				{
+					Upstream:    "8.8.8.8:53",
+					Queries:     12,
+					Failures:    2,
+					AvgLatency:  durationpb.New(15 * time.Millisecond),
+					LastLatency: durationpb.New(10 * time.Millisecond),
+				},
+			},
+			Blocklists: []*proto.DNSBlocklistStats{
+				{
+					Name:    "Ads",
+					Blocked: 7,
+				},
+			},
+		},
 	},
 	DaemonVersion: "0.14.1",
 }
@@ -230,6 +252,28 @@ var overview = statusOutputOverview{
 			Error:   "timeout",
 		},
 	},
+	DNSStats: dnsStatsOutput{
+		CacheEntries:    12,
+		CacheHits:       30,
+		CacheMisses:     10,
+		CachePrefetches: 2,
+		CacheEvictions:  1,
+		Upstreams: []dnsUpstreamStatsOutput{
+			{
+				Upstream:    "8.8.8.8:53",
+				Queries:     12,
+				Failures:    2,
+				AvgLatency:  15 * time.Millisecond,
+				LastLatency: 10 * time.Millisecond,
+			},
+		},
+		Blocklists: []dnsBlocklistStatsOutput{
+			{
+				Name:    "Ads",
+				Blocked: 7,
+			},
+		},
+	},
 	Routes: []string{
 		"10.10.0.0/24",
 	},
@@ -393,7 +437,29 @@ func TestParsingToJSON(t *testing.T) {
               "enabled": false,
               "error": "timeout"
             }
-          ]
+          ],
+          "dnsStats": {
+            "cacheEntries": 12,
+            "cacheHits": 30,
+            "cacheMisses": 10,
+            "cachePrefetches": 2,
+            "cacheEvictions": 1,
+            "upstreams": [
+              {
+                "upstream": "8.8.8.8:53",
+                "queries": 12,
+                "failures": 2,
+                "avgLatency": 15000000,
+                "lastLatency": 10000000
+              }
+            ],
+            "blocklists": [
+              {
+                "name": "Ads",
+                "blocked": 7
+              }
+            ]
+          }
         }`
 	// @formatter:on
 
@@ -497,6 +563,21 @@ dnsServers:
         - example.net
       enabled: false
       error: timeout
+dnsStats:
+    cacheEntries: 12
+    cacheHits: 30
+    cacheMisses: 10
+    cachePrefetches: 2
+    cacheEvictions: 1
+    upstreams:
+        - upstream: 8.8.8.8:53
+          queries: 12
+          failures: 2
+          avgLatency: 15ms
+          lastLatency: 10ms
+    blocklists:
+        - name: Ads
+          blocked: 7
 `
 
 	assert.Equal(t, expectedYAML, yaml)
@@ -565,6 +646,11 @@ Quantum resistance: false
 Routes: 10.10.0.0/24
 Networks: 10.10.0.0/24
 Peers count: 2/2 Connected
+DNS cache: 12 entries, 30 hits, 10 misses, 2 prefetches, 1 evictions
+DNS upstreams:
+  [8.8.8.8:53] 12 queries, 2 failures, avg latency 15ms, last latency 10ms
+DNS blocklists:
+  [Ads] 7 blocked queries
 `, lastConnectionUpdate1, lastHandshake1, lastConnectionUpdate2, lastHandshake2, runtime.GOOS, runtime.GOARCH, overview.CliVersion)
 
 	assert.Equal(t, expectedDetail, detail)
@@ -33,6 +33,8 @@ type aclManager struct {
 	iptablesClient     *iptables.IPTables
 	wgIface            iFaceMapper
 	routingFwChainName string
+	// ipv6 is set if the manager handles the ip6tables rules of the IPv6 overlay addresses
+	ipv6 bool
 
 	entries         aclEntries
 	optionalEntries map[string][]entry
@@ -46,6 +48,7 @@ func newAclManager(iptablesClient *iptables.IPTables, wgIface iFaceMapper, routi
 		iptablesClient:     iptablesClient,
 		wgIface:            wgIface,
 		routingFwChainName: routingFwChainName,
+		ipv6:               iptablesClient.Proto() == iptables.ProtocolIPv6,
 
 		entries:         make(map[string][][]string),
 		optionalEntries: make(map[string][]entry),
@@ -98,6 +101,12 @@ func (m *aclManager) AddPeerFiltering(
 	chain := chainNameInputRules
 
 	ipsetName = transformIPsetName(ipsetName, sPortVal, dPortVal)
+	var ipsetOpts []ipset.Option
+	if m.ipv6 && ipsetName != "" {
+		// ipsets are shared between iptables and ip6tables, the IPv6 sets need their own names
+		ipsetName += "-v6"
+		ipsetOpts = append(ipsetOpts, ipset.OptIPv6())
+	}
 	specs := filterRuleSpecs(ip, string(protocol), sPortVal, dPortVal, action, ipsetName)
 	if ipsetName != "" {
 		if ipList, ipsetExists := m.ipsetStore.ipset(ipsetName); ipsetExists {
@@ -119,7 +128,7 @@ func (m *aclManager) AddPeerFiltering(
 		if err := ipset.Flush(ipsetName); err != nil {
 			log.Errorf("flush ipset %s before use it: %s", ipsetName, err)
 		}
-		if err := ipset.Create(ipsetName); err != nil {
+		if err := ipset.Create(ipsetName, ipsetOpts...); err != nil {
 			return nil, fmt.Errorf("failed to create ipset: %w", err)
 		}
 		if err := ipset.Add(ipsetName, ip.String()); err != nil {
@@ -303,7 +312,10 @@ func (m *aclManager) seedInitialEntries() {
 	m.appendToEntries("INPUT", append([]string{"-i", m.wgIface.Name()}, established...))
 
 	m.appendToEntries("FORWARD", []string{"-i", m.wgIface.Name(), "-j", "DROP"})
-	m.appendToEntries("FORWARD", []string{"-i", m.wgIface.Name(), "-j", m.routingFwChainName})
+	// there is no IPv6 router, forwarded IPv6 traffic from the interface is dropped
+	if m.routingFwChainName != "" {
+		m.appendToEntries("FORWARD", []string{"-i", m.wgIface.Name(), "-j", m.routingFwChainName})
+	}
 	m.appendToEntries("FORWARD", append([]string{"-o", m.wgIface.Name()}, established...))
 }
 
@@ -345,8 +357,13 @@ func (m *aclManager) updateState() {
 	currentState.Lock()
 	defer currentState.Unlock()
 
-	currentState.ACLEntries = m.entries
-	currentState.ACLIPsetStore = m.ipsetStore
+	if m.ipv6 {
+		currentState.ACL6Entries = m.entries
+		currentState.ACL6IPsetStore = m.ipsetStore
+	} else {
+		currentState.ACLEntries = m.entries
+		currentState.ACLIPsetStore = m.ipsetStore
+	}
 
 	if err := m.stateManager.UpdateState(currentState); err != nil {
 		log.Errorf("failed to update state: %v", err)
@@ -356,8 +373,8 @@ func (m *aclManager) updateState() {
 // filterRuleSpecs returns the specs of a filtering rule
 func filterRuleSpecs(ip net.IP, protocol, sPort, dPort string, action firewall.Action, ipsetName string) (specs []string) {
 	matchByIP := true
-	// don't use IP matching if IP is ip 0.0.0.0
-	if ip.String() == "0.0.0.0" {
+	// don't use IP matching if IP is ip 0.0.0.0 or ::
+	if ip.String() == "0.0.0.0" || ip.String() == "::" {
 		matchByIP = false
 	}
 
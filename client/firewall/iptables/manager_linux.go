@@ -26,6 +26,9 @@ type Manager struct {
 	ipv4Client *iptables.IPTables
 	aclMgr     *aclManager
 	router     *router
+
+	// aclMgr6 filters the IPv6 overlay traffic, it is nil if the interface has no IPv6 address
+	aclMgr6 *aclManager
 }
 
 // iFaceMapper defines subset methods of interface required for manager
@@ -57,6 +60,19 @@ func Create(wgIface iFaceMapper) (*Manager, error) {
 		return nil, fmt.Errorf("create acl manager: %w", err)
 	}
 
+	if wgIface.Address().HasIPv6() {
+		ip6tablesClient, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+		if err != nil {
+			log.Warnf("failed to init ip6tables, ipv6 overlay traffic is not filtered: %v", err)
+			return m, nil
+		}
+
+		m.aclMgr6, err = newAclManager(ip6tablesClient, wgIface, "")
+		if err != nil {
+			return nil, fmt.Errorf("create ipv6 acl manager: %w", err)
+		}
+	}
+
 	return m, nil
 }
 
@@ -82,6 +98,14 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 		return fmt.Errorf("acl manager init: %w", err)
 	}
 
+	if m.aclMgr6 != nil {
+		if err := m.aclMgr6.init(stateManager); err != nil {
+			// the IPv4 filtering keeps working without ip6tables
+			log.Warnf("failed to init ipv6 acl manager, ipv6 overlay traffic is not filtered: %v", err)
+			m.aclMgr6 = nil
+		}
+	}
+
 	// persist early to ensure cleanup of chains
 	go func() {
 		if err := stateManager.PersistState(context.Background()); err != nil {
@@ -96,6 +120,7 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 //
 // Comment will be ignored because some system this feature is not supported
 func (m *Manager) AddPeerFiltering(
+	_ []byte,
 	ip net.IP,
 	protocol firewall.Protocol,
 	sPort *firewall.Port,
@@ -107,6 +132,14 @@ func (m *Manager) AddPeerFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if ip.To4() == nil {
+		if m.aclMgr6 == nil {
+			log.Debugf("ipv6 filtering is not available, skipping rule for %s", ip)
+			return nil, nil
+		}
+		return m.aclMgr6.AddPeerFiltering(ip, protocol, sPort, dPort, action, ipsetName)
+	}
+
 	return m.aclMgr.AddPeerFiltering(ip, protocol, sPort, dPort, action, ipsetName)
 }
 
@@ -133,6 +166,10 @@ func (m *Manager) DeletePeerRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if r, ok := rule.(*Rule); ok && net.ParseIP(r.ip).To4() == nil && m.aclMgr6 != nil {
+		return m.aclMgr6.DeletePeerRule(rule)
+	}
+
 	return m.aclMgr.DeletePeerRule(rule)
 }
 
@@ -175,6 +212,11 @@ func (m *Manager) Reset(stateManager *statemanager.Manager) error {
 	if err := m.aclMgr.Reset(); err != nil {
 		merr = multierror.Append(merr, fmt.Errorf("reset acl manager: %w", err))
 	}
+	if m.aclMgr6 != nil {
+		if err := m.aclMgr6.Reset(); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("reset ipv6 acl manager: %w", err))
+		}
+	}
 	if err := m.router.Reset(); err != nil {
 		merr = multierror.Append(merr, fmt.Errorf("reset router: %w", err))
 	}
@@ -196,6 +238,7 @@ func (m *Manager) AllowNetbird() error {
 	}
 
 	_, err := m.AddPeerFiltering(
+		nil,
 		net.IP{0, 0, 0, 0},
 		"all",
 		nil,
@@ -207,6 +250,12 @@ func (m *Manager) AllowNetbird() error {
 	if err != nil {
 		return fmt.Errorf("allow netbird interface traffic: %w", err)
 	}
+
+	if m.aclMgr6 != nil {
+		if _, err := m.AddPeerFiltering(nil, net.IPv6zero, "all", nil, nil, firewall.ActionAccept, "", ""); err != nil {
+			return fmt.Errorf("allow netbird interface ipv6 traffic: %w", err)
+		}
+	}
 	return nil
 }
 
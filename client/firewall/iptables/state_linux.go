@@ -36,6 +36,9 @@ type ShutdownState struct {
 
 	ACLEntries    aclEntries  `json:"acl_entries,omitempty"`
 	ACLIPsetStore *ipsetStore `json:"acl_ipset_store,omitempty"`
+
+	ACL6Entries    aclEntries  `json:"acl6_entries,omitempty"`
+	ACL6IPsetStore *ipsetStore `json:"acl6_ipset_store,omitempty"`
 }
 
 func (s *ShutdownState) Name() string {
@@ -62,6 +65,15 @@ func (s *ShutdownState) Cleanup() error {
 		ipt.aclMgr.ipsetStore = s.ACLIPsetStore
 	}
 
+	if ipt.aclMgr6 != nil {
+		if s.ACL6Entries != nil {
+			ipt.aclMgr6.entries = s.ACL6Entries
+		}
+		if s.ACL6IPsetStore != nil {
+			ipt.aclMgr6.ipsetStore = s.ACL6IPsetStore
+		}
+	}
+
 	if err := ipt.Reset(nil); err != nil {
 		return fmt.Errorf("reset iptables manager: %w", err)
 	}
@@ -62,9 +62,11 @@ type Manager interface {
 
 	// AddPeerFiltering adds a rule to the firewall
 	//
+	// The id argument is the management ID of the policy that generated the rule, it can be empty.
 	// If comment argument is empty firewall manager should set
 	// rule ID as comment for the rule
 	AddPeerFiltering(
+		id []byte,
 		ip net.IP,
 		proto Protocol,
 		sPort *Port,
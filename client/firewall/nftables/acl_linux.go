@@ -136,7 +136,7 @@ func (m *AclManager) DeletePeerRule(rule firewall.Rule) error {
 		return m.rConn.Flush()
 	}
 	if _, ok := ips[r.ip.String()]; ok {
-		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: r.ip.To4()}})
+		err := m.sConn.SetDeleteElements(r.nftSet, []nftables.SetElement{{Key: m.rawIP(r.ip)}})
 		if err != nil {
 			log.Errorf("delete elements for set %q: %v", r.nftSet.Name, err)
 		}
@@ -179,25 +179,26 @@ func (m *AclManager) DeletePeerRule(rule firewall.Rule) error {
 
 // createDefaultAllowRules creates default allow rules for the input and output chains
 func (m *AclManager) createDefaultAllowRules() error {
+	addrOffset, addrLen := m.srcAddrPayload()
 	expIn := []expr.Any{
 		&expr.Payload{
 			DestRegister: 1,
 			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       12,
-			Len:          4,
+			Offset:       addrOffset,
+			Len:          addrLen,
 		},
 		// mask
 		&expr.Bitwise{
 			SourceRegister: 1,
 			DestRegister:   1,
-			Len:            4,
-			Mask:           []byte{0, 0, 0, 0},
-			Xor:            []byte{0, 0, 0, 0},
+			Len:            addrLen,
+			Mask:           make([]byte, addrLen),
+			Xor:            make([]byte, addrLen),
 		},
 		// net address
 		&expr.Cmp{
 			Register: 1,
-			Data:     []byte{0, 0, 0, 0},
+			Data:     make([]byte, addrLen),
 		},
 		&expr.Verdict{
 			Kind: expr.VerdictAccept,
@@ -226,7 +227,7 @@ func (m *AclManager) Flush() error {
 	}
 
 	if err := m.refreshRuleHandles(m.chainInputRules); err != nil {
-		log.Errorf("failed to refresh rule handles ipv4 input chain: %v", err)
+		log.Errorf("failed to refresh rule handles %s input chain: %v", m.familyName(), err)
 	}
 
 	return nil
@@ -254,10 +255,15 @@ func (m *AclManager) addIOFiltering(
 	var expressions []expr.Any
 
 	if proto != firewall.ProtocolALL {
+		// protocol field of the IPv4 header or the next header field of the IPv6 header
+		protoOffset := uint32(9)
+		if m.isIPv6() {
+			protoOffset = 6
+		}
 		expressions = append(expressions, &expr.Payload{
 			DestRegister: 1,
 			Base:         expr.PayloadBaseNetworkHeader,
-			Offset:       uint32(9),
+			Offset:       protoOffset,
 			Len:          uint32(1),
 		})
 
@@ -265,6 +271,9 @@ func (m *AclManager) addIOFiltering(
 		if err != nil {
 			return nil, fmt.Errorf("convert protocol to number: %v", err)
 		}
+		if m.isIPv6() && proto == firewall.ProtocolICMP {
+			protoData = unix.IPPROTO_ICMPV6
+		}
 
 		expressions = append(expressions, &expr.Cmp{
 			Register: 1,
@@ -273,19 +282,19 @@ func (m *AclManager) addIOFiltering(
 		})
 	}
 
-	rawIP := ip.To4()
-	// check if rawIP contains zeroed IPv4 0.0.0.0 value
+	rawIP := m.rawIP(ip)
+	// check if rawIP contains zeroed IPv4 0.0.0.0 or IPv6 :: value
 	// in that case not add IP match expression into the rule definition
 	if !bytes.HasPrefix(anyIP, rawIP) {
 		// source address position
-		addrOffset := uint32(12)
+		addrOffset, addrLen := m.srcAddrPayload()
 
 		expressions = append(expressions,
 			&expr.Payload{
 				DestRegister: 1,
 				Base:         expr.PayloadBaseNetworkHeader,
 				Offset:       addrOffset,
-				Len:          4,
+				Len:          addrLen,
 			},
 		)
 		// add individual IP for match if no ipset defined
@@ -393,7 +402,10 @@ func (m *AclManager) createDefaultChains() (err error) {
 
 	// netbird-acl-forward-filter
 	chainFwFilter := m.createFilterChainWithHook(chainNameForwardFilter, nftables.ChainHookForward)
-	m.addJumpRulesToRtForward(chainFwFilter) // to netbird-rt-fwd
+	// the IPv6 table has no router, forwarded IPv6 traffic from the interface is dropped
+	if m.routingFwChainName != "" {
+		m.addJumpRulesToRtForward(chainFwFilter) // to netbird-rt-fwd
+	}
 	m.addDropExpressions(chainFwFilter, expr.MetaKeyIIFNAME)
 
 	err = m.rConn.Flush()
@@ -580,7 +592,7 @@ func (m *AclManager) addJumpRule(chain *nftables.Chain, to string, ifaceKey expr
 
 func (m *AclManager) addIpToSet(ipsetName string, ip net.IP) (*nftables.Set, error) {
 	ipset, err := m.rConn.GetSetByName(m.workTable, ipsetName)
-	rawIP := ip.To4()
+	rawIP := m.rawIP(ip)
 	if err != nil {
 		if ipset, err = m.createSet(m.workTable, ipsetName); err != nil {
 			return nil, fmt.Errorf("get set name: %v", err)
@@ -608,11 +620,16 @@ func (m *AclManager) addIpToSet(ipsetName string, ip net.IP) (*nftables.Set, err
 
 // createSet in given table by name
 func (m *AclManager) createSet(table *nftables.Table, name string) (*nftables.Set, error) {
+	keyType := nftables.TypeIPAddr
+	if m.isIPv6() {
+		keyType = nftables.TypeIP6Addr
+	}
+
 	ipset := &nftables.Set{
 		Name:    name,
 		Table:   table,
 		Dynamic: true,
-		KeyType: nftables.TypeIPAddr,
+		KeyType: keyType,
 	}
 
 	if err := m.rConn.AddSet(ipset, nil); err != nil {
@@ -672,6 +689,33 @@ func (m *AclManager) refreshRuleHandles(chain *nftables.Chain) error {
 	return nil
 }
 
+func (m *AclManager) isIPv6() bool {
+	return m.workTable.Family == nftables.TableFamilyIPv6
+}
+
+func (m *AclManager) familyName() string {
+	if m.isIPv6() {
+		return "ipv6"
+	}
+	return "ipv4"
+}
+
+// rawIP returns the IP address in the length of the table family
+func (m *AclManager) rawIP(ip net.IP) []byte {
+	if m.isIPv6() {
+		return ip.To16()
+	}
+	return ip.To4()
+}
+
+// srcAddrPayload returns the offset and the length of the source address in the network header
+func (m *AclManager) srcAddrPayload() (uint32, uint32) {
+	if m.isIPv6() {
+		return 8, 16
+	}
+	return 12, 4
+}
+
 func generatePeerRuleId(ip net.IP, sPort *firewall.Port, dPort *firewall.Port, action firewall.Action, ipset *nftables.Set) string {
 	rulesetID := ":"
 	if sPort != nil {
@@ -41,6 +41,8 @@ type Manager struct {
 
 	router     *router
 	aclManager *AclManager
+	// aclManager6 filters the IPv6 overlay traffic, it is nil if the interface has no IPv6 address
+	aclManager6 *AclManager
 }
 
 // Create nftables firewall manager
@@ -63,12 +65,20 @@ func Create(wgIface iFaceMapper) (*Manager, error) {
 		return nil, fmt.Errorf("create acl manager: %w", err)
 	}
 
+	if wgIface.Address().HasIPv6() {
+		workTable6 := &nftables.Table{Name: tableNameNetbird, Family: nftables.TableFamilyIPv6}
+		m.aclManager6, err = newAclManager(workTable6, wgIface, "")
+		if err != nil {
+			return nil, fmt.Errorf("create ipv6 acl manager: %w", err)
+		}
+	}
+
 	return m, nil
 }
 
 // Init nftables firewall manager
 func (m *Manager) Init(stateManager *statemanager.Manager) error {
-	workTable, err := m.createWorkTable()
+	workTable, err := m.createWorkTable(nftables.TableFamilyIPv4)
 	if err != nil {
 		return fmt.Errorf("create work table: %w", err)
 	}
@@ -82,6 +92,14 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 		return fmt.Errorf("acl manager init: %w", err)
 	}
 
+	if m.aclManager6 != nil {
+		if err := m.initIPv6(); err != nil {
+			// the IPv4 filtering keeps working without IPv6 support of the kernel
+			log.Warnf("failed to init ipv6 acl manager, ipv6 overlay traffic is not filtered: %v", err)
+			m.aclManager6 = nil
+		}
+	}
+
 	stateManager.RegisterState(&ShutdownState{})
 
 	// We only need to record minimal interface state for potential recreation.
@@ -113,6 +131,7 @@ func (m *Manager) Init(stateManager *statemanager.Manager) error {
 // If comment argument is empty firewall manager should set
 // rule ID as comment for the rule
 func (m *Manager) AddPeerFiltering(
+	_ []byte,
 	ip net.IP,
 	proto firewall.Protocol,
 	sPort *firewall.Port,
@@ -126,7 +145,14 @@ func (m *Manager) AddPeerFiltering(
 
 	rawIP := ip.To4()
 	if rawIP == nil {
-		return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+		if ip.To16() == nil {
+			return nil, fmt.Errorf("unsupported IP version: %s", ip.String())
+		}
+		if m.aclManager6 == nil {
+			log.Debugf("ipv6 filtering is not available, skipping rule for %s", ip)
+			return nil, nil
+		}
+		return m.aclManager6.AddPeerFiltering(ip, proto, sPort, dPort, action, ipsetName, comment)
 	}
 
 	return m.aclManager.AddPeerFiltering(ip, proto, sPort, dPort, action, ipsetName, comment)
@@ -155,6 +181,10 @@ func (m *Manager) DeletePeerRule(rule firewall.Rule) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if r, ok := rule.(*Rule); ok && r.ip.To4() == nil && m.aclManager6 != nil {
+		return m.aclManager6.DeletePeerRule(rule)
+	}
+
 	return m.aclManager.DeletePeerRule(rule)
 }
 
@@ -198,6 +228,12 @@ func (m *Manager) AllowNetbird() error {
 		return fmt.Errorf("failed to create default allow rules: %v", err)
 	}
 
+	if m.aclManager6 != nil {
+		if err := m.aclManager6.createDefaultAllowRules(); err != nil {
+			return fmt.Errorf("failed to create default ipv6 allow rules: %v", err)
+		}
+	}
+
 	chains, err := m.rConn.ListChainsOfTableFamily(nftables.TableFamilyIPv4)
 	if err != nil {
 		return fmt.Errorf("list of chains: %w", err)
@@ -326,11 +362,29 @@ func (m *Manager) Flush() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if m.aclManager6 != nil {
+		if err := m.aclManager6.Flush(); err != nil {
+			return fmt.Errorf("flush ipv6 acl: %w", err)
+		}
+	}
+
 	return m.aclManager.Flush()
 }
 
-func (m *Manager) createWorkTable() (*nftables.Table, error) {
-	tables, err := m.rConn.ListTablesOfFamily(nftables.TableFamilyIPv4)
+func (m *Manager) initIPv6() error {
+	workTable, err := m.createWorkTable(nftables.TableFamilyIPv6)
+	if err != nil {
+		return fmt.Errorf("create ipv6 work table: %w", err)
+	}
+
+	if err := m.aclManager6.init(workTable); err != nil {
+		return fmt.Errorf("ipv6 acl manager init: %w", err)
+	}
+	return nil
+}
+
+func (m *Manager) createWorkTable(family nftables.TableFamily) (*nftables.Table, error) {
+	tables, err := m.rConn.ListTablesOfFamily(family)
 	if err != nil {
 		return nil, fmt.Errorf("list of tables: %w", err)
 	}
@@ -341,7 +395,7 @@ func (m *Manager) createWorkTable() (*nftables.Table, error) {
 		}
 	}
 
-	table := m.rConn.AddTable(&nftables.Table{Name: tableNameNetbird, Family: nftables.TableFamilyIPv4})
+	table := m.rConn.AddTable(&nftables.Table{Name: tableNameNetbird, Family: family})
 	err = m.rConn.Flush()
 	return table, err
 }
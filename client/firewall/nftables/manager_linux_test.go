@@ -17,6 +17,7 @@ import (
 
 	fw "github.com/netbirdio/netbird/client/firewall/manager"
 	"github.com/netbirdio/netbird/client/iface"
+	"github.com/netbirdio/netbird/client/iface/device"
 )
 
 var ifaceMock = &iFaceMock{
@@ -74,7 +75,7 @@ func TestNftablesManager(t *testing.T) {
 
 	testClient := &nftables.Conn{}
 
-	rule, err := manager.AddPeerFiltering(ip, fw.ProtocolTCP, nil, &fw.Port{Values: []int{53}}, fw.ActionDrop, "", "")
+	rule, err := manager.AddPeerFiltering(nil, ip, fw.ProtocolTCP, nil, &fw.Port{Values: []int{53}}, fw.ActionDrop, "", "")
 	require.NoError(t, err, "failed to add rule")
 
 	err = manager.Flush()
@@ -166,6 +167,80 @@ func TestNftablesManager(t *testing.T) {
 	require.NoError(t, err, "failed to reset")
 }
 
+func TestNftablesManagerIPv6(t *testing.T) {
+	mock := &iFaceMock{
+		NameFunc: func() string {
+			return "lo"
+		},
+		AddressFunc: func() iface.WGAddress {
+			addr, err := device.ParseWGAddresses("100.96.0.1/16", "fd00:1234::1/64")
+			require.NoError(t, err)
+			return addr
+		},
+	}
+
+	manager, err := Create(mock)
+	require.NoError(t, err)
+	require.NoError(t, manager.Init(nil))
+	require.NotNil(t, manager.aclManager6, "ipv6 acl manager should be created")
+
+	defer func() {
+		require.NoError(t, manager.Reset(nil), "failed to reset")
+	}()
+
+	ip := net.ParseIP("fd00:1234::2")
+
+	rule, err := manager.AddPeerFiltering(nil, ip, fw.ProtocolICMP, nil, nil, fw.ActionAccept, "", "")
+	require.NoError(t, err, "failed to add rule")
+	require.NoError(t, manager.Flush(), "failed to flush")
+
+	testClient := &nftables.Conn{}
+	rules, err := testClient.GetRules(manager.aclManager6.workTable, manager.aclManager6.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, rules, 2, "expected established and peer rule")
+
+	expectedExprs := []expr.Any{
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       uint32(6),
+			Len:          uint32(1),
+		},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     []byte{unix.IPPROTO_ICMPV6},
+		},
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       8,
+			Len:          16,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     ip.To16(),
+		},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	}
+	require.ElementsMatch(t, rules[1].Exprs, expectedExprs, "expected the same expressions")
+
+	// ipv6 rules must not end up in the ipv4 table
+	rules, err = testClient.GetRules(manager.aclManager.workTable, manager.aclManager.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, rules, 1, "expected only the established rule")
+
+	for _, r := range rule {
+		require.NoError(t, manager.DeletePeerRule(r), "failed to delete rule")
+	}
+	require.NoError(t, manager.Flush(), "failed to flush")
+
+	rules, err = testClient.GetRules(manager.aclManager6.workTable, manager.aclManager6.chainInputRules)
+	require.NoError(t, err, "failed to get rules")
+	require.Len(t, rules, 1, "expected 1 rules after deletion")
+}
+
 func TestNFtablesCreatePerformance(t *testing.T) {
 	mock := &iFaceMock{
 		NameFunc: func() string {
@@ -201,7 +276,7 @@ func TestNFtablesCreatePerformance(t *testing.T) {
 			start := time.Now()
 			for i := 0; i < testMax; i++ {
 				port := &fw.Port{Values: []int{1000 + i}}
-				_, err = manager.AddPeerFiltering(ip, "tcp", nil, port, fw.ActionAccept, "", "accept HTTP traffic")
+				_, err = manager.AddPeerFiltering(nil, ip, "tcp", nil, port, fw.ActionAccept, "", "accept HTTP traffic")
 				require.NoError(t, err, "failed to add rule")
 
 				if i%100 == 0 {
@@ -283,7 +358,7 @@ func TestNftablesManagerCompatibilityWithIptables(t *testing.T) {
 	})
 
 	ip := net.ParseIP("100.96.0.1")
-	_, err = manager.AddPeerFiltering(ip, fw.ProtocolTCP, nil, &fw.Port{Values: []int{80}}, fw.ActionAccept, "", "test rule")
+	_, err = manager.AddPeerFiltering(nil, ip, fw.ProtocolTCP, nil, &fw.Port{Values: []int{80}}, fw.ActionAccept, "", "test rule")
 	require.NoError(t, err, "failed to add peer filtering rule")
 
 	_, err = manager.AddRouteFiltering(
@@ -3,19 +3,31 @@ package conntrack
 
 import (
 	"net"
+	"net/netip"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/google/uuid"
+
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 )
 
 // BaseConnTrack provides common fields and locking for all connection types
 type BaseConnTrack struct {
+	FlowId      uuid.UUID
+	Direction   nftypes.Direction
+	RuleID      []byte
 	SourceIP    net.IP
 	DestIP      net.IP
 	SourcePort  uint16
 	DestPort    uint16
 	lastSeen    atomic.Int64 // Unix nano for atomic access
 	established atomic.Bool
+	packetsTx   atomic.Uint64
+	packetsRx   atomic.Uint64
+	bytesTx     atomic.Uint64
+	bytesRx     atomic.Uint64
 }
 
 // these small methods will be inlined by the compiler
@@ -40,6 +52,23 @@ func (b *BaseConnTrack) GetLastSeen() time.Time {
 	return time.Unix(0, b.lastSeen.Load())
 }
 
+// UpdateCounters updates the packet and byte counters of the connection.
+// Outbound packets are counted as sent, inbound packets as received.
+func (b *BaseConnTrack) UpdateCounters(outbound bool, bytes int) {
+	if outbound {
+		b.packetsTx.Add(1)
+		b.bytesTx.Add(uint64(bytes))
+		return
+	}
+	b.packetsRx.Add(1)
+	b.bytesRx.Add(uint64(bytes))
+}
+
+// GetCounters returns the received and sent packets and bytes of the connection
+func (b *BaseConnTrack) GetCounters() (rxPackets, txPackets, rxBytes, txBytes uint64) {
+	return b.packetsRx.Load(), b.packetsTx.Load(), b.bytesRx.Load(), b.bytesTx.Load()
+}
+
 // timeoutExceeded checks if the connection has exceeded the given timeout
 func (b *BaseConnTrack) timeoutExceeded(timeout time.Duration) bool {
 	lastSeen := time.Unix(0, b.lastSeen.Load())
@@ -126,12 +155,46 @@ func (p *PreallocatedIPs) Put(ip net.IP) {
 	p.Pool.Put(&ip)
 }
 
+var v4InV6Prefix = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff}
+
 // copyIP copies an IP address efficiently
 func copyIP(dst, src net.IP) {
 	if len(src) == 16 {
 		copy(dst, src)
 	} else {
-		// Handle IPv4
+		// Handle IPv4, store it in the IPv4-mapped form as pooled IPs might be reused for IPv6
+		copy(dst, v4InV6Prefix)
 		copy(dst[12:], src.To4())
 	}
 }
+
+// flowEventFields builds the flow event fields common to all protocols.
+// Connections are stored from the local peer's point of view, ingress connections are reported with the
+// remote peer as the source.
+func (b *BaseConnTrack) flowEventFields(typ nftypes.Type, proto nftypes.Protocol) nftypes.EventFields {
+	fields := nftypes.EventFields{
+		FlowID:     b.FlowId,
+		Type:       typ,
+		RuleID:     b.RuleID,
+		Direction:  b.Direction,
+		Protocol:   proto,
+		SourceIP:   toAddr(b.SourceIP),
+		DestIP:     toAddr(b.DestIP),
+		SourcePort: b.SourcePort,
+		DestPort:   b.DestPort,
+	}
+	if b.Direction == nftypes.Ingress {
+		fields.SourceIP, fields.DestIP = fields.DestIP, fields.SourceIP
+		fields.SourcePort, fields.DestPort = fields.DestPort, fields.SourcePort
+	}
+
+	if typ == nftypes.TypeEnd {
+		fields.RxPackets, fields.TxPackets, fields.RxBytes, fields.TxBytes = b.GetCounters()
+	}
+	return fields
+}
+
+func toAddr(ip net.IP) netip.Addr {
+	addr, _ := netip.AddrFromSlice(ip)
+	return addr.Unmap()
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/google/gopacket/layers"
+	"github.com/google/uuid"
+
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 )
 
 const (
@@ -29,6 +32,8 @@ type ICMPConnTrack struct {
 	BaseConnTrack
 	Sequence uint16
 	ID       uint16
+	ICMPType uint8
+	ICMPCode uint8
 }
 
 // ICMPTracker manages ICMP connection states
@@ -39,10 +44,11 @@ type ICMPTracker struct {
 	mutex         sync.RWMutex
 	done          chan struct{}
 	ipPool        *PreallocatedIPs
+	flowLogger    nftypes.FlowLogger
 }
 
 // NewICMPTracker creates a new ICMP connection tracker
-func NewICMPTracker(timeout time.Duration) *ICMPTracker {
+func NewICMPTracker(timeout time.Duration, flowLogger nftypes.FlowLogger) *ICMPTracker {
 	if timeout == 0 {
 		timeout = DefaultICMPTimeout
 	}
@@ -53,6 +59,7 @@ func NewICMPTracker(timeout time.Duration) *ICMPTracker {
 		cleanupTicker: time.NewTicker(ICMPCleanupInterval),
 		done:          make(chan struct{}),
 		ipPool:        NewPreallocatedIPs(),
+		flowLogger:    flowLogger,
 	}
 
 	go tracker.cleanupRoutine()
@@ -60,7 +67,19 @@ func NewICMPTracker(timeout time.Duration) *ICMPTracker {
 }
 
 // TrackOutbound records an outbound ICMP Echo Request
-func (t *ICMPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16) {
+func (t *ICMPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, size int) {
+	t.track(srcIP, dstIP, id, seq, nftypes.Egress, nil, size)
+}
+
+// TrackInbound records an inbound ICMP Echo Request accepted by the rule with the given ID
+func (t *ICMPTracker) TrackInbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, ruleID []byte, size int) {
+	// connections are keyed from the local peer's point of view
+	t.track(dstIP, srcIP, id, seq, nftypes.Ingress, ruleID, size)
+}
+
+// track creates a connection entry if it doesn't exist yet and updates its state.
+// srcIP always belongs to the local peer.
+func (t *ICMPTracker) track(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, direction nftypes.Direction, ruleID []byte, size int) {
 	key := makeICMPKey(srcIP, dstIP, id, seq)
 	now := time.Now().UnixNano()
 
@@ -74,11 +93,15 @@ func (t *ICMPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, id uint16, seq u
 
 		conn = &ICMPConnTrack{
 			BaseConnTrack: BaseConnTrack{
-				SourceIP: srcIPCopy,
-				DestIP:   dstIPCopy,
+				FlowId:    uuid.New(),
+				Direction: direction,
+				RuleID:    ruleID,
+				SourceIP:  srcIPCopy,
+				DestIP:    dstIPCopy,
 			},
 			ID:       id,
 			Sequence: seq,
+			ICMPType: uint8(layers.ICMPv4TypeEchoRequest),
 		}
 		conn.lastSeen.Store(now)
 		conn.established.Store(true)
@@ -87,10 +110,16 @@ func (t *ICMPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, id uint16, seq u
 	t.mutex.Unlock()
 
 	conn.lastSeen.Store(now)
+	// egress tracking is called for outbound packets, ingress tracking for inbound ones
+	conn.UpdateCounters(direction == nftypes.Egress, size)
+
+	if !exists {
+		t.sendEvent(nftypes.TypeStart, conn)
+	}
 }
 
 // IsValidInbound checks if an inbound ICMP Echo Reply matches a tracked request
-func (t *ICMPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, icmpType uint8) bool {
+func (t *ICMPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, id uint16, seq uint16, icmpType uint8, size int) bool {
 	switch icmpType {
 	case uint8(layers.ICMPv4TypeDestinationUnreachable),
 		uint8(layers.ICMPv4TypeTimeExceeded):
@@ -115,11 +144,17 @@ func (t *ICMPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, id uint16, seq
 		return false
 	}
 
-	return conn.IsEstablished() &&
+	valid := conn.IsEstablished() &&
 		ValidateIPs(MakeIPAddr(srcIP), conn.DestIP) &&
 		ValidateIPs(MakeIPAddr(dstIP), conn.SourceIP) &&
 		conn.ID == id &&
 		conn.Sequence == seq
+
+	if valid {
+		conn.UpdateCounters(false, size)
+	}
+
+	return valid
 }
 
 func (t *ICMPTracker) cleanupRoutine() {
@@ -138,6 +173,7 @@ func (t *ICMPTracker) cleanup() {
 
 	for key, conn := range t.connections {
 		if conn.timeoutExceeded(t.timeout) {
+			t.sendEvent(nftypes.TypeEnd, conn)
 			t.ipPool.Put(conn.SourceIP)
 			t.ipPool.Put(conn.DestIP)
 			delete(t.connections, key)
@@ -159,6 +195,16 @@ func (t *ICMPTracker) Close() {
 	t.mutex.Unlock()
 }
 
+func (t *ICMPTracker) sendEvent(typ nftypes.Type, conn *ICMPConnTrack) {
+	if t.flowLogger == nil {
+		return
+	}
+	fields := conn.flowEventFields(typ, nftypes.ICMP)
+	fields.ICMPType = conn.ICMPType
+	fields.ICMPCode = conn.ICMPCode
+	t.flowLogger.StoreEvent(fields)
+}
+
 // makeICMPKey creates an ICMP connection key
 func makeICMPKey(srcIP net.IP, dstIP net.IP, id uint16, seq uint16) ICMPConnKey {
 	return ICMPConnKey{
@@ -6,6 +6,10 @@ import (
 	"net"
 	"sync"
 	"time"
+
+	"github.com/google/uuid"
+
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 )
 
 const (
@@ -73,16 +77,18 @@ type TCPTracker struct {
 	done          chan struct{}
 	timeout       time.Duration
 	ipPool        *PreallocatedIPs
+	flowLogger    nftypes.FlowLogger
 }
 
 // NewTCPTracker creates a new TCP connection tracker
-func NewTCPTracker(timeout time.Duration) *TCPTracker {
+func NewTCPTracker(timeout time.Duration, flowLogger nftypes.FlowLogger) *TCPTracker {
 	tracker := &TCPTracker{
 		connections:   make(map[ConnKey]*TCPConnTrack),
 		cleanupTicker: time.NewTicker(TCPCleanupInterval),
 		done:          make(chan struct{}),
 		timeout:       timeout,
 		ipPool:        NewPreallocatedIPs(),
+		flowLogger:    flowLogger,
 	}
 
 	go tracker.cleanupRoutine()
@@ -90,7 +96,19 @@ func NewTCPTracker(timeout time.Duration) *TCPTracker {
 }
 
 // TrackOutbound processes an outbound TCP packet and updates connection state
-func (t *TCPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8) {
+func (t *TCPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8, size int) {
+	t.track(srcIP, dstIP, srcPort, dstPort, flags, nftypes.Egress, nil, size)
+}
+
+// TrackInbound processes an inbound TCP packet accepted by the rule with the given ID and updates connection state
+func (t *TCPTracker) TrackInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8, ruleID []byte, size int) {
+	// connections are keyed from the local peer's point of view
+	t.track(dstIP, srcIP, dstPort, srcPort, flags, nftypes.Ingress, ruleID, size)
+}
+
+// track creates a connection entry if it doesn't exist yet and updates its state.
+// srcIP and srcPort always belong to the local peer.
+func (t *TCPTracker) track(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8, direction nftypes.Direction, ruleID []byte, size int) {
 	// Create key before lock
 	key := makeConnKey(srcIP, dstIP, srcPort, dstPort)
 	now := time.Now().UnixNano()
@@ -106,6 +124,9 @@ func (t *TCPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, d
 
 		conn = &TCPConnTrack{
 			BaseConnTrack: BaseConnTrack{
+				FlowId:     uuid.New(),
+				Direction:  direction,
+				RuleID:     ruleID,
 				SourceIP:   srcIPCopy,
 				DestIP:     dstIPCopy,
 				SourcePort: srcPort,
@@ -121,13 +142,19 @@ func (t *TCPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, d
 
 	// Lock individual connection for state update
 	conn.Lock()
-	t.updateState(conn, flags, true)
+	// egress tracking is called for outbound packets, ingress tracking for inbound ones
+	t.updateState(conn, flags, direction == nftypes.Egress)
 	conn.Unlock()
 	conn.lastSeen.Store(now)
+	conn.UpdateCounters(direction == nftypes.Egress, size)
+
+	if !exists {
+		t.sendEvent(nftypes.TypeStart, conn)
+	}
 }
 
 // IsValidInbound checks if an inbound TCP packet matches a tracked connection
-func (t *TCPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8) bool {
+func (t *TCPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, flags uint8, size int) bool {
 	if !isValidFlagCombination(flags) {
 		return false
 	}
@@ -149,6 +176,7 @@ func (t *TCPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16,
 			conn.State = TCPStateClosed
 			conn.SetEstablished(false)
 			conn.Unlock()
+			conn.UpdateCounters(false, size)
 			return true
 		}
 		conn.Unlock()
@@ -162,7 +190,12 @@ func (t *TCPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16,
 	isValidState := t.isValidStateForFlags(conn.State, flags)
 	conn.Unlock()
 
-	return isEstablished || isValidState
+	valid := isEstablished || isValidState
+	if valid {
+		conn.UpdateCounters(false, size)
+	}
+
+	return valid
 }
 
 // updateState updates the TCP connection state based on flags
@@ -177,22 +210,24 @@ func (t *TCPTracker) updateState(conn *TCPConnTrack, flags uint8, isOutbound boo
 	switch conn.State {
 	case TCPStateNew:
 		if flags&TCPSyn != 0 && flags&TCPAck == 0 {
-			conn.State = TCPStateSynSent
-		}
-
-	case TCPStateSynSent:
-		if flags&TCPSyn != 0 && flags&TCPAck != 0 {
 			if isOutbound {
-				conn.State = TCPStateSynReceived
+				conn.State = TCPStateSynSent
 			} else {
-				// Simultaneous open
-				conn.State = TCPStateEstablished
-				conn.SetEstablished(true)
+				// The remote peer opens the connection
+				conn.State = TCPStateSynReceived
 			}
 		}
 
+	case TCPStateSynSent:
+		// The remote peer acknowledges our SYN
+		if flags&TCPSyn != 0 && flags&TCPAck != 0 && !isOutbound {
+			conn.State = TCPStateEstablished
+			conn.SetEstablished(true)
+		}
+
 	case TCPStateSynReceived:
-		if flags&TCPAck != 0 && flags&TCPSyn == 0 {
+		// The remote peer acknowledges our SYN-ACK, the outbound SYN-ACK itself keeps the state
+		if flags&TCPAck != 0 && flags&TCPSyn == 0 && !isOutbound {
 			conn.State = TCPStateEstablished
 			conn.SetEstablished(true)
 		}
@@ -314,6 +349,8 @@ func (t *TCPTracker) cleanup() {
 
 		lastSeen := conn.GetLastSeen()
 		if time.Since(lastSeen) > timeout {
+			t.sendEvent(nftypes.TypeEnd, conn)
+
 			// Return IPs to pool
 			t.ipPool.Put(conn.SourceIP)
 			t.ipPool.Put(conn.DestIP)
@@ -337,6 +374,13 @@ func (t *TCPTracker) Close() {
 	t.mutex.Unlock()
 }
 
+func (t *TCPTracker) sendEvent(typ nftypes.Type, conn *TCPConnTrack) {
+	if t.flowLogger == nil {
+		return
+	}
+	t.flowLogger.StoreEvent(conn.flowEventFields(typ, nftypes.TCP))
+}
+
 func isValidFlagCombination(flags uint8) bool {
 	// Invalid: SYN+FIN
 	if flags&TCPSyn != 0 && flags&TCPFin != 0 {
@@ -4,6 +4,10 @@ import (
 	"net"
 	"sync"
 	"time"
+
+	"github.com/google/uuid"
+
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 )
 
 const (
@@ -26,10 +30,11 @@ type UDPTracker struct {
 	mutex         sync.RWMutex
 	done          chan struct{}
 	ipPool        *PreallocatedIPs
+	flowLogger    nftypes.FlowLogger
 }
 
 // NewUDPTracker creates a new UDP connection tracker
-func NewUDPTracker(timeout time.Duration) *UDPTracker {
+func NewUDPTracker(timeout time.Duration, flowLogger nftypes.FlowLogger) *UDPTracker {
 	if timeout == 0 {
 		timeout = DefaultUDPTimeout
 	}
@@ -40,6 +45,7 @@ func NewUDPTracker(timeout time.Duration) *UDPTracker {
 		cleanupTicker: time.NewTicker(UDPCleanupInterval),
 		done:          make(chan struct{}),
 		ipPool:        NewPreallocatedIPs(),
+		flowLogger:    flowLogger,
 	}
 
 	go tracker.cleanupRoutine()
@@ -47,7 +53,19 @@ func NewUDPTracker(timeout time.Duration) *UDPTracker {
 }
 
 // TrackOutbound records an outbound UDP connection
-func (t *UDPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16) {
+func (t *UDPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, size int) {
+	t.track(srcIP, dstIP, srcPort, dstPort, nftypes.Egress, nil, size)
+}
+
+// TrackInbound records an inbound UDP connection accepted by the rule with the given ID
+func (t *UDPTracker) TrackInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, ruleID []byte, size int) {
+	// connections are keyed from the local peer's point of view
+	t.track(dstIP, srcIP, dstPort, srcPort, nftypes.Ingress, ruleID, size)
+}
+
+// track creates a connection entry if it doesn't exist yet and updates its state.
+// srcIP and srcPort always belong to the local peer.
+func (t *UDPTracker) track(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, direction nftypes.Direction, ruleID []byte, size int) {
 	key := makeConnKey(srcIP, dstIP, srcPort, dstPort)
 	now := time.Now().UnixNano()
 
@@ -61,6 +79,9 @@ func (t *UDPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, d
 
 		conn = &UDPConnTrack{
 			BaseConnTrack: BaseConnTrack{
+				FlowId:     uuid.New(),
+				Direction:  direction,
+				RuleID:     ruleID,
 				SourceIP:   srcIPCopy,
 				DestIP:     dstIPCopy,
 				SourcePort: srcPort,
@@ -74,10 +95,16 @@ func (t *UDPTracker) TrackOutbound(srcIP net.IP, dstIP net.IP, srcPort uint16, d
 	t.mutex.Unlock()
 
 	conn.lastSeen.Store(now)
+	// egress tracking is called for outbound packets, ingress tracking for inbound ones
+	conn.UpdateCounters(direction == nftypes.Egress, size)
+
+	if !exists {
+		t.sendEvent(nftypes.TypeStart, conn)
+	}
 }
 
 // IsValidInbound checks if an inbound packet matches a tracked connection
-func (t *UDPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16) bool {
+func (t *UDPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16, dstPort uint16, size int) bool {
 	key := makeConnKey(dstIP, srcIP, dstPort, srcPort)
 
 	t.mutex.RLock()
@@ -92,11 +119,17 @@ func (t *UDPTracker) IsValidInbound(srcIP net.IP, dstIP net.IP, srcPort uint16,
 		return false
 	}
 
-	return conn.IsEstablished() &&
+	valid := conn.IsEstablished() &&
 		ValidateIPs(MakeIPAddr(srcIP), conn.DestIP) &&
 		ValidateIPs(MakeIPAddr(dstIP), conn.SourceIP) &&
 		conn.DestPort == srcPort &&
 		conn.SourcePort == dstPort
+
+	if valid {
+		conn.UpdateCounters(false, size)
+	}
+
+	return valid
 }
 
 // cleanupRoutine periodically removes stale connections
@@ -117,6 +150,7 @@ func (t *UDPTracker) cleanup() {
 
 	for key, conn := range t.connections {
 		if conn.timeoutExceeded(t.timeout) {
+			t.sendEvent(nftypes.TypeEnd, conn)
 			t.ipPool.Put(conn.SourceIP)
 			t.ipPool.Put(conn.DestIP)
 			delete(t.connections, key)
@@ -124,6 +158,13 @@ func (t *UDPTracker) cleanup() {
 	}
 }
 
+func (t *UDPTracker) sendEvent(typ nftypes.Type, conn *UDPConnTrack) {
+	if t.flowLogger == nil {
+		return
+	}
+	t.flowLogger.StoreEvent(conn.flowEventFields(typ, nftypes.UDP))
+}
+
 // Close stops the cleanup routine and releases resources
 func (t *UDPTracker) Close() {
 	t.cleanupTicker.Stop()
@@ -2,11 +2,15 @@ package conntrack
 
 import (
 	"net"
+	"net/netip"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/netflow/logger"
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 )
 
 func TestNewUDPTracker(t *testing.T) {
@@ -29,7 +33,7 @@ func TestNewUDPTracker(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tracker := NewUDPTracker(tt.timeout)
+			tracker := NewUDPTracker(tt.timeout, nil)
 			assert.NotNil(t, tracker)
 			assert.Equal(t, tt.wantTimeout, tracker.timeout)
 			assert.NotNil(t, tracker.connections)
@@ -40,7 +44,7 @@ func TestNewUDPTracker(t *testing.T) {
 }
 
 func TestUDPTracker_TrackOutbound(t *testing.T) {
-	tracker := NewUDPTracker(DefaultUDPTimeout)
+	tracker := NewUDPTracker(DefaultUDPTimeout, nil)
 	defer tracker.Close()
 
 	srcIP := net.ParseIP("192.168.1.2")
@@ -48,7 +52,7 @@ func TestUDPTracker_TrackOutbound(t *testing.T) {
 	srcPort := uint16(12345)
 	dstPort := uint16(53)
 
-	tracker.TrackOutbound(srcIP, dstIP, srcPort, dstPort)
+	tracker.TrackOutbound(srcIP, dstIP, srcPort, dstPort, 0)
 
 	// Verify connection was tracked
 	key := makeConnKey(srcIP, dstIP, srcPort, dstPort)
@@ -63,7 +67,7 @@ func TestUDPTracker_TrackOutbound(t *testing.T) {
 }
 
 func TestUDPTracker_IsValidInbound(t *testing.T) {
-	tracker := NewUDPTracker(1 * time.Second)
+	tracker := NewUDPTracker(1*time.Second, nil)
 	defer tracker.Close()
 
 	srcIP := net.ParseIP("192.168.1.2")
@@ -72,7 +76,7 @@ func TestUDPTracker_IsValidInbound(t *testing.T) {
 	dstPort := uint16(53)
 
 	// Track outbound connection
-	tracker.TrackOutbound(srcIP, dstIP, srcPort, dstPort)
+	tracker.TrackOutbound(srcIP, dstIP, srcPort, dstPort, 0)
 
 	tests := []struct {
 		name    string
@@ -144,7 +148,7 @@ func TestUDPTracker_IsValidInbound(t *testing.T) {
 			if tt.sleep > 0 {
 				time.Sleep(tt.sleep)
 			}
-			got := tracker.IsValidInbound(tt.srcIP, tt.dstIP, tt.srcPort, tt.dstPort)
+			got := tracker.IsValidInbound(tt.srcIP, tt.dstIP, tt.srcPort, tt.dstPort, 0)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -189,7 +193,7 @@ func TestUDPTracker_Cleanup(t *testing.T) {
 	}
 
 	for _, conn := range connections {
-		tracker.TrackOutbound(conn.srcIP, conn.dstIP, conn.srcPort, conn.dstPort)
+		tracker.TrackOutbound(conn.srcIP, conn.dstIP, conn.srcPort, conn.dstPort, 0)
 	}
 
 	// Verify initial connections
@@ -211,7 +215,7 @@ func TestUDPTracker_Cleanup(t *testing.T) {
 
 func BenchmarkUDPTracker(b *testing.B) {
 	b.Run("TrackOutbound", func(b *testing.B) {
-		tracker := NewUDPTracker(DefaultUDPTimeout)
+		tracker := NewUDPTracker(DefaultUDPTimeout, nil)
 		defer tracker.Close()
 
 		srcIP := net.ParseIP("192.168.1.1")
@@ -219,12 +223,12 @@ func BenchmarkUDPTracker(b *testing.B) {
 
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			tracker.TrackOutbound(srcIP, dstIP, uint16(i%65535), 80)
+			tracker.TrackOutbound(srcIP, dstIP, uint16(i%65535), 80, 0)
 		}
 	})
 
 	b.Run("IsValidInbound", func(b *testing.B) {
-		tracker := NewUDPTracker(DefaultUDPTimeout)
+		tracker := NewUDPTracker(DefaultUDPTimeout, nil)
 		defer tracker.Close()
 
 		srcIP := net.ParseIP("192.168.1.1")
@@ -232,12 +236,56 @@ func BenchmarkUDPTracker(b *testing.B) {
 
 		// Pre-populate some connections
 		for i := 0; i < 1000; i++ {
-			tracker.TrackOutbound(srcIP, dstIP, uint16(i), 80)
+			tracker.TrackOutbound(srcIP, dstIP, uint16(i), 80, 0)
 		}
 
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			tracker.IsValidInbound(dstIP, srcIP, 80, uint16(i%1000))
+			tracker.IsValidInbound(dstIP, srcIP, 80, uint16(i%1000), 0)
 		}
 	})
 }
+
+func TestUDPTracker_FlowEvents(t *testing.T) {
+	flowLogger := logger.New(logger.DefaultMaxEvents)
+	flowLogger.Enable()
+
+	tracker := NewUDPTracker(DefaultUDPTimeout, flowLogger)
+	defer tracker.Close()
+
+	localIP := net.ParseIP("100.64.0.1")
+	remoteIP := net.ParseIP("100.64.0.2")
+	ruleID := []byte("policy-id")
+
+	tracker.TrackInbound(remoteIP, localIP, 12345, 53, ruleID, 100)
+	tracker.TrackInbound(remoteIP, localIP, 12345, 53, ruleID, 100)
+	tracker.TrackOutbound(localIP, remoteIP, 53, 12345, 50)
+
+	events := flowLogger.GetEvents()
+	require.Len(t, events, 1, "only the first packet should start a flow")
+	start := events[0]
+	assert.Equal(t, nftypes.TypeStart, start.Type)
+	assert.Equal(t, nftypes.Ingress, start.Direction)
+	assert.Equal(t, nftypes.UDP, start.Protocol)
+	assert.Equal(t, ruleID, start.RuleID)
+	assert.Equal(t, netip.MustParseAddr("100.64.0.2"), start.SourceIP)
+	assert.Equal(t, netip.MustParseAddr("100.64.0.1"), start.DestIP)
+	assert.Equal(t, uint16(12345), start.SourcePort)
+	assert.Equal(t, uint16(53), start.DestPort)
+
+	// expire the connection and trigger the cleanup
+	conn, exists := tracker.GetConnection(localIP, 53, remoteIP, 12345)
+	require.True(t, exists)
+	conn.lastSeen.Store(time.Now().Add(-2 * DefaultUDPTimeout).UnixNano())
+	tracker.cleanup()
+
+	events = flowLogger.GetEvents()
+	require.Len(t, events, 2)
+	end := events[1]
+	assert.Equal(t, nftypes.TypeEnd, end.Type)
+	assert.Equal(t, start.FlowID, end.FlowID)
+	assert.Equal(t, uint64(2), end.RxPackets)
+	assert.Equal(t, uint64(200), end.RxBytes)
+	assert.Equal(t, uint64(1), end.TxPackets)
+	assert.Equal(t, uint64(50), end.TxBytes)
+}
@@ -9,6 +9,7 @@ import (
 // Rule to handle management of rules
 type Rule struct {
 	id         string
+	mgmtId     []byte
 	ip         net.IP
 	ipLayer    gopacket.LayerType
 	matchByIP  bool
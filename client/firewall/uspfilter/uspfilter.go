@@ -17,6 +17,7 @@ import (
 	"github.com/netbirdio/netbird/client/firewall/uspfilter/conntrack"
 	"github.com/netbirdio/netbird/client/iface"
 	"github.com/netbirdio/netbird/client/iface/device"
+	nftypes "github.com/netbirdio/netbird/client/internal/netflow/types"
 	"github.com/netbirdio/netbird/client/internal/statemanager"
 )
 
@@ -44,6 +45,7 @@ type Manager struct {
 	// incomingRules is used for filtering and hooks
 	incomingRules  map[string]RuleSet
 	wgNetwork      *net.IPNet
+	wgNetworkV6    *net.IPNet
 	decoders       sync.Pool
 	wgIface        IFaceMapper
 	nativeFirewall firewall.Manager
@@ -54,6 +56,7 @@ type Manager struct {
 	udpTracker  *conntrack.UDPTracker
 	icmpTracker *conntrack.ICMPTracker
 	tcpTracker  *conntrack.TCPTracker
+	flowLogger  nftypes.FlowLogger
 }
 
 // decoder for packages
@@ -67,15 +70,24 @@ type decoder struct {
 	icmp6   layers.ICMPv6
 	decoded []gopacket.LayerType
 	parser  *gopacket.DecodingLayerParser
+	parser6 *gopacket.DecodingLayerParser
+}
+
+// decodePacket decodes the packet with the parser matching the IP version of the packet
+func (d *decoder) decodePacket(packetData []byte) error {
+	if len(packetData) > 0 && packetData[0]>>4 == 6 {
+		return d.parser6.DecodeLayers(packetData, &d.decoded)
+	}
+	return d.parser.DecodeLayers(packetData, &d.decoded)
 }
 
 // Create userspace firewall manager constructor
-func Create(iface IFaceMapper) (*Manager, error) {
-	return create(iface)
+func Create(iface IFaceMapper, flowLogger nftypes.FlowLogger) (*Manager, error) {
+	return create(iface, flowLogger)
 }
 
-func CreateWithNativeFirewall(iface IFaceMapper, nativeFirewall firewall.Manager) (*Manager, error) {
-	mgr, err := create(iface)
+func CreateWithNativeFirewall(iface IFaceMapper, nativeFirewall firewall.Manager, flowLogger nftypes.FlowLogger) (*Manager, error) {
+	mgr, err := create(iface, flowLogger)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +96,7 @@ func CreateWithNativeFirewall(iface IFaceMapper, nativeFirewall firewall.Manager
 	return mgr, nil
 }
 
-func create(iface IFaceMapper) (*Manager, error) {
+func create(iface IFaceMapper, flowLogger nftypes.FlowLogger) (*Manager, error) {
 	disableConntrack, _ := strconv.ParseBool(os.Getenv(EnvDisableConntrack))
 
 	m := &Manager{
@@ -98,6 +110,11 @@ func create(iface IFaceMapper) (*Manager, error) {
 					&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
 				)
 				d.parser.IgnoreUnsupported = true
+				d.parser6 = gopacket.NewDecodingLayerParser(
+					layers.LayerTypeIPv6,
+					&d.eth, &d.ip4, &d.ip6, &d.icmp4, &d.icmp6, &d.tcp, &d.udp,
+				)
+				d.parser6.IgnoreUnsupported = true
 				return d
 			},
 		},
@@ -105,15 +122,16 @@ func create(iface IFaceMapper) (*Manager, error) {
 		incomingRules: make(map[string]RuleSet),
 		wgIface:       iface,
 		stateful:      !disableConntrack,
+		flowLogger:    flowLogger,
 	}
 
 	// Only initialize trackers if stateful mode is enabled
 	if disableConntrack {
 		log.Info("conntrack is disabled")
 	} else {
-		m.udpTracker = conntrack.NewUDPTracker(conntrack.DefaultUDPTimeout)
-		m.icmpTracker = conntrack.NewICMPTracker(conntrack.DefaultICMPTimeout)
-		m.tcpTracker = conntrack.NewTCPTracker(conntrack.DefaultTCPTimeout)
+		m.udpTracker = conntrack.NewUDPTracker(conntrack.DefaultUDPTimeout, flowLogger)
+		m.icmpTracker = conntrack.NewICMPTracker(conntrack.DefaultICMPTimeout, flowLogger)
+		m.tcpTracker = conntrack.NewTCPTracker(conntrack.DefaultTCPTimeout, flowLogger)
 	}
 
 	if err := iface.SetFilter(m); err != nil {
@@ -154,6 +172,7 @@ func (m *Manager) RemoveNatRule(pair firewall.RouterPair) error {
 // If comment argument is empty firewall manager should set
 // rule ID as comment for the rule
 func (m *Manager) AddPeerFiltering(
+	id []byte,
 	ip net.IP,
 	proto firewall.Protocol,
 	sPort *firewall.Port,
@@ -164,6 +183,7 @@ func (m *Manager) AddPeerFiltering(
 ) ([]firewall.Rule, error) {
 	r := Rule{
 		id:        uuid.New().String(),
+		mgmtId:    id,
 		ip:        ip,
 		ipLayer:   layers.LayerTypeIPv6,
 		matchByIP: true,
@@ -271,7 +291,7 @@ func (m *Manager) processOutgoingHooks(packetData []byte) bool {
 	d := m.decoders.Get().(*decoder)
 	defer m.decoders.Put(d)
 
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	if err := d.decodePacket(packetData); err != nil {
 		return false
 	}
 
@@ -288,7 +308,7 @@ func (m *Manager) processOutgoingHooks(packetData []byte) bool {
 	if d.decoded[1] == layers.LayerTypeUDP {
 		// Track UDP state only if enabled
 		if m.stateful {
-			m.trackUDPOutbound(d, srcIP, dstIP)
+			m.trackUDPOutbound(d, srcIP, dstIP, len(packetData))
 		}
 		return m.checkUDPHooks(d, dstIP, packetData)
 	}
@@ -297,9 +317,9 @@ func (m *Manager) processOutgoingHooks(packetData []byte) bool {
 	if m.stateful {
 		switch d.decoded[1] {
 		case layers.LayerTypeTCP:
-			m.trackTCPOutbound(d, srcIP, dstIP)
+			m.trackTCPOutbound(d, srcIP, dstIP, len(packetData))
 		case layers.LayerTypeICMPv4:
-			m.trackICMPOutbound(d, srcIP, dstIP)
+			m.trackICMPOutbound(d, srcIP, dstIP, len(packetData))
 		}
 	}
 
@@ -317,7 +337,7 @@ func (m *Manager) extractIPs(d *decoder) (srcIP, dstIP net.IP) {
 	}
 }
 
-func (m *Manager) trackTCPOutbound(d *decoder, srcIP, dstIP net.IP) {
+func (m *Manager) trackTCPOutbound(d *decoder, srcIP, dstIP net.IP, size int) {
 	flags := getTCPFlags(&d.tcp)
 	m.tcpTracker.TrackOutbound(
 		srcIP,
@@ -325,6 +345,7 @@ func (m *Manager) trackTCPOutbound(d *decoder, srcIP, dstIP net.IP) {
 		uint16(d.tcp.SrcPort),
 		uint16(d.tcp.DstPort),
 		flags,
+		size,
 	)
 }
 
@@ -351,12 +372,13 @@ func getTCPFlags(tcp *layers.TCP) uint8 {
 	return flags
 }
 
-func (m *Manager) trackUDPOutbound(d *decoder, srcIP, dstIP net.IP) {
+func (m *Manager) trackUDPOutbound(d *decoder, srcIP, dstIP net.IP, size int) {
 	m.udpTracker.TrackOutbound(
 		srcIP,
 		dstIP,
 		uint16(d.udp.SrcPort),
 		uint16(d.udp.DstPort),
+		size,
 	)
 }
 
@@ -373,13 +395,14 @@ func (m *Manager) checkUDPHooks(d *decoder, dstIP net.IP, packetData []byte) boo
 	return false
 }
 
-func (m *Manager) trackICMPOutbound(d *decoder, srcIP, dstIP net.IP) {
+func (m *Manager) trackICMPOutbound(d *decoder, srcIP, dstIP net.IP, size int) {
 	if d.icmp4.TypeCode.Type() == layers.ICMPv4TypeEchoRequest {
 		m.icmpTracker.TrackOutbound(
 			srcIP,
 			dstIP,
 			d.icmp4.Id,
 			d.icmp4.Seq,
+			size,
 		)
 	}
 }
@@ -409,15 +432,28 @@ func (m *Manager) dropFilter(packetData []byte, rules map[string]RuleSet) bool {
 	}
 
 	// Check connection state only if enabled
-	if m.stateful && m.isValidTrackedConnection(d, srcIP, dstIP) {
+	if m.stateful && m.isValidTrackedConnection(d, srcIP, dstIP, len(packetData)) {
 		return false
 	}
 
-	return m.applyRules(srcIP, packetData, rules, d)
+	ruleID, drop, matched := m.applyRules(srcIP, packetData, rules, d)
+	if drop {
+		// packets consumed by UDP hooks are not reported as dropped
+		if !matched || ruleID != nil {
+			m.logDroppedPacket(d, srcIP, dstIP, ruleID, len(packetData))
+		}
+		return true
+	}
+
+	if m.stateful {
+		m.trackInbound(d, srcIP, dstIP, ruleID, len(packetData))
+	}
+
+	return false
 }
 
 func (m *Manager) isValidPacket(d *decoder, packetData []byte) bool {
-	if err := d.parser.DecodeLayers(packetData, &d.decoded); err != nil {
+	if err := d.decodePacket(packetData); err != nil {
 		log.Tracef("couldn't decode layer, err: %s", err)
 		return false
 	}
@@ -430,10 +466,13 @@ func (m *Manager) isValidPacket(d *decoder, packetData []byte) bool {
 }
 
 func (m *Manager) isWireguardTraffic(srcIP, dstIP net.IP) bool {
+	if srcIP.To4() == nil {
+		return m.wgNetworkV6 != nil && m.wgNetworkV6.Contains(srcIP) && m.wgNetworkV6.Contains(dstIP)
+	}
 	return m.wgNetwork.Contains(srcIP) && m.wgNetwork.Contains(dstIP)
 }
 
-func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool {
+func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP, size int) bool {
 	switch d.decoded[1] {
 	case layers.LayerTypeTCP:
 		return m.tcpTracker.IsValidInbound(
@@ -442,6 +481,7 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 			uint16(d.tcp.SrcPort),
 			uint16(d.tcp.DstPort),
 			getTCPFlags(&d.tcp),
+			size,
 		)
 
 	case layers.LayerTypeUDP:
@@ -450,6 +490,7 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 			dstIP,
 			uint16(d.udp.SrcPort),
 			uint16(d.udp.DstPort),
+			size,
 		)
 
 	case layers.LayerTypeICMPv4:
@@ -459,6 +500,7 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 			d.icmp4.Id,
 			d.icmp4.Seq,
 			d.icmp4.TypeCode.Type(),
+			size,
 		)
 
 		// TODO: ICMPv6
@@ -467,24 +509,100 @@ func (m *Manager) isValidTrackedConnection(d *decoder, srcIP, dstIP net.IP) bool
 	return false
 }
 
-func (m *Manager) applyRules(srcIP net.IP, packetData []byte, rules map[string]RuleSet, d *decoder) bool {
-	if filter, ok := validateRule(srcIP, packetData, rules[srcIP.String()], d); ok {
-		return filter
+// trackInbound records an inbound connection accepted by the rule with the given ID
+func (m *Manager) trackInbound(d *decoder, srcIP, dstIP net.IP, ruleID []byte, size int) {
+	switch d.decoded[1] {
+	case layers.LayerTypeTCP:
+		m.tcpTracker.TrackInbound(
+			srcIP,
+			dstIP,
+			uint16(d.tcp.SrcPort),
+			uint16(d.tcp.DstPort),
+			getTCPFlags(&d.tcp),
+			ruleID,
+			size,
+		)
+	case layers.LayerTypeUDP:
+		m.udpTracker.TrackInbound(
+			srcIP,
+			dstIP,
+			uint16(d.udp.SrcPort),
+			uint16(d.udp.DstPort),
+			ruleID,
+			size,
+		)
+	case layers.LayerTypeICMPv4:
+		if d.icmp4.TypeCode.Type() == layers.ICMPv4TypeEchoRequest {
+			m.icmpTracker.TrackInbound(
+				srcIP,
+				dstIP,
+				d.icmp4.Id,
+				d.icmp4.Seq,
+				ruleID,
+				size,
+			)
+		}
+	}
+}
+
+// logDroppedPacket reports a packet dropped by the rule with the given ID or by the default policy
+func (m *Manager) logDroppedPacket(d *decoder, srcIP, dstIP net.IP, ruleID []byte, size int) {
+	if m.flowLogger == nil || !m.flowLogger.Enabled() {
+		return
+	}
+
+	srcAddr, _ := netip.AddrFromSlice(srcIP)
+	dstAddr, _ := netip.AddrFromSlice(dstIP)
+
+	fields := nftypes.EventFields{
+		FlowID:    uuid.New(),
+		Type:      nftypes.TypeDrop,
+		RuleID:    ruleID,
+		Direction: nftypes.Ingress,
+		SourceIP:  srcAddr.Unmap(),
+		DestIP:    dstAddr.Unmap(),
+		RxPackets: 1,
+		RxBytes:   uint64(size),
+	}
+
+	switch d.decoded[1] {
+	case layers.LayerTypeTCP:
+		fields.Protocol = nftypes.TCP
+		fields.SourcePort = uint16(d.tcp.SrcPort)
+		fields.DestPort = uint16(d.tcp.DstPort)
+	case layers.LayerTypeUDP:
+		fields.Protocol = nftypes.UDP
+		fields.SourcePort = uint16(d.udp.SrcPort)
+		fields.DestPort = uint16(d.udp.DstPort)
+	case layers.LayerTypeICMPv4:
+		fields.Protocol = nftypes.ICMP
+		fields.ICMPType = d.icmp4.TypeCode.Type()
+		fields.ICMPCode = d.icmp4.TypeCode.Code()
+	}
+
+	m.flowLogger.StoreEvent(fields)
+}
+
+// applyRules returns the management ID of the rule that matched the packet, whether the packet should be dropped
+// and whether any rule matched at all. Packets not matched by any rule are dropped by the default policy.
+func (m *Manager) applyRules(srcIP net.IP, packetData []byte, rules map[string]RuleSet, d *decoder) ([]byte, bool, bool) {
+	if ruleID, filter, ok := validateRule(srcIP, packetData, rules[srcIP.String()], d); ok {
+		return ruleID, filter, true
 	}
 
-	if filter, ok := validateRule(srcIP, packetData, rules["0.0.0.0"], d); ok {
-		return filter
+	if ruleID, filter, ok := validateRule(srcIP, packetData, rules["0.0.0.0"], d); ok {
+		return ruleID, filter, true
 	}
 
-	if filter, ok := validateRule(srcIP, packetData, rules["::"], d); ok {
-		return filter
+	if ruleID, filter, ok := validateRule(srcIP, packetData, rules["::"], d); ok {
+		return ruleID, filter, true
 	}
 
 	// Default policy: DROP ALL
-	return true
+	return nil, true, false
 }
 
-func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decoder) (bool, bool) {
+func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decoder) ([]byte, bool, bool) {
 	payloadLayer := d.decoded[1]
 	for _, rule := range rules {
 		if rule.matchByIP && !ip.Equal(rule.ip) {
@@ -492,7 +610,7 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 		}
 
 		if rule.protoLayer == layerTypeAll {
-			return rule.drop, true
+			return rule.mgmtId, rule.drop, true
 		}
 
 		if payloadLayer != rule.protoLayer {
@@ -502,39 +620,43 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 		switch payloadLayer {
 		case layers.LayerTypeTCP:
 			if rule.sPort == 0 && rule.dPort == 0 {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 			if rule.sPort != 0 && rule.sPort == uint16(d.tcp.SrcPort) {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 			if rule.dPort != 0 && rule.dPort == uint16(d.tcp.DstPort) {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 		case layers.LayerTypeUDP:
 			// if rule has UDP hook (and if we are here we match this rule)
 			// we ignore rule.drop and call this hook
 			if rule.udpHook != nil {
-				return rule.udpHook(packetData), true
+				return rule.mgmtId, rule.udpHook(packetData), true
 			}
 
 			if rule.sPort == 0 && rule.dPort == 0 {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 			if rule.sPort != 0 && rule.sPort == uint16(d.udp.SrcPort) {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 			if rule.dPort != 0 && rule.dPort == uint16(d.udp.DstPort) {
-				return rule.drop, true
+				return rule.mgmtId, rule.drop, true
 			}
 		case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
-			return rule.drop, true
+			return rule.mgmtId, rule.drop, true
 		}
 	}
-	return false, false
+	return nil, false, false
 }
 
 // SetNetwork of the wireguard interface to which filtering applied
 func (m *Manager) SetNetwork(network *net.IPNet) {
+	if network.IP.To4() == nil {
+		m.wgNetworkV6 = network
+		return
+	}
 	m.wgNetwork = network
 }
 
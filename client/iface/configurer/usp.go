@@ -54,7 +54,7 @@ func (c *WGUSPConfigurer) ConfigureInterface(privateKey string, port int) error
 
 func (c *WGUSPConfigurer) UpdatePeer(peerKey string, allowedIps string, keepAlive time.Duration, endpoint *net.UDPAddr, preSharedKey *wgtypes.Key) error {
 	// parse allowed ips
-	_, ipNet, err := net.ParseCIDR(allowedIps)
+	ipNets, err := parseAllowedIPs(allowedIps)
 	if err != nil {
 		return err
 	}
@@ -67,7 +67,7 @@ func (c *WGUSPConfigurer) UpdatePeer(peerKey string, allowedIps string, keepAliv
 		PublicKey:         peerKeyParsed,
 		ReplaceAllowedIPs: false,
 		// don't replace allowed ips, wg will handle duplicated peer IP
-		AllowedIPs:                  []net.IPNet{*ipNet},
+		AllowedIPs:                  ipNets,
 		PersistentKeepaliveInterval: &keepAlive,
 		PresharedKey:                preSharedKey,
 		Endpoint:                    endpoint,
@@ -80,6 +80,19 @@ func (c *WGUSPConfigurer) UpdatePeer(peerKey string, allowedIps string, keepAliv
 	return c.device.IpcSet(toWgUserspaceString(config))
 }
 
+// parseAllowedIPs parses a comma separated list of CIDRs, e.g. "100.64.0.1/32,fd00::1/128"
+func parseAllowedIPs(allowedIps string) ([]net.IPNet, error) {
+	var ipNets []net.IPNet
+	for _, allowedIP := range strings.Split(allowedIps, ",") {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(allowedIP))
+		if err != nil {
+			return nil, err
+		}
+		ipNets = append(ipNets, *ipNet)
+	}
+	return ipNets, nil
+}
+
 func (c *WGUSPConfigurer) RemovePeer(peerKey string) error {
 	peerKeyParsed, err := wgtypes.ParseKey(peerKey)
 	if err != nil {
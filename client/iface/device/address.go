@@ -9,6 +9,10 @@ import (
 type WGAddress struct {
 	IP      net.IP
 	Network *net.IPNet
+
+	// IPv6 and NetworkV6 are the optional IPv6 overlay address of the interface
+	IPv6      net.IP
+	NetworkV6 *net.IPNet
 }
 
 // ParseWGAddress parse a string ("1.2.3.4/24") address to WG Address
@@ -23,7 +27,45 @@ func ParseWGAddress(address string) (WGAddress, error) {
 	}, nil
 }
 
+// ParseWGAddresses parses an IPv4 ("1.2.3.4/24") and an optional IPv6 ("fd00::1/64") address to WG Address
+func ParseWGAddresses(address, addressV6 string) (WGAddress, error) {
+	addr, err := ParseWGAddress(address)
+	if err != nil {
+		return WGAddress{}, err
+	}
+
+	if addressV6 == "" {
+		return addr, nil
+	}
+
+	ip, network, err := net.ParseCIDR(addressV6)
+	if err != nil {
+		return WGAddress{}, err
+	}
+	if ip.To4() != nil {
+		return WGAddress{}, fmt.Errorf("address %s is not an IPv6 address", addressV6)
+	}
+
+	addr.IPv6 = ip
+	addr.NetworkV6 = network
+	return addr, nil
+}
+
+// HasIPv6 returns true if the address has an IPv6 overlay address
+func (addr WGAddress) HasIPv6() bool {
+	return addr.IPv6 != nil && addr.NetworkV6 != nil
+}
+
 func (addr WGAddress) String() string {
 	maskSize, _ := addr.Network.Mask.Size()
 	return fmt.Sprintf("%s/%d", addr.IP.String(), maskSize)
 }
+
+// IPv6String returns the IPv6 address in CIDR notation or an empty string if the address has no IPv6
+func (addr WGAddress) IPv6String() string {
+	if !addr.HasIPv6() {
+		return ""
+	}
+	maskSize, _ := addr.NetworkV6.Mask.Size()
+	return fmt.Sprintf("%s/%d", addr.IPv6.String(), maskSize)
+}
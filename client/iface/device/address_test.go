@@ -0,0 +1,39 @@
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWGAddresses(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       string
+		addressV6     string
+		expectedV6    string
+		expectedError bool
+	}{
+		{name: "ipv4 only", address: "100.64.0.1/16"},
+		{name: "ipv4 and ipv6", address: "100.64.0.1/16", addressV6: "fd00:1234::1/64", expectedV6: "fd00:1234::1/64"},
+		{name: "invalid ipv6", address: "100.64.0.1/16", addressV6: "fd00::zz/64", expectedError: true},
+		{name: "ipv4 as ipv6 address", address: "100.64.0.1/16", addressV6: "100.64.0.2/16", expectedError: true},
+		{name: "invalid ipv4", address: "100.64.0.1", expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := ParseWGAddresses(tc.address, tc.addressV6)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.address, addr.String())
+			assert.Equal(t, tc.expectedV6 != "", addr.HasIPv6())
+			assert.Equal(t, tc.expectedV6, addr.IPv6String())
+		})
+	}
+}
@@ -5,6 +5,7 @@ package device
 import (
 	"fmt"
 	"os/exec"
+	"strconv"
 
 	log "github.com/sirupsen/logrus"
 	"golang.zx2c4.com/wireguard/device"
@@ -136,5 +137,25 @@ func (t *TunDevice) assignAddr() error {
 		log.Errorf("adding route command '%v' failed with output: %s", routeCmd.String(), out)
 		return err
 	}
+
+	if t.address.HasIPv6() {
+		t.assignAddrV6()
+	}
 	return nil
 }
+
+// assignAddrV6 adds the IPv6 overlay address and the route of the IPv6 overlay network to the tunnel interface.
+// Failures are not fatal as the IPv4 overlay keeps working without IPv6.
+func (t *TunDevice) assignAddrV6() {
+	prefixLen, _ := t.address.NetworkV6.Mask.Size()
+	cmd := exec.Command("ifconfig", t.name, "inet6", t.address.IPv6.String(), "prefixlen", strconv.Itoa(prefixLen), "alias")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Warnf("adding address command '%v' failed with output: %s", cmd.String(), out)
+		return
+	}
+
+	routeCmd := exec.Command("route", "add", "-inet6", "-net", t.address.NetworkV6.String(), "-interface", t.name)
+	if out, err := routeCmd.CombinedOutput(); err != nil {
+		log.Warnf("adding route command '%v' failed with output: %s", routeCmd.String(), out)
+	}
+}
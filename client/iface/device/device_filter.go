@@ -24,7 +24,8 @@ type PacketFilter interface {
 	// RemovePacketHook removes hook by ID
 	RemovePacketHook(hookID string) error
 
-	// SetNetwork of the wireguard interface to which filtering applied
+	// SetNetwork of the wireguard interface to which filtering applied.
+	// It is called once per address family if the interface has an IPv6 overlay address.
 	SetNetwork(*net.IPNet)
 }
 
@@ -44,7 +44,12 @@ func NewNetstackDevice(name string, address WGAddress, wgPort int, key string, m
 
 func (t *TunNetstackDevice) Create() (WGConfigurer, error) {
 	log.Info("create netstack tun interface")
-	t.nsTun = netstack.NewNetStackTun(t.listenAddress, t.address.IP.String(), t.mtu)
+	addresses := []string{t.address.IP.String()}
+	if t.address.HasIPv6() {
+		addresses = append(addresses, t.address.IPv6.String())
+	}
+
+	t.nsTun = netstack.NewNetStackTun(t.listenAddress, addresses, t.mtu)
 	tunIface, err := t.nsTun.Create()
 	if err != nil {
 		return nil, fmt.Errorf("error creating tun device: %s", err)
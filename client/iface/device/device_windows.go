@@ -167,5 +167,17 @@ func (t *TunDevice) GetInterfaceGUIDString() (string, error) {
 func (t *TunDevice) assignAddr() error {
 	luid := winipcfg.LUID(t.nativeTunDevice.LUID())
 	log.Debugf("adding address %s to interface: %s", t.address.IP, t.name)
-	return luid.SetIPAddresses([]netip.Prefix{netip.MustParsePrefix(t.address.String())})
+	if err := luid.SetIPAddresses([]netip.Prefix{netip.MustParsePrefix(t.address.String())}); err != nil {
+		return err
+	}
+
+	if t.address.HasIPv6() {
+		log.Debugf("adding address %s to interface: %s", t.address.IPv6, t.name)
+		// IPv6 might be disabled on the host, the IPv4 overlay keeps working without it
+		if err := luid.AddIPAddress(netip.MustParsePrefix(t.address.IPv6String())); err != nil {
+			log.Warnf("failed to add IPv6 address %s to interface %s: %v", t.address.IPv6String(), t.name, err)
+		}
+	}
+
+	return nil
 }
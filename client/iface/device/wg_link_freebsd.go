@@ -72,6 +72,16 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 		return fmt.Errorf("assign addr: %w", err)
 	}
 
+	if address.HasIPv6() {
+		prefixLen, _ := address.NetworkV6.Mask.Size()
+		log.Infof("assign addr %s/%d to %s interface", address.IPv6.String(), prefixLen, l.name)
+
+		// IPv6 might be disabled on the host, the IPv4 overlay keeps working without it
+		if err := link.AssignAddrV6(address.IPv6.String(), prefixLen); err != nil {
+			log.Warnf("failed to assign IPv6 address to %s interface: %v", l.name, err)
+		}
+	}
+
 	err = link.Up()
 	if err != nil {
 		return fmt.Errorf("up: %w", err)
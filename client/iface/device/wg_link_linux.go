@@ -8,6 +8,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
 type wgLink struct {
@@ -81,6 +82,29 @@ func (l *wgLink) setMTU(mtu int) error {
 	return nil
 }
 
+// assignAddrV6 adds the IPv6 overlay address to the interface. Failures are not fatal as IPv6 might be disabled on the host.
+func (l *wgLink) assignAddrV6(address WGAddress) {
+	name := l.attrs.Name
+	addrStr := address.IPv6String()
+
+	log.Debugf("adding address %s to interface: %s", addrStr, name)
+
+	addr, err := netlink.ParseAddr(addrStr)
+	if err != nil {
+		log.Warnf("failed to parse IPv6 address %s: %v", addrStr, err)
+		return
+	}
+	// the overlay address is unique within the account, skip the duplicate address detection
+	addr.Flags = unix.IFA_F_NODAD
+
+	err = netlink.AddrAdd(l, addr)
+	if os.IsExist(err) {
+		log.Infof("interface %s already has the address: %s", name, addrStr)
+	} else if err != nil {
+		log.Warnf("failed to add IPv6 address %s to interface %s, IPv6 overlay traffic will not work: %v", addrStr, name, err)
+	}
+}
+
 func (l *wgLink) up() error {
 	if err := netlink.LinkSetUp(l); err != nil {
 		log.Errorf("error bringing up interface: %s", l.attrs.Name)
@@ -124,6 +148,10 @@ func (l *wgLink) assignAddr(address WGAddress) error {
 		return fmt.Errorf("add addr: %w", err)
 	}
 
+	if address.HasIPv6() {
+		l.assignAddrV6(address)
+	}
+
 	// On linux, the link must be brought up
 	if err := netlink.LinkSetUp(l); err != nil {
 		return fmt.Errorf("link setup: %w", err)
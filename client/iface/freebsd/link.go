@@ -103,6 +103,11 @@ func (l *Link) AssignAddr(ip, netmask string) error {
 	return l.setAddr(ip, netmask)
 }
 
+// AssignAddrV6 adds an IPv6 address with the given prefix length to the interface
+func (l *Link) AssignAddrV6(ip string, prefixLen int) error {
+	return l.setAddrV6(ip, prefixLen)
+}
+
 func (l *Link) Up() error {
 	return l.up(l.name)
 }
@@ -206,6 +211,21 @@ func (l *Link) setAddr(ip, netmask string) error {
 	return nil
 }
 
+func (l *Link) setAddrV6(ip string, prefixLen int) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("ifconfig", l.name, "inet6", ip, "prefixlen", strconv.Itoa(prefixLen), "alias")
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		log.Debugf("ifconfig out: %s", stderr.String())
+
+		return fmt.Errorf("set interface inet6 addr: %w", err)
+	}
+
+	return nil
+}
+
 func (l *Link) up(name string) error {
 	var stderr bytes.Buffer
 
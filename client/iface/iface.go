@@ -34,6 +34,7 @@ type wgProxyFactory interface {
 type WGIFaceOpts struct {
 	IFaceName    string
 	Address      string
+	AddressV6    string
 	WGPort       int
 	WGPrivKey    string
 	MTU          int
@@ -94,12 +95,12 @@ func (w *WGIface) Up() (*bind.UniversalUDPMuxDefault, error) {
 	return w.tun.Up()
 }
 
-// UpdateAddr updates address of the interface
-func (w *WGIface) UpdateAddr(newAddr string) error {
+// UpdateAddr updates the IPv4 and the optional IPv6 address of the interface
+func (w *WGIface) UpdateAddr(newAddr, newAddrV6 string) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	addr, err := device.ParseWGAddress(newAddr)
+	addr, err := device.ParseWGAddresses(newAddr, newAddrV6)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,11 @@ func (w *WGIface) SetFilter(filter device.PacketFilter) error {
 	}
 
 	w.filter = filter
-	w.filter.SetNetwork(w.tun.WgAddress().Network)
+	addr := w.tun.WgAddress()
+	w.filter.SetNetwork(addr.Network)
+	if addr.HasIPv6() {
+		w.filter.SetNetwork(addr.NetworkV6)
+	}
 
 	w.tun.FilteredDevice().SetFilter(filter)
 	return nil
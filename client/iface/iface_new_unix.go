@@ -13,7 +13,7 @@ import (
 
 // NewWGIFace Creates a new WireGuard interface instance
 func NewWGIFace(opts WGIFaceOpts) (*WGIface, error) {
-	wgAddress, err := device.ParseWGAddress(opts.Address)
+	wgAddress, err := device.ParseWGAddresses(opts.Address, opts.AddressV6)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func TestWGIface_UpdateAddr(t *testing.T) {
 
 	//update WireGuard address
 	addr = "100.64.0.2/8"
-	err = iface.UpdateAddr(addr)
+	err = iface.UpdateAddr(addr, "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -22,7 +22,7 @@ type IWGIface interface {
 	Address() device.WGAddress
 	ToInterface() *net.Interface
 	Up() (*bind.UniversalUDPMuxDefault, error)
-	UpdateAddr(newAddr string) error
+	UpdateAddr(newAddr, newAddrV6 string) error
 	GetProxy() wgproxy.Proxy
 	UpdatePeer(peerKey string, allowedIps string, keepAlive time.Duration, endpoint *net.UDPAddr, preSharedKey *wgtypes.Key) error
 	RemovePeer(peerKey string) error
@@ -9,7 +9,7 @@ import (
 )
 
 type NetStackTun struct { //nolint:revive
-	address       string
+	addresses     []string
 	mtu           int
 	listenAddress string
 
@@ -17,17 +17,23 @@ type NetStackTun struct { //nolint:revive
 	tundev tun.Device
 }
 
-func NewNetStackTun(listenAddress string, address string, mtu int) *NetStackTun {
+// NewNetStackTun creates a netstack tun with the given local addresses, e.g. the IPv4 and the IPv6 overlay address
+func NewNetStackTun(listenAddress string, addresses []string, mtu int) *NetStackTun {
 	return &NetStackTun{
-		address:       address,
+		addresses:     addresses,
 		mtu:           mtu,
 		listenAddress: listenAddress,
 	}
 }
 
 func (t *NetStackTun) Create() (tun.Device, error) {
+	localAddresses := make([]netip.Addr, 0, len(t.addresses))
+	for _, address := range t.addresses {
+		localAddresses = append(localAddresses, netip.MustParseAddr(address))
+	}
+
 	nsTunDev, tunNet, err := netstack.CreateNetTUN(
-		[]netip.Addr{netip.MustParseAddr(t.address)},
+		localAddresses,
 		[]netip.Addr{},
 		t.mtu)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/netip"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -110,6 +111,15 @@ func (d *DefaultManager) applyPeerACLs(networkMap *mgmProto.NetworkMap) {
 			Protocol:  mgmProto.RuleProtocol_TCP,
 			Port:      strconv.Itoa(ssh.DefaultSSHPort),
 		})
+		if networkMap.PeerConfig.GetAddressV6() != "" {
+			rules = append(rules, &mgmProto.FirewallRule{
+				PeerIP:    "::",
+				Direction: mgmProto.RuleDirection_IN,
+				Action:    mgmProto.RuleAction_ACCEPT,
+				Protocol:  mgmProto.RuleProtocol_TCP,
+				Port:      strconv.Itoa(ssh.DefaultSSHPort),
+			})
+		}
 	}
 
 	// if we got empty rules list but management not set networkMap.FirewallRulesIsEmpty flag
@@ -286,11 +296,11 @@ func (d *DefaultManager) protoRuleToFirewallRule(
 	var rules []firewall.Rule
 	switch r.Direction {
 	case mgmProto.RuleDirection_IN:
-		rules, err = d.addInRules(ip, protocol, port, action, ipsetName, "")
+		rules, err = d.addInRules(r.PolicyID, ip, protocol, port, action, ipsetName, "")
 	case mgmProto.RuleDirection_OUT:
 		// TODO: Remove this soon. Outbound rules are obsolete.
 		// We only maintain this for return traffic (inbound dir) which is now handled by the stateful firewall already
-		rules, err = d.addOutRules(ip, protocol, port, action, ipsetName, "")
+		rules, err = d.addOutRules(r.PolicyID, ip, protocol, port, action, ipsetName, "")
 	default:
 		return "", nil, fmt.Errorf("invalid direction, skipping firewall rule")
 	}
@@ -303,6 +313,7 @@ func (d *DefaultManager) protoRuleToFirewallRule(
 }
 
 func (d *DefaultManager) addInRules(
+	id []byte,
 	ip net.IP,
 	protocol firewall.Protocol,
 	port *firewall.Port,
@@ -310,7 +321,7 @@ func (d *DefaultManager) addInRules(
 	ipsetName string,
 	comment string,
 ) ([]firewall.Rule, error) {
-	rule, err := d.firewall.AddPeerFiltering(ip, protocol, nil, port, action, ipsetName, comment)
+	rule, err := d.firewall.AddPeerFiltering(id, ip, protocol, nil, port, action, ipsetName, comment)
 	if err != nil {
 		return nil, fmt.Errorf("add firewall rule: %w", err)
 	}
@@ -319,6 +330,7 @@ func (d *DefaultManager) addInRules(
 }
 
 func (d *DefaultManager) addOutRules(
+	id []byte,
 	ip net.IP,
 	protocol firewall.Protocol,
 	port *firewall.Port,
@@ -330,7 +342,7 @@ func (d *DefaultManager) addOutRules(
 		return nil, nil
 	}
 
-	rule, err := d.firewall.AddPeerFiltering(ip, protocol, port, nil, action, ipsetName, comment)
+	rule, err := d.firewall.AddPeerFiltering(id, ip, protocol, port, nil, action, ipsetName, comment)
 	if err != nil {
 		return nil, fmt.Errorf("add firewall rule: %w", err)
 	}
@@ -364,9 +376,13 @@ func (d *DefaultManager) squashAcceptRules(
 	networkMap *mgmProto.NetworkMap,
 ) ([]*mgmProto.FirewallRule, map[mgmProto.RuleProtocol]struct{}) {
 	totalIPs := 0
+	hasIPv6 := false
 	for _, p := range append(networkMap.RemotePeers, networkMap.OfflinePeers...) {
-		for range p.AllowedIps {
+		for _, allowedIP := range p.AllowedIps {
 			totalIPs++
+			if strings.Contains(allowedIP, ":") {
+				hasIPv6 = true
+			}
 		}
 	}
 
@@ -399,7 +415,7 @@ func (d *DefaultManager) squashAcceptRules(
 		// special case, when we receive this all network IP address
 		// it means that rules for that protocol was already optimized on the
 		// management side
-		if r.PeerIP == "0.0.0.0" {
+		if r.PeerIP == "0.0.0.0" || r.PeerIP == "::" {
 			squashedRules = append(squashedRules, r)
 			squashedProtocols[r.Protocol] = struct{}{}
 			return
@@ -447,6 +463,15 @@ func (d *DefaultManager) squashAcceptRules(
 				Action:    mgmProto.RuleAction_ACCEPT,
 				Protocol:  protocol,
 			})
+			// the same for IPv6 with the special rule ::
+			if hasIPv6 {
+				squashedRules = append(squashedRules, &mgmProto.FirewallRule{
+					PeerIP:    "::",
+					Direction: direction,
+					Action:    mgmProto.RuleAction_ACCEPT,
+					Protocol:  protocol,
+				})
+			}
 			squashedProtocols[protocol] = struct{}{}
 
 			if protocol == mgmProto.RuleProtocol_ALL {
@@ -51,7 +51,7 @@ func TestDefaultManager(t *testing.T) {
 	}).AnyTimes()
 
 	// we receive one rule from the management so for testing purposes ignore it
-	fw, err := firewall.NewFirewall(ifaceMock, nil)
+	fw, err := firewall.NewFirewall(ifaceMock, nil, nil)
 	if err != nil {
 		t.Errorf("create firewall: %v", err)
 		return
@@ -226,6 +226,42 @@ func TestDefaultManagerSquashRules(t *testing.T) {
 	}
 }
 
+func TestDefaultManagerSquashRulesIPv6(t *testing.T) {
+	networkMap := &mgmProto.NetworkMap{
+		RemotePeers: []*mgmProto.RemotePeerConfig{
+			{AllowedIps: []string{"10.93.0.1/32", "fd00::1/128"}},
+			{AllowedIps: []string{"10.93.0.2/32", "fd00::2/128"}},
+		},
+	}
+	for _, ip := range []string{"10.93.0.1", "fd00::1", "10.93.0.2", "fd00::2"} {
+		networkMap.FirewallRules = append(networkMap.FirewallRules, &mgmProto.FirewallRule{
+			PeerIP:    ip,
+			Direction: mgmProto.RuleDirection_IN,
+			Action:    mgmProto.RuleAction_ACCEPT,
+			Protocol:  mgmProto.RuleProtocol_ALL,
+		})
+	}
+
+	manager := &DefaultManager{}
+	rules, _ := manager.squashAcceptRules(networkMap)
+	if len(rules) != 2 {
+		t.Errorf("rules should contain 2, got: %v", rules)
+		return
+	}
+
+	for i, ip := range []string{"0.0.0.0", "::"} {
+		r := rules[i]
+		switch {
+		case r.PeerIP != ip:
+			t.Errorf("IP should be %s, got: %v", ip, r.PeerIP)
+		case r.Direction != mgmProto.RuleDirection_IN:
+			t.Errorf("direction should be IN, got: %v", r.Direction)
+		case r.Protocol != mgmProto.RuleProtocol_ALL:
+			t.Errorf("protocol should be ALL, got: %v", r.Protocol)
+		}
+	}
+}
+
 func TestDefaultManagerSquashRulesNoAffect(t *testing.T) {
 	networkMap := &mgmProto.NetworkMap{
 		RemotePeers: []*mgmProto.RemotePeerConfig{
@@ -344,7 +380,7 @@ func TestDefaultManagerEnableSSHRules(t *testing.T) {
 	}).AnyTimes()
 
 	// we receive one rule from the management so for testing purposes ignore it
-	fw, err := firewall.NewFirewall(ifaceMock, nil)
+	fw, err := firewall.NewFirewall(ifaceMock, nil, nil)
 	if err != nil {
 		t.Errorf("create firewall: %v", err)
 		return
@@ -0,0 +1,199 @@
+package dns
+
+import (
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	// PriorityBlocklist is the priority of the blocklist handler, blocked domains take precedence over all other handlers
+	PriorityBlocklist = 150
+
+	// blocklistTTL is the TTL of the sinkhole answers of blocked queries
+	blocklistTTL = 60
+)
+
+// blocklist is a blocklist of the configuration with its blocked queries counter
+type blocklist struct {
+	name         string
+	sinkhole     bool
+	sinkholeIPv4 netip.Addr
+	sinkholeIPv6 netip.Addr
+	blocked      *atomic.Uint64
+}
+
+// blocklistResolver answers the queries for blocked domains and their subdomains
+// and passes the other queries on to the next handler of the chain
+type blocklistResolver struct {
+	mu         sync.RWMutex
+	blocklists []*blocklist
+	// domains maps the blocked domains to the first blocklist that contains them
+	domains map[string]*blocklist
+	// counters keeps the blocked queries per blocklist ID across configuration updates
+	counters map[string]*atomic.Uint64
+}
+
+func newBlocklistResolver() *blocklistResolver {
+	return &blocklistResolver{
+		domains:  make(map[string]*blocklist),
+		counters: make(map[string]*atomic.Uint64),
+	}
+}
+
+// String returns a string representation of the blocklist resolver
+func (b *blocklistResolver) String() string {
+	return "blocklist resolver"
+}
+
+// update replaces the blocked domains and returns whether any domain is blocked
+func (b *blocklistResolver) update(update []nbdns.Blocklist) bool {
+	blocklists := make([]*blocklist, 0, len(update))
+	domains := make(map[string]*blocklist)
+	counters := make(map[string]*atomic.Uint64, len(update))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, config := range update {
+		counter, ok := b.counters[config.ID]
+		if !ok {
+			counter = &atomic.Uint64{}
+		}
+		counters[config.ID] = counter
+
+		list := &blocklist{
+			name:         config.Name,
+			sinkhole:     config.Sinkhole,
+			sinkholeIPv4: config.SinkholeIPv4,
+			sinkholeIPv6: config.SinkholeIPv6,
+			blocked:      counter,
+		}
+		blocklists = append(blocklists, list)
+
+		for _, domain := range config.Domains {
+			domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+			if _, exists := domains[domain]; !exists && domain != "" {
+				domains[domain] = list
+			}
+		}
+	}
+
+	b.blocklists = blocklists
+	b.domains = domains
+	b.counters = counters
+
+	log.Debugf("blocking %d domains from %d blocklists", len(domains), len(blocklists))
+
+	return len(domains) > 0
+}
+
+// match returns the blocklist blocking the name or one of its parent domains
+func (b *blocklistResolver) match(name string) *blocklist {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for name != "" {
+		if list, ok := b.domains[name]; ok {
+			return list
+		}
+		_, parent, found := strings.Cut(name, ".")
+		if !found {
+			break
+		}
+		name = parent
+	}
+
+	return nil
+}
+
+// ServeDNS answers queries for blocked domains with NXDOMAIN or the sinkhole address of the blocklist
+func (b *blocklistResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	if len(r.Question) == 0 {
+		return
+	}
+	question := r.Question[0]
+
+	list := b.match(question.Name)
+	if list == nil {
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeNameError)
+		// Set Zero bit to signal handler chain to continue
+		resp.MsgHdr.Zero = true
+		if err := w.WriteMsg(resp); err != nil {
+			log.Errorf("failed writing DNS continue response: %v", err)
+		}
+		return
+	}
+
+	list.blocked.Add(1)
+	log.Tracef("blocked query for domain=%s type=%s by blocklist %s",
+		question.Name, dns.TypeToString[question.Qtype], list.name)
+
+	resp := new(dns.Msg)
+	resp.SetReply(r)
+	resp.Authoritative = true
+	resp.RecursionAvailable = true
+
+	if !list.sinkhole {
+		resp.Rcode = dns.RcodeNameError
+	} else if rr := list.sinkholeRecord(question); rr != nil {
+		resp.Answer = append(resp.Answer, rr)
+	}
+
+	if err := w.WriteMsg(resp); err != nil {
+		log.Errorf("failed writing DNS blocklist response: %v", err)
+	}
+}
+
+// sinkholeRecord returns the sinkhole answer for the question, nil for query types without a sinkhole address
+func (l *blocklist) sinkholeRecord(question dns.Question) dns.RR {
+	header := dns.RR_Header{Name: question.Name, Rrtype: question.Qtype, Class: dns.ClassINET, Ttl: blocklistTTL}
+
+	switch question.Qtype {
+	case dns.TypeA:
+		addr := netip.IPv4Unspecified()
+		if l.sinkholeIPv4.Is4() {
+			addr = l.sinkholeIPv4
+		}
+		return &dns.A{Hdr: header, A: net.IP(addr.AsSlice())}
+	case dns.TypeAAAA:
+		addr := netip.IPv6Unspecified()
+		if l.sinkholeIPv6.Is6() {
+			addr = l.sinkholeIPv6
+		}
+		return &dns.AAAA{Hdr: header, AAAA: net.IP(addr.AsSlice())}
+	default:
+		return nil
+	}
+}
+
+// Stats returns the number of blocked queries per blocklist
+func (b *blocklistResolver) Stats() []peer.DNSBlocklistStats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	stats := make([]peer.DNSBlocklistStats, 0, len(b.blocklists))
+	for _, list := range b.blocklists {
+		stats = append(stats, peer.DNSBlocklistStats{
+			Name:    list.name,
+			Blocked: list.blocked.Load(),
+		})
+	}
+	slices.SortFunc(stats, func(a, b peer.DNSBlocklistStats) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return stats
+}
@@ -0,0 +1,99 @@
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func TestBlocklistResolver_ServeDNS(t *testing.T) {
+	resolver := newBlocklistResolver()
+	require.True(t, resolver.update([]nbdns.Blocklist{
+		{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com", "Tracker.io."}},
+		{
+			ID:           "malware",
+			Name:         "Malware",
+			Domains:      []string{"malware.test", "ads.example.com"},
+			Sinkhole:     true,
+			SinkholeIPv4: netip.MustParseAddr("10.0.0.1"),
+		},
+	}))
+
+	upstream := dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(r)
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+			A:   netip.MustParseAddr("192.0.2.1").AsSlice(),
+		})
+		_ = w.WriteMsg(resp)
+	})
+
+	chain := NewHandlerChain()
+	chain.AddHandler(nbdns.RootZone, upstream, PriorityDefault, nil)
+	chain.AddHandler(nbdns.RootZone, resolver, PriorityBlocklist, nil)
+
+	testCases := []struct {
+		name           string
+		qname          string
+		qtype          uint16
+		expectedRcode  int
+		expectedAnswer string
+	}{
+		{name: "blocked domain", qname: "ads.example.com.", qtype: dns.TypeA, expectedRcode: dns.RcodeNameError},
+		{name: "blocked subdomain", qname: "cdn.Tracker.io.", qtype: dns.TypeA, expectedRcode: dns.RcodeNameError},
+		{name: "sinkhole A", qname: "www.malware.test.", qtype: dns.TypeA, expectedRcode: dns.RcodeSuccess, expectedAnswer: "10.0.0.1"},
+		{name: "sinkhole AAAA defaults to unspecified", qname: "malware.test.", qtype: dns.TypeAAAA, expectedRcode: dns.RcodeSuccess, expectedAnswer: "::"},
+		{name: "sinkhole other type", qname: "malware.test.", qtype: dns.TypeTXT, expectedRcode: dns.RcodeSuccess},
+		{name: "parent domain not blocked", qname: "example.com.", qtype: dns.TypeA, expectedRcode: dns.RcodeSuccess, expectedAnswer: "192.0.2.1"},
+		{name: "suffix without label boundary not blocked", qname: "badads.example.com.", qtype: dns.TypeA, expectedRcode: dns.RcodeSuccess, expectedAnswer: "192.0.2.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var response *dns.Msg
+			w := &mockResponseWriter{
+				WriteMsgFunc: func(m *dns.Msg) error {
+					response = m
+					return nil
+				},
+			}
+
+			chain.ServeDNS(w, new(dns.Msg).SetQuestion(tc.qname, tc.qtype))
+
+			require.NotNil(t, response)
+			assert.Equal(t, tc.expectedRcode, response.Rcode)
+			if tc.expectedAnswer == "" {
+				assert.Empty(t, response.Answer)
+				return
+			}
+			require.Len(t, response.Answer, 1)
+			switch rr := response.Answer[0].(type) {
+			case *dns.A:
+				assert.Equal(t, tc.expectedAnswer, rr.A.String())
+			case *dns.AAAA:
+				assert.Equal(t, tc.expectedAnswer, rr.AAAA.String())
+			}
+		})
+	}
+
+	assert.Equal(t, []peer.DNSBlocklistStats{
+		{Name: "Ads", Blocked: 2},
+		{Name: "Malware", Blocked: 3},
+	}, resolver.Stats())
+
+	// counters are kept across updates and dropped with the blocklist
+	require.True(t, resolver.update([]nbdns.Blocklist{
+		{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com"}},
+	}))
+	assert.Equal(t, []peer.DNSBlocklistStats{{Name: "Ads", Blocked: 2}}, resolver.Stats())
+
+	assert.False(t, resolver.update(nil))
+	assert.Empty(t, resolver.Stats())
+}
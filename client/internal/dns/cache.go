@@ -0,0 +1,341 @@
+package dns
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+)
+
+const (
+	defaultCacheSize = 10000
+	// maxCacheTTL caps the TTL of positive responses
+	maxCacheTTL = time.Hour
+	// maxNegativeCacheTTL caps the TTL of negative responses, see RFC 2308 section 5
+	maxNegativeCacheTTL = 15 * time.Minute
+	// prefetchMinHits is the number of hits after which an entry is considered hot
+	prefetchMinHits = 3
+	// prefetchThreshold is the fraction of the original TTL left at which hot entries are prefetched
+	prefetchThreshold = 0.1
+)
+
+type cacheKey struct {
+	scope  string
+	name   string
+	qtype  uint16
+	qclass uint16
+	do     bool
+	cd     bool
+}
+
+type cacheEntry struct {
+	key         cacheKey
+	msg         *dns.Msg
+	storedAt    time.Time
+	ttl         time.Duration
+	hits        int
+	prefetching bool
+}
+
+// ResponseCache is a TTL-aware cache of upstream DNS responses shared by the handlers of the HandlerChain.
+// Entries are scoped by the upstream they were received from, so handlers with different upstreams don't share answers.
+type ResponseCache struct {
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	maxSize int
+
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	prefetches atomic.Uint64
+	evictions  atomic.Uint64
+
+	now func() time.Time
+}
+
+// NewResponseCache returns a response cache holding up to maxSize entries
+func NewResponseCache(maxSize int) *ResponseCache {
+	return &ResponseCache{
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+		now:     time.Now,
+	}
+}
+
+func newCacheKey(scope string, r *dns.Msg) (cacheKey, bool) {
+	if len(r.Question) != 1 {
+		return cacheKey{}, false
+	}
+	question := r.Question[0]
+
+	key := cacheKey{
+		scope:  scope,
+		name:   strings.ToLower(question.Name),
+		qtype:  question.Qtype,
+		qclass: question.Qclass,
+		cd:     r.CheckingDisabled,
+	}
+	if opt := r.IsEdns0(); opt != nil {
+		key.do = opt.Do()
+	}
+	return key, true
+}
+
+// Get returns a copy of the cached response for the query with the TTLs adjusted by the time spent in the cache.
+// prefetch is true if the entry is hot and about to expire, the caller should refresh it then.
+func (c *ResponseCache) Get(scope string, r *dns.Msg) (rm *dns.Msg, prefetch bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	key, ok := newCacheKey(scope, r)
+	if !ok {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	elapsed := c.now().Sub(entry.storedAt)
+	if elapsed >= entry.ttl {
+		c.removeElement(element)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+	entry.hits++
+	c.hits.Add(1)
+
+	if entry.hits >= prefetchMinHits && !entry.prefetching &&
+		entry.ttl-elapsed <= time.Duration(float64(entry.ttl)*prefetchThreshold) {
+		entry.prefetching = true
+		prefetch = true
+		c.prefetches.Add(1)
+	}
+
+	rm = entry.msg.Copy()
+	rm.Id = r.Id
+	decrementTTLs(rm, uint32(elapsed/time.Second))
+
+	return rm, prefetch
+}
+
+// Set caches the response to the query if it is cacheable
+func (c *ResponseCache) Set(scope string, r, rm *dns.Msg) {
+	if c == nil || rm == nil {
+		return
+	}
+
+	key, ok := newCacheKey(scope, r)
+	if !ok {
+		return
+	}
+
+	ttl, ok := cacheTTL(rm)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.removeElement(element)
+	}
+
+	entry := &cacheEntry{
+		key:      key,
+		msg:      rm.Copy(),
+		storedAt: c.now(),
+		ttl:      ttl,
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.maxSize {
+		c.removeElement(c.lru.Back())
+		c.evictions.Add(1)
+	}
+}
+
+// Flush removes all entries from the cache
+func (c *ResponseCache) Flush() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[cacheKey]*list.Element)
+	c.lru.Init()
+}
+
+// Stats returns the cache statistics
+func (c *ResponseCache) Stats() peer.DNSCacheStats {
+	if c == nil {
+		return peer.DNSCacheStats{}
+	}
+
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+
+	return peer.DNSCacheStats{
+		Entries:    entries,
+		Hits:       c.hits.Load(),
+		Misses:     c.misses.Load(),
+		Prefetches: c.prefetches.Load(),
+		Evictions:  c.evictions.Load(),
+	}
+}
+
+func (c *ResponseCache) removeElement(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+}
+
+// cacheTTL returns how long the response can be cached. Positive responses are cached for the lowest answer TTL,
+// negative responses (NXDOMAIN and NODATA) for the SOA minimum as described in RFC 2308.
+func cacheTTL(rm *dns.Msg) (time.Duration, bool) {
+	if !rm.Response || rm.Truncated {
+		return 0, false
+	}
+
+	switch {
+	case rm.Rcode == dns.RcodeSuccess && len(rm.Answer) > 0:
+		ttl := minTTL(rm.Answer)
+		return capTTL(ttl, maxCacheTTL)
+	case rm.Rcode == dns.RcodeNameError, rm.Rcode == dns.RcodeSuccess:
+		for _, rr := range rm.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				return capTTL(min(soa.Hdr.Ttl, soa.Minttl), maxNegativeCacheTTL)
+			}
+		}
+		// negative responses without SOA must not be cached
+		return 0, false
+	default:
+		return 0, false
+	}
+}
+
+func capTTL(ttl uint32, maxTTL time.Duration) (time.Duration, bool) {
+	if ttl == 0 {
+		return 0, false
+	}
+	return min(time.Duration(ttl)*time.Second, maxTTL), true
+}
+
+func minTTL(records []dns.RR) uint32 {
+	var ttl uint32
+	for i, rr := range records {
+		if i == 0 || rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+	}
+	return ttl
+}
+
+func decrementTTLs(rm *dns.Msg, elapsed uint32) {
+	for _, section := range [][]dns.RR{rm.Answer, rm.Ns, rm.Extra} {
+		for _, rr := range section {
+			hdr := rr.Header()
+			if hdr.Rrtype == dns.TypeOPT {
+				continue
+			}
+			if hdr.Ttl > elapsed {
+				hdr.Ttl -= elapsed
+			} else {
+				hdr.Ttl = 0
+			}
+		}
+	}
+}
+
+type upstreamQueryStats struct {
+	queries      uint64
+	failures     uint64
+	totalLatency time.Duration
+	lastLatency  time.Duration
+}
+
+// QueryStats tracks the number of queries, failures and latencies per upstream
+type QueryStats struct {
+	mu        sync.Mutex
+	upstreams map[string]*upstreamQueryStats
+}
+
+// NewQueryStats returns an empty query stats instance
+func NewQueryStats() *QueryStats {
+	return &QueryStats{
+		upstreams: make(map[string]*upstreamQueryStats),
+	}
+}
+
+// Record records the result of a query to the given upstream
+func (s *QueryStats) Record(upstream string, latency time.Duration, err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.upstreams[upstream]
+	if !ok {
+		stats = &upstreamQueryStats{}
+		s.upstreams[upstream] = stats
+	}
+
+	stats.queries++
+	if err != nil {
+		stats.failures++
+		return
+	}
+	stats.totalLatency += latency
+	stats.lastLatency = latency
+}
+
+// Stats returns the statistics of all upstreams
+func (s *QueryStats) Stats() []peer.DNSUpstreamStats {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	upstreams := make([]peer.DNSUpstreamStats, 0, len(s.upstreams))
+	for upstream, stats := range s.upstreams {
+		var avgLatency time.Duration
+		if succeeded := stats.queries - stats.failures; succeeded > 0 {
+			avgLatency = stats.totalLatency / time.Duration(succeeded)
+		}
+		upstreams = append(upstreams, peer.DNSUpstreamStats{
+			Upstream:    upstream,
+			Queries:     stats.queries,
+			Failures:    stats.failures,
+			AvgLatency:  avgLatency,
+			LastLatency: stats.lastLatency,
+		})
+	}
+
+	slices.SortFunc(upstreams, func(a, b peer.DNSUpstreamStats) int {
+		return strings.Compare(a.Upstream, b.Upstream)
+	})
+	return upstreams
+}
@@ -0,0 +1,267 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testScope = "8.8.8.8:53"
+
+func newTestQuery(name string) *dns.Msg {
+	r := new(dns.Msg)
+	r.SetQuestion(name, dns.TypeA)
+	return r
+}
+
+func newTestAnswer(r *dns.Msg, ttl uint32) *dns.Msg {
+	rm := new(dns.Msg)
+	rm.SetReply(r)
+	rm.Answer = append(rm.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl},
+		A:   net.ParseIP("10.0.0.1"),
+	})
+	return rm
+}
+
+func newTestNegativeAnswer(r *dns.Msg, rcode int, soaTTL, minTTL uint32) *dns.Msg {
+	rm := new(dns.Msg)
+	rm.SetRcode(r, rcode)
+	rm.Ns = append(rm.Ns, &dns.SOA{
+		Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: soaTTL},
+		Ns:     "ns.example.com.",
+		Mbox:   "admin.example.com.",
+		Minttl: minTTL,
+	})
+	return rm
+}
+
+func newTestCache(maxSize int, now *time.Time) *ResponseCache {
+	cache := NewResponseCache(maxSize)
+	cache.now = func() time.Time { return *now }
+	return cache
+}
+
+func TestResponseCache_PositiveResponse(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(10, &now)
+
+	r := newTestQuery("example.com.")
+	cache.Set(testScope, r, newTestAnswer(r, 60))
+
+	rm, _ := cache.Get("1.1.1.1:53", r)
+	assert.Nil(t, rm, "entries should be scoped by upstream")
+
+	r.Id = 1234
+	now = now.Add(20 * time.Second)
+	rm, prefetch := cache.Get(testScope, r)
+	require.NotNil(t, rm)
+	assert.False(t, prefetch)
+	assert.Equal(t, uint16(1234), rm.Id, "cached response should carry the query id")
+	assert.Equal(t, uint32(40), rm.Answer[0].Header().Ttl, "ttl should be decremented by the time spent in the cache")
+
+	upper := newTestQuery("EXAMPLE.com.")
+	rm, _ = cache.Get(testScope, upper)
+	assert.NotNil(t, rm, "lookups should be case insensitive")
+
+	now = now.Add(40 * time.Second)
+	rm, _ = cache.Get(testScope, r)
+	assert.Nil(t, rm, "expired entry should not be returned")
+
+	stats := cache.Stats()
+	assert.Equal(t, 0, stats.Entries)
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+}
+
+func TestResponseCache_TTLCap(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(10, &now)
+
+	r := newTestQuery("example.com.")
+	cache.Set(testScope, r, newTestAnswer(r, 86400))
+
+	now = now.Add(maxCacheTTL)
+	rm, _ := cache.Get(testScope, r)
+	assert.Nil(t, rm, "positive responses should be capped at the max ttl")
+}
+
+func TestResponseCache_NegativeResponse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		response  func(r *dns.Msg) *dns.Msg
+		cached    bool
+		expiresIn time.Duration
+	}{
+		{
+			name: "nxdomain uses soa minimum",
+			response: func(r *dns.Msg) *dns.Msg {
+				return newTestNegativeAnswer(r, dns.RcodeNameError, 300, 60)
+			},
+			cached:    true,
+			expiresIn: 60 * time.Second,
+		},
+		{
+			name: "nodata uses soa ttl if lower",
+			response: func(r *dns.Msg) *dns.Msg {
+				return newTestNegativeAnswer(r, dns.RcodeSuccess, 30, 60)
+			},
+			cached:    true,
+			expiresIn: 30 * time.Second,
+		},
+		{
+			name: "negative ttl is capped",
+			response: func(r *dns.Msg) *dns.Msg {
+				return newTestNegativeAnswer(r, dns.RcodeNameError, 86400, 86400)
+			},
+			cached:    true,
+			expiresIn: maxNegativeCacheTTL,
+		},
+		{
+			name: "nxdomain without soa is not cached",
+			response: func(r *dns.Msg) *dns.Msg {
+				rm := new(dns.Msg)
+				rm.SetRcode(r, dns.RcodeNameError)
+				return rm
+			},
+		},
+		{
+			name: "servfail is not cached",
+			response: func(r *dns.Msg) *dns.Msg {
+				return newTestNegativeAnswer(r, dns.RcodeServerFailure, 300, 300)
+			},
+		},
+		{
+			name: "truncated response is not cached",
+			response: func(r *dns.Msg) *dns.Msg {
+				rm := newTestAnswer(r, 60)
+				rm.Truncated = true
+				return rm
+			},
+		},
+		{
+			name: "zero ttl is not cached",
+			response: func(r *dns.Msg) *dns.Msg {
+				return newTestAnswer(r, 0)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			now := time.Now()
+			cache := newTestCache(10, &now)
+
+			r := newTestQuery("missing.example.com.")
+			cache.Set(testScope, r, testCase.response(r))
+
+			rm, _ := cache.Get(testScope, r)
+			if !testCase.cached {
+				assert.Nil(t, rm)
+				return
+			}
+			require.NotNil(t, rm)
+
+			now = now.Add(testCase.expiresIn - time.Second)
+			rm, _ = cache.Get(testScope, r)
+			assert.NotNil(t, rm, "entry should be cached until the ttl expires")
+
+			now = now.Add(time.Second)
+			rm, _ = cache.Get(testScope, r)
+			assert.Nil(t, rm, "entry should expire after the ttl")
+		})
+	}
+}
+
+func TestResponseCache_Eviction(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(2, &now)
+
+	first := newTestQuery("first.example.com.")
+	second := newTestQuery("second.example.com.")
+	third := newTestQuery("third.example.com.")
+
+	cache.Set(testScope, first, newTestAnswer(first, 60))
+	cache.Set(testScope, second, newTestAnswer(second, 60))
+
+	// mark first as recently used so second is evicted
+	rm, _ := cache.Get(testScope, first)
+	require.NotNil(t, rm)
+
+	cache.Set(testScope, third, newTestAnswer(third, 60))
+
+	rm, _ = cache.Get(testScope, second)
+	assert.Nil(t, rm, "least recently used entry should be evicted")
+	rm, _ = cache.Get(testScope, first)
+	assert.NotNil(t, rm)
+	rm, _ = cache.Get(testScope, third)
+	assert.NotNil(t, rm)
+
+	stats := cache.Stats()
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, uint64(1), stats.Evictions)
+}
+
+func TestResponseCache_Prefetch(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(10, &now)
+
+	r := newTestQuery("example.com.")
+	cache.Set(testScope, r, newTestAnswer(r, 100))
+
+	for i := 0; i < prefetchMinHits; i++ {
+		_, prefetch := cache.Get(testScope, r)
+		assert.False(t, prefetch, "entry should not be prefetched before it is about to expire")
+	}
+
+	now = now.Add(95 * time.Second)
+	_, prefetch := cache.Get(testScope, r)
+	assert.True(t, prefetch, "hot entry should be prefetched before it expires")
+
+	_, prefetch = cache.Get(testScope, r)
+	assert.False(t, prefetch, "entry should be prefetched only once")
+
+	cache.Set(testScope, r, newTestAnswer(r, 100))
+	now = now.Add(50 * time.Second)
+	rm, _ := cache.Get(testScope, r)
+	assert.NotNil(t, rm, "prefetched entry should replace the expiring one")
+	assert.Equal(t, uint64(1), cache.Stats().Prefetches)
+}
+
+func TestResponseCache_Nil(t *testing.T) {
+	var cache *ResponseCache
+
+	r := newTestQuery("example.com.")
+	cache.Set(testScope, r, newTestAnswer(r, 60))
+	rm, prefetch := cache.Get(testScope, r)
+	assert.Nil(t, rm)
+	assert.False(t, prefetch)
+	assert.Zero(t, cache.Stats())
+}
+
+func TestQueryStats(t *testing.T) {
+	stats := NewQueryStats()
+
+	stats.Record("8.8.8.8:53", 10*time.Millisecond, nil)
+	stats.Record("8.8.8.8:53", 30*time.Millisecond, nil)
+	stats.Record("8.8.8.8:53", time.Second, errors.New("timeout"))
+	stats.Record("1.1.1.1:53", 5*time.Millisecond, nil)
+
+	upstreams := stats.Stats()
+	require.Len(t, upstreams, 2)
+
+	assert.Equal(t, "1.1.1.1:53", upstreams[0].Upstream)
+	assert.Equal(t, uint64(1), upstreams[0].Queries)
+	assert.Equal(t, 5*time.Millisecond, upstreams[0].AvgLatency)
+
+	assert.Equal(t, "8.8.8.8:53", upstreams[1].Upstream)
+	assert.Equal(t, uint64(3), upstreams[1].Queries)
+	assert.Equal(t, uint64(1), upstreams[1].Failures)
+	assert.Equal(t, 20*time.Millisecond, upstreams[1].AvgLatency)
+	assert.Equal(t, 30*time.Millisecond, upstreams[1].LastLatency)
+}
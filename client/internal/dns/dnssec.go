@@ -0,0 +1,906 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	nberrors "github.com/netbirdio/netbird/client/errors"
+)
+
+const (
+	// dnssecMaxCacheTTL caps how long validated zone keys and delegations are cached
+	dnssecMaxCacheTTL = time.Hour
+	// dnssecFailureCacheTTL is how long failed zone key and delegation lookups are cached
+	dnssecFailureCacheTTL = 30 * time.Second
+	// dnssecMaxCacheEntries limits the number of cached zone keys and delegations
+	dnssecMaxCacheEntries = 4096
+	// dnssecUDPSize is the EDNS0 buffer size of the queries with the DO bit set
+	dnssecUDPSize = 4096
+)
+
+// rootTrustAnchors are the DS records of the root zone key signing keys published by IANA
+var rootTrustAnchors = []string{
+	". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	". IN DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16",
+}
+
+// exchangeFunc sends a query to the upstream the validated response was received from
+type exchangeFunc func(ctx context.Context, r *dns.Msg) (*dns.Msg, error)
+
+type delegationState int
+
+const (
+	// delegationNone means the name is not a zone cut
+	delegationNone delegationState = iota
+	// delegationSecure means the name is a zone cut with validated DS records
+	delegationSecure
+	// delegationInsecure means the name is a zone cut without DS records or below an insecure parent zone
+	delegationInsecure
+)
+
+type delegation struct {
+	state   delegationState
+	ds      []*dns.DS
+	err     error
+	expires time.Time
+}
+
+type zoneKeys struct {
+	// keys is nil for insecure zones
+	keys    []*dns.DNSKEY
+	err     error
+	expires time.Time
+}
+
+// signedRRset is an RRset of a response section with the signatures covering it
+type signedRRset struct {
+	rrs  []dns.RR
+	sigs []*dns.RRSIG
+}
+
+// wildcardExpansion is the owner of an RRset expanded from a wildcard and the number of labels of the wildcard without
+// the asterisk
+type wildcardExpansion struct {
+	name   string
+	labels int
+}
+
+// dnssecValidator validates the DNSSEC signatures of upstream responses up to the trust anchors.
+// The zone keys and delegations of the chains of trust are fetched from the upstream and cached.
+type dnssecValidator struct {
+	// anchors maps the trust anchor zones to their DS records
+	anchors map[string][]*dns.DS
+	now     func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]*zoneKeys
+	delegations map[string]*delegation
+}
+
+// newDNSSECValidator returns a validator for the DS records in presentation format, the root zone trust anchors are used if empty
+func newDNSSECValidator(trustAnchors []string) (*dnssecValidator, error) {
+	if len(trustAnchors) == 0 {
+		trustAnchors = rootTrustAnchors
+	}
+
+	v := &dnssecValidator{
+		anchors:     make(map[string][]*dns.DS),
+		now:         time.Now,
+		keys:        make(map[string]*zoneKeys),
+		delegations: make(map[string]*delegation),
+	}
+
+	var merr *multierror.Error
+	for _, anchor := range trustAnchors {
+		rr, err := dns.NewRR(anchor)
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("parse trust anchor %q: %w", anchor, err))
+			continue
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			merr = multierror.Append(merr, fmt.Errorf("trust anchor %q is not a DS record", anchor))
+			continue
+		}
+		zone := dns.CanonicalName(ds.Hdr.Name)
+		v.anchors[zone] = append(v.anchors[zone], ds)
+	}
+
+	if len(v.anchors) == 0 {
+		return nil, fmt.Errorf("no valid trust anchors: %w", nberrors.FormatErrorOrNil(merr))
+	}
+	if merr != nil {
+		log.Warnf("skipping invalid DNSSEC trust anchors: %v", nberrors.FormatErrorOrNil(merr))
+	}
+
+	return v, nil
+}
+
+// upstreamQuery returns a copy of the query requesting the DNSSEC records without upstream validation
+func (v *dnssecValidator) upstreamQuery(r *dns.Msg) *dns.Msg {
+	req := r.Copy()
+	if opt := req.IsEdns0(); opt != nil {
+		opt.SetDo()
+	} else {
+		req.SetEdns0(dnssecUDPSize, true)
+	}
+	req.AuthenticatedData = false
+	req.CheckingDisabled = true
+	return req
+}
+
+// validate validates the answer or the denial of existence of the response.
+// It returns true if the response is secure, false if it is insecure and an error if it is bogus.
+func (v *dnssecValidator) validate(ctx context.Context, exchange exchangeFunc, rm *dns.Msg) (bool, error) {
+	if len(rm.Question) == 0 {
+		return false, errors.New("response without question")
+	}
+	if rm.Rcode != dns.RcodeSuccess && rm.Rcode != dns.RcodeNameError {
+		return false, nil
+	}
+	question := rm.Question[0]
+
+	secure := true
+	target := dns.CanonicalName(question.Name)
+	answered := false
+	var expanded []wildcardExpansion
+	for _, set := range rrsetsOf(rm.Answer) {
+		sig, err := v.verifySignature(ctx, exchange, set, set.owner())
+		if err != nil {
+			return false, err
+		}
+		secure = secure && sig != nil
+		if sig != nil && int(sig.Labels) < dns.CountLabel(set.owner()) {
+			expanded = append(expanded, wildcardExpansion{name: set.owner(), labels: int(sig.Labels)})
+		}
+
+		header := set.rrs[0].Header()
+		if dns.CanonicalName(header.Name) != target {
+			continue
+		}
+		switch {
+		case header.Rrtype == question.Qtype || question.Qtype == dns.TypeANY:
+			answered = true
+		case header.Rrtype == dns.TypeCNAME:
+			target = dns.CanonicalName(set.rrs[0].(*dns.CNAME).Target)
+		}
+	}
+
+	if secure && len(expanded) > 0 {
+		if err := v.verifyWildcardExpansions(ctx, exchange, rm, expanded); err != nil {
+			return false, err
+		}
+	}
+
+	if rm.Rcode == dns.RcodeSuccess && answered {
+		return secure, nil
+	}
+
+	denied, err := v.verifyDenial(ctx, exchange, rm, target, question.Qtype)
+	if err != nil {
+		return false, err
+	}
+	return secure && denied, nil
+}
+
+// verifyDenial validates the authority section of a negative response for the name and type
+func (v *dnssecValidator) verifyDenial(ctx context.Context, exchange exchangeFunc, rm *dns.Msg, name string, qtype uint16) (bool, error) {
+	sets := rrsetsOf(rm.Ns)
+	if len(sets) == 0 {
+		insecure, err := v.provenInsecure(ctx, exchange, name)
+		if err != nil {
+			return false, err
+		}
+		if !insecure {
+			return false, fmt.Errorf("missing denial of existence for %s", name)
+		}
+		return false, nil
+	}
+
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, set := range sets {
+		ok, err := v.verifyRRset(ctx, exchange, set, set.owner())
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+		nsecs, nsec3s = appendDenialRecords(set, nsecs, nsec3s)
+	}
+
+	if err := proveDenial(name, qtype, rm.Rcode == dns.RcodeNameError, nsecs, nsec3s); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyWildcardExpansions checks that the authority section proves that no closer match than the wildcard exists for
+// the owners of the RRsets expanded from a wildcard, see RFC 4035 section 5.3.4 and RFC 5155 section 8.8
+func (v *dnssecValidator) verifyWildcardExpansions(ctx context.Context, exchange exchangeFunc, rm *dns.Msg, expanded []wildcardExpansion) error {
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, set := range rrsetsOf(rm.Ns) {
+		rrtype := set.rrs[0].Header().Rrtype
+		if rrtype != dns.TypeNSEC && rrtype != dns.TypeNSEC3 {
+			continue
+		}
+		ok, err := v.verifyRRset(ctx, exchange, set, set.owner())
+		if err != nil {
+			return err
+		}
+		if ok {
+			nsecs, nsec3s = appendDenialRecords(set, nsecs, nsec3s)
+		}
+	}
+
+	for _, e := range expanded {
+		if err := proveNoCloserMatch(e.name, e.labels, nsecs, nsec3s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyRRset verifies the RRset with the keys of the signing zone. Unsigned RRsets are insecure
+// if the name insecureAt is proven to be in an insecure zone, otherwise they are bogus.
+func (v *dnssecValidator) verifyRRset(ctx context.Context, exchange exchangeFunc, set *signedRRset, insecureAt string) (bool, error) {
+	sig, err := v.verifySignature(ctx, exchange, set, insecureAt)
+	return sig != nil, err
+}
+
+// verifySignature is verifyRRset returning the valid signature of the RRset, nil if the RRset is insecure
+func (v *dnssecValidator) verifySignature(ctx context.Context, exchange exchangeFunc, set *signedRRset, insecureAt string) (*dns.RRSIG, error) {
+	owner := set.owner()
+	rrtype := dns.TypeToString[set.rrs[0].Header().Rrtype]
+
+	if len(set.sigs) == 0 {
+		insecure, err := v.provenInsecure(ctx, exchange, insecureAt)
+		if err != nil {
+			return nil, err
+		}
+		if !insecure {
+			return nil, fmt.Errorf("missing signature for %s %s", owner, rrtype)
+		}
+		return nil, nil
+	}
+
+	var merr *multierror.Error
+	for _, sig := range set.sigs {
+		signer := dns.CanonicalName(sig.SignerName)
+		if !dns.IsSubDomain(signer, owner) {
+			merr = multierror.Append(merr, fmt.Errorf("signer %s is not a parent of %s", signer, owner))
+			continue
+		}
+
+		// the owner of an RRset expanded from a wildcard has more labels than the signature, never fewer
+		if int(sig.Labels) > dns.CountLabel(owner) {
+			merr = multierror.Append(merr, fmt.Errorf("signature of %s %s by key %d has more labels than the owner", owner, rrtype, sig.KeyTag))
+			continue
+		}
+
+		keys, err := v.zoneKeys(ctx, exchange, signer)
+		if err != nil {
+			merr = multierror.Append(merr, err)
+			continue
+		}
+		if keys == nil {
+			return nil, nil
+		}
+
+		if !sig.ValidityPeriod(v.now()) {
+			merr = multierror.Append(merr, fmt.Errorf("signature of %s %s by key %d is expired or not yet valid", owner, rrtype, sig.KeyTag))
+			continue
+		}
+
+		for _, key := range keys {
+			if sig.KeyTag == key.KeyTag() && sig.Algorithm == key.Algorithm && sig.Verify(key, set.rrs) == nil {
+				return sig, nil
+			}
+		}
+		merr = multierror.Append(merr, fmt.Errorf("no valid signature of %s %s by key %d of zone %s", owner, rrtype, sig.KeyTag, signer))
+	}
+
+	return nil, nberrors.FormatErrorOrNil(merr)
+}
+
+// zoneKeys returns the validated zone signing keys of the zone, nil if the zone is insecure
+func (v *dnssecValidator) zoneKeys(ctx context.Context, exchange exchangeFunc, zone string) ([]*dns.DNSKEY, error) {
+	v.mu.Lock()
+	entry, ok := v.keys[zone]
+	v.mu.Unlock()
+	if ok && v.now().Before(entry.expires) {
+		return entry.keys, entry.err
+	}
+
+	keys, ttl, err := v.fetchZoneKeys(ctx, exchange, zone)
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
+	if err != nil {
+		ttl = dnssecFailureCacheTTL
+	}
+
+	v.mu.Lock()
+	pruneDNSSECCache(v.keys, v.now(), func(e *zoneKeys) time.Time { return e.expires })
+	v.keys[zone] = &zoneKeys{keys: keys, err: err, expires: v.now().Add(ttl)}
+	v.mu.Unlock()
+
+	return keys, err
+}
+
+func (v *dnssecValidator) fetchZoneKeys(ctx context.Context, exchange exchangeFunc, zone string) ([]*dns.DNSKEY, time.Duration, error) {
+	anchor, ok := v.anchorFor(zone)
+	if !ok {
+		return nil, dnssecMaxCacheTTL, nil
+	}
+
+	dsSet := v.anchors[anchor]
+	ttl := dnssecMaxCacheTTL
+	if zone != anchor {
+		d, err := v.delegation(ctx, exchange, zone)
+		if err != nil {
+			return nil, 0, err
+		}
+		ttl = d.expires.Sub(v.now())
+		switch d.state {
+		case delegationInsecure:
+			return nil, ttl, nil
+		case delegationNone:
+			return nil, 0, fmt.Errorf("signer %s is not a zone cut", zone)
+		}
+		dsSet = d.ds
+	}
+
+	resp, err := v.query(ctx, exchange, zone, dns.TypeDNSKEY)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var keySet *signedRRset
+	for _, set := range rrsetsOf(resp.Answer) {
+		if set.owner() == zone && set.rrs[0].Header().Rrtype == dns.TypeDNSKEY {
+			keySet = set
+			break
+		}
+	}
+	if keySet == nil {
+		return nil, 0, fmt.Errorf("no DNSKEY records for zone %s", zone)
+	}
+
+	var keys, trusted []*dns.DNSKEY
+	for _, rr := range keySet.rrs {
+		key := rr.(*dns.DNSKEY)
+		if key.Flags&dns.ZONE == 0 || key.Flags&dns.REVOKE != 0 {
+			continue
+		}
+		keys = append(keys, key)
+		if slices.ContainsFunc(dsSet, func(ds *dns.DS) bool { return matchesDS(key, ds) }) {
+			trusted = append(trusted, key)
+		}
+	}
+	if len(trusted) == 0 {
+		return nil, 0, fmt.Errorf("no DNSKEY of zone %s matches its DS records", zone)
+	}
+
+	now := v.now()
+	for _, sig := range keySet.sigs {
+		if !sig.ValidityPeriod(now) {
+			continue
+		}
+		for _, key := range trusted {
+			if sig.KeyTag == key.KeyTag() && sig.Algorithm == key.Algorithm && sig.Verify(key, keySet.rrs) == nil {
+				return keys, min(ttl, time.Duration(keySet.rrs[0].Header().Ttl)*time.Second), nil
+			}
+		}
+	}
+
+	return nil, 0, fmt.Errorf("no valid signature of the DNSKEY records of zone %s by a trusted key", zone)
+}
+
+// delegation returns the validated delegation state of the name from its parent zone
+func (v *dnssecValidator) delegation(ctx context.Context, exchange exchangeFunc, name string) (*delegation, error) {
+	v.mu.Lock()
+	entry, ok := v.delegations[name]
+	v.mu.Unlock()
+	if ok && v.now().Before(entry.expires) {
+		return entry, entry.err
+	}
+
+	d, err := v.fetchDelegation(ctx, exchange, name)
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
+	if err != nil {
+		d = &delegation{err: err, expires: v.now().Add(dnssecFailureCacheTTL)}
+	}
+
+	v.mu.Lock()
+	pruneDNSSECCache(v.delegations, v.now(), func(e *delegation) time.Time { return e.expires })
+	v.delegations[name] = d
+	v.mu.Unlock()
+
+	return d, err
+}
+
+func (v *dnssecValidator) fetchDelegation(ctx context.Context, exchange exchangeFunc, name string) (*delegation, error) {
+	resp, err := v.query(ctx, exchange, name, dns.TypeDS)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := parentName(name)
+	ttl := uint32(dnssecMaxCacheTTL.Seconds())
+	insecure := func() (*delegation, error) {
+		return &delegation{state: delegationInsecure, expires: v.now().Add(time.Duration(ttl) * time.Second)}, nil
+	}
+
+	// the DS records and their denial must be signed by a parent zone, this also bounds the recursion of the validation
+	checkSigners := func(set *signedRRset) error {
+		for _, sig := range set.sigs {
+			if dns.CanonicalName(sig.SignerName) == name {
+				return fmt.Errorf("DS records of %s are signed by the zone itself", name)
+			}
+		}
+		return nil
+	}
+
+	for _, set := range rrsetsOf(resp.Answer) {
+		header := set.rrs[0].Header()
+		if set.owner() != name || header.Rrtype != dns.TypeDS {
+			continue
+		}
+		if err := checkSigners(set); err != nil {
+			return nil, err
+		}
+		ttl = min(ttl, header.Ttl)
+
+		secure, err := v.verifyRRset(ctx, exchange, set, parent)
+		if err != nil {
+			return nil, err
+		}
+		if !secure {
+			return insecure()
+		}
+
+		var dsSet []*dns.DS
+		for _, rr := range set.rrs {
+			if ds := rr.(*dns.DS); supportedDS(ds) {
+				dsSet = append(dsSet, ds)
+			}
+		}
+		if len(dsSet) == 0 {
+			return insecure()
+		}
+		return &delegation{state: delegationSecure, ds: dsSet, expires: v.now().Add(time.Duration(ttl) * time.Second)}, nil
+	}
+
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, set := range rrsetsOf(resp.Ns) {
+		rrtype := set.rrs[0].Header().Rrtype
+		if rrtype != dns.TypeNSEC && rrtype != dns.TypeNSEC3 {
+			continue
+		}
+		if err := checkSigners(set); err != nil {
+			return nil, err
+		}
+		ttl = min(ttl, set.rrs[0].Header().Ttl)
+
+		secure, err := v.verifyRRset(ctx, exchange, set, parent)
+		if err != nil {
+			return nil, err
+		}
+		if !secure {
+			return insecure()
+		}
+		nsecs, nsec3s = appendDenialRecords(set, nsecs, nsec3s)
+	}
+
+	if len(nsecs) == 0 && len(nsec3s) == 0 {
+		provenInsecure, err := v.provenInsecure(ctx, exchange, parent)
+		if err != nil {
+			return nil, err
+		}
+		if !provenInsecure {
+			return nil, fmt.Errorf("missing DS denial of existence for %s", name)
+		}
+		return insecure()
+	}
+
+	state, err := dsDenialState(name, nsecs, nsec3s)
+	if err != nil {
+		return nil, err
+	}
+	return &delegation{state: state, expires: v.now().Add(time.Duration(ttl) * time.Second)}, nil
+}
+
+// provenInsecure walks the delegations from the trust anchor down to the name and
+// returns true if one of them is insecure or the name is not below a trust anchor
+func (v *dnssecValidator) provenInsecure(ctx context.Context, exchange exchangeFunc, name string) (bool, error) {
+	anchor, ok := v.anchorFor(name)
+	if !ok {
+		return true, nil
+	}
+
+	for _, cut := range namesBelow(anchor, name) {
+		d, err := v.delegation(ctx, exchange, cut)
+		if err != nil {
+			return false, err
+		}
+		if d.state == delegationInsecure {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// anchorFor returns the closest trust anchor zone of the name
+func (v *dnssecValidator) anchorFor(name string) (string, bool) {
+	for zone := name; zone != ""; zone = parentName(zone) {
+		if _, ok := v.anchors[zone]; ok {
+			return zone, true
+		}
+	}
+	return "", false
+}
+
+func (v *dnssecValidator) query(ctx context.Context, exchange exchangeFunc, name string, qtype uint16) (*dns.Msg, error) {
+	r := new(dns.Msg).SetQuestion(name, qtype)
+	r.SetEdns0(dnssecUDPSize, true)
+	r.CheckingDisabled = true
+
+	resp, err := exchange(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("query %s %s: %w", name, dns.TypeToString[qtype], err)
+	}
+	if resp == nil || resp.Truncated {
+		return nil, fmt.Errorf("query %s %s: incomplete response", name, dns.TypeToString[qtype])
+	}
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return nil, fmt.Errorf("query %s %s: %s", name, dns.TypeToString[qtype], dns.RcodeToString[resp.Rcode])
+	}
+	return resp, nil
+}
+
+// dsDenialState returns the delegation state of the name proven by the denial of its DS records
+func dsDenialState(name string, nsecs []*dns.NSEC, nsec3s []*dns.NSEC3) (delegationState, error) {
+	delegationFromTypes := func(types []uint16) (delegationState, error) {
+		if slices.Contains(types, dns.TypeDS) {
+			return delegationNone, fmt.Errorf("denial of existence of the DS records of %s lists DS", name)
+		}
+		if slices.Contains(types, dns.TypeNS) && !slices.Contains(types, dns.TypeSOA) {
+			return delegationInsecure, nil
+		}
+		return delegationNone, nil
+	}
+
+	for _, nsec := range nsecs {
+		if dns.CanonicalName(nsec.Hdr.Name) == name {
+			return delegationFromTypes(nsec.TypeBitMap)
+		}
+	}
+	for _, nsec := range nsecs {
+		if nsecCovers(nsec, name) {
+			return delegationNone, nil
+		}
+	}
+
+	for _, nsec3 := range nsec3s {
+		if nsec3.Match(name) {
+			return delegationFromTypes(nsec3.TypeBitMap)
+		}
+	}
+	for _, nsec3 := range nsec3s {
+		if nsec3.Cover(name) {
+			// opt-out spans may contain insecure delegations
+			if nsec3.Flags&1 == 1 {
+				return delegationInsecure, nil
+			}
+			return delegationNone, nil
+		}
+	}
+
+	return delegationNone, fmt.Errorf("no denial of existence proof for the DS records of %s", name)
+}
+
+// proveDenial checks that the NSEC or NSEC3 records prove the nonexistence of the name or of its records of the type
+func proveDenial(name string, qtype uint16, nxdomain bool, nsecs []*dns.NSEC, nsec3s []*dns.NSEC3) error {
+	absent := func(types []uint16) bool {
+		return !slices.Contains(types, qtype) && !slices.Contains(types, dns.TypeCNAME)
+	}
+
+	if !nxdomain {
+		for _, nsec := range nsecs {
+			if dns.CanonicalName(nsec.Hdr.Name) == name && absent(nsec.TypeBitMap) {
+				return nil
+			}
+		}
+		for _, nsec3 := range nsec3s {
+			if nsec3.Match(name) && absent(nsec3.TypeBitMap) {
+				return nil
+			}
+		}
+	}
+
+	if len(nsecs) > 0 {
+		for _, nsec := range nsecs {
+			if !nsecCovers(nsec, name) {
+				continue
+			}
+			encloser := closestEncloser(name, nsec)
+			wildcard := "*." + encloser
+			if encloser == "." {
+				wildcard = "*."
+			}
+			for _, other := range nsecs {
+				if nxdomain && nsecCovers(other, wildcard) {
+					return nil
+				}
+				if !nxdomain && dns.CanonicalName(other.Hdr.Name) == wildcard && absent(other.TypeBitMap) {
+					return nil
+				}
+			}
+		}
+	}
+
+	if len(nsec3s) > 0 {
+		encloser, nextCloser, ok := nsec3ClosestEncloser(name, nsec3s)
+		if ok {
+			wildcard := "*." + encloser
+			if encloser == "." {
+				wildcard = "*."
+			}
+			for _, nsec3 := range nsec3s {
+				if nxdomain && nsec3.Cover(wildcard) {
+					return nil
+				}
+				if !nxdomain && nsec3.Match(wildcard) && absent(nsec3.TypeBitMap) {
+					return nil
+				}
+				// the next closer name of a DS query may be in an opt-out span
+				if !nxdomain && qtype == dns.TypeDS && nsec3.Flags&1 == 1 && nsec3.Cover(nextCloser) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("no denial of existence proof for %s %s", name, dns.TypeToString[qtype])
+}
+
+// proveNoCloserMatch checks that an NSEC record covers the name or an NSEC3 record covers its next closer name below
+// the wildcard with the given number of labels, so the name was rightly expanded from the wildcard
+func proveNoCloserMatch(name string, labels int, nsecs []*dns.NSEC, nsec3s []*dns.NSEC3) error {
+	for _, nsec := range nsecs {
+		if nsecCovers(nsec, name) {
+			return nil
+		}
+	}
+
+	nextCloser := name
+	for dns.CountLabel(nextCloser) > labels+1 {
+		nextCloser = parentName(nextCloser)
+	}
+	for _, nsec3 := range nsec3s {
+		if nsec3.Cover(nextCloser) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no proof that %s has no closer match than its wildcard", name)
+}
+
+// nsec3ClosestEncloser returns the closest encloser of the name matched by an NSEC3 record
+// and its next closer name, which must be covered by another NSEC3 record
+func nsec3ClosestEncloser(name string, nsec3s []*dns.NSEC3) (string, string, bool) {
+	nextCloser := name
+	for encloser := parentName(name); encloser != ""; encloser = parentName(encloser) {
+		if slices.ContainsFunc(nsec3s, func(rr *dns.NSEC3) bool { return rr.Match(encloser) }) {
+			covered := slices.ContainsFunc(nsec3s, func(rr *dns.NSEC3) bool { return rr.Cover(nextCloser) })
+			return encloser, nextCloser, covered
+		}
+		nextCloser = encloser
+	}
+	return "", "", false
+}
+
+// closestEncloser returns the longest existing ancestor of the name proven by the NSEC record covering it
+func closestEncloser(name string, nsec *dns.NSEC) string {
+	labels := max(dns.CompareDomainName(name, nsec.Hdr.Name), dns.CompareDomainName(name, nsec.NextDomain))
+	encloser := name
+	for dns.CountLabel(encloser) > labels {
+		encloser = parentName(encloser)
+	}
+	return encloser
+}
+
+// nsecCovers returns true if the name is between the owner and the next name of the NSEC record in canonical order
+func nsecCovers(nsec *dns.NSEC, name string) bool {
+	owner, next := nsec.Hdr.Name, nsec.NextDomain
+	if canonicalCompare(owner, next) < 0 {
+		return canonicalCompare(owner, name) < 0 && canonicalCompare(name, next) < 0
+	}
+	// the last NSEC record of the zone points back to the apex
+	return canonicalCompare(owner, name) < 0 || canonicalCompare(name, next) < 0
+}
+
+// canonicalCompare compares the names in the canonical DNS name order of RFC 4034 section 6.1
+func canonicalCompare(a, b string) int {
+	labelsA := dns.SplitDomainName(strings.ToLower(a))
+	labelsB := dns.SplitDomainName(strings.ToLower(b))
+
+	for i, j := len(labelsA)-1, len(labelsB)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(labelsA[i], labelsB[j]); c != 0 {
+			return c
+		}
+	}
+	return len(labelsA) - len(labelsB)
+}
+
+// matchesDS returns true if the key is the key signing key referenced by the DS record
+func matchesDS(key *dns.DNSKEY, ds *dns.DS) bool {
+	if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+		return false
+	}
+	keyDS := key.ToDS(ds.DigestType)
+	return keyDS != nil && strings.EqualFold(keyDS.Digest, ds.Digest)
+}
+
+// supportedDS returns true if the digest type and algorithm of the DS record can be validated
+func supportedDS(ds *dns.DS) bool {
+	switch ds.DigestType {
+	case dns.SHA1, dns.SHA256, dns.SHA384:
+	default:
+		return false
+	}
+
+	switch ds.Algorithm {
+	case dns.RSASHA1, dns.RSASHA1NSEC3SHA1, dns.RSASHA256, dns.RSASHA512,
+		dns.ECDSAP256SHA256, dns.ECDSAP384SHA384, dns.ED25519:
+		return true
+	default:
+		return false
+	}
+}
+
+// rrsetsOf groups the records of a response section into RRsets with their signatures
+func rrsetsOf(section []dns.RR) []*signedRRset {
+	type rrsetKey struct {
+		name   string
+		rrtype uint16
+		class  uint16
+	}
+
+	var sets []*signedRRset
+	index := make(map[rrsetKey]*signedRRset)
+	get := func(key rrsetKey) *signedRRset {
+		set, ok := index[key]
+		if !ok {
+			set = &signedRRset{}
+			index[key] = set
+			sets = append(sets, set)
+		}
+		return set
+	}
+
+	for _, rr := range section {
+		header := rr.Header()
+		if sig, ok := rr.(*dns.RRSIG); ok {
+			set := get(rrsetKey{name: dns.CanonicalName(header.Name), rrtype: sig.TypeCovered, class: header.Class})
+			set.sigs = append(set.sigs, sig)
+			continue
+		}
+		set := get(rrsetKey{name: dns.CanonicalName(header.Name), rrtype: header.Rrtype, class: header.Class})
+		set.rrs = append(set.rrs, rr)
+	}
+
+	// drop signatures without records
+	return slices.DeleteFunc(sets, func(set *signedRRset) bool {
+		return len(set.rrs) == 0
+	})
+}
+
+func (s *signedRRset) owner() string {
+	return dns.CanonicalName(s.rrs[0].Header().Name)
+}
+
+func appendDenialRecords(set *signedRRset, nsecs []*dns.NSEC, nsec3s []*dns.NSEC3) ([]*dns.NSEC, []*dns.NSEC3) {
+	for _, rr := range set.rrs {
+		switch rr := rr.(type) {
+		case *dns.NSEC:
+			nsecs = append(nsecs, rr)
+		case *dns.NSEC3:
+			nsec3s = append(nsec3s, rr)
+		}
+	}
+	return nsecs, nsec3s
+}
+
+// namesBelow returns the names from the child of the zone down to the name
+func namesBelow(zone, name string) []string {
+	var names []string
+	for ; name != zone && name != ""; name = parentName(name) {
+		names = append(names, name)
+	}
+	slices.Reverse(names)
+	return names
+}
+
+// parentName returns the parent of the name, empty for the root zone
+func parentName(name string) string {
+	if name == "." || name == "" {
+		return ""
+	}
+	off, end := dns.NextLabel(name, 0)
+	if end {
+		return "."
+	}
+	return name[off:]
+}
+
+// pruneDNSSECCache drops the expired entries of a full cache, and all of them if none is expired
+func pruneDNSSECCache[T any](cache map[string]T, now time.Time, expires func(T) time.Time) {
+	if len(cache) < dnssecMaxCacheEntries {
+		return
+	}
+	for name, entry := range cache {
+		if !now.Before(expires(entry)) {
+			delete(cache, name)
+		}
+	}
+	if len(cache) >= dnssecMaxCacheEntries {
+		clear(cache)
+	}
+}
+
+// stripDNSSECRecords removes the DNSSEC records from the response to a query without the DO bit
+func stripDNSSECRecords(rm *dns.Msg) {
+	var qtype uint16
+	if len(rm.Question) > 0 {
+		qtype = rm.Question[0].Qtype
+	}
+	strip := func(section []dns.RR) []dns.RR {
+		return slices.DeleteFunc(section, func(rr dns.RR) bool {
+			rrtype := rr.Header().Rrtype
+			return rrtype != qtype && (rrtype == dns.TypeRRSIG || rrtype == dns.TypeNSEC || rrtype == dns.TypeNSEC3)
+		})
+	}
+	rm.Answer = strip(rm.Answer)
+	rm.Ns = strip(rm.Ns)
+	rm.Extra = strip(rm.Extra)
+
+	if opt := rm.IsEdns0(); opt != nil {
+		opt.SetDo(false)
+	}
+}
+
+// dnssecOK returns true if the query has the DO bit set
+func dnssecOK(r *dns.Msg) bool {
+	opt := r.IsEdns0()
+	return opt != nil && opt.Do()
+}
+
+// dnssecBogusResponse returns the SERVFAIL response for a query with a bogus answer
+func dnssecBogusResponse(r *dns.Msg, err error) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetRcode(r, dns.RcodeServerFailure)
+	resp.RecursionAvailable = true
+
+	if r.IsEdns0() != nil {
+		resp.SetEdns0(dnssecUDPSize, dnssecOK(r))
+		opt := resp.IsEdns0()
+		opt.Option = append(opt.Option, &dns.EDNS0_EDE{InfoCode: dns.ExtendedErrorCodeDNSBogus, ExtraText: err.Error()})
+	}
+	return resp
+}
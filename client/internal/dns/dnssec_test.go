@@ -0,0 +1,465 @@
+package dns
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSignedZone is a DNSSEC signed zone with a key signing and a zone signing key
+type testSignedZone struct {
+	name    string
+	ksk     *dns.DNSKEY
+	kskPriv crypto.Signer
+	zsk     *dns.DNSKEY
+	zskPriv crypto.Signer
+}
+
+func newTestSignedZone(t *testing.T, name string) *testSignedZone {
+	t.Helper()
+
+	z := &testSignedZone{name: name}
+	z.ksk, z.kskPriv = generateTestKey(t, name, dns.ZONE|dns.SEP)
+	z.zsk, z.zskPriv = generateTestKey(t, name, dns.ZONE)
+	return z
+}
+
+func generateTestKey(t *testing.T, zone string, flags uint16) (*dns.DNSKEY, crypto.Signer) {
+	t.Helper()
+
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: zone, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     flags,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := key.Generate(256)
+	require.NoError(t, err)
+	return key, priv.(crypto.Signer)
+}
+
+func signTestRRset(t *testing.T, key *dns.DNSKEY, priv crypto.Signer, validFrom, validUntil time.Time, rrs ...dns.RR) []dns.RR {
+	t.Helper()
+
+	header := rrs[0].Header()
+	sig := &dns.RRSIG{
+		Hdr:         dns.RR_Header{Name: header.Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: header.Ttl},
+		TypeCovered: header.Rrtype,
+		Algorithm:   key.Algorithm,
+		Labels:      uint8(dns.CountLabel(header.Name)),
+		OrigTtl:     header.Ttl,
+		Expiration:  uint32(validUntil.Unix()),
+		Inception:   uint32(validFrom.Unix()),
+		KeyTag:      key.KeyTag(),
+		SignerName:  key.Hdr.Name,
+	}
+	require.NoError(t, sig.Sign(priv, rrs))
+	return append(rrs, sig)
+}
+
+// sign returns the records with their signature by the zone signing key
+func (z *testSignedZone) sign(t *testing.T, rrs ...dns.RR) []dns.RR {
+	t.Helper()
+	return signTestRRset(t, z.zsk, z.zskPriv, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), rrs...)
+}
+
+// signWildcard returns the records of the wildcard of the zone expanded to the name with their signature
+func (z *testSignedZone) signWildcard(t *testing.T, name string, rrs ...dns.RR) []dns.RR {
+	t.Helper()
+
+	for _, rr := range rrs {
+		rr.Header().Name = "*." + z.name
+	}
+	signed := z.sign(t, rrs...)
+	for _, rr := range signed {
+		rr.Header().Name = name
+	}
+	return signed
+}
+
+// keySet returns the DNSKEY records of the zone signed by the key signing key
+func (z *testSignedZone) keySet(t *testing.T) []dns.RR {
+	t.Helper()
+	return signTestRRset(t, z.ksk, z.kskPriv, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), z.ksk, z.zsk)
+}
+
+func (z *testSignedZone) ds() *dns.DS {
+	return z.ksk.ToDS(dns.SHA256)
+}
+
+// testDNSSECUpstream answers the queries with the configured responses
+type testDNSSECUpstream struct {
+	responses map[string]*dns.Msg
+	queries   []string
+}
+
+func testQueryKey(name string, qtype uint16) string {
+	return fmt.Sprintf("%s/%s", dns.CanonicalName(name), dns.TypeToString[qtype])
+}
+
+func (u *testDNSSECUpstream) add(name string, qtype uint16, rcode int, answer, ns []dns.RR) {
+	u.responses[testQueryKey(name, qtype)] = &dns.Msg{
+		MsgHdr: dns.MsgHdr{Rcode: rcode},
+		Answer: answer,
+		Ns:     ns,
+	}
+}
+
+func (u *testDNSSECUpstream) exchange(_ context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	key := testQueryKey(r.Question[0].Name, r.Question[0].Qtype)
+	u.queries = append(u.queries, key)
+
+	resp, ok := u.responses[key]
+	if !ok {
+		return nil, 0, fmt.Errorf("unexpected query %s", key)
+	}
+	rm := resp.Copy()
+	rm.SetReply(r)
+	rm.Rcode = resp.Rcode
+	return rm, time.Millisecond, nil
+}
+
+func (u *testDNSSECUpstream) exchangeFunc() exchangeFunc {
+	return func(ctx context.Context, r *dns.Msg) (*dns.Msg, error) {
+		rm, _, err := u.exchange(ctx, "", r)
+		return rm, err
+	}
+}
+
+func testA(t *testing.T, name, ip string) *dns.A {
+	t.Helper()
+	rr, err := dns.NewRR(fmt.Sprintf("%s 300 IN A %s", name, ip))
+	require.NoError(t, err)
+	return rr.(*dns.A)
+}
+
+func testNSEC(name, next string, types ...uint16) *dns.NSEC {
+	return &dns.NSEC{
+		Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: 300},
+		NextDomain: next,
+		TypeBitMap: types,
+	}
+}
+
+// newTestDNSSECHierarchy returns an upstream serving a signed root zone with a
+// secure delegation to example. and an insecure delegation to insecure.example.
+func newTestDNSSECHierarchy(t *testing.T) (*testDNSSECUpstream, *testSignedZone, *testSignedZone) {
+	t.Helper()
+
+	root := newTestSignedZone(t, ".")
+	example := newTestSignedZone(t, "example.")
+
+	upstream := &testDNSSECUpstream{responses: make(map[string]*dns.Msg)}
+	upstream.add(".", dns.TypeDNSKEY, dns.RcodeSuccess, root.keySet(t), nil)
+	upstream.add("example.", dns.TypeDS, dns.RcodeSuccess, root.sign(t, example.ds()), nil)
+	upstream.add("example.", dns.TypeDNSKEY, dns.RcodeSuccess, example.keySet(t), nil)
+	upstream.add("insecure.example.", dns.TypeDS, dns.RcodeSuccess, nil,
+		example.sign(t, testNSEC("insecure.example.", "www.example.", dns.TypeNS, dns.TypeRRSIG, dns.TypeNSEC)))
+	upstream.add("www.example.", dns.TypeDS, dns.RcodeSuccess, nil,
+		example.sign(t, testNSEC("www.example.", "example.", dns.TypeA, dns.TypeRRSIG, dns.TypeNSEC)))
+
+	return upstream, root, example
+}
+
+func TestDNSSECValidator_Validate(t *testing.T) {
+	upstream, root, example := newTestDNSSECHierarchy(t)
+
+	tampered := example.sign(t, testA(t, "www.example.", "192.0.2.1"))
+	tampered[0].(*dns.A).A = net.ParseIP("192.0.2.66")
+
+	soa, err := dns.NewRR("example. 300 IN SOA ns.example. admin.example. 1 3600 600 86400 300")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		question       dns.Question
+		rcode          int
+		answer         []dns.RR
+		ns             []dns.RR
+		expectedSecure bool
+		expectedError  bool
+	}{
+		{
+			name:           "signed answer",
+			question:       dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:         example.sign(t, testA(t, "www.example.", "192.0.2.1")),
+			expectedSecure: true,
+		},
+		{
+			name:          "tampered answer",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:        tampered,
+			expectedError: true,
+		},
+		{
+			name:     "expired signature",
+			question: dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer: signTestRRset(t, example.zsk, example.zskPriv, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour),
+				testA(t, "www.example.", "192.0.2.1")),
+			expectedError: true,
+		},
+		{
+			name:          "unsigned answer in secure zone",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:        []dns.RR{testA(t, "www.example.", "192.0.2.1")},
+			expectedError: true,
+		},
+		{
+			name:          "answer signed by a zone that is not a parent",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:        newTestSignedZone(t, "other.").sign(t, testA(t, "www.example.", "192.0.2.1")),
+			expectedError: true,
+		},
+		{
+			name:     "unsigned answer in insecure zone",
+			question: dns.Question{Name: "host.insecure.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:   []dns.RR{testA(t, "host.insecure.example.", "192.0.2.2")},
+		},
+		{
+			name:     "proven nonexistent name",
+			question: dns.Question{Name: "missing.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			rcode:    dns.RcodeNameError,
+			ns: append(append(example.sign(t, soa),
+				example.sign(t, testNSEC("example.", "insecure.example.", dns.TypeSOA, dns.TypeNS, dns.TypeRRSIG, dns.TypeNSEC, dns.TypeDNSKEY))...),
+				example.sign(t, testNSEC("insecure.example.", "www.example.", dns.TypeNS, dns.TypeRRSIG, dns.TypeNSEC))...),
+			expectedSecure: true,
+		},
+		{
+			name:     "nonexistent name without wildcard proof",
+			question: dns.Question{Name: "missing.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			rcode:    dns.RcodeNameError,
+			ns: append(example.sign(t, soa),
+				example.sign(t, testNSEC("insecure.example.", "www.example.", dns.TypeNS, dns.TypeRRSIG, dns.TypeNSEC))...),
+			expectedError: true,
+		},
+		{
+			name:           "wildcard expansion with proof of no closer match",
+			question:       dns.Question{Name: "host.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:         example.signWildcard(t, "host.example.", testA(t, "*.example.", "192.0.2.4")),
+			ns:             example.sign(t, testNSEC("*.example.", "insecure.example.", dns.TypeA, dns.TypeRRSIG, dns.TypeNSEC)),
+			expectedSecure: true,
+		},
+		{
+			name:          "wildcard expansion without proof",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:        example.signWildcard(t, "www.example.", testA(t, "*.example.", "192.0.2.4")),
+			expectedError: true,
+		},
+		{
+			name:          "wildcard expansion with proof for another name",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer:        example.signWildcard(t, "www.example.", testA(t, "*.example.", "192.0.2.4")),
+			ns:            example.sign(t, testNSEC("*.example.", "insecure.example.", dns.TypeA, dns.TypeRRSIG, dns.TypeNSEC)),
+			expectedError: true,
+		},
+		{
+			name:     "signature with more labels than the owner",
+			question: dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			answer: func() []dns.RR {
+				signed := example.sign(t, testA(t, "host.www.example.", "192.0.2.1"))
+				for _, rr := range signed {
+					rr.Header().Name = "www.example."
+				}
+				return signed
+			}(),
+			expectedError: true,
+		},
+		{
+			name:           "proven nonexistent type",
+			question:       dns.Question{Name: "www.example.", Qtype: dns.TypeAAAA, Qclass: dns.ClassINET},
+			ns:             example.sign(t, testNSEC("www.example.", "example.", dns.TypeA, dns.TypeRRSIG, dns.TypeNSEC)),
+			expectedSecure: true,
+		},
+		{
+			name:          "nonexistent type listed in the proof",
+			question:      dns.Question{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+			ns:            example.sign(t, testNSEC("www.example.", "example.", dns.TypeA, dns.TypeRRSIG, dns.TypeNSEC)),
+			expectedError: true,
+		},
+	}
+
+	validator, err := newDNSSECValidator([]string{root.ds().String()})
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rm := &dns.Msg{
+				MsgHdr:   dns.MsgHdr{Response: true, Rcode: tc.rcode},
+				Question: []dns.Question{tc.question},
+				Answer:   tc.answer,
+				Ns:       tc.ns,
+			}
+
+			secure, err := validator.validate(context.Background(), upstream.exchangeFunc(), rm)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSecure, secure)
+		})
+	}
+}
+
+func TestProveNoCloserMatch(t *testing.T) {
+	testNSEC3 := func(owner, next string) *dns.NSEC3 {
+		return &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: owner + ".example.", Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: 300},
+			Hash:       dns.SHA1,
+			NextDomain: next,
+			TypeBitMap: []uint16{dns.TypeA, dns.TypeRRSIG},
+		}
+	}
+	first, last := strings.Repeat("0", 32), strings.Repeat("V", 32)
+	nextCloserHash := dns.HashName("b.example.", dns.SHA1, 0, "")
+
+	testCases := []struct {
+		name          string
+		nsecs         []*dns.NSEC
+		nsec3s        []*dns.NSEC3
+		expectedError bool
+	}{
+		{
+			name:  "NSEC covering the name",
+			nsecs: []*dns.NSEC{testNSEC("*.example.", "c.example.", dns.TypeA)},
+		},
+		{
+			name:          "NSEC covering another name",
+			nsecs:         []*dns.NSEC{testNSEC("c.example.", "d.example.", dns.TypeA)},
+			expectedError: true,
+		},
+		{
+			name:   "NSEC3 covering the next closer name",
+			nsec3s: []*dns.NSEC3{testNSEC3(first, last)},
+		},
+		{
+			name:          "NSEC3 not covering the next closer name",
+			nsec3s:        []*dns.NSEC3{testNSEC3(first, nextCloserHash)},
+			expectedError: true,
+		},
+		{
+			name:          "no proof",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// a.b.example. expanded from *.example.
+			err := proveNoCloserMatch("a.b.example.", 1, tc.nsecs, tc.nsec3s)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDNSSECValidator_CustomTrustAnchor(t *testing.T) {
+	upstream, _, example := newTestDNSSECHierarchy(t)
+
+	validator, err := newDNSSECValidator([]string{example.ds().String(), "invalid"})
+	require.NoError(t, err)
+
+	rm := &dns.Msg{
+		MsgHdr:   dns.MsgHdr{Response: true},
+		Question: []dns.Question{{Name: "www.example.", Qtype: dns.TypeA, Qclass: dns.ClassINET}},
+		Answer:   example.sign(t, testA(t, "www.example.", "192.0.2.1")),
+	}
+	secure, err := validator.validate(context.Background(), upstream.exchangeFunc(), rm)
+	require.NoError(t, err)
+	assert.True(t, secure)
+	assert.Equal(t, []string{"example./DNSKEY"}, upstream.queries, "the chain should end at the trust anchor")
+
+	rm = &dns.Msg{
+		MsgHdr:   dns.MsgHdr{Response: true},
+		Question: []dns.Question{{Name: "other.test.", Qtype: dns.TypeA, Qclass: dns.ClassINET}},
+		Answer:   []dns.RR{testA(t, "other.test.", "192.0.2.3")},
+	}
+	secure, err = validator.validate(context.Background(), upstream.exchangeFunc(), rm)
+	require.NoError(t, err)
+	assert.False(t, secure, "names without trust anchor should be insecure")
+
+	_, err = newDNSSECValidator([]string{"example. 300 IN A 192.0.2.1"})
+	assert.Error(t, err)
+}
+
+func TestUpstreamResolver_DNSSECValidation(t *testing.T) {
+	upstream, root, example := newTestDNSSECHierarchy(t)
+
+	tampered := example.sign(t, testA(t, "bad.example.", "192.0.2.1"))
+	tampered[0].(*dns.A).A = net.ParseIP("192.0.2.66")
+	upstream.add("www.example.", dns.TypeA, dns.RcodeSuccess, example.sign(t, testA(t, "www.example.", "192.0.2.1")), nil)
+	upstream.add("bad.example.", dns.TypeA, dns.RcodeSuccess, tampered, nil)
+	upstream.add("host.insecure.example.", dns.TypeA, dns.RcodeSuccess, []dns.RR{testA(t, "host.insecure.example.", "192.0.2.2")}, nil)
+
+	validator, err := newDNSSECValidator([]string{root.ds().String()})
+	require.NoError(t, err)
+
+	resolver := &upstreamResolverBase{
+		ctx:             context.Background(),
+		upstreamClient:  upstream,
+		upstreamServers: []string{"192.0.2.53:53"},
+		upstreamTimeout: upstreamTimeout,
+		failsTillDeact:  failsTillDeact,
+		dnssec:          validator,
+	}
+
+	testCases := []struct {
+		name          string
+		qname         string
+		do            bool
+		cd            bool
+		expectedRcode int
+		expectedAD    bool
+		expectedSigs  bool
+	}{
+		{name: "secure answer", qname: "www.example.", expectedAD: true},
+		{name: "secure answer with DNSSEC records", qname: "www.example.", do: true, expectedAD: true, expectedSigs: true},
+		{name: "insecure answer", qname: "host.insecure.example."},
+		{name: "bogus answer", qname: "bad.example.", expectedRcode: dns.RcodeServerFailure},
+		{name: "bogus answer with checking disabled", qname: "bad.example.", cd: true, do: true, expectedSigs: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := new(dns.Msg).SetQuestion(tc.qname, dns.TypeA)
+			if tc.do {
+				r.SetEdns0(4096, true)
+			}
+			r.CheckingDisabled = tc.cd
+
+			var response *dns.Msg
+			resolver.ServeDNS(&mockResponseWriter{
+				WriteMsgFunc: func(m *dns.Msg) error {
+					response = m
+					return nil
+				},
+			}, r)
+
+			require.NotNil(t, response)
+			assert.Equal(t, tc.expectedRcode, response.Rcode)
+			assert.Equal(t, tc.expectedAD, response.AuthenticatedData)
+			if tc.expectedRcode != dns.RcodeSuccess {
+				return
+			}
+
+			hasSigs := false
+			for _, rr := range response.Answer {
+				if rr.Header().Rrtype == dns.TypeRRSIG {
+					hasSigs = true
+				}
+			}
+			assert.Equal(t, tc.expectedSigs, hasSigs)
+		})
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
 )
 
 const (
@@ -15,6 +18,15 @@ const (
 	PriorityDefault     = 0
 )
 
+// Kinds of handlers reported in the DNS query log
+const (
+	QueryHandlerLocal       = "local"
+	QueryHandlerUpstream    = "upstream"
+	QueryHandlerInterceptor = "interceptor"
+	QueryHandlerBlocklist   = "blocklist"
+	QueryHandlerNone        = "none"
+)
+
 type SubdomainMatcher interface {
 	dns.Handler
 	MatchSubdomains() bool
@@ -34,6 +46,18 @@ type HandlerEntry struct {
 type HandlerChain struct {
 	mu       sync.RWMutex
 	handlers []HandlerEntry
+
+	// cache and queryStats are shared by the handlers that forward queries to upstreams
+	cache      *ResponseCache
+	queryStats *QueryStats
+
+	queryRecorder queryRecorder
+}
+
+// queryRecorder receives the queries answered by the chain while the query log is enabled
+type queryRecorder interface {
+	DNSQueryLogEnabled() bool
+	RecordDNSQuery(query peer.DNSQuery)
 }
 
 // ResponseWriterChain wraps a dns.ResponseWriter to track if handler wants to continue chain
@@ -41,6 +65,13 @@ type ResponseWriterChain struct {
 	dns.ResponseWriter
 	origPattern    string
 	shouldContinue bool
+
+	// handler, upstream, cached and rcode describe the response for the query log
+	handler  string
+	upstream string
+	cached   bool
+	rcode    int
+	written  bool
 }
 
 func (w *ResponseWriterChain) WriteMsg(m *dns.Msg) error {
@@ -49,12 +80,48 @@ func (w *ResponseWriterChain) WriteMsg(m *dns.Msg) error {
 		w.shouldContinue = true
 		return nil
 	}
+	w.rcode = m.Rcode
+	w.written = true
 	return w.ResponseWriter.WriteMsg(m)
 }
 
+// SetUpstream records the upstream the handler forwarded the query to for the query log.
+// cached reports that the response was served from the response cache of the upstream
+func (w *ResponseWriterChain) SetUpstream(upstream string, cached bool) {
+	w.upstream = upstream
+	w.cached = cached
+}
+
 func NewHandlerChain() *HandlerChain {
 	return &HandlerChain{
-		handlers: make([]HandlerEntry, 0),
+		handlers:   make([]HandlerEntry, 0),
+		cache:      NewResponseCache(defaultCacheSize),
+		queryStats: NewQueryStats(),
+	}
+}
+
+// Cache returns the response cache shared by the handlers of the chain
+func (c *HandlerChain) Cache() *ResponseCache {
+	return c.cache
+}
+
+// QueryStats returns the upstream query statistics shared by the handlers of the chain
+func (c *HandlerChain) QueryStats() *QueryStats {
+	return c.queryStats
+}
+
+// SetQueryRecorder sets the recorder of the DNS query log
+func (c *HandlerChain) SetQueryRecorder(recorder queryRecorder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queryRecorder = recorder
+}
+
+// Stats returns the cache and upstream query statistics of the chain
+func (c *HandlerChain) Stats() peer.DNSStats {
+	return peer.DNSStats{
+		Cache:     c.cache.Stats(),
+		Upstreams: c.queryStats.Stats(),
 	}
 }
 
@@ -162,8 +229,18 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 
 	c.mu.RLock()
 	handlers := slices.Clone(c.handlers)
+	recorder := c.queryRecorder
 	c.mu.RUnlock()
 
+	if recorder != nil && recorder.DNSQueryLogEnabled() {
+		start := time.Now()
+		logWriter := &ResponseWriterChain{ResponseWriter: w}
+		defer func() {
+			recordQuery(recorder, r.Question[0], logWriter, start)
+		}()
+		w = logWriter
+	}
+
 	if log.IsLevelEnabled(log.TraceLevel) {
 		log.Tracef("current handlers (%d):", len(handlers))
 		for _, h := range handlers {
@@ -212,6 +289,12 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 			log.Tracef("handler requested continue to next handler")
 			continue
 		}
+
+		if logWriter, ok := w.(*ResponseWriterChain); ok {
+			logWriter.handler = handlerKind(entry)
+			logWriter.upstream = chainWriter.upstream
+			logWriter.cached = chainWriter.cached
+		}
 		return
 	}
 
@@ -223,3 +306,40 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		log.Errorf("failed to write DNS response: %v", err)
 	}
 }
+
+// handlerKind returns the kind of the handler reported in the query log
+func handlerKind(entry HandlerEntry) string {
+	if entry.Priority == PriorityDNSRoute {
+		return QueryHandlerInterceptor
+	}
+	switch entry.Handler.(type) {
+	case *localResolver:
+		return QueryHandlerLocal
+	case *blocklistResolver:
+		return QueryHandlerBlocklist
+	}
+	return QueryHandlerUpstream
+}
+
+// recordQuery adds the query answered through the log writer to the query log
+func recordQuery(recorder queryRecorder, question dns.Question, logWriter *ResponseWriterChain, start time.Time) {
+	if !logWriter.written {
+		return
+	}
+
+	handler := logWriter.handler
+	if handler == "" {
+		handler = QueryHandlerNone
+	}
+
+	recorder.RecordDNSQuery(peer.DNSQuery{
+		Time:     start,
+		Name:     strings.ToLower(question.Name),
+		Type:     dns.Type(question.Qtype).String(),
+		Handler:  handler,
+		Upstream: logWriter.upstream,
+		Cached:   logWriter.cached,
+		Rcode:    dns.RcodeToString[logWriter.rcode],
+		Latency:  time.Since(start),
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	nbdns "github.com/netbirdio/netbird/client/internal/dns"
+	"github.com/netbirdio/netbird/client/internal/peer"
 )
 
 // TestHandlerChain_ServeDNS_Priorities tests that handlers are executed in priority order
@@ -677,3 +678,64 @@ func TestHandlerChain_CaseSensitivity(t *testing.T) {
 		})
 	}
 }
+
+// TestHandlerChain_QueryLog tests that the answered queries are recorded in the query log
+func TestHandlerChain_QueryLog(t *testing.T) {
+	recorder := peer.NewRecorder("")
+	chain := nbdns.NewHandlerChain()
+	chain.SetQueryRecorder(recorder)
+
+	chain.AddHandler("example.com.", dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		w.(*nbdns.ResponseWriterChain).SetUpstream("100.64.0.10:5353", false)
+		resp := new(dns.Msg)
+		resp.SetReply(r)
+		_ = w.WriteMsg(resp)
+	}), nbdns.PriorityDNSRoute, nil)
+
+	chain.AddHandler("fallback.com.", dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeNameError)
+		resp.MsgHdr.Zero = true
+		_ = w.WriteMsg(resp)
+	}), nbdns.PriorityDNSRoute, nil)
+
+	chain.AddHandler("fallback.com.", dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		w.(*nbdns.ResponseWriterChain).SetUpstream("", true)
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeServerFailure)
+		_ = w.WriteMsg(resp)
+	}), nbdns.PriorityMatchDomain, nil)
+
+	query := func(name string, qtype uint16) {
+		r := new(dns.Msg)
+		r.SetQuestion(name, qtype)
+		chain.ServeDNS(&mockResponseWriter{}, r)
+	}
+
+	query("example.com.", dns.TypeA)
+	assert.Empty(t, recorder.GetDNSQueries(), "queries should not be recorded while the query log is disabled")
+
+	recorder.SetDNSQueryLogEnabled(true)
+	query("Example.com.", dns.TypeSRV)
+	query("fallback.com.", dns.TypeTXT)
+	query("missing.org.", dns.TypeAAAA)
+
+	queries := recorder.GetDNSQueries()
+	if !assert.Len(t, queries, 3) {
+		return
+	}
+
+	assert.Equal(t, "example.com.", queries[0].Name)
+	assert.Equal(t, "SRV", queries[0].Type)
+	assert.Equal(t, nbdns.QueryHandlerInterceptor, queries[0].Handler)
+	assert.Equal(t, "100.64.0.10:5353", queries[0].Upstream)
+	assert.Equal(t, "NOERROR", queries[0].Rcode)
+
+	assert.Equal(t, nbdns.QueryHandlerUpstream, queries[1].Handler)
+	assert.True(t, queries[1].Cached)
+	assert.Equal(t, "SERVFAIL", queries[1].Rcode)
+
+	assert.Equal(t, nbdns.QueryHandlerNone, queries[2].Handler)
+	assert.Equal(t, "NXDOMAIN", queries[2].Rcode)
+	assert.Empty(t, queries[2].Upstream)
+}
@@ -2,7 +2,9 @@ package dns
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
@@ -10,11 +12,21 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 )
 
+// maxCNAMEChain limits the number of CNAME records followed within the local records
+const maxCNAMEChain = 8
+
 type registrationMap map[string]struct{}
 
 type localResolver struct {
 	registeredMap registrationMap
-	records       sync.Map
+
+	mu sync.RWMutex
+	// records holds the record sets by name, class and type
+	records map[string][]dns.RR
+	// names counts the records below each name, including the empty non-terminals between the records and the root
+	names map[string]int
+	// rotation shifts the order of multi-record answers on every lookup, spreading clients over the record set
+	rotation atomic.Uint32
 }
 
 func (d *localResolver) MatchSubdomains() bool {
@@ -40,11 +52,10 @@ func (d *localResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	replyMessage.RecursionAvailable = true
 	replyMessage.Rcode = dns.RcodeSuccess
 
-	response := d.lookupRecord(r)
-	if response != nil {
-		replyMessage.Answer = append(replyMessage.Answer, response)
+	if len(r.Question) == 0 {
+		replyMessage.Rcode = dns.RcodeFormatError
 	} else {
-		replyMessage.Rcode = dns.RcodeNameError
+		replyMessage.Answer, replyMessage.Rcode = d.lookupRecords(r.Question[0])
 	}
 
 	err := w.WriteMsg(replyMessage)
@@ -53,36 +64,205 @@ func (d *localResolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 }
 
-func (d *localResolver) lookupRecord(r *dns.Msg) dns.RR {
-	question := r.Question[0]
-	record, found := d.records.Load(buildRecordKey(question.Name, question.Qclass, question.Qtype))
-	if !found {
+// rotate returns the records in round-robin order, starting at the next record on each call
+func (d *localResolver) rotate(records []dns.RR) []dns.RR {
+	if len(records) < 2 {
+		return records
+	}
+
+	offset := int(d.rotation.Add(1) % uint32(len(records)))
+	rotated := make([]dns.RR, 0, len(records))
+	rotated = append(rotated, records[offset:]...)
+	return append(rotated, records[:offset]...)
+}
+
+// lookupRecords returns the answers to the question and the response code.
+// CNAME records are followed as long as their targets are found in the local records.
+// A name without records of the requested type results in NODATA, an unknown name in NXDOMAIN.
+func (d *localResolver) lookupRecords(question dns.Question) ([]dns.RR, int) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var answers []dns.RR
+	name := strings.ToLower(dns.Fqdn(question.Name))
+	visited := make(map[string]struct{})
+
+	for range maxCNAMEChain {
+		visited[name] = struct{}{}
+
+		records, exists := d.lookupName(name, question.Qclass, question.Qtype)
+		if len(records) > 0 {
+			return append(answers, d.rotate(records)...), dns.RcodeSuccess
+		}
+
+		if !exists {
+			if len(answers) > 0 {
+				// the chain leaves the local records, the client will resolve the target
+				return answers, dns.RcodeSuccess
+			}
+			return nil, dns.RcodeNameError
+		}
+
+		if question.Qtype == dns.TypeCNAME {
+			return answers, dns.RcodeSuccess
+		}
+
+		cnames, _ := d.lookupName(name, question.Qclass, dns.TypeCNAME)
+		if len(cnames) == 0 {
+			return answers, dns.RcodeSuccess
+		}
+
+		answers = append(answers, cnames[0])
+		name = strings.ToLower(cnames[0].(*dns.CNAME).Target)
+		if _, loop := visited[name]; loop {
+			log.Debugf("CNAME loop detected in the local records for %s", question.Name)
+			return answers, dns.RcodeServerFailure
+		}
+	}
+
+	return answers, dns.RcodeSuccess
+}
+
+// lookupName returns copies of the records of the given type for the name, synthesizing them
+// from a wildcard as described in RFC 4592 if the name doesn't exist. exists reports whether
+// the name exists at all, either with records or as an empty non-terminal.
+func (d *localResolver) lookupName(name string, class, qType uint16) (records []dns.RR, exists bool) {
+	if d.names[name] > 0 {
+		return copyRecords(d.records[buildRecordKey(name, class, qType)], ""), true
+	}
+
+	// look for a wildcard at the closest encloser of the name
+	for parent := name; ; {
+		_, parent, _ = strings.Cut(parent, ".")
+		if parent == "" {
+			return nil, false
+		}
+
+		wildcard := "*." + parent
+		if d.names[wildcard] > 0 {
+			return copyRecords(d.records[buildRecordKey(wildcard, class, qType)], name), true
+		}
+
+		if d.names[parent] > 0 {
+			return nil, false
+		}
+	}
+}
+
+func copyRecords(records []dns.RR, owner string) []dns.RR {
+	if len(records) == 0 {
 		return nil
 	}
 
-	return record.(dns.RR)
+	copied := make([]dns.RR, 0, len(records))
+	for _, record := range records {
+		record = dns.Copy(record)
+		if owner != "" {
+			record.Header().Name = owner
+		}
+		copied = append(copied, record)
+	}
+	return copied
 }
 
 func (d *localResolver) registerRecord(record nbdns.SimpleRecord) error {
-	fullRecord, err := dns.NewRR(record.String())
+	fullRecord, err := newLocalRecord(record)
 	if err != nil {
 		return fmt.Errorf("register record: %w", err)
 	}
 
-	fullRecord.Header().Rdlength = record.Len()
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-	header := fullRecord.Header()
-	d.records.Store(buildRecordKey(header.Name, header.Class, header.Rrtype), fullRecord)
+	if d.records == nil {
+		d.records = make(map[string][]dns.RR)
+		d.names = make(map[string]int)
+	}
+	addLocalRecord(d.records, d.names, fullRecord)
 
 	return nil
 }
 
 func (d *localResolver) deleteRecord(recordKey string) {
-	d.records.Delete(dns.Fqdn(recordKey))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	records, ok := d.records[recordKey]
+	if !ok {
+		return
+	}
+	delete(d.records, recordKey)
+
+	name := strings.ToLower(records[0].Header().Name)
+	for node := name; node != ""; _, node, _ = strings.Cut(node, ".") {
+		d.names[node] -= len(records)
+		if d.names[node] <= 0 {
+			delete(d.names, node)
+		}
+	}
+}
+
+// updateRecords replaces all records of the resolver with the given record sets
+func (d *localResolver) updateRecords(update map[string][]nbdns.SimpleRecord) {
+	records := make(map[string][]dns.RR, len(update))
+	names := make(map[string]int)
+	registered := make(registrationMap, len(update))
+
+	for key, recordSet := range update {
+		for _, record := range recordSet {
+			fullRecord, err := newLocalRecord(record)
+			if err != nil {
+				log.Warnf("got an error while registering the record (%s), error: %v", record.String(), err)
+				continue
+			}
+			addLocalRecord(records, names, fullRecord)
+		}
+		registered[key] = struct{}{}
+	}
+
+	d.mu.Lock()
+	d.records = records
+	d.names = names
+	d.mu.Unlock()
+
+	d.registeredMap = registered
+}
+
+func newLocalRecord(record nbdns.SimpleRecord) (dns.RR, error) {
+	fullRecord, err := dns.NewRR(record.String())
+	if err != nil {
+		return nil, err
+	}
+	if fullRecord == nil {
+		return nil, fmt.Errorf("empty record %s", record.String())
+	}
+
+	if length := record.Len(); length > 0 {
+		fullRecord.Header().Rdlength = length
+	}
+
+	return fullRecord, nil
+}
+
+func addLocalRecord(records map[string][]dns.RR, names map[string]int, record dns.RR) {
+	header := record.Header()
+	key := buildRecordKey(header.Name, header.Class, header.Rrtype)
+
+	for _, existing := range records[key] {
+		if dns.IsDuplicate(existing, record) {
+			return
+		}
+	}
+	records[key] = append(records[key], record)
+
+	name := strings.ToLower(header.Name)
+	for node := name; node != ""; _, node, _ = strings.Cut(node, ".") {
+		names[node]++
+	}
 }
 
 func buildRecordKey(name string, class, qType uint16) string {
-	key := fmt.Sprintf("%s_%d_%d", name, class, qType)
+	key := fmt.Sprintf("%s_%d_%d", strings.ToLower(dns.Fqdn(name)), class, qType)
 	return key
 }
 
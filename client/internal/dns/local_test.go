@@ -1,6 +1,7 @@
 package dns
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
@@ -86,3 +87,237 @@ func TestLocalResolver_ServeDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalResolver_lookupRecords(t *testing.T) {
+	records := []nbdns.SimpleRecord{
+		{Name: "peera.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.1"},
+		{Name: "web.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.2"},
+		{Name: "web.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.3"},
+		{Name: "netbird.cloud.", Type: int(dns.TypeMX), Class: nbdns.DefaultClass, TTL: 300, RData: "10 peera.netbird.cloud."},
+		{Name: "netbird.cloud.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: `"v=spf1 -all"`},
+		{Name: "_sip._tcp.netbird.cloud.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: 300, RData: "10 5 5060 peera.netbird.cloud."},
+		{Name: "www.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "web.netbird.cloud."},
+		{Name: "docs.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "www.netbird.cloud."},
+		{Name: "external.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "netbird.io."},
+		{Name: "loop1.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "loop2.netbird.cloud."},
+		{Name: "loop2.netbird.cloud.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 300, RData: "loop1.netbird.cloud."},
+		{Name: "*.apps.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.4"},
+		{Name: "static.apps.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.5"},
+		{Name: "1.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 300, RData: "peera.netbird.cloud."},
+	}
+
+	resolver := &localResolver{}
+	for _, record := range records {
+		if err := resolver.registerRecord(record); err != nil {
+			t.Fatalf("register record %s: %v", record.String(), err)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		question      string
+		qtype         uint16
+		expectedRcode int
+		expected      []string
+	}{
+		{
+			name:          "Should Return All Records Of A Set",
+			question:      "web.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"100.64.0.2", "100.64.0.3"},
+		},
+		{
+			name:          "Should Match Case Insensitive",
+			question:      "PeerA.NetBird.Cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"100.64.0.1"},
+		},
+		{
+			name:          "Should Resolve MX Record",
+			question:      "netbird.cloud.",
+			qtype:         dns.TypeMX,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"10 peera.netbird.cloud."},
+		},
+		{
+			name:          "Should Resolve TXT Record",
+			question:      "netbird.cloud.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{`"v=spf1 -all"`},
+		},
+		{
+			name:          "Should Resolve SRV Record",
+			question:      "_sip._tcp.netbird.cloud.",
+			qtype:         dns.TypeSRV,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"10 5 5060 peera.netbird.cloud."},
+		},
+		{
+			name:          "Should Resolve PTR Record",
+			question:      "1.0.64.100.in-addr.arpa.",
+			qtype:         dns.TypePTR,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"peera.netbird.cloud."},
+		},
+		{
+			name:          "Should Chase CNAME Records",
+			question:      "docs.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"www.netbird.cloud.", "web.netbird.cloud.", "100.64.0.2", "100.64.0.3"},
+		},
+		{
+			name:          "Should Return CNAME When Asked For It",
+			question:      "www.netbird.cloud.",
+			qtype:         dns.TypeCNAME,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"web.netbird.cloud."},
+		},
+		{
+			name:          "Should Return CNAME To External Target",
+			question:      "external.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"netbird.io."},
+		},
+		{
+			name:          "Should Fail On CNAME Loop",
+			question:      "loop1.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeServerFailure,
+			expected:      []string{"loop2.netbird.cloud.", "loop1.netbird.cloud."},
+		},
+		{
+			name:          "Should Synthesize Wildcard Records",
+			question:      "grafana.apps.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"grafana.apps.netbird.cloud.\t300\tIN\tA\t100.64.0.4"},
+		},
+		{
+			name:          "Should Synthesize Wildcard Records For Deeper Names",
+			question:      "a.b.apps.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"100.64.0.4"},
+		},
+		{
+			name:          "Should Prefer Existing Names Over Wildcard",
+			question:      "static.apps.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expected:      []string{"100.64.0.5"},
+		},
+		{
+			name:          "Should Not Synthesize Wildcard Below Existing Names",
+			question:      "sub.static.apps.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+		},
+		{
+			name:          "Should Return NODATA For Existing Name Without Type",
+			question:      "peera.netbird.cloud.",
+			qtype:         dns.TypeAAAA,
+			expectedRcode: dns.RcodeSuccess,
+		},
+		{
+			name:          "Should Return NODATA For Empty Non-Terminal",
+			question:      "_tcp.netbird.cloud.",
+			qtype:         dns.TypeSRV,
+			expectedRcode: dns.RcodeSuccess,
+		},
+		{
+			name:          "Should Return NODATA For Wildcard Without Type",
+			question:      "grafana.apps.netbird.cloud.",
+			qtype:         dns.TypeTXT,
+			expectedRcode: dns.RcodeSuccess,
+		},
+		{
+			name:          "Should Return NXDOMAIN For Unknown Name",
+			question:      "unknown.netbird.cloud.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			answers, rcode := resolver.lookupRecords(dns.Question{Name: testCase.question, Qtype: testCase.qtype, Qclass: dns.ClassINET})
+			if rcode != testCase.expectedRcode {
+				t.Fatalf("unexpected rcode, want %s, got %s", dns.RcodeToString[testCase.expectedRcode], dns.RcodeToString[rcode])
+			}
+			if len(answers) != len(testCase.expected) {
+				t.Fatalf("unexpected number of answers, want %d, got %d: %v", len(testCase.expected), len(answers), answers)
+			}
+			// the records of a set are rotated on every lookup, so the answers are matched regardless of their order
+			for _, expected := range testCase.expected {
+				if !slices.ContainsFunc(answers, func(answer dns.RR) bool { return strings.Contains(answer.String(), expected) }) {
+					t.Fatalf("answers don't contain the expected data: \nWant: %s\nGot: %v", expected, answers)
+				}
+			}
+		})
+	}
+}
+
+func TestLocalResolver_updateRecords(t *testing.T) {
+	recordA := nbdns.SimpleRecord{Name: "peera.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.1"}
+	recordTXT := nbdns.SimpleRecord{Name: "peera.netbird.cloud.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: `"hello"`}
+
+	resolver := &localResolver{}
+	resolver.updateRecords(map[string][]nbdns.SimpleRecord{
+		buildRecordKey(recordA.Name, dns.ClassINET, dns.TypeA):     {recordA},
+		buildRecordKey(recordTXT.Name, dns.ClassINET, dns.TypeTXT): {recordTXT},
+	})
+
+	answers, _ := resolver.lookupRecords(dns.Question{Name: recordTXT.Name, Qtype: dns.TypeTXT, Qclass: dns.ClassINET})
+	if len(answers) != 1 {
+		t.Fatalf("expected the TXT record to be registered, got %v", answers)
+	}
+
+	resolver.updateRecords(map[string][]nbdns.SimpleRecord{
+		buildRecordKey(recordA.Name, dns.ClassINET, dns.TypeA): {recordA},
+	})
+
+	answers, rcode := resolver.lookupRecords(dns.Question{Name: recordTXT.Name, Qtype: dns.TypeTXT, Qclass: dns.ClassINET})
+	if len(answers) != 0 || rcode != dns.RcodeSuccess {
+		t.Fatalf("expected NODATA for the removed TXT record, got %s %v", dns.RcodeToString[rcode], answers)
+	}
+
+	resolver.deleteRecord(buildRecordKey(recordA.Name, dns.ClassINET, dns.TypeA))
+	_, rcode = resolver.lookupRecords(dns.Question{Name: recordA.Name, Qtype: dns.TypeA, Qclass: dns.ClassINET})
+	if rcode != dns.RcodeNameError {
+		t.Fatalf("expected NXDOMAIN after deleting all records of the name, got %s", dns.RcodeToString[rcode])
+	}
+}
+
+func TestLocalResolver_RoundRobin(t *testing.T) {
+	records := []nbdns.SimpleRecord{
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 30, RData: "100.64.0.1"},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 30, RData: "100.64.0.2"},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 30, RData: "100.64.0.3"},
+	}
+
+	resolver := &localResolver{}
+	resolver.updateRecords(map[string][]nbdns.SimpleRecord{
+		buildRecordKey("db.netbird.cloud.", dns.ClassINET, dns.TypeA): records,
+	})
+
+	question := dns.Question{Name: "db.netbird.cloud.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	first := make(map[string]int)
+	for range len(records) * 2 {
+		answers, rcode := resolver.lookupRecords(question)
+		if rcode != dns.RcodeSuccess || len(answers) != len(records) {
+			t.Fatalf("expected all %d records, got %s %v", len(records), dns.RcodeToString[rcode], answers)
+		}
+		first[answers[0].(*dns.A).A.String()]++
+	}
+
+	for _, record := range records {
+		if first[record.RData] != 2 {
+			t.Fatalf("expected every record to be answered first twice, got %v", first)
+		}
+	}
+}
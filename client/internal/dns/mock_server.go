@@ -68,3 +68,13 @@ func (m *MockServer) SearchDomains() []string {
 // ProbeAvailability mocks implementation of ProbeAvailability from the Server interface
 func (m *MockServer) ProbeAvailability() {
 }
+
+// ResponseCache mock implementation of ResponseCache from Server interface
+func (m *MockServer) ResponseCache() *ResponseCache {
+	return nil
+}
+
+// QueryStats mock implementation of QueryStats from Server interface
+func (m *MockServer) QueryStats() *QueryStats {
+	return nil
+}
@@ -40,6 +40,8 @@ type Server interface {
 	OnUpdatedHostDNSServer(strings []string)
 	SearchDomains() []string
 	ProbeAvailability()
+	ResponseCache() *ResponseCache
+	QueryStats() *QueryStats
 }
 
 type registeredHandlerMap map[string]handlerWithStop
@@ -60,6 +62,7 @@ type DefaultServer struct {
 	previousConfigHash uint64
 	currentConfig      HostDNSConfig
 	handlerChain       *HandlerChain
+	blocklistResolver  *blocklistResolver
 
 	// permanent related properties
 	permanent      bool
@@ -163,6 +166,7 @@ func newDefaultServer(
 		disableSys:        disableSys,
 		service:           dnsService,
 		handlerChain:      NewHandlerChain(),
+		blocklistResolver: newBlocklistResolver(),
 		dnsMuxMap:         make(registeredHandlerMap),
 		handlerPriorities: make(map[string]int),
 		localResolver: &localResolver{
@@ -174,9 +178,31 @@ func newDefaultServer(
 		hostsDNSHolder: newHostsDNSHolder(),
 	}
 
+	if statusRecorder != nil {
+		statusRecorder.SetDNSStatsProvider(defaultServer.stats)
+		defaultServer.handlerChain.SetQueryRecorder(statusRecorder)
+	}
+
 	return defaultServer
 }
 
+// ResponseCache returns the response cache shared by the DNS handlers
+func (s *DefaultServer) ResponseCache() *ResponseCache {
+	return s.handlerChain.Cache()
+}
+
+// QueryStats returns the upstream query statistics shared by the DNS handlers
+func (s *DefaultServer) QueryStats() *QueryStats {
+	return s.handlerChain.QueryStats()
+}
+
+// stats returns the statistics of the handler chain and the blocked queries per blocklist
+func (s *DefaultServer) stats() peer.DNSStats {
+	stats := s.handlerChain.Stats()
+	stats.Blocklists = s.blocklistResolver.Stats()
+	return stats
+}
+
 func (s *DefaultServer) RegisterHandler(domains []string, handler dns.Handler, priority int) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -390,6 +416,7 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 
 	s.updateMux(muxUpdates)
 	s.updateLocalResolver(localRecords)
+	s.updateBlocklists(update.Blocklists)
 	s.currentConfig = dnsConfigToHostDNSConfig(update, s.service.RuntimeIP(), s.service.RuntimePort())
 
 	hostUpdate := s.currentConfig
@@ -419,9 +446,9 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 	return nil
 }
 
-func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]muxUpdate, map[string]nbdns.SimpleRecord, error) {
+func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]muxUpdate, map[string][]nbdns.SimpleRecord, error) {
 	var muxUpdates []muxUpdate
-	localRecords := make(map[string]nbdns.SimpleRecord, 0)
+	localRecords := make(map[string][]nbdns.SimpleRecord, 0)
 
 	for _, customZone := range customZones {
 		if len(customZone.Records) == 0 {
@@ -440,7 +467,7 @@ func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone)
 				return nil, nil, fmt.Errorf("received an invalid class type: %s", record.Class)
 			}
 			key := buildRecordKey(record.Name, class, uint16(record.Type))
-			localRecords[key] = record
+			localRecords[key] = append(localRecords[key], record)
 		}
 	}
 	return muxUpdates, localRecords, nil
@@ -466,13 +493,21 @@ func (s *DefaultServer) buildUpstreamHandlerUpdate(nameServerGroups []*nbdns.Nam
 		if err != nil {
 			return nil, fmt.Errorf("unable to create a new upstream resolver, error: %v", err)
 		}
+		handler.cache = s.handlerChain.Cache()
+		handler.queryStats = s.handlerChain.QueryStats()
 		for _, ns := range nsGroup.NameServers {
-			if ns.NSType != nbdns.UDPNameServerType {
-				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s",
-					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String())
-				continue
+			switch ns.NSType {
+			case nbdns.UDPNameServerType:
+				handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
+			case nbdns.TLSNameServerType, nbdns.HTTPSNameServerType:
+				if err := handler.addEncryptedUpstream(ns); err != nil {
+					log.Warnf("skipping nameserver %s: %v", ns.URL(), err)
+				}
+			default:
+				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s, %s and %s",
+					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String(),
+					nbdns.TLSNameServerType.String(), nbdns.HTTPSNameServerType.String())
 			}
-			handler.upstreamServers = append(handler.upstreamServers, getNSHostPort(ns))
 		}
 
 		if len(handler.upstreamServers) == 0 {
@@ -481,6 +516,14 @@ func (s *DefaultServer) buildUpstreamHandlerUpdate(nameServerGroups []*nbdns.Nam
 			continue
 		}
 
+		if nsGroup.DNSSECValidation {
+			if handler.dnssec, err = newDNSSECValidator(nsGroup.DNSSECTrustAnchors); err != nil {
+				handler.stop()
+				log.Errorf("skipping nameserver group %v with DNSSEC validation: %v", handler.upstreamServers, err)
+				continue
+			}
+		}
+
 		// when upstream fails to resolve domain several times over all it servers
 		// it will calls this hook to exclude self from the configuration and
 		// reapply DNS settings, but it not touch the original configuration and serial number
@@ -563,24 +606,24 @@ func (s *DefaultServer) updateMux(muxUpdates []muxUpdate) {
 	s.handlerPriorities = handlersByPriority
 }
 
-func (s *DefaultServer) updateLocalResolver(update map[string]nbdns.SimpleRecord) {
-	for key := range s.localResolver.registeredMap {
-		_, found := update[key]
-		if !found {
-			s.localResolver.deleteRecord(key)
-		}
-	}
+func (s *DefaultServer) updateLocalResolver(update map[string][]nbdns.SimpleRecord) {
+	s.localResolver.updateRecords(update)
+}
 
-	updatedMap := make(registrationMap)
-	for key, record := range update {
-		err := s.localResolver.registerRecord(record)
-		if err != nil {
-			log.Warnf("got an error while registering the record (%s), error: %v", record.String(), err)
-		}
-		updatedMap[key] = struct{}{}
+// updateBlocklists applies the blocked domains. The blocklist resolver is added to the root zone of the chain
+// directly as the root zone handler registered through updateMux is tracked by domain only.
+// Blocklists only apply to the queries the host sends to this server.
+func (s *DefaultServer) updateBlocklists(blocklists []nbdns.Blocklist) {
+	if s.blocklistResolver.update(blocklists) {
+		s.handlerChain.AddHandler(nbdns.RootZone, s.blocklistResolver, PriorityBlocklist, nil)
+		s.service.RegisterMux(nbdns.RootZone, s.handlerChain)
+		return
 	}
 
-	s.localResolver.registeredMap = updatedMap
+	s.handlerChain.RemoveHandler(nbdns.RootZone, PriorityBlocklist)
+	if !s.handlerChain.HasHandlers(nbdns.RootZone) {
+		s.service.DeregisterMux(nbdns.RootZone)
+	}
 }
 
 func getNSHostPort(ns nbdns.NameServer) string {
@@ -682,6 +725,8 @@ func (s *DefaultServer) addHostRootZone() {
 		return
 	}
 
+	handler.cache = s.handlerChain.Cache()
+	handler.queryStats = s.handlerChain.QueryStats()
 	handler.upstreamServers = make([]string, 0)
 	for k := range s.hostsDNSHolder.get() {
 		handler.upstreamServers = append(handler.upstreamServers, k)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/client/internal/peer"
+	nbdns "github.com/netbirdio/netbird/dns"
 )
 
 const (
@@ -30,16 +32,27 @@ type upstreamClient interface {
 	exchange(ctx context.Context, upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error)
 }
 
+type encryptedUpstreamClient interface {
+	upstreamClient
+	close()
+}
+
 type UpstreamResolver interface {
 	serveDNS(r *dns.Msg) (*dns.Msg, time.Duration, error)
 	upstreamExchange(upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error)
 }
 
 type upstreamResolverBase struct {
-	ctx              context.Context
-	cancel           context.CancelFunc
-	upstreamClient   upstreamClient
-	upstreamServers  []string
+	ctx             context.Context
+	cancel          context.CancelFunc
+	upstreamClient  upstreamClient
+	upstreamServers []string
+	// encryptedClients are the clients of the DNS-over-TLS and DNS-over-HTTPS upstreams
+	encryptedClients map[string]encryptedUpstreamClient
+	cache            *ResponseCache
+	queryStats       *QueryStats
+	// dnssec validates the upstream answers if DNSSEC validation is enabled for the nameserver group
+	dnssec           *dnssecValidator
 	disabled         bool
 	failsCount       atomic.Int32
 	successCount     atomic.Int32
@@ -63,6 +76,7 @@ func newUpstreamResolverBase(ctx context.Context, statusRecorder *peer.Status) *
 		reactivatePeriod: reactivatePeriod,
 		failsTillDeact:   failsTillDeact,
 		statusRecorder:   statusRecorder,
+		encryptedClients: make(map[string]encryptedUpstreamClient),
 	}
 }
 
@@ -78,6 +92,31 @@ func (u *upstreamResolverBase) MatchSubdomains() bool {
 func (u *upstreamResolverBase) stop() {
 	log.Debugf("stopping serving DNS for upstreams %s", u.upstreamServers)
 	u.cancel()
+
+	for _, client := range u.encryptedClients {
+		client.close()
+	}
+}
+
+// addEncryptedUpstream adds a DNS-over-TLS or DNS-over-HTTPS nameserver to the upstreams
+func (u *upstreamResolverBase) addEncryptedUpstream(ns nbdns.NameServer) error {
+	client, err := newEncryptedUpstreamClient(ns)
+	if err != nil {
+		return err
+	}
+
+	upstream := ns.URL()
+	u.encryptedClients[upstream] = client
+	u.upstreamServers = append(u.upstreamServers, upstream)
+	return nil
+}
+
+// clientFor returns the client to query the given upstream with
+func (u *upstreamResolverBase) clientFor(upstream string) upstreamClient {
+	if client, ok := u.encryptedClients[upstream]; ok {
+		return client
+	}
+	return u.upstreamClient
 }
 
 // ServeDNS handles a DNS request
@@ -100,6 +139,20 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	default:
 	}
 
+	if rm, prefetch := u.cache.Get(u.cacheScope(), r); rm != nil {
+		if prefetch {
+			go u.prefetch(r.Copy())
+		}
+		if cw, ok := w.(*ResponseWriterChain); ok {
+			cw.SetUpstream("", true)
+		}
+		if err := w.WriteMsg(rm); err != nil {
+			log.WithError(err).Error("got an error while writing the cached upstream response")
+		}
+		return
+	}
+
+	req := u.upstreamQuery(r)
 	for _, upstream := range u.upstreamServers {
 		var rm *dns.Msg
 		var t time.Duration
@@ -107,8 +160,9 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		func() {
 			ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
 			defer cancel()
-			rm, t, err = u.upstreamClient.exchange(ctx, upstream, r)
+			rm, t, err = u.clientFor(upstream).exchange(ctx, upstream, req)
 		}()
+		u.queryStats.Record(upstream, t, err)
 
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) || isTimeout(err) {
@@ -137,6 +191,19 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		u.successCount.Add(1)
 		log.Tracef("took %s to query the upstream %s", t, upstream)
 
+		if rm, ok := u.validateResponse(upstream, r, rm); !ok {
+			if err := w.WriteMsg(rm); err != nil {
+				log.WithError(err).Error("got an error while writing the DNSSEC validation failure response")
+			}
+			u.failsCount.Store(0)
+			return
+		}
+
+		u.cache.Set(u.cacheScope(), r, rm)
+
+		if cw, ok := w.(*ResponseWriterChain); ok {
+			cw.SetUpstream(upstream, false)
+		}
 		err = w.WriteMsg(rm)
 		if err != nil {
 			log.WithError(err).Error("got an error while writing the upstream resolver response")
@@ -149,6 +216,79 @@ func (u *upstreamResolverBase) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	log.Error("all queries to the upstream nameservers failed with timeout")
 }
 
+// cacheScope returns the scope of the cached responses of this resolver
+func (u *upstreamResolverBase) cacheScope() string {
+	scope := strings.Join(u.upstreamServers, ",")
+	if u.dnssec != nil {
+		scope += "+dnssec"
+	}
+	return scope
+}
+
+// upstreamQuery returns the query to send to the upstreams, it requests the DNSSEC records if the answers are validated
+func (u *upstreamResolverBase) upstreamQuery(r *dns.Msg) *dns.Msg {
+	if u.dnssec == nil || r.CheckingDisabled {
+		return r
+	}
+	return u.dnssec.upstreamQuery(r)
+}
+
+// validateResponse validates the DNSSEC signatures of the upstream response if validation is enabled.
+// The AD bit is set only for validated answers. Bogus answers are replaced with a SERVFAIL response and false is returned.
+func (u *upstreamResolverBase) validateResponse(upstream string, r, rm *dns.Msg) (*dns.Msg, bool) {
+	// queries with the CD bit set ask for the answer without validation
+	if u.dnssec == nil || r.CheckingDisabled {
+		return rm, true
+	}
+
+	secure := false
+	if !rm.Truncated {
+		ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
+		defer cancel()
+
+		exchange := func(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+			resp, _, err := u.clientFor(upstream).exchange(ctx, upstream, m)
+			return resp, err
+		}
+
+		var err error
+		if secure, err = u.dnssec.validate(ctx, exchange, rm); err != nil {
+			log.WithField("question", r.Question[0]).WithField("upstream", upstream).
+				Warnf("DNSSEC validation failed: %v", err)
+			return dnssecBogusResponse(r, err), false
+		}
+	}
+
+	rm.AuthenticatedData = secure
+	if !dnssecOK(r) {
+		stripDNSSECRecords(rm)
+	}
+	return rm, true
+}
+
+// prefetch refreshes the cached response of a hot query before it expires
+func (u *upstreamResolverBase) prefetch(r *dns.Msg) {
+	for _, upstream := range u.upstreamServers {
+		ctx, cancel := context.WithTimeout(u.ctx, u.upstreamTimeout)
+		rm, t, err := u.clientFor(upstream).exchange(ctx, upstream, u.upstreamQuery(r))
+		cancel()
+		u.queryStats.Record(upstream, t, err)
+
+		if err != nil || rm == nil || !rm.Response {
+			continue
+		}
+
+		rm, ok := u.validateResponse(upstream, r, rm)
+		if !ok {
+			return
+		}
+
+		log.Tracef("prefetched %s from the upstream %s", r.Question[0].Name, upstream)
+		u.cache.Set(u.cacheScope(), r, rm)
+		return
+	}
+}
+
 // checkUpstreamFails counts fails and disables or enables upstream resolving
 //
 // If fails count is greater that failsTillDeact, upstream resolving
@@ -294,6 +434,6 @@ func (u *upstreamResolverBase) testNameserver(server string, timeout time.Durati
 
 	r := new(dns.Msg).SetQuestion(testRecord, dns.TypeSOA)
 
-	_, _, err := u.upstreamClient.exchange(ctx, server, r)
+	_, _, err := u.clientFor(server).exchange(ctx, server, r)
 	return err
 }
@@ -0,0 +1,240 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	encryptedDialTimeout      = 5 * time.Second
+	encryptedIdleTimeout      = 60 * time.Second
+	encryptedMaxIdleConns     = 4
+	encryptedSessionCacheSize = 8
+	dohMediaType              = "application/dns-message"
+	dohMaxResponseSize        = dns.MaxMsgSize
+)
+
+// newEncryptedUpstreamClient returns the upstream client for a DNS-over-TLS or DNS-over-HTTPS nameserver.
+// The clients keep their connections and TLS sessions open across queries.
+func newEncryptedUpstreamClient(ns nbdns.NameServer) (encryptedUpstreamClient, error) {
+	address := netip.AddrPortFrom(ns.IP, uint16(ns.Port)).String()
+	tlsConfig := &tls.Config{
+		ServerName:         ns.ServerName(),
+		MinVersion:         tls.VersionTLS12,
+		ClientSessionCache: tls.NewLRUClientSessionCache(encryptedSessionCacheSize),
+	}
+
+	switch ns.NSType {
+	case nbdns.TLSNameServerType:
+		return newDoTClient(address, tlsConfig), nil
+	case nbdns.HTTPSNameServerType:
+		return newDoHClient(address, ns, tlsConfig), nil
+	default:
+		return nil, fmt.Errorf("nameserver type %s is not encrypted", ns.NSType)
+	}
+}
+
+// dotClient queries a DNS-over-TLS nameserver reusing idle connections
+type dotClient struct {
+	address   string
+	tlsConfig *tls.Config
+	dialer    *tls.Dialer
+
+	mu        sync.Mutex
+	idleConns []*dns.Conn
+	closed    bool
+}
+
+func newDoTClient(address string, tlsConfig *tls.Config) *dotClient {
+	return &dotClient{
+		address:   address,
+		tlsConfig: tlsConfig,
+		dialer: &tls.Dialer{
+			NetDialer: &net.Dialer{Timeout: encryptedDialTimeout, KeepAlive: encryptedIdleTimeout},
+			Config:    tlsConfig,
+		},
+	}
+}
+
+func (c *dotClient) exchange(ctx context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	conn, reused, err := c.getConn(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rm, t, err := c.exchangeWithConn(ctx, conn, r)
+	if err != nil && reused {
+		// the server may have closed the idle connection in the meantime, retry once with a new connection
+		if conn, err = c.dial(ctx); err != nil {
+			return nil, 0, err
+		}
+		rm, t, err = c.exchangeWithConn(ctx, conn, r)
+	}
+	if err != nil {
+		return nil, t, err
+	}
+
+	c.putConn(conn)
+	return rm, t, nil
+}
+
+func (c *dotClient) exchangeWithConn(ctx context.Context, conn *dns.Conn, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	client := &dns.Client{Net: "tcp-tls", TLSConfig: c.tlsConfig}
+	rm, t, err := client.ExchangeWithConnContext(ctx, r, conn)
+	if err != nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			log.Debugf("failed to close connection to DNS-over-TLS upstream %s: %v", c.address, closeErr)
+		}
+	}
+	return rm, t, err
+}
+
+func (c *dotClient) getConn(ctx context.Context) (*dns.Conn, bool, error) {
+	c.mu.Lock()
+	if n := len(c.idleConns); n > 0 {
+		conn := c.idleConns[n-1]
+		c.idleConns = c.idleConns[:n-1]
+		c.mu.Unlock()
+		return conn, true, nil
+	}
+	c.mu.Unlock()
+
+	conn, err := c.dial(ctx)
+	return conn, false, err
+}
+
+func (c *dotClient) dial(ctx context.Context) (*dns.Conn, error) {
+	conn, err := c.dialer.DialContext(ctx, "tcp", c.address)
+	if err != nil {
+		return nil, fmt.Errorf("dial DNS-over-TLS upstream %s: %w", c.address, err)
+	}
+	return &dns.Conn{Conn: conn}, nil
+}
+
+func (c *dotClient) putConn(conn *dns.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || len(c.idleConns) >= encryptedMaxIdleConns {
+		if err := conn.Close(); err != nil {
+			log.Debugf("failed to close connection to DNS-over-TLS upstream %s: %v", c.address, err)
+		}
+		return
+	}
+	c.idleConns = append(c.idleConns, conn)
+}
+
+func (c *dotClient) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	for _, conn := range c.idleConns {
+		if err := conn.Close(); err != nil {
+			log.Debugf("failed to close connection to DNS-over-TLS upstream %s: %v", c.address, err)
+		}
+	}
+	c.idleConns = nil
+}
+
+// dohClient queries a DNS-over-HTTPS nameserver over a keep-alive HTTP client
+type dohClient struct {
+	url        string
+	httpClient *http.Client
+	transport  *http.Transport
+}
+
+func newDoHClient(address string, ns nbdns.NameServer, tlsConfig *tls.Config) *dohClient {
+	dialer := &net.Dialer{Timeout: encryptedDialTimeout, KeepAlive: encryptedIdleTimeout}
+	transport := &http.Transport{
+		// always connect to the configured IP, the hostname is only used for the request and the TLS verification
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		},
+		TLSClientConfig:     tlsConfig,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        encryptedMaxIdleConns,
+		MaxIdleConnsPerHost: encryptedMaxIdleConns,
+		IdleConnTimeout:     encryptedIdleTimeout,
+		TLSHandshakeTimeout: encryptedDialTimeout,
+	}
+
+	host := address
+	if ns.Hostname != "" {
+		host = net.JoinHostPort(ns.Hostname, fmt.Sprint(ns.Port))
+	}
+	path := ns.Path
+	if path == "" {
+		path = nbdns.DefaultHTTPSNameServerPath
+	}
+
+	return &dohClient{
+		url:        (&url.URL{Scheme: "https", Host: host, Path: path}).String(),
+		httpClient: &http.Client{Transport: transport},
+		transport:  transport,
+	}
+}
+
+func (c *dohClient) exchange(ctx context.Context, _ string, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	// RFC 8484 recommends the ID 0 to make responses cacheable, the original ID is restored on the response
+	query := r.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, 0, fmt.Errorf("pack DNS query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create DNS-over-HTTPS request: %w", err)
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query DNS-over-HTTPS upstream %s: %w", c.url, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debugf("failed to close response body of DNS-over-HTTPS upstream %s: %v", c.url, err)
+		}
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dohMaxResponseSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("read DNS-over-HTTPS response: %w", err)
+	}
+	t := time.Since(start)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, t, fmt.Errorf("DNS-over-HTTPS upstream %s returned status %s", c.url, resp.Status)
+	}
+
+	rm := new(dns.Msg)
+	if err := rm.Unpack(body); err != nil {
+		return nil, t, fmt.Errorf("unpack DNS-over-HTTPS response: %w", err)
+	}
+	rm.Id = r.Id
+
+	return rm, t, nil
+}
+
+func (c *dohClient) close() {
+	c.transport.CloseIdleConnections()
+}
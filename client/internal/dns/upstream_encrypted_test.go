@@ -0,0 +1,121 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func answerA(r *dns.Msg) *dns.Msg {
+	rm := new(dns.Msg).SetReply(r)
+	rm.Answer = append(rm.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+		A:   net.ParseIP("10.0.0.1"),
+	})
+	return rm
+}
+
+func TestDoTClient_ReusesConnection(t *testing.T) {
+	certServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer certServer.Close()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", certServer.TLS)
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		Listener: listener,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			_ = w.WriteMsg(answerA(r))
+		}),
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	defer func() {
+		_ = server.Shutdown()
+	}()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certServer.Certificate())
+	client := newDoTClient(listener.Addr().String(), &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"})
+	defer client.close()
+
+	for i := 0; i < 3; i++ {
+		rm, _, err := client.exchange(context.Background(), "", new(dns.Msg).SetQuestion("example.com.", dns.TypeA))
+		require.NoError(t, err)
+		require.Len(t, rm.Answer, 1)
+		assert.Equal(t, "10.0.0.1", rm.Answer[0].(*dns.A).A.String())
+	}
+
+	client.mu.Lock()
+	assert.Len(t, client.idleConns, 1, "the connection should be kept open across queries")
+	client.mu.Unlock()
+}
+
+func TestDoHClient_Exchange(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		if req.URL.Path != "/custom-query" || req.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r := new(dns.Msg)
+		if err := r.Unpack(body); err != nil || r.Id != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		packed, err := answerA(r).Pack()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(packed)
+	}))
+	defer server.Close()
+
+	addrPort := netip.MustParseAddrPort(server.Listener.Addr().String())
+	ns := nbdns.NameServer{
+		IP:     addrPort.Addr(),
+		NSType: nbdns.HTTPSNameServerType,
+		Port:   int(addrPort.Port()),
+		Path:   "/custom-query",
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	client := newDoHClient(addrPort.String(), ns, &tls.Config{RootCAs: pool, ServerName: ns.ServerName()})
+	defer client.close()
+
+	for i := 0; i < 2; i++ {
+		query := new(dns.Msg).SetQuestion("example.com.", dns.TypeA)
+		rm, _, err := client.exchange(context.Background(), "", query)
+		require.NoError(t, err)
+		assert.Equal(t, query.Id, rm.Id, "the original query ID should be restored")
+		require.Len(t, rm.Answer, 1)
+		assert.Equal(t, "10.0.0.1", rm.Answer[0].(*dns.A).A.String())
+	}
+	assert.Equal(t, int32(2), requests.Load())
+}
@@ -2,8 +2,12 @@ package dnsfwd
 
 import (
 	"context"
-	"errors"
-	"net"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
@@ -11,26 +15,37 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 )
 
-const errResolveFailed = "failed to resolve query for domain=%s: %v"
+const (
+	upstreamTimeout = 5 * time.Second
+	defaultDNSPort  = 53
+)
+
+// ForwarderEntry is a domain served by the forwarder. Queries for the domain are relayed to the
+// upstreams of the entry or to the system resolvers of the routing peer when the entry has none
+type ForwarderEntry struct {
+	Domain    string
+	Upstreams []string
+}
 
 type DNSForwarder struct {
 	listenAddress string
-	ttl           uint32
 	domains       []string
 
 	dnsServer *dns.Server
 	mux       *dns.ServeMux
+
+	mu              sync.RWMutex
+	systemResolvers []string
 }
 
-func NewDNSForwarder(listenAddress string, ttl uint32) *DNSForwarder {
-	log.Debugf("creating DNS forwarder with listen_address=%s ttl=%d", listenAddress, ttl)
+func NewDNSForwarder(listenAddress string) *DNSForwarder {
+	log.Debugf("creating DNS forwarder with listen_address=%s", listenAddress)
 	return &DNSForwarder{
 		listenAddress: listenAddress,
-		ttl:           ttl,
 	}
 }
 
-func (f *DNSForwarder) Listen(domains []string) error {
+func (f *DNSForwarder) Listen(entries []ForwarderEntry) error {
 	log.Infof("listen DNS forwarder on address=%s", f.listenAddress)
 	mux := dns.NewServeMux()
 
@@ -42,21 +57,27 @@ func (f *DNSForwarder) Listen(domains []string) error {
 	f.dnsServer = dnsServer
 	f.mux = mux
 
-	f.UpdateDomains(domains)
+	f.UpdateDomains(entries)
 
 	return dnsServer.ListenAndServe()
 }
 
-func (f *DNSForwarder) UpdateDomains(domains []string) {
-	log.Debugf("Updating domains from %v to %v", f.domains, domains)
+func (f *DNSForwarder) UpdateDomains(entries []ForwarderEntry) {
+	log.Debugf("Updating domains from %v to %v", f.domains, entries)
+
+	f.refreshSystemResolvers()
 
 	for _, d := range f.domains {
 		f.mux.HandleRemove(d)
 	}
 
-	newDomains := filterDomains(domains)
-	for _, d := range newDomains {
-		f.mux.HandleFunc(d, f.handleDNSQuery)
+	upstreamsByDomain := filterEntries(entries)
+	newDomains := make([]string, 0, len(upstreamsByDomain))
+	for d, upstreams := range upstreamsByDomain {
+		f.mux.HandleFunc(d, func(w dns.ResponseWriter, query *dns.Msg) {
+			f.handleDNSQuery(w, query, upstreams)
+		})
+		newDomains = append(newDomains, d)
 	}
 	f.domains = newDomains
 }
@@ -68,90 +89,156 @@ func (f *DNSForwarder) Close(ctx context.Context) error {
 	return f.dnsServer.ShutdownContext(ctx)
 }
 
-func (f *DNSForwarder) handleDNSQuery(w dns.ResponseWriter, query *dns.Msg) {
+// refreshSystemResolvers reloads the resolvers of the host used for the domains without upstreams
+func (f *DNSForwarder) refreshSystemResolvers() {
+	resolvers, err := systemResolvers()
+	if err != nil {
+		log.Warnf("failed to read the system resolvers for the DNS forwarder: %v", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// keep the previous resolvers if the system configuration can't be read at the moment
+	if len(resolvers) > 0 || err == nil {
+		f.systemResolvers = resolvers
+	}
+}
+
+func (f *DNSForwarder) upstreamsFor(upstreams []string) []string {
+	if len(upstreams) > 0 {
+		return upstreams
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.systemResolvers
+}
+
+// handleDNSQuery relays the query as is to the upstreams and writes back the first response, so
+// all record types, the DNSSEC records and flags and the TTLs of the upstream are preserved
+func (f *DNSForwarder) handleDNSQuery(w dns.ResponseWriter, query *dns.Msg, upstreams []string) {
 	if len(query.Question) == 0 {
 		return
 	}
+	question := query.Question[0]
 	log.Tracef("received DNS request for DNS forwarder: domain=%v type=%v class=%v",
-		query.Question[0].Name, query.Question[0].Qtype, query.Question[0].Qclass)
+		question.Name, question.Qtype, question.Qclass)
 
-	question := query.Question[0]
-	domain := question.Name
+	resp := f.exchange(query, f.upstreamsFor(upstreams))
+	if resp == nil {
+		resp = new(dns.Msg)
+		resp.SetRcode(query, dns.RcodeServerFailure)
+	}
 
-	resp := query.SetReply(query)
+	resp.Id = query.Id
+	resp.Truncate(udpSize(query))
 
-	ips, err := net.LookupIP(domain)
-	if err != nil {
-		var dnsErr *net.DNSError
-
-		switch {
-		case errors.As(err, &dnsErr):
-			resp.Rcode = dns.RcodeServerFailure
-			if dnsErr.IsNotFound {
-				// Pass through NXDOMAIN
-				resp.Rcode = dns.RcodeNameError
-			}
+	if err := w.WriteMsg(resp); err != nil {
+		log.Errorf("failed to write DNS response: %v", err)
+	}
+}
 
-			if dnsErr.Server != "" {
-				log.Warnf("failed to resolve query for domain=%s server=%s: %v", domain, dnsErr.Server, err)
-			} else {
-				log.Warnf(errResolveFailed, domain, err)
-			}
-		default:
-			resp.Rcode = dns.RcodeServerFailure
-			log.Warnf(errResolveFailed, domain, err)
+// exchange tries the upstreams in order and falls back to TCP when a UDP response is truncated
+func (f *DNSForwarder) exchange(query *dns.Msg, upstreams []string) *dns.Msg {
+	domain := query.Question[0].Name
+	if len(upstreams) == 0 {
+		log.Warnf("failed to resolve query for domain=%s: no upstream resolvers available", domain)
+		return nil
+	}
+
+	for _, upstream := range upstreams {
+		client := &dns.Client{Net: "udp", Timeout: upstreamTimeout}
+		resp, _, err := client.Exchange(query, upstream)
+		if err == nil && resp.Truncated {
+			client.Net = "tcp"
+			resp, _, err = client.Exchange(query, upstream)
+		}
+		if err != nil {
+			log.Warnf("failed to resolve query for domain=%s server=%s: %v", domain, upstream, err)
+			continue
 		}
 
-		if err := w.WriteMsg(resp); err != nil {
-			log.Errorf("failed to write failure DNS response: %v", err)
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			log.Debugf("upstream %s returned %s for domain=%s, trying next upstream",
+				upstream, dns.RcodeToString[resp.Rcode], domain)
+			continue
 		}
-		return
+
+		log.Tracef("resolved domain=%s type=%d with upstream=%s answers=%v",
+			domain, query.Question[0].Qtype, upstream, resp.Answer)
+		return resp
 	}
 
-	for _, ip := range ips {
-		var respRecord dns.RR
-		if ip.To4() == nil {
-			log.Tracef("resolved domain=%s to IPv6=%s", domain, ip)
-			rr := dns.AAAA{
-				AAAA: ip,
-				Hdr: dns.RR_Header{
-					Name:   domain,
-					Rrtype: dns.TypeAAAA,
-					Class:  dns.ClassINET,
-					Ttl:    f.ttl,
-				},
+	return nil
+}
+
+// udpSize returns the response size the client can receive over UDP
+func udpSize(query *dns.Msg) int {
+	if opt := query.IsEdns0(); opt != nil && opt.UDPSize() > dns.MinMsgSize {
+		return int(opt.UDPSize())
+	}
+	return dns.MinMsgSize
+}
+
+// filterEntries returns the upstreams of the normalized domains. Upstreams of entries sharing
+// the same domain are merged
+func filterEntries(entries []ForwarderEntry) map[string][]string {
+	upstreamsByDomain := make(map[string][]string, len(entries))
+	for _, entry := range entries {
+		if entry.Domain == "" {
+			log.Warn("empty domain in DNS forwarder")
+			continue
+		}
+
+		domain := nbdns.NormalizeZone(entry.Domain)
+		upstreams := upstreamsByDomain[domain]
+		for _, upstream := range entry.Upstreams {
+			address, err := normalizeUpstream(upstream)
+			if err != nil {
+				log.Warnf("invalid upstream for domain=%s in DNS forwarder: %v", domain, err)
+				continue
 			}
-			respRecord = &rr
-		} else {
-			log.Tracef("resolved domain=%s to IPv4=%s", domain, ip)
-			rr := dns.A{
-				A: ip,
-				Hdr: dns.RR_Header{
-					Name:   domain,
-					Rrtype: dns.TypeA,
-					Class:  dns.ClassINET,
-					Ttl:    f.ttl,
-				},
+			if !slices.Contains(upstreams, address) {
+				upstreams = append(upstreams, address)
 			}
-			respRecord = &rr
 		}
-		resp.Answer = append(resp.Answer, respRecord)
+		upstreamsByDomain[domain] = upstreams
 	}
+	return upstreamsByDomain
+}
 
-	if err := w.WriteMsg(resp); err != nil {
-		log.Errorf("failed to write DNS response: %v", err)
+// normalizeUpstream returns the address of an upstream given as IP or IP and port
+func normalizeUpstream(upstream string) (string, error) {
+	if addrPort, err := netip.ParseAddrPort(upstream); err == nil {
+		return addrPort.String(), nil
 	}
+
+	addr, err := netip.ParseAddr(upstream)
+	if err != nil {
+		return "", fmt.Errorf("parse upstream %s: %w", upstream, err)
+	}
+	return netip.AddrPortFrom(addr.Unmap(), defaultDNSPort).String(), nil
 }
 
-// filterDomains returns a list of normalized domains
-func filterDomains(domains []string) []string {
-	newDomains := make([]string, 0, len(domains))
-	for _, d := range domains {
-		if d == "" {
-			log.Warn("empty domain in DNS forwarder")
+func addressesToUpstreams(addrs []netip.Addr, port int) []string {
+	upstreams := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if addr.IsUnspecified() {
 			continue
 		}
-		newDomains = append(newDomains, nbdns.NormalizeZone(d))
+		upstream := netip.AddrPortFrom(addr.Unmap(), uint16(port)).String()
+		if !slices.Contains(upstreams, upstream) {
+			upstreams = append(upstreams, upstream)
+		}
+	}
+	return upstreams
+}
+
+// parsePort returns the port from the resolv.conf style configuration or the default DNS port
+func parsePort(port string) int {
+	p, err := strconv.Atoi(port)
+	if err != nil || p <= 0 || p > 65535 {
+		return defaultDNSPort
 	}
-	return newDomains
+	return p
 }
@@ -0,0 +1,149 @@
+package dnsfwd
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (w *recordingWriter) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
+
+func startUpstream(t *testing.T, handler dns.HandlerFunc) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{PacketConn: conn, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	<-started
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	return conn.LocalAddr().String()
+}
+
+func TestDNSForwarder_handleDNSQuery(t *testing.T) {
+	failing := startUpstream(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeServerFailure)
+		_ = w.WriteMsg(resp)
+	})
+
+	upstream := startUpstream(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(r)
+		resp.AuthenticatedData = true
+		switch r.Question[0].Qtype {
+		case dns.TypeSRV:
+			srv, _ := dns.NewRR("_sip._tcp.example.com. 1234 IN SRV 10 5 5060 sip.example.com.")
+			sig, _ := dns.NewRR("_sip._tcp.example.com. 1234 IN RRSIG SRV 13 4 3600 20300101000000 20200101000000 12345 example.com. c2lnbmF0dXJl")
+			resp.Answer = []dns.RR{srv, sig}
+		case dns.TypeA:
+			cname, _ := dns.NewRR("www.example.com. 300 IN CNAME edge.example.net.")
+			a, _ := dns.NewRR("edge.example.net. 20 IN A 192.0.2.10")
+			resp.Answer = []dns.RR{cname, a}
+		default:
+			resp.Rcode = dns.RcodeNameError
+		}
+		_ = w.WriteMsg(resp)
+	})
+
+	testCases := []struct {
+		name      string
+		query     *dns.Msg
+		upstreams []string
+		validate  func(t *testing.T, resp *dns.Msg)
+	}{
+		{
+			name:      "SRV query keeps the DNSSEC records and TTLs",
+			query:     new(dns.Msg).SetQuestion("_sip._tcp.example.com.", dns.TypeSRV),
+			upstreams: []string{upstream},
+			validate: func(t *testing.T, resp *dns.Msg) {
+				assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+				assert.True(t, resp.AuthenticatedData)
+				require.Len(t, resp.Answer, 2)
+				assert.Equal(t, uint32(1234), resp.Answer[0].Header().Ttl)
+				assert.IsType(t, &dns.SRV{}, resp.Answer[0])
+				assert.IsType(t, &dns.RRSIG{}, resp.Answer[1])
+			},
+		},
+		{
+			name:      "CNAME chain is passed through",
+			query:     new(dns.Msg).SetQuestion("www.example.com.", dns.TypeA),
+			upstreams: []string{upstream},
+			validate: func(t *testing.T, resp *dns.Msg) {
+				require.Len(t, resp.Answer, 2)
+				assert.IsType(t, &dns.CNAME{}, resp.Answer[0])
+				assert.Equal(t, "edge.example.net.", resp.Answer[1].Header().Name)
+			},
+		},
+		{
+			name:      "NXDOMAIN of the upstream is passed through",
+			query:     new(dns.Msg).SetQuestion("example.com.", dns.TypeTXT),
+			upstreams: []string{upstream},
+			validate: func(t *testing.T, resp *dns.Msg) {
+				assert.Equal(t, dns.RcodeNameError, resp.Rcode)
+			},
+		},
+		{
+			name:      "failing upstream falls back to the next one",
+			query:     new(dns.Msg).SetQuestion("_sip._tcp.example.com.", dns.TypeSRV),
+			upstreams: []string{failing, upstream},
+			validate: func(t *testing.T, resp *dns.Msg) {
+				assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+				assert.Len(t, resp.Answer, 2)
+			},
+		},
+		{
+			name:      "all upstreams failing returns SERVFAIL",
+			query:     new(dns.Msg).SetQuestion("_sip._tcp.example.com.", dns.TypeSRV),
+			upstreams: []string{failing},
+			validate: func(t *testing.T, resp *dns.Msg) {
+				assert.Equal(t, dns.RcodeServerFailure, resp.Rcode)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			forwarder := NewDNSForwarder("127.0.0.1:0")
+			writer := &recordingWriter{}
+
+			forwarder.handleDNSQuery(writer, testCase.query, testCase.upstreams)
+
+			require.NotNil(t, writer.msg)
+			assert.Equal(t, testCase.query.Id, writer.msg.Id)
+			testCase.validate(t, writer.msg)
+		})
+	}
+}
+
+func TestFilterEntries(t *testing.T) {
+	upstreams := filterEntries([]ForwarderEntry{
+		{Domain: "*.example.com", Upstreams: []string{"10.0.0.53", "10.0.0.54:5353"}},
+		{Domain: "*.example.com", Upstreams: []string{"10.0.0.53:53", "invalid"}},
+		{Domain: "example.org"},
+		{Domain: ""},
+	})
+
+	assert.Equal(t, map[string][]string{
+		"example.com": {"10.0.0.53:53", "10.0.0.54:5353"},
+		"example.org": nil,
+	}, upstreams)
+}
@@ -0,0 +1,44 @@
+//go:build !windows
+
+package dnsfwd
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	resolvConfPath = "/etc/resolv.conf"
+	// resolvConfBackupPath holds the original resolvers when the client manages resolv.conf itself
+	resolvConfBackupPath = resolvConfPath + ".original.netbird"
+)
+
+// systemResolvers returns the resolvers configured on the host
+func systemResolvers() ([]string, error) {
+	path := resolvConfPath
+	if _, err := os.Stat(resolvConfBackupPath); err == nil {
+		path = resolvConfBackupPath
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("stat %s: %w", resolvConfBackupPath, err)
+	}
+
+	config, err := dns.ClientConfigFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	addrs := make([]netip.Addr, 0, len(config.Servers))
+	for _, server := range config.Servers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addressesToUpstreams(addrs, parsePort(config.Port)), nil
+}
@@ -0,0 +1,36 @@
+package dnsfwd
+
+import (
+	"fmt"
+	"net/netip"
+
+	"golang.org/x/sys/windows"
+	"golang.zx2c4.com/wireguard/windows/tunnel/winipcfg"
+)
+
+// siteLocalPrefix holds the deprecated placeholder resolvers Windows assigns to IPv6 adapters
+var siteLocalPrefix = netip.MustParsePrefix("fec0::/10")
+
+// systemResolvers returns the resolvers of the adapters that are up
+func systemResolvers() ([]string, error) {
+	adapters, err := winipcfg.GetAdaptersAddresses(windows.AF_UNSPEC, winipcfg.GAAFlagDefault)
+	if err != nil {
+		return nil, fmt.Errorf("get adapters addresses: %w", err)
+	}
+
+	var addrs []netip.Addr
+	for _, adapter := range adapters {
+		if adapter.OperStatus != winipcfg.IfOperStatusUp {
+			continue
+		}
+		for server := adapter.FirstDNSServerAddress; server != nil; server = server.Next {
+			addr, ok := netip.AddrFromSlice(server.Address.IP())
+			if !ok || siteLocalPrefix.Contains(addr) {
+				continue
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addressesToUpstreams(addrs, defaultDNSPort), nil
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/netbirdio/netbird/client/internal/acl"
 	"github.com/netbirdio/netbird/client/internal/dns"
 	"github.com/netbirdio/netbird/client/internal/dnsfwd"
+	"github.com/netbirdio/netbird/client/internal/netflow"
 	"github.com/netbirdio/netbird/client/internal/networkmonitor"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/internal/peer/guard"
@@ -79,6 +80,8 @@ type EngineConfig struct {
 
 	// WgAddr is a Wireguard local address (Netbird Network IP)
 	WgAddr string
+	// WgAddrV6 is the optional Wireguard local IPv6 address (Netbird Network IPv6)
+	WgAddrV6 string
 
 	// WgPrivateKey is a Wireguard private key of our peer (it MUST never leave the machine)
 	WgPrivateKey wgtypes.Key
@@ -172,6 +175,11 @@ type Engine struct {
 	routeManager  routemanager.Manager
 	acl           acl.Manager
 	dnsForwardMgr *dnsfwd.Manager
+	flowManager   *netflow.Manager
+
+	// exitNodeLANRules block routed LAN access while this peer serves an exit node that doesn't allow it
+	exitNodeLANRules   []manager.Rule
+	exitNodeLANBlocked bool
 
 	dnsServer dns.Server
 
@@ -254,6 +262,7 @@ func NewEngineWithProbes(
 		probes:         probes,
 		checks:         checks,
 		connSemaphore:  semaphoregroup.NewSemaphoreGroup(connInitLimit),
+		flowManager:    netflow.NewManager(clientCtx, mgmClient),
 	}
 	if runtime.GOOS == "ios" {
 		if !fileExists(mobileDep.StateFilePath) {
@@ -465,7 +474,7 @@ func (e *Engine) createFirewall() error {
 	}
 
 	var err error
-	e.firewall, err = firewall.NewFirewall(e.wgInterface, e.stateManager)
+	e.firewall, err = firewall.NewFirewall(e.wgInterface, e.stateManager, e.flowManager.GetLogger())
 	if err != nil || e.firewall == nil {
 		log.Errorf("failed creating firewall manager: %s", err)
 		return nil
@@ -499,6 +508,7 @@ func (e *Engine) initFirewall() error {
 
 	// this rule is static and will be torn down on engine down by the firewall manager
 	if _, err := e.firewall.AddPeerFiltering(
+		nil,
 		net.IP{0, 0, 0, 0},
 		manager.ProtocolUDP,
 		nil,
@@ -516,8 +526,9 @@ func (e *Engine) initFirewall() error {
 	return nil
 }
 
-func (e *Engine) blockLanAccess() {
+func (e *Engine) blockLanAccess() []manager.Rule {
 	var merr *multierror.Error
+	var rules []manager.Rule
 
 	// TODO: keep this updated
 	toBlock, err := getInterfacePrefixes()
@@ -528,21 +539,57 @@ func (e *Engine) blockLanAccess() {
 	log.Infof("blocking route LAN access for networks: %v", toBlock)
 	v4 := netip.PrefixFrom(netip.IPv4Unspecified(), 0)
 	for _, network := range toBlock {
-		if _, err := e.firewall.AddRouteFiltering(
+		rule, err := e.firewall.AddRouteFiltering(
 			[]netip.Prefix{v4},
 			network,
 			manager.ProtocolALL,
 			nil,
 			nil,
 			manager.ActionDrop,
-		); err != nil {
+		)
+		if err != nil {
 			merr = multierror.Append(merr, fmt.Errorf("add fw rule for network %s: %w", network, err))
+			continue
 		}
+		rules = append(rules, rule)
 	}
 
 	if merr != nil {
 		log.Warnf("encountered errors blocking IPs to block LAN access: %v", nberrors.FormatErrorOrNil(merr))
 	}
+
+	return rules
+}
+
+// updateExitNodeLANAccess blocks routed access to the local networks while this peer serves
+// an exit node that doesn't allow LAN access and lifts the block once it no longer does.
+func (e *Engine) updateExitNodeLANAccess(routes []*route.Route) {
+	if e.firewall == nil || !e.firewall.IsServerRouteSupported() || e.config.BlockLANAccess {
+		return
+	}
+
+	ownKey := e.config.WgPrivateKey.PublicKey().String()
+	block := slices.ContainsFunc(routes, func(r *route.Route) bool {
+		return r.Peer == ownKey && r.IsExitNode() && !r.ExitNode.AllowLANAccess
+	})
+	if block == e.exitNodeLANBlocked {
+		return
+	}
+
+	if block {
+		e.exitNodeLANRules = e.blockLanAccess()
+		e.exitNodeLANBlocked = true
+		return
+	}
+
+	log.Infof("allowing route LAN access for exit node")
+	for _, rule := range e.exitNodeLANRules {
+		if err := e.firewall.DeleteRouteRule(rule); err != nil {
+			log.Warnf("failed to delete LAN access block rule %s: %v", rule.GetRuleID(), err)
+		}
+	}
+	e.exitNodeLANRules = nil
+	e.exitNodeLANBlocked = false
 }
 
 // modifyPeers updates peers that have been modified (e.g. IP address has been changed).
@@ -669,12 +716,14 @@ func (e *Engine) handleSync(update *mgmProto.SyncResponse) error {
 			}
 
 			e.relayManager.UpdateServerURLs(relayMsg.Urls)
+			e.relayManager.UpdateServerRegions(relayMsg.GetRegions())
 
 			// Just in case the agent started with an MGM server where the relay was disabled but was later enabled.
 			// We can ignore all errors because the guard will manage the reconnection retries.
 			_ = e.relayManager.Serve()
 		} else {
 			e.relayManager.UpdateServerURLs(nil)
+			e.relayManager.UpdateServerRegions(nil)
 		}
 
 		// todo update signal
@@ -795,15 +844,17 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 		return errors.New("wireguard interface is not initialized")
 	}
 
-	if e.wgInterface.Address().String() != conf.Address {
+	if e.wgInterface.Address().String() != conf.Address || e.wgInterface.Address().IPv6String() != conf.GetAddressV6() {
 		oldAddr := e.wgInterface.Address().String()
-		log.Debugf("updating peer address from %s to %s", oldAddr, conf.Address)
-		err := e.wgInterface.UpdateAddr(conf.Address)
+		oldAddrV6 := e.wgInterface.Address().IPv6String()
+		log.Debugf("updating peer address from %s %s to %s %s", oldAddr, oldAddrV6, conf.Address, conf.GetAddressV6())
+		err := e.wgInterface.UpdateAddr(conf.Address, conf.GetAddressV6())
 		if err != nil {
 			return err
 		}
 		e.config.WgAddr = conf.Address
-		log.Infof("updated peer address from %s to %s", oldAddr, conf.Address)
+		e.config.WgAddrV6 = conf.GetAddressV6()
+		log.Infof("updated peer address from %s %s to %s %s", oldAddr, oldAddrV6, conf.Address, conf.GetAddressV6())
 	}
 
 	if conf.GetSshConfig() != nil {
@@ -813,6 +864,8 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 		}
 	}
 
+	e.flowManager.Update(conf.GetFlowConfig())
+
 	state := e.statusRecorder.GetLocalPeerState()
 	state.IP = e.config.WgAddr
 	state.PubKey = e.config.WgPrivateKey.PublicKey().String()
@@ -924,6 +977,7 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 	if err := e.routeManager.UpdateRoutes(serial, routes, dnsRouteFeatureFlag); err != nil {
 		log.Errorf("failed to update clientRoutes, err: %v", err)
 	}
+	e.updateExitNodeLANAccess(routes)
 
 	log.Debugf("got peers update from Management Service, total peers to connect to = %d", len(networkMap.GetRemotePeers()))
 
@@ -1018,24 +1072,33 @@ func toRoutes(protoRoutes []*mgmProto.Route) []*route.Route {
 			Metric:      int(protoRoute.Metric),
 			Masquerade:  protoRoute.Masquerade,
 			KeepRoute:   protoRoute.KeepRoute,
+			ExitNode: route.ExitNode{
+				Enabled:        protoRoute.IsExitNode,
+				AllowLANAccess: protoRoute.ExitNodeAllowLanAccess,
+			},
 		}
 		routes = append(routes, convertedRoute)
 	}
 	return routes
 }
 
-func toRouteDomains(myPubKey string, protoRoutes []*mgmProto.Route) []string {
+func toRouteDomains(myPubKey string, protoRoutes []*mgmProto.Route) []dnsfwd.ForwarderEntry {
 	if protoRoutes == nil {
 		protoRoutes = []*mgmProto.Route{}
 	}
 
-	var dnsRoutes []string
+	var dnsRoutes []dnsfwd.ForwarderEntry
 	for _, protoRoute := range protoRoutes {
 		if len(protoRoute.Domains) == 0 {
 			continue
 		}
 		if protoRoute.Peer == myPubKey {
-			dnsRoutes = append(dnsRoutes, protoRoute.Domains...)
+			for _, d := range protoRoute.Domains {
+				dnsRoutes = append(dnsRoutes, dnsfwd.ForwarderEntry{
+					Domain:    d,
+					Upstreams: protoRoute.GetDnsUpstreams(),
+				})
+			}
 		}
 	}
 	return dnsRoutes
@@ -1050,7 +1113,8 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig) nbdns.Config {
 
 	for _, zone := range protoDNSConfig.GetCustomZones() {
 		dnsZone := nbdns.CustomZone{
-			Domain: zone.GetDomain(),
+			Domain:               zone.GetDomain(),
+			SearchDomainDisabled: zone.GetSearchDomainDisabled(),
 		}
 		for _, record := range zone.Records {
 			dnsRecord := nbdns.SimpleRecord{
@@ -1070,17 +1134,37 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig) nbdns.Config {
 			Primary:              nsGroup.GetPrimary(),
 			Domains:              nsGroup.GetDomains(),
 			SearchDomainsEnabled: nsGroup.GetSearchDomainsEnabled(),
+			DNSSECValidation:     nsGroup.GetDNSSECValidation(),
+			DNSSECTrustAnchors:   nsGroup.GetDNSSECTrustAnchors(),
 		}
 		for _, ns := range nsGroup.GetNameServers() {
 			dnsNS := nbdns.NameServer{
-				IP:     netip.MustParseAddr(ns.GetIP()),
-				NSType: nbdns.NameServerType(ns.GetNSType()),
-				Port:   int(ns.GetPort()),
+				IP:       netip.MustParseAddr(ns.GetIP()),
+				NSType:   nbdns.NameServerType(ns.GetNSType()),
+				Port:     int(ns.GetPort()),
+				Hostname: ns.GetHostname(),
+				Path:     ns.GetPath(),
 			}
 			dnsNSGroup.NameServers = append(dnsNSGroup.NameServers, dnsNS)
 		}
 		dnsUpdate.NameServerGroups = append(dnsUpdate.NameServerGroups, dnsNSGroup)
 	}
+
+	for _, blocklist := range protoDNSConfig.GetBlocklists() {
+		dnsBlocklist := nbdns.Blocklist{
+			ID:       blocklist.GetID(),
+			Name:     blocklist.GetName(),
+			Domains:  blocklist.GetDomains(),
+			Sinkhole: blocklist.GetSinkhole(),
+		}
+		if blocklist.GetSinkholeIPv4() != "" {
+			dnsBlocklist.SinkholeIPv4, _ = netip.ParseAddr(blocklist.GetSinkholeIPv4())
+		}
+		if blocklist.GetSinkholeIPv6() != "" {
+			dnsBlocklist.SinkholeIPv6, _ = netip.ParseAddr(blocklist.GetSinkholeIPv6())
+		}
+		dnsUpdate.Blocklists = append(dnsUpdate.Blocklists, dnsBlocklist)
+	}
 	return dnsUpdate
 }
 
@@ -1358,6 +1442,8 @@ func (e *Engine) close() {
 		}
 	}
 
+	e.flowManager.Close()
+
 	if e.firewall != nil {
 		err := e.firewall.Reset(e.stateManager)
 		if err != nil {
@@ -1400,6 +1486,7 @@ func (e *Engine) newWgIface() (*iface.WGIface, error) {
 	opts := iface.WGIFaceOpts{
 		IFaceName:    e.config.WgIfaceName,
 		Address:      e.config.WgAddr,
+		AddressV6:    e.config.WgAddrV6,
 		WGPort:       e.config.WgPort,
 		WGPrivKey:    e.config.WgPrivateKey.String(),
 		MTU:          iface.DefaultMTU,
@@ -1704,7 +1791,7 @@ func (e *Engine) GetLatestNetworkMap() (*mgmProto.NetworkMap, error) {
 }
 
 // updateDNSForwarder start or stop the DNS forwarder based on the domains and the feature flag
-func (e *Engine) updateDNSForwarder(enabled bool, domains []string) {
+func (e *Engine) updateDNSForwarder(enabled bool, domains []dnsfwd.ForwarderEntry) {
 	if !enabled {
 		if e.dnsForwardMgr == nil {
 			return
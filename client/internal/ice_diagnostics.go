@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pion/ice/v3"
+	"github.com/pion/stun/v2"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/relay"
+)
+
+// ICEDiagnostics describes why the connection to a peer is or is not peer-to-peer
+type ICEDiagnostics struct {
+	Peer peer.State
+	ICE  peer.ICEDiagnostics
+	// STUNServers holds the reachability of the STUN and TURN servers
+	STUNServers []relay.ProbeResult
+	NAT         relay.NATResult
+	// P2PFailureReasons explain why the connection is not peer-to-peer, empty if it is
+	P2PFailureReasons []string
+}
+
+// DiagnoseICE returns the ICE diagnostics of the peer identified by its public key, NetBird IP, FQDN or hostname. It
+// probes the STUN and TURN servers and classifies the NAT, so it takes a few seconds.
+func (e *Engine) DiagnoseICE(ctx context.Context, peerID string) (*ICEDiagnostics, error) {
+	state, ok := e.findPeerState(peerID)
+	if !ok {
+		return nil, fmt.Errorf("peer %s not found", peerID)
+	}
+
+	conn, ok := e.peerStore.PeerConn(state.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("no connection to peer %s", peerID)
+	}
+
+	e.syncMsgMux.Lock()
+	stuns := append([]*stun.URI(nil), e.STUNs...)
+	turns := append([]*stun.URI(nil), e.TURNs...)
+	e.syncMsgMux.Unlock()
+
+	diag := &ICEDiagnostics{
+		Peer: state,
+		ICE:  conn.ICEDiagnostics(),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		diag.STUNServers = append(relay.ProbeAll(ctx, relay.ProbeSTUN, stuns), relay.ProbeAll(ctx, relay.ProbeTURN, turns)...)
+	}()
+	go func() {
+		defer wg.Done()
+		diag.NAT = relay.ClassifyNAT(ctx, append(stuns, turns...))
+	}()
+	wg.Wait()
+
+	diag.P2PFailureReasons = p2pFailureReasons(diag.ICE, diag.STUNServers, diag.NAT)
+	return diag, nil
+}
+
+func (e *Engine) findPeerState(peerID string) (peer.State, bool) {
+	if state, err := e.statusRecorder.GetPeer(peerID); err == nil {
+		return state, true
+	}
+
+	name := strings.TrimSuffix(peerID, ".")
+	for _, state := range e.statusRecorder.GetFullStatus().Peers {
+		fqdn := strings.TrimSuffix(state.FQDN, ".")
+		if state.IP == peerID || fqdn == name || strings.Split(fqdn, ".")[0] == name {
+			return state, true
+		}
+	}
+	return peer.State{}, false
+}
+
+// p2pFailureReasons explains from the ICE diagnostics why the connection is not peer-to-peer
+func p2pFailureReasons(d peer.ICEDiagnostics, stunServers []relay.ProbeResult, nat relay.NATResult) []string {
+	if d.P2PActive {
+		return nil
+	}
+
+	if d.ForceRelay {
+		return []string{"ICE is disabled with NB_FORCE_RELAY, the peer is connected via the relay service only"}
+	}
+
+	if !d.Started {
+		return []string{"no ICE offer or answer was exchanged with the peer, check that the peer is online and both peers are connected to the signal service"}
+	}
+
+	var reasons []string
+	if d.LastError != "" {
+		reasons = append(reasons, fmt.Sprintf("ICE agent error: %s", d.LastError))
+	}
+
+	localSrflx := hasCandidate(d.LocalCandidates, ice.CandidateTypeServerReflexive)
+	if len(d.LocalCandidates) == 0 {
+		reasons = append(reasons, "no local candidates were gathered")
+	} else if !localSrflx {
+		switch {
+		case len(stunServers) == 0:
+			reasons = append(reasons, "no STUN servers are configured, the local public address is unknown to the peer")
+		case stunServersUnreachable(stunServers):
+			reasons = append(reasons, "no STUN server is reachable, the local public address is unknown to the peer")
+		default:
+			reasons = append(reasons, "no local server reflexive candidate was gathered, the local public address is unknown to the peer")
+		}
+	}
+
+	var remote, ignored int
+	for _, c := range d.RemoteCandidates {
+		if c.Ignored {
+			ignored++
+			continue
+		}
+		remote++
+	}
+	if ignored > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d remote candidates were ignored since their addresses are part of routed networks", ignored))
+	}
+	if remote == 0 {
+		reasons = append(reasons, "no usable remote candidates were received from the peer")
+	} else if !hasCandidate(d.RemoteCandidates, ice.CandidateTypeServerReflexive) && !hasCandidate(d.RemoteCandidates, ice.CandidateTypePeerReflexive) {
+		reasons = append(reasons, "the peer sent no server reflexive candidate, its public address is unknown")
+	}
+
+	if nat.Type == relay.NATEndpointDependent {
+		reasons = append(reasons, "the local NAT uses endpoint-dependent mapping (symmetric NAT), the peer cannot reach the mapped address the STUN servers see")
+	}
+
+	failed := 0
+	for _, p := range d.Pairs {
+		if p.State == ice.CandidatePairState(ice.CandidatePairStateFailed).String() {
+			failed++
+		}
+	}
+	switch {
+	case d.SelectedPair != nil && d.SelectedPair.Relayed():
+		reasons = append(reasons, "only the candidate pairs via a TURN server succeeded")
+	case len(d.Pairs) > 0 && failed == len(d.Pairs):
+		reasons = append(reasons, fmt.Sprintf("all %d candidate pairs failed the connectivity checks, UDP traffic between the peers is likely blocked by a firewall or NAT", len(d.Pairs)))
+	}
+
+	if len(reasons) == 0 {
+		if d.State == ice.ConnectionStateChecking.String() || d.State == ice.ConnectionStateNew.String() {
+			reasons = append(reasons, "the ICE connectivity checks are still running")
+		} else {
+			reasons = append(reasons, fmt.Sprintf("the ICE connection was not established, the last ICE state is %s", d.State))
+		}
+	}
+	return reasons
+}
+
+func hasCandidate(candidates []peer.ICECandidateInfo, candidateType ice.CandidateType) bool {
+	for _, c := range candidates {
+		if !c.Ignored && c.Type == candidateType.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func stunServersUnreachable(results []relay.ProbeResult) bool {
+	for _, r := range results {
+		if r.Err == nil {
+			return false
+		}
+	}
+	return true
+}
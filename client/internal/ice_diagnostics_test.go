@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/relay"
+)
+
+func TestP2PFailureReasons(t *testing.T) {
+	host := peer.ICECandidateInfo{Type: "host", Address: "192.168.1.10", Port: 51820}
+	srflx := peer.ICECandidateInfo{Type: "srflx", Address: "203.0.113.10", Port: 51820}
+	relayed := peer.ICECandidateInfo{Type: "relay", Address: "198.51.100.1", Port: 40000}
+	reachable := []relay.ProbeResult{{URI: "stun:stun.example.com:3478", Addr: "203.0.113.10:51820"}}
+
+	tests := []struct {
+		name        string
+		diag        peer.ICEDiagnostics
+		stunServers []relay.ProbeResult
+		nat         relay.NATResult
+		expected    []string
+	}{
+		{
+			name:     "p2p",
+			diag:     peer.ICEDiagnostics{Started: true, P2PActive: true},
+			expected: nil,
+		},
+		{
+			name: "force relay",
+			diag: peer.ICEDiagnostics{ForceRelay: true},
+			expected: []string{
+				"ICE is disabled with NB_FORCE_RELAY, the peer is connected via the relay service only",
+			},
+		},
+		{
+			name: "no offer",
+			diag: peer.ICEDiagnostics{},
+			expected: []string{
+				"no ICE offer or answer was exchanged with the peer, check that the peer is online and both peers are connected to the signal service",
+			},
+		},
+		{
+			name: "stun unreachable and symmetric nat",
+			diag: peer.ICEDiagnostics{
+				Started:          true,
+				State:            "Failed",
+				LocalCandidates:  []peer.ICECandidateInfo{host},
+				RemoteCandidates: []peer.ICECandidateInfo{host, srflx},
+				Pairs: []peer.ICECandidatePairInfo{
+					{Local: host, Remote: host, State: "failed"},
+					{Local: host, Remote: srflx, State: "failed"},
+				},
+			},
+			stunServers: []relay.ProbeResult{{URI: "stun:stun.example.com:3478", Err: errors.New("timeout")}},
+			nat:         relay.NATResult{Type: relay.NATEndpointDependent},
+			expected: []string{
+				"no STUN server is reachable, the local public address is unknown to the peer",
+				"the local NAT uses endpoint-dependent mapping (symmetric NAT), the peer cannot reach the mapped address the STUN servers see",
+				"all 2 candidate pairs failed the connectivity checks, UDP traffic between the peers is likely blocked by a firewall or NAT",
+			},
+		},
+		{
+			name: "only turn succeeded",
+			diag: peer.ICEDiagnostics{
+				Started:          true,
+				State:            "Connected",
+				LocalCandidates:  []peer.ICECandidateInfo{host, srflx, relayed},
+				RemoteCandidates: []peer.ICECandidateInfo{srflx},
+				Pairs: []peer.ICECandidatePairInfo{
+					{Local: srflx, Remote: srflx, State: "failed"},
+					{Local: relayed, Remote: srflx, State: "succeeded", Selected: true},
+				},
+				SelectedPair: &peer.ICECandidatePairInfo{Local: relayed, Remote: srflx, State: "succeeded", Selected: true},
+			},
+			stunServers: reachable,
+			nat:         relay.NATResult{Type: relay.NATEndpointIndependent},
+			expected: []string{
+				"only the candidate pairs via a TURN server succeeded",
+			},
+		},
+		{
+			name: "routed remote candidates",
+			diag: peer.ICEDiagnostics{
+				Started:          true,
+				State:            "Checking",
+				LocalCandidates:  []peer.ICECandidateInfo{host, srflx},
+				RemoteCandidates: []peer.ICECandidateInfo{{Type: "host", Address: "10.0.0.5", Port: 51820, Ignored: true}},
+			},
+			stunServers: reachable,
+			expected: []string{
+				"1 remote candidates were ignored since their addresses are part of routed networks",
+				"no usable remote candidates were received from the peer",
+			},
+		},
+		{
+			name: "checks running",
+			diag: peer.ICEDiagnostics{
+				Started:          true,
+				State:            "Checking",
+				LocalCandidates:  []peer.ICECandidateInfo{host, srflx},
+				RemoteCandidates: []peer.ICECandidateInfo{host, srflx},
+				Pairs: []peer.ICECandidatePairInfo{
+					{Local: srflx, Remote: srflx, State: "in-progress"},
+				},
+			},
+			stunServers: reachable,
+			expected: []string{
+				"the ICE connectivity checks are still running",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, p2pFailureReasons(tt.diag, tt.stunServers, tt.nat))
+		})
+	}
+}
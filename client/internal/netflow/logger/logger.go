@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/internal/netflow/types"
+)
+
+// DefaultMaxEvents is the number of events kept in memory until they are sent to the management service
+const DefaultMaxEvents = 10000
+
+// Logger is an in-memory FlowLogger with a bounded buffer
+type Logger struct {
+	mux       sync.Mutex
+	enabled   atomic.Bool
+	events    []*types.Event
+	maxEvents int
+	dropped   uint64
+}
+
+// New creates a disabled Logger that keeps at most maxEvents events
+func New(maxEvents int) *Logger {
+	if maxEvents <= 0 {
+		maxEvents = DefaultMaxEvents
+	}
+	return &Logger{
+		maxEvents: maxEvents,
+	}
+}
+
+// StoreEvent stores a flow event. Events are dropped if the logger is disabled or the buffer is full
+func (l *Logger) StoreEvent(flowEvent types.EventFields) {
+	if !l.enabled.Load() {
+		return
+	}
+
+	event := &types.Event{
+		ID:          uuid.New(),
+		Timestamp:   time.Now().UTC(),
+		EventFields: flowEvent,
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if len(l.events) >= l.maxEvents {
+		l.dropped++
+		if l.dropped%1000 == 1 {
+			log.Warnf("flow event buffer is full, dropped %d events so far", l.dropped)
+		}
+		return
+	}
+	l.events = append(l.events, event)
+}
+
+// GetEvents returns a copy of the stored events in the order they were stored
+func (l *Logger) GetEvents() []*types.Event {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	events := make([]*types.Event, len(l.events))
+	copy(events, l.events)
+	return events
+}
+
+// DeleteEvents removes the events with the given IDs
+func (l *Logger) DeleteEvents(ids []uuid.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+
+	toDelete := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		toDelete[id] = struct{}{}
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	kept := l.events[:0]
+	for _, event := range l.events {
+		if _, ok := toDelete[event.ID]; !ok {
+			kept = append(kept, event)
+		}
+	}
+	// release references to the deleted events
+	for i := len(kept); i < len(l.events); i++ {
+		l.events[i] = nil
+	}
+	l.events = kept
+}
+
+// Enable starts accepting events
+func (l *Logger) Enable() {
+	l.enabled.Store(true)
+}
+
+// Disable stops accepting events and drops the stored ones
+func (l *Logger) Disable() {
+	l.enabled.Store(false)
+
+	l.mux.Lock()
+	l.events = nil
+	l.mux.Unlock()
+}
+
+// Enabled returns true if the logger accepts events
+func (l *Logger) Enabled() bool {
+	return l.enabled.Load()
+}
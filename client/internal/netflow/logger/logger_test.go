@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/netflow/types"
+)
+
+func TestLogger_StoreEvent(t *testing.T) {
+	l := New(2)
+
+	l.StoreEvent(types.EventFields{FlowID: uuid.New(), Type: types.TypeStart})
+	assert.Empty(t, l.GetEvents(), "disabled logger should not store events")
+
+	l.Enable()
+	for i := 0; i < 3; i++ {
+		l.StoreEvent(types.EventFields{FlowID: uuid.New(), Type: types.TypeStart})
+	}
+
+	events := l.GetEvents()
+	require.Len(t, events, 2, "events above the limit should be dropped")
+	assert.NotEqual(t, uuid.Nil, events[0].ID)
+	assert.False(t, events[0].Timestamp.IsZero())
+}
+
+func TestLogger_DeleteEvents(t *testing.T) {
+	l := New(DefaultMaxEvents)
+	l.Enable()
+
+	for i := 0; i < 3; i++ {
+		l.StoreEvent(types.EventFields{FlowID: uuid.New(), Type: types.TypeEnd})
+	}
+	events := l.GetEvents()
+	require.Len(t, events, 3)
+
+	l.DeleteEvents([]uuid.UUID{events[0].ID, events[2].ID})
+
+	remaining := l.GetEvents()
+	require.Len(t, remaining, 1)
+	assert.Equal(t, events[1].ID, remaining[0].ID)
+
+	l.Disable()
+	assert.Empty(t, l.GetEvents(), "disabling the logger should drop stored events")
+	assert.False(t, l.Enabled())
+}
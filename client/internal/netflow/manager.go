@@ -0,0 +1,192 @@
+package netflow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal/netflow/logger"
+	"github.com/netbirdio/netbird/client/internal/netflow/types"
+	mgmProto "github.com/netbirdio/netbird/management/proto"
+)
+
+const (
+	// DefaultInterval is used when the management service doesn't define a flow interval
+	DefaultInterval = 1 * time.Minute
+	// maxBatchSize is the maximum number of events sent in a single request
+	maxBatchSize = 1000
+)
+
+// Sender sends flow events to the management service
+type Sender interface {
+	SendFlowEvents(events []*mgmProto.FlowEvent) error
+}
+
+// Manager keeps the flow logger and periodically sends the collected events to the management service
+type Manager struct {
+	mux      sync.Mutex
+	ctx      context.Context
+	logger   *logger.Logger
+	sender   Sender
+	interval time.Duration
+	cancel   context.CancelFunc
+}
+
+// NewManager creates a new flow Manager. Flow logging stays disabled until enabled by Update
+func NewManager(ctx context.Context, sender Sender) *Manager {
+	return &Manager{
+		ctx:    ctx,
+		logger: logger.New(logger.DefaultMaxEvents),
+		sender: sender,
+	}
+}
+
+// GetLogger returns the flow logger to be used by the packet filter
+func (m *Manager) GetLogger() types.FlowLogger {
+	return m.logger
+}
+
+// Update applies the flow configuration received from the management service
+func (m *Manager) Update(config *mgmProto.FlowConfig) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if config == nil || !config.GetEnabled() {
+		if m.cancel != nil {
+			log.Info("flow logging disabled")
+		}
+		m.stopSender()
+		m.logger.Disable()
+		return
+	}
+
+	interval := DefaultInterval
+	if config.GetInterval() != nil && config.GetInterval().AsDuration() > 0 {
+		interval = config.GetInterval().AsDuration()
+	}
+
+	if m.cancel != nil && interval == m.interval {
+		return
+	}
+
+	m.stopSender()
+	m.interval = interval
+	m.logger.Enable()
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.cancel = cancel
+	go m.sendLoop(ctx, interval)
+
+	log.Infof("flow logging enabled, sending events every %s", interval)
+}
+
+// Close stops sending events and disables the flow logger
+func (m *Manager) Close() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.stopSender()
+	m.logger.Disable()
+}
+
+func (m *Manager) stopSender() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.cancel = nil
+}
+
+func (m *Manager) sendLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.send()
+		}
+	}
+}
+
+func (m *Manager) send() {
+	events := m.logger.GetEvents()
+	for len(events) > 0 {
+		batch := events
+		if len(batch) > maxBatchSize {
+			batch = events[:maxBatchSize]
+		}
+		events = events[len(batch):]
+
+		protoEvents := make([]*mgmProto.FlowEvent, 0, len(batch))
+		ids := make([]uuid.UUID, 0, len(batch))
+		for _, event := range batch {
+			protoEvents = append(protoEvents, toProtoEvent(event))
+			ids = append(ids, event.ID)
+		}
+
+		if err := m.sender.SendFlowEvents(protoEvents); err != nil {
+			// keep the events, they will be retried on the next tick
+			log.Debugf("failed to send flow events: %v", err)
+			return
+		}
+		m.logger.DeleteEvents(ids)
+	}
+}
+
+func toProtoEvent(event *types.Event) *mgmProto.FlowEvent {
+	protoEvent := &mgmProto.FlowEvent{
+		EventId:    event.ID[:],
+		Timestamp:  timestamppb.New(event.Timestamp),
+		FlowId:     event.FlowID[:],
+		Type:       toProtoType(event.Type),
+		RuleId:     event.RuleID,
+		Direction:  toProtoDirection(event.Direction),
+		Protocol:   uint32(event.Protocol),
+		SourcePort: uint32(event.SourcePort),
+		DestPort:   uint32(event.DestPort),
+		IcmpType:   uint32(event.ICMPType),
+		IcmpCode:   uint32(event.ICMPCode),
+		RxPackets:  event.RxPackets,
+		TxPackets:  event.TxPackets,
+		RxBytes:    event.RxBytes,
+		TxBytes:    event.TxBytes,
+	}
+	if event.SourceIP.IsValid() {
+		protoEvent.SourceIp = event.SourceIP.AsSlice()
+	}
+	if event.DestIP.IsValid() {
+		protoEvent.DestIp = event.DestIP.AsSlice()
+	}
+	return protoEvent
+}
+
+func toProtoType(t types.Type) mgmProto.FlowEvent_Type {
+	switch t {
+	case types.TypeStart:
+		return mgmProto.FlowEvent_TYPE_START
+	case types.TypeEnd:
+		return mgmProto.FlowEvent_TYPE_END
+	case types.TypeDrop:
+		return mgmProto.FlowEvent_TYPE_DROP
+	default:
+		return mgmProto.FlowEvent_TYPE_UNKNOWN
+	}
+}
+
+func toProtoDirection(d types.Direction) mgmProto.FlowEvent_Direction {
+	switch d {
+	case types.Ingress:
+		return mgmProto.FlowEvent_INGRESS
+	case types.Egress:
+		return mgmProto.FlowEvent_EGRESS
+	default:
+		return mgmProto.FlowEvent_DIRECTION_UNKNOWN
+	}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type is the type of a flow event
+type Type int
+
+const (
+	TypeUnknown Type = iota
+	// TypeStart is emitted when a new connection is tracked
+	TypeStart
+	// TypeEnd is emitted when a tracked connection is removed
+	TypeEnd
+	// TypeDrop is emitted when a packet is dropped by the firewall
+	TypeDrop
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeStart:
+		return "start"
+	case TypeEnd:
+		return "end"
+	case TypeDrop:
+		return "drop"
+	default:
+		return "unknown"
+	}
+}
+
+// Direction is the direction of a flow relative to the local peer
+type Direction int
+
+const (
+	DirectionUnknown Direction = iota
+	// Ingress is a flow initiated by a remote peer
+	Ingress
+	// Egress is a flow initiated by the local peer
+	Egress
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Ingress:
+		return "ingress"
+	case Egress:
+		return "egress"
+	default:
+		return "unknown"
+	}
+}
+
+// Protocol is the IANA protocol number of a flow
+type Protocol uint8
+
+const (
+	ProtocolUnknown Protocol = 0
+	ICMP            Protocol = 1
+	TCP             Protocol = 6
+	UDP             Protocol = 17
+)
+
+func (p Protocol) String() string {
+	switch p {
+	case ICMP:
+		return "ICMP"
+	case TCP:
+		return "TCP"
+	case UDP:
+		return "UDP"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a flow event with its ID and the time it was stored
+type Event struct {
+	ID        uuid.UUID
+	Timestamp time.Time
+	EventFields
+}
+
+// EventFields holds the flow information reported by the packet filter
+type EventFields struct {
+	FlowID    uuid.UUID
+	Type      Type
+	RuleID    []byte
+	Direction Direction
+	Protocol  Protocol
+	SourceIP  netip.Addr
+	DestIP    netip.Addr
+	// SourcePort and DestPort are only set for TCP and UDP flows
+	SourcePort uint16
+	DestPort   uint16
+	// ICMPType and ICMPCode are only set for ICMP flows
+	ICMPType  uint8
+	ICMPCode  uint8
+	RxPackets uint64
+	TxPackets uint64
+	RxBytes   uint64
+	TxBytes   uint64
+}
+
+// FlowLogger records flow events reported by the packet filter
+type FlowLogger interface {
+	// StoreEvent stores a flow event if the logger is enabled
+	StoreEvent(flowEvent EventFields)
+	// GetEvents returns all stored events
+	GetEvents() []*Event
+	// DeleteEvents removes the events with the given IDs
+	DeleteEvents([]uuid.UUID)
+	// Enable starts accepting events
+	Enable()
+	// Disable stops accepting events and drops the stored ones
+	Disable()
+	// Enabled returns true if the logger accepts events
+	Enabled() bool
+}
@@ -112,7 +112,8 @@ type Conn struct {
 // NewConn creates a new not opened Conn to the remote peer.
 // To establish a connection run Conn.Open
 func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Status, signaler *Signaler, iFaceDiscover stdnet.ExternalIFaceDiscover, relayManager *relayClient.Manager, srWatcher *guard.SRWatcher, semaphore *semaphoregroup.SemaphoreGroup) (*Conn, error) {
-	allowedIP, _, err := net.ParseCIDR(config.WgConfig.AllowedIps)
+	// the first allowed IP is the IPv4 overlay address of the peer, an optional IPv6 address follows it
+	allowedIP, _, err := net.ParseCIDR(strings.Split(config.WgConfig.AllowedIps, ",")[0])
 	if err != nil {
 		log.Errorf("failed to parse allowedIPS: %v", err)
 		return nil, err
@@ -157,7 +158,7 @@ func NewConn(engineCtx context.Context, config ConnConfig, statusRecorder *Statu
 	conn.handshaker = NewHandshaker(ctx, connLog, config, signaler, conn.workerICE, conn.workerRelay)
 
 	conn.handshaker.AddOnNewOfferListener(conn.workerRelay.OnNewOffer)
-	if os.Getenv("NB_FORCE_RELAY") != "true" {
+	if !isForceRelayed() {
 		conn.handshaker.AddOnNewOfferListener(conn.workerICE.OnNewOffer)
 	}
 
@@ -181,7 +182,7 @@ func (conn *Conn) Open() {
 
 	peerState := State{
 		PubKey:           conn.config.Key,
-		IP:               strings.Split(conn.config.WgConfig.AllowedIps, "/")[0],
+		IP:               strings.Split(strings.Split(conn.config.WgConfig.AllowedIps, ",")[0], "/")[0],
 		ConnStatusUpdate: time.Now(),
 		ConnStatus:       StatusDisconnected,
 		Mux:              new(sync.RWMutex),
@@ -303,6 +304,19 @@ func (conn *Conn) GetKey() string {
 	return conn.config.Key
 }
 
+// ICEDiagnostics returns the state of the last ICE connection attempt to the peer
+func (conn *Conn) ICEDiagnostics() ICEDiagnostics {
+	d := conn.workerICE.Diagnostics()
+	d.ForceRelay = isForceRelayed()
+
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+
+	d.P2PActive = conn.statusICE.Get() == StatusConnected && conn.currentConnPriority == connPriorityICEP2P
+	d.RelayActive = conn.evalStatus() == StatusConnected && !d.P2PActive
+	return d
+}
+
 // configureConnection starts proxying traffic from/to local Wireguard and sets connection status to StatusConnected
 func (conn *Conn) iCEConnectionIsReady(priority ConnPriority, iceConnInfo ICEConnInfo) {
 	conn.mu.Lock()
@@ -756,6 +770,11 @@ func isController(config ConnConfig) bool {
 	return config.LocalKey > config.Key
 }
 
+// isForceRelayed reports whether ICE is disabled and the peers are connected via the relay service only
+func isForceRelayed() bool {
+	return os.Getenv("NB_FORCE_RELAY") == "true"
+}
+
 func isRosenpassEnabled(remoteRosenpassPubKey []byte) bool {
 	return remoteRosenpassPubKey != nil
 }
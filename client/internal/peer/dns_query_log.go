@@ -0,0 +1,111 @@
+package peer
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultDNSQueryLogSize is the number of queries kept by the DNS query log
+const DefaultDNSQueryLogSize = 1000
+
+// DNSQuery is a query answered by the DNS server of the client
+type DNSQuery struct {
+	Time time.Time
+	Name string
+	Type string
+	// Handler is the kind of handler that answered the query, e.g. local, upstream or interceptor
+	Handler string
+	// Upstream is the resolver the query was forwarded to, empty if it wasn't forwarded
+	Upstream string
+	// Cached reports if the response was served from the response cache
+	Cached  bool
+	Rcode   string
+	Latency time.Duration
+}
+
+// dnsQueryLog keeps the most recent queries in a ring buffer
+type dnsQueryLog struct {
+	enabled atomic.Bool
+
+	mu      sync.Mutex
+	entries []DNSQuery
+	next    int
+	full    bool
+}
+
+func newDNSQueryLog(size int) *dnsQueryLog {
+	return &dnsQueryLog{
+		entries: make([]DNSQuery, size),
+	}
+}
+
+func (l *dnsQueryLog) setEnabled(enabled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !enabled {
+		clear(l.entries)
+		l.next = 0
+		l.full = false
+	}
+	l.enabled.Store(enabled)
+}
+
+func (l *dnsQueryLog) record(query DNSQuery) {
+	if !l.enabled.Load() || len(l.entries) == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = query
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// queries returns the logged queries, oldest first
+func (l *dnsQueryLog) queries() []DNSQuery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.full {
+		return append([]DNSQuery(nil), l.entries[:l.next]...)
+	}
+
+	queries := make([]DNSQuery, 0, len(l.entries))
+	queries = append(queries, l.entries[l.next:]...)
+	return append(queries, l.entries[:l.next]...)
+}
+
+// SetDNSQueryLogEnabled enables or disables the DNS query log. Disabling the log drops the logged queries
+func (d *Status) SetDNSQueryLogEnabled(enabled bool) {
+	if d.dnsQueryLog == nil {
+		return
+	}
+	d.dnsQueryLog.setEnabled(enabled)
+}
+
+// DNSQueryLogEnabled returns if the DNS query log is enabled
+func (d *Status) DNSQueryLogEnabled() bool {
+	return d.dnsQueryLog != nil && d.dnsQueryLog.enabled.Load()
+}
+
+// RecordDNSQuery adds a query to the DNS query log if it is enabled
+func (d *Status) RecordDNSQuery(query DNSQuery) {
+	if d.dnsQueryLog == nil {
+		return
+	}
+	d.dnsQueryLog.record(query)
+}
+
+// GetDNSQueries returns the queries of the DNS query log, oldest first
+func (d *Status) GetDNSQueries() []DNSQuery {
+	if d.dnsQueryLog == nil {
+		return nil
+	}
+	return d.dnsQueryLog.queries()
+}
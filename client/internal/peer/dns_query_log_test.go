@@ -0,0 +1,37 @@
+package peer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSQueryLog(t *testing.T) {
+	log := newDNSQueryLog(3)
+
+	log.record(DNSQuery{Name: "disabled."})
+	assert.Empty(t, log.queries(), "queries should not be recorded while disabled")
+
+	log.setEnabled(true)
+	for i := 0; i < 2; i++ {
+		log.record(DNSQuery{Name: fmt.Sprintf("q%d.", i)})
+	}
+	assert.Equal(t, []string{"q0.", "q1."}, queryNames(log.queries()))
+
+	for i := 2; i < 5; i++ {
+		log.record(DNSQuery{Name: fmt.Sprintf("q%d.", i)})
+	}
+	assert.Equal(t, []string{"q2.", "q3.", "q4."}, queryNames(log.queries()), "oldest queries should be dropped first")
+
+	log.setEnabled(false)
+	assert.Empty(t, log.queries(), "disabling the log should drop the queries")
+}
+
+func queryNames(queries []DNSQuery) []string {
+	names := make([]string, 0, len(queries))
+	for _, query := range queries {
+		names = append(names, query.Name)
+	}
+	return names
+}
@@ -0,0 +1,240 @@
+package peer
+
+import (
+	"context"
+	"time"
+
+	"github.com/pion/ice/v3"
+)
+
+// iceDiagnosticsInterval is how often the ICE stats are recorded while the connectivity checks are running. The agent
+// drops its candidate pairs when the checks fail, so they are recorded before that happens.
+var iceDiagnosticsInterval = 2 * time.Second
+
+// ICECandidateInfo describes a local or remote ICE candidate
+type ICECandidateInfo struct {
+	ID string
+	// Type is the candidate type: host, srflx, prflx or relay
+	Type          string
+	Network       string
+	Address       string
+	Port          int
+	URL           string
+	RelayProtocol string
+	// Ignored is set for the remote candidates not used since their address is part of a routed network
+	Ignored bool
+}
+
+// ICECandidatePairInfo describes a candidate pair checked by the ICE agent
+type ICECandidatePairInfo struct {
+	Local     ICECandidateInfo
+	Remote    ICECandidateInfo
+	State     string
+	Nominated bool
+	Selected  bool
+	// RTT is the round trip time of the connectivity checks, zero if unknown
+	RTT time.Duration
+}
+
+// Relayed reports whether the pair goes through a TURN server
+func (p ICECandidatePairInfo) Relayed() bool {
+	return p.Local.Type == ice.CandidateTypeRelay.String() || p.Remote.Type == ice.CandidateTypeRelay.String()
+}
+
+// ICEDiagnostics describes the last ICE connection attempt to a peer
+type ICEDiagnostics struct {
+	// ForceRelay is set when ICE is disabled with NB_FORCE_RELAY
+	ForceRelay bool
+	// Started is set once an offer or answer started an ICE agent
+	Started bool
+	// AgentRunning is set while the ICE agent of the last attempt is alive
+	AgentRunning bool
+	// TURNDisabled is set when the TURN candidates are not gathered since the relay service is used instead
+	TURNDisabled bool
+	// State is the last ICE connection state of the agent
+	State            string
+	LocalCandidates  []ICECandidateInfo
+	RemoteCandidates []ICECandidateInfo
+	Pairs            []ICECandidatePairInfo
+	SelectedPair     *ICECandidatePairInfo
+	// LastError is the last error of the ICE agent, e.g. the failed candidate gathering
+	LastError string
+	StartedAt time.Time
+	UpdatedAt time.Time
+
+	// P2PActive is set when the WireGuard traffic uses a direct ICE connection
+	P2PActive bool
+	// RelayActive is set when the WireGuard traffic uses the relay service or a TURN server
+	RelayActive bool
+}
+
+// Diagnostics returns the state of the last ICE connection attempt
+func (w *WorkerICE) Diagnostics() ICEDiagnostics {
+	w.muxAgent.Lock()
+	agent := w.agent
+	w.muxAgent.Unlock()
+
+	// the agent must not be queried while holding muxAgent, its state change handler takes the lock
+	if agent != nil {
+		w.recordAgentStats(agent)
+	}
+
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	d := w.diag
+	d.AgentRunning = agent != nil
+	d.LocalCandidates = append([]ICECandidateInfo(nil), w.diag.LocalCandidates...)
+	d.RemoteCandidates = append(append([]ICECandidateInfo(nil), w.diag.RemoteCandidates...), w.ignoredCandidates...)
+	d.Pairs = append([]ICECandidatePairInfo(nil), w.diag.Pairs...)
+	if w.diag.SelectedPair != nil {
+		selected := *w.diag.SelectedPair
+		d.SelectedPair = &selected
+	}
+	return d
+}
+
+func (w *WorkerICE) resetDiagnostics(turnDisabled bool) {
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	now := time.Now()
+	w.diag = ICEDiagnostics{
+		Started:      true,
+		TURNDisabled: turnDisabled,
+		State:        ice.ConnectionStateNew.String(),
+		StartedAt:    now,
+		UpdatedAt:    now,
+	}
+	w.ignoredCandidates = nil
+}
+
+func (w *WorkerICE) recordError(err error) {
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	w.diag.LastError = err.Error()
+	w.diag.UpdatedAt = time.Now()
+}
+
+func (w *WorkerICE) recordState(state ice.ConnectionState) {
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	w.diag.State = state.String()
+	w.diag.UpdatedAt = time.Now()
+}
+
+func (w *WorkerICE) recordIgnoredCandidate(candidate ice.Candidate) {
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	info := candidateInfo(candidate)
+	info.Ignored = true
+	w.ignoredCandidates = append(w.ignoredCandidates, info)
+}
+
+// recordAgentStats records the candidates and the candidate pairs of the agent. The lists of the closed or failed agent
+// are empty, in that case the previously recorded ones are kept.
+func (w *WorkerICE) recordAgentStats(agent *ice.Agent) {
+	localStats := agent.GetLocalCandidatesStats()
+	remoteStats := agent.GetRemoteCandidatesStats()
+	pairStats := agent.GetCandidatePairsStats()
+	selected, _ := agent.GetSelectedCandidatePair()
+
+	candidates := make(map[string]ICECandidateInfo, len(localStats)+len(remoteStats))
+	local := make([]ICECandidateInfo, 0, len(localStats))
+	for _, s := range localStats {
+		info := candidateStatsInfo(s)
+		candidates[s.ID] = info
+		local = append(local, info)
+	}
+	remote := make([]ICECandidateInfo, 0, len(remoteStats))
+	for _, s := range remoteStats {
+		info := candidateStatsInfo(s)
+		candidates[s.ID] = info
+		remote = append(remote, info)
+	}
+
+	var selectedPair *ICECandidatePairInfo
+	pairs := make([]ICECandidatePairInfo, 0, len(pairStats))
+	for _, s := range pairStats {
+		pair := ICECandidatePairInfo{
+			Local:     candidates[s.LocalCandidateID],
+			Remote:    candidates[s.RemoteCandidateID],
+			State:     s.State.String(),
+			Nominated: s.Nominated,
+			RTT:       time.Duration(s.CurrentRoundTripTime * float64(time.Second)),
+		}
+		if selected != nil && selected.Local.ID() == s.LocalCandidateID && selected.Remote.ID() == s.RemoteCandidateID {
+			pair.Selected = true
+			// the stats of the agent do not carry the round trip times, only the selected pair measures it
+			if pair.RTT == 0 {
+				pair.RTT = selected.Latency()
+			}
+			selectedPair = &pair
+		}
+		pairs = append(pairs, pair)
+	}
+
+	w.diagMu.Lock()
+	defer w.diagMu.Unlock()
+
+	if len(local) > 0 {
+		w.diag.LocalCandidates = local
+	}
+	if len(remote) > 0 {
+		w.diag.RemoteCandidates = remote
+	}
+	if len(pairs) > 0 {
+		w.diag.Pairs = pairs
+		w.diag.SelectedPair = selectedPair
+	}
+	w.diag.UpdatedAt = time.Now()
+}
+
+// trackDiagnostics records the stats of the agent while the connectivity checks are running
+func (w *WorkerICE) trackDiagnostics(ctx context.Context, agent *ice.Agent) {
+	ticker := time.NewTicker(iceDiagnosticsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.recordAgentStats(agent)
+
+			w.diagMu.Lock()
+			connected := w.diag.State == ice.ConnectionStateConnected.String()
+			w.diagMu.Unlock()
+
+			// the pairs of a connected agent are kept until it is closed, Diagnostics queries them on demand
+			if connected {
+				return
+			}
+		}
+	}
+}
+
+func candidateInfo(candidate ice.Candidate) ICECandidateInfo {
+	return ICECandidateInfo{
+		ID:      candidate.ID(),
+		Type:    candidate.Type().String(),
+		Network: candidate.NetworkType().String(),
+		Address: candidate.Address(),
+		Port:    candidate.Port(),
+	}
+}
+
+func candidateStatsInfo(s ice.CandidateStats) ICECandidateInfo {
+	return ICECandidateInfo{
+		ID:            s.ID,
+		Type:          s.CandidateType.String(),
+		Network:       s.NetworkType.String(),
+		Address:       s.IP,
+		Port:          s.Port,
+		URL:           s.URL,
+		RelayProtocol: s.RelayProtocol,
+	}
+}
@@ -120,6 +120,37 @@ type NSGroupState struct {
 	Error   error
 }
 
+// DNSCacheStats contains the statistics of the DNS response cache
+type DNSCacheStats struct {
+	Entries    int
+	Hits       uint64
+	Misses     uint64
+	Prefetches uint64
+	Evictions  uint64
+}
+
+// DNSUpstreamStats contains the query statistics of a DNS upstream
+type DNSUpstreamStats struct {
+	Upstream    string
+	Queries     uint64
+	Failures    uint64
+	AvgLatency  time.Duration
+	LastLatency time.Duration
+}
+
+// DNSBlocklistStats contains the number of queries blocked by a DNS blocklist
+type DNSBlocklistStats struct {
+	Name    string
+	Blocked uint64
+}
+
+// DNSStats contains the statistics of the DNS server
+type DNSStats struct {
+	Cache      DNSCacheStats
+	Upstreams  []DNSUpstreamStats
+	Blocklists []DNSBlocklistStats
+}
+
 // FullStatus contains the full state held by the Status instance
 type FullStatus struct {
 	Peers           []State
@@ -129,6 +160,7 @@ type FullStatus struct {
 	RosenpassState  RosenpassState
 	Relays          []relay.ProbeResult
 	NSGroupStates   []NSGroupState
+	DNSStats        DNSStats
 }
 
 // Status holds a state of peers, signal, management connections and relays
@@ -149,6 +181,8 @@ type Status struct {
 	rosenpassEnabled      bool
 	rosenpassPermissive   bool
 	nsGroupStates         []NSGroupState
+	dnsStatsProvider      func() DNSStats
+	dnsQueryLog           *dnsQueryLog
 	resolvedDomainsStates map[domain.Domain]ResolvedDomainInfo
 
 	// To reduce the number of notification invocation this bool will be true when need to call the notification
@@ -168,6 +202,7 @@ func NewRecorder(mgmAddress string) *Status {
 		notifier:              newNotifier(),
 		mgmAddress:            mgmAddress,
 		resolvedDomainsStates: map[domain.Domain]ResolvedDomainInfo{},
+		dnsQueryLog:           newDNSQueryLog(DefaultDNSQueryLogSize),
 	}
 }
 
@@ -718,6 +753,25 @@ func (d *Status) GetRelayStates() []relay.ProbeResult {
 	return append(relayStates, relayState)
 }
 
+// SetDNSStatsProvider sets the function used to fetch the DNS server statistics
+func (d *Status) SetDNSStatsProvider(provider func() DNSStats) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.dnsStatsProvider = provider
+}
+
+// GetDNSStats returns the DNS server statistics
+func (d *Status) GetDNSStats() DNSStats {
+	d.mux.Lock()
+	provider := d.dnsStatsProvider
+	d.mux.Unlock()
+
+	if provider == nil {
+		return DNSStats{}
+	}
+	return provider()
+}
+
 func (d *Status) GetDNSStates() []NSGroupState {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -738,6 +792,7 @@ func (d *Status) GetFullStatus() FullStatus {
 		Relays:          d.GetRelayStates(),
 		RosenpassState:  d.GetRosenpassState(),
 		NSGroupStates:   d.GetDNSStates(),
+		DNSStats:        d.GetDNSStats(),
 	}
 
 	d.mux.Lock()
@@ -58,6 +58,11 @@ type WorkerICE struct {
 
 	// we record the last known state of the ICE agent to avoid duplicate on disconnected events
 	lastKnownState ice.ConnectionState
+
+	// diag holds the diagnostics of the last ICE connection attempt
+	diag              ICEDiagnostics
+	ignoredCandidates []ICECandidateInfo
+	diagMu            sync.Mutex
 }
 
 func NewWorkerICE(ctx context.Context, log *log.Entry, config ConnConfig, signaler *Signaler, ifaceDiscover stdnet.ExternalIFaceDiscover, statusRecorder *Status, hasRelayOnLocally bool, callBacks WorkerICECallbacks) (*WorkerICE, error) {
@@ -92,27 +97,33 @@ func (w *WorkerICE) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	}
 
 	var preferredCandidateTypes []ice.CandidateType
-	if w.hasRelayOnLocally && remoteOfferAnswer.RelaySrvAddress != "" {
+	turnDisabled := w.hasRelayOnLocally && remoteOfferAnswer.RelaySrvAddress != ""
+	if turnDisabled {
 		preferredCandidateTypes = icemaker.CandidateTypesP2P()
 	} else {
 		preferredCandidateTypes = icemaker.CandidateTypes()
 	}
+	w.resetDiagnostics(turnDisabled)
 
 	w.log.Debugf("recreate ICE agent")
 	agentCtx, agentCancel := context.WithCancel(w.ctx)
 	agent, err := w.reCreateAgent(agentCancel, preferredCandidateTypes)
 	if err != nil {
 		w.log.Errorf("failed to recreate ICE Agent: %s", err)
+		w.recordError(err)
 		w.muxAgent.Unlock()
 		return
 	}
 	w.agent = agent
 	w.muxAgent.Unlock()
 
+	go w.trackDiagnostics(agentCtx, agent)
+
 	w.log.Debugf("gather candidates")
 	err = w.agent.GatherCandidates()
 	if err != nil {
 		w.log.Debugf("failed to gather candidates: %s", err)
+		w.recordError(fmt.Errorf("gather candidates: %w", err))
 		return
 	}
 
@@ -123,6 +134,9 @@ func (w *WorkerICE) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	remoteConn, err := w.turnAgentDial(agentCtx, remoteOfferAnswer)
 	if err != nil {
 		w.log.Debugf("failed to dial the remote peer: %s", err)
+		if agentCtx.Err() == nil {
+			w.recordError(fmt.Errorf("dial: %w", err))
+		}
 		return
 	}
 	w.log.Debugf("agent dial succeeded")
@@ -169,6 +183,7 @@ func (w *WorkerICE) OnRemoteCandidate(candidate ice.Candidate, haRoutes route.HA
 	}
 
 	if candidateViaRoutes(candidate, haRoutes) {
+		w.recordIgnoredCandidate(candidate)
 		return
 	}
 
@@ -193,6 +208,7 @@ func (w *WorkerICE) Close() {
 		return
 	}
 
+	w.recordAgentStats(w.agent)
 	if err := w.agent.Close(); err != nil {
 		w.log.Warnf("failed to close ICE agent: %s", err)
 	}
@@ -213,6 +229,7 @@ func (w *WorkerICE) reCreateAgent(agentCancel context.CancelFunc, candidates []i
 
 	err = agent.OnConnectionStateChange(func(state ice.ConnectionState) {
 		w.log.Debugf("ICE ConnectionState has changed to %s", state.String())
+		w.recordState(state)
 		switch state {
 		case ice.ConnectionStateConnected:
 			w.lastKnownState = ice.ConnectionStateConnected
@@ -259,6 +276,7 @@ func (w *WorkerICE) closeAgent(cancel context.CancelFunc) {
 		return
 	}
 
+	w.recordAgentStats(w.agent)
 	if err := w.agent.Close(); err != nil {
 		w.log.Warnf("failed to close ICE agent: %s", err)
 	}
@@ -0,0 +1,225 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pion/stun/v2"
+	log "github.com/sirupsen/logrus"
+
+	nbnet "github.com/netbirdio/netbird/util/net"
+)
+
+const (
+	// natProbeMaxServers is the number of STUN servers the NAT classification asks for the mapped address
+	natProbeMaxServers = 3
+	natProbeTimeout    = 2 * time.Second
+	natProbeAttempts   = 3
+)
+
+// NATType is the mapping behavior of the NAT in front of the client, see RFC 4787
+type NATType int
+
+const (
+	// NATUnknown means the mapping behavior could not be determined, fewer than two STUN servers with distinct IP
+	// addresses responded
+	NATUnknown NATType = iota
+	// NATEndpointIndependent means the NAT maps the local address to the same public address regardless of the
+	// destination. The remote peers can reach the server reflexive candidates.
+	NATEndpointIndependent
+	// NATEndpointDependent means the NAT maps the local address to a different public address for each destination
+	// (symmetric NAT). The server reflexive candidates are of no use for the remote peers.
+	NATEndpointDependent
+)
+
+func (t NATType) String() string {
+	switch t {
+	case NATEndpointIndependent:
+		return "endpoint-independent"
+	case NATEndpointDependent:
+		return "endpoint-dependent"
+	default:
+		return "unknown"
+	}
+}
+
+// NATMapping is the public address a STUN server saw for the local socket of the NAT classification
+type NATMapping struct {
+	URI  string
+	Addr string
+	Err  error
+}
+
+// NATResult holds the result of the NAT classification
+type NATResult struct {
+	Type     NATType
+	Mappings []NATMapping
+}
+
+// ClassifyNAT determines the mapping behavior of the NAT by sending binding requests from the same local socket to
+// several STUN servers and comparing the mapped addresses. TURN servers answer binding requests as well, so their URIs
+// can be passed too. Only the UDP servers with distinct IP addresses are used.
+func ClassifyNAT(ctx context.Context, uris []*stun.URI) NATResult {
+	servers, mappings := natProbeServers(uris)
+	if len(servers) == 0 {
+		return NATResult{Mappings: mappings}
+	}
+
+	conn, err := nbnet.NewListener().ListenPacket(ctx, "udp4", "")
+	if err != nil {
+		log.Debugf("failed to listen for the NAT classification: %s", err)
+		return NATResult{Mappings: mappings}
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debugf("failed to close the NAT classification socket: %s", err)
+		}
+	}()
+
+	result := NATResult{
+		Mappings: mappings,
+	}
+	for _, server := range servers {
+		addr, err := mappedAddress(ctx, conn, server.addr)
+		if err != nil {
+			log.Debugf("NAT classification request to %s failed: %s", server.uri, err)
+		}
+		result.Mappings = append(result.Mappings, NATMapping{URI: server.uri.String(), Addr: addr, Err: err})
+	}
+	result.Type = classifyMappings(result.Mappings)
+	return result
+}
+
+// classifyMappings compares the mapped addresses of the responding servers, they have distinct IP addresses
+func classifyMappings(mappings []NATMapping) NATType {
+	var (
+		first     string
+		responses int
+	)
+	for _, m := range mappings {
+		if m.Err != nil {
+			continue
+		}
+		responses++
+		if first == "" {
+			first = m.Addr
+			continue
+		}
+		if m.Addr != first {
+			return NATEndpointDependent
+		}
+	}
+
+	if responses < 2 {
+		return NATUnknown
+	}
+	return NATEndpointIndependent
+}
+
+// natProbeServer is a server of the NAT classification and its resolved address
+type natProbeServer struct {
+	uri  *stun.URI
+	addr *net.UDPAddr
+}
+
+// natProbeServers resolves the UDP servers and returns the ones with distinct IP addresses. Servers sharing an IP
+// address, e.g. the STUN and TURN URIs of the same host, may see the same mapping behind an endpoint-dependent NAT, so
+// only one of them is asked. The servers that can't be resolved are returned as failed mappings.
+func natProbeServers(uris []*stun.URI) ([]natProbeServer, []NATMapping) {
+	seen := make(map[string]struct{})
+	var (
+		servers []natProbeServer
+		failed  []NATMapping
+	)
+	for _, uri := range uris {
+		if uri == nil || uri.Proto != stun.ProtoTypeUDP || uri.Scheme == stun.SchemeTypeSTUNS || uri.Scheme == stun.SchemeTypeTURNS {
+			continue
+		}
+
+		addr, err := net.ResolveUDPAddr("udp4", net.JoinHostPort(uri.Host, strconv.Itoa(uri.Port)))
+		if err != nil {
+			log.Debugf("failed to resolve %s for the NAT classification: %s", uri, err)
+			failed = append(failed, NATMapping{URI: uri.String(), Err: fmt.Errorf("resolve: %w", err)})
+			continue
+		}
+
+		ip := addr.IP.String()
+		if _, ok := seen[ip]; ok {
+			continue
+		}
+		seen[ip] = struct{}{}
+
+		servers = append(servers, natProbeServer{uri: uri, addr: addr})
+		if len(servers) == natProbeMaxServers {
+			break
+		}
+	}
+	return servers, failed
+}
+
+// mappedAddress sends a binding request to the server and returns the mapped address of the response
+func mappedAddress(ctx context.Context, conn net.PacketConn, serverAddr *net.UDPAddr) (string, error) {
+	request, err := stun.Build(stun.TransactionID, stun.BindingRequest, stun.Fingerprint)
+	if err != nil {
+		return "", fmt.Errorf("build request: %w", err)
+	}
+
+	// the requests are retransmitted, UDP may lose them
+	attemptTimeout := natProbeTimeout / natProbeAttempts
+	buf := make([]byte, 1500)
+	for range natProbeAttempts {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		if _, err := conn.WriteTo(request.Raw, serverAddr); err != nil {
+			return "", fmt.Errorf("write: %w", err)
+		}
+
+		addr, err := readMappedAddress(conn, buf, request.TransactionID, time.Now().Add(attemptTimeout))
+		if errors.Is(err, errNoResponse) {
+			continue
+		}
+		return addr, err
+	}
+	return "", errNoResponse
+}
+
+var errNoResponse = errors.New("no response")
+
+// readMappedAddress reads the response of the transaction until the deadline, other packets are ignored
+func readMappedAddress(conn net.PacketConn, buf []byte, transactionID [stun.TransactionIDSize]byte, deadline time.Time) (string, error) {
+	if err := conn.SetReadDeadline(deadline); err != nil {
+		return "", fmt.Errorf("set read deadline: %w", err)
+	}
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				return "", errNoResponse
+			}
+			return "", fmt.Errorf("read: %w", err)
+		}
+
+		response := &stun.Message{Raw: append([]byte(nil), buf[:n]...)}
+		if err := response.Decode(); err != nil || response.TransactionID != transactionID {
+			continue
+		}
+
+		if response.Type != stun.BindingSuccess {
+			return "", fmt.Errorf("unexpected response: %s", response.Type)
+		}
+
+		var xorAddr stun.XORMappedAddress
+		if err := xorAddr.GetFrom(response); err != nil {
+			return "", fmt.Errorf("get xor addr: %w", err)
+		}
+		return xorAddr.String(), nil
+	}
+}
@@ -0,0 +1,151 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/pion/stun/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startSTUNServer starts a STUN server on the ip answering the binding requests. The mapped port is shifted by portOffset to
+// emulate an endpoint-dependent NAT.
+func startSTUNServer(t *testing.T, ip string, portOffset int) *stun.URI {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp4", net.JoinHostPort(ip, "0"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			request := &stun.Message{Raw: append([]byte(nil), buf[:n]...)}
+			if err := request.Decode(); err != nil {
+				continue
+			}
+
+			udpAddr := addr.(*net.UDPAddr)
+			response, err := stun.Build(
+				stun.NewTransactionIDSetter(request.TransactionID),
+				stun.BindingSuccess,
+				&stun.XORMappedAddress{IP: udpAddr.IP, Port: udpAddr.Port + portOffset},
+				stun.Fingerprint,
+			)
+			if err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(response.Raw, addr)
+		}
+	}()
+
+	uri, err := stun.ParseURI(fmt.Sprintf("stun:%s", conn.LocalAddr()))
+	require.NoError(t, err)
+	return uri
+}
+
+func TestClassifyNAT(t *testing.T) {
+	t.Run("endpoint-independent", func(t *testing.T) {
+		uris := []*stun.URI{startSTUNServer(t, "127.0.0.1", 0), startSTUNServer(t, "127.0.0.2", 0)}
+
+		result := ClassifyNAT(context.Background(), uris)
+		assert.Equal(t, NATEndpointIndependent, result.Type)
+		require.Len(t, result.Mappings, 2)
+		for _, m := range result.Mappings {
+			assert.NoError(t, m.Err)
+		}
+		assert.Equal(t, result.Mappings[0].Addr, result.Mappings[1].Addr)
+	})
+
+	t.Run("endpoint-dependent", func(t *testing.T) {
+		uris := []*stun.URI{startSTUNServer(t, "127.0.0.1", 0), startSTUNServer(t, "127.0.0.2", 1)}
+
+		result := ClassifyNAT(context.Background(), uris)
+		assert.Equal(t, NATEndpointDependent, result.Type)
+	})
+
+	t.Run("single server", func(t *testing.T) {
+		uri := startSTUNServer(t, "127.0.0.1", 0)
+
+		result := ClassifyNAT(context.Background(), []*stun.URI{uri, uri})
+		assert.Equal(t, NATUnknown, result.Type, "the same server must not be probed twice")
+		assert.Len(t, result.Mappings, 1)
+	})
+
+	t.Run("servers sharing an address", func(t *testing.T) {
+		uris := []*stun.URI{startSTUNServer(t, "127.0.0.1", 0), startSTUNServer(t, "127.0.0.1", 1)}
+
+		result := ClassifyNAT(context.Background(), uris)
+		assert.Equal(t, NATUnknown, result.Type, "servers with the same IP address must not be compared")
+		assert.Len(t, result.Mappings, 1)
+	})
+
+	t.Run("unresolvable server", func(t *testing.T) {
+		uri := startSTUNServer(t, "127.0.0.1", 0)
+		unresolvable, err := stun.ParseURI("stun:stun.invalid:3478")
+		require.NoError(t, err)
+
+		result := ClassifyNAT(context.Background(), []*stun.URI{unresolvable, uri})
+		assert.Equal(t, NATUnknown, result.Type)
+		require.Len(t, result.Mappings, 2)
+		assert.Error(t, result.Mappings[0].Err)
+		assert.NoError(t, result.Mappings[1].Err)
+	})
+}
+
+func TestClassifyMappings(t *testing.T) {
+	failed := errors.New("no response")
+
+	tests := []struct {
+		name     string
+		mappings []NATMapping
+		expected NATType
+	}{
+		{
+			name:     "no servers",
+			expected: NATUnknown,
+		},
+		{
+			name: "one response",
+			mappings: []NATMapping{
+				{Addr: "203.0.113.1:5000"},
+				{Err: failed},
+			},
+			expected: NATUnknown,
+		},
+		{
+			name: "same mapping",
+			mappings: []NATMapping{
+				{Addr: "203.0.113.1:5000"},
+				{Err: failed},
+				{Addr: "203.0.113.1:5000"},
+			},
+			expected: NATEndpointIndependent,
+		},
+		{
+			name: "different port",
+			mappings: []NATMapping{
+				{Addr: "203.0.113.1:5000"},
+				{Addr: "203.0.113.1:5001"},
+			},
+			expected: NATEndpointDependent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, classifyMappings(tt.mappings))
+		})
+	}
+}
@@ -161,12 +161,23 @@ func (d *DnsInterceptor) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		return
 	}
 
-	client := &dns.Client{
-		Timeout: 5 * time.Second,
-		Net:     "udp",
-	}
 	upstream := fmt.Sprintf("%s:%d", upstreamIP, dnsfwd.ListenPort)
-	reply, _, err := client.ExchangeContext(context.Background(), r, upstream)
+
+	// cached responses are still passed through writeMsg to keep the routes of the resolved IPs in place
+	if reply, prefetch := d.dnsServer.ResponseCache().Get(upstream, r); reply != nil {
+		if prefetch {
+			go d.prefetch(upstream, r.Copy())
+		}
+		if writer, ok := w.(*nbdns.ResponseWriterChain); ok {
+			writer.SetUpstream(upstream, true)
+		}
+		if err := d.writeMsg(w, reply); err != nil {
+			log.Errorf("failed writing DNS response: %v", err)
+		}
+		return
+	}
+
+	reply, err := d.exchange(upstream, r)
 
 	var answer []dns.RR
 	if reply != nil {
@@ -183,11 +194,35 @@ func (d *DnsInterceptor) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 
 	reply.Id = r.Id
+	d.dnsServer.ResponseCache().Set(upstream, r, reply)
+	if writer, ok := w.(*nbdns.ResponseWriterChain); ok {
+		writer.SetUpstream(upstream, false)
+	}
 	if err := d.writeMsg(w, reply); err != nil {
 		log.Errorf("failed writing DNS response: %v", err)
 	}
 }
 
+func (d *DnsInterceptor) exchange(upstream string, r *dns.Msg) (*dns.Msg, error) {
+	client := &dns.Client{
+		Timeout: 5 * time.Second,
+		Net:     "udp",
+	}
+	reply, t, err := client.ExchangeContext(context.Background(), r, upstream)
+	d.dnsServer.QueryStats().Record(upstream, t, err)
+	return reply, err
+}
+
+// prefetch refreshes the cached response of a hot query before it expires
+func (d *DnsInterceptor) prefetch(upstream string, r *dns.Msg) {
+	reply, err := d.exchange(upstream, r)
+	if err != nil {
+		log.Debugf("failed to prefetch %s from %s: %v", r.Question[0].Name, upstream, err)
+		return
+	}
+	d.dnsServer.ResponseCache().Set(upstream, r, reply)
+}
+
 // continueToNextHandler signals the handler chain to try the next handler
 func (d *DnsInterceptor) continueToNextHandler(w dns.ResponseWriter, r *dns.Msg, reason string) {
 	log.Tracef("continuing to next handler for domain=%s reason=%s", r.Question[0].Name, reason)
@@ -228,8 +263,16 @@ func (d *DnsInterceptor) writeMsg(w dns.ResponseWriter, r *dns.Msg) error {
 			originalDomain = resolvedDomain
 		}
 
+		// the forwarder passes the CNAME chain of the upstream, only the addresses the chain leads to are routed
+		chain := cnameChain(r.Question[0].Name, r.Answer)
+
 		var newPrefixes []netip.Prefix
 		for _, answer := range r.Answer {
+			if _, ok := chain[strings.ToLower(answer.Header().Name)]; !ok {
+				log.Tracef("skipping answer outside of the CNAME chain for domain=%s: %s", resolvedDomain, answer)
+				continue
+			}
+
 			var ip netip.Addr
 			switch rr := answer.(type) {
 			case *dns.A:
@@ -268,6 +311,31 @@ func (d *DnsInterceptor) writeMsg(w dns.ResponseWriter, r *dns.Msg) error {
 	return nil
 }
 
+// cnameChain returns the lowercase names reachable from the question name through the CNAME answers
+func cnameChain(qname string, answers []dns.RR) map[string]struct{} {
+	name := strings.ToLower(qname)
+	chain := map[string]struct{}{name: {}}
+
+	// each iteration extends the chain by at most one link, so the loop ends on a CNAME loop too
+	for range answers {
+		next := ""
+		for _, answer := range answers {
+			cname, ok := answer.(*dns.CNAME)
+			if ok && strings.EqualFold(cname.Hdr.Name, name) {
+				next = strings.ToLower(cname.Target)
+				break
+			}
+		}
+		if _, seen := chain[next]; next == "" || seen {
+			break
+		}
+		chain[next] = struct{}{}
+		name = next
+	}
+
+	return chain
+}
+
 func (d *DnsInterceptor) updateDomainPrefixes(resolvedDomain, originalDomain domain.Domain, newPrefixes []netip.Prefix) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
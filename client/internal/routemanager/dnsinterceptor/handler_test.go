@@ -0,0 +1,63 @@
+package dnsinterceptor
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCnameChain(t *testing.T) {
+	newRR := func(s string) dns.RR {
+		rr, err := dns.NewRR(s)
+		require.NoError(t, err)
+		return rr
+	}
+
+	testCases := []struct {
+		name     string
+		qname    string
+		answers  []dns.RR
+		expected []string
+	}{
+		{
+			name:     "no CNAME",
+			qname:    "api.example.com.",
+			answers:  []dns.RR{newRR("api.example.com. 60 IN A 192.0.2.1")},
+			expected: []string{"api.example.com."},
+		},
+		{
+			name:  "chain across zones",
+			qname: "WWW.example.com.",
+			answers: []dns.RR{
+				newRR("www.example.com. 60 IN CNAME edge.example.net."),
+				newRR("edge.example.net. 60 IN CNAME pop1.cdn.example."),
+				newRR("pop1.cdn.example. 60 IN A 192.0.2.1"),
+				newRR("unrelated.example. 60 IN A 192.0.2.2"),
+			},
+			expected: []string{"www.example.com.", "edge.example.net.", "pop1.cdn.example."},
+		},
+		{
+			name:  "CNAME loop",
+			qname: "a.example.com.",
+			answers: []dns.RR{
+				newRR("a.example.com. 60 IN CNAME b.example.com."),
+				newRR("b.example.com. 60 IN CNAME a.example.com."),
+			},
+			expected: []string{"a.example.com.", "b.example.com."},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			chain := cnameChain(testCase.qname, testCase.answers)
+
+			names := make([]string, 0, len(chain))
+			for name := range chain {
+				names = append(names, name)
+			}
+			assert.ElementsMatch(t, testCase.expected, names)
+		})
+	}
+}
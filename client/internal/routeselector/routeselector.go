@@ -7,7 +7,6 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-multierror"
-	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/client/errors"
 	route "github.com/netbirdio/netbird/route"
@@ -17,6 +16,8 @@ type RouteSelector struct {
 	mu             sync.RWMutex
 	selectedRoutes map[route.NetID]struct{}
 	selectAll      bool
+	// exitNode is the exit node chosen by the user, exit nodes are never selected by default
+	exitNode route.NetID
 }
 
 func NewRouteSelector() *RouteSelector {
@@ -107,24 +108,71 @@ func (rs *RouteSelector) IsSelected(routeID route.NetID) bool {
 	return selected
 }
 
+// SelectExitNode sets the exit node to use, replacing the previously selected one.
+func (rs *RouteSelector) SelectExitNode(exitNode route.NetID, allExitNodes []route.NetID) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if !slices.Contains(allExitNodes, exitNode) {
+		return fmt.Errorf("exit node '%s' is not available", exitNode)
+	}
+
+	rs.exitNode = exitNode
+	return nil
+}
+
+// DeselectExitNode stops using any exit node.
+func (rs *RouteSelector) DeselectExitNode() {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.exitNode = ""
+}
+
+// SelectedExitNode returns the selected exit node or an empty NetID if none is selected.
+func (rs *RouteSelector) SelectedExitNode() route.NetID {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.exitNode
+}
+
 // FilterSelected removes unselected routes from the provided map.
+// Exit node routes are kept only if they belong to the selected exit node.
 func (rs *RouteSelector) FilterSelected(routes route.HAMap) route.HAMap {
 	rs.mu.RLock()
 	defer rs.mu.RUnlock()
 
-	if rs.selectAll {
-		return maps.Clone(routes)
-	}
-
 	filtered := route.HAMap{}
 	for id, rt := range routes {
-		if rs.IsSelected(id.NetID()) {
+		netID := id.NetID()
+		if isExitNode(rt) {
+			if netID == rs.exitNode {
+				filtered[id] = rt
+			}
+			continue
+		}
+
+		if rs.selectAll {
+			filtered[id] = rt
+			continue
+		}
+		if _, selected := rs.selectedRoutes[netID]; selected {
 			filtered[id] = rt
 		}
 	}
 	return filtered
 }
 
+func isExitNode(routes []*route.Route) bool {
+	for _, r := range routes {
+		if r.IsExitNode() {
+			return true
+		}
+	}
+	return false
+}
+
 // MarshalJSON implements the json.Marshaler interface
 func (rs *RouteSelector) MarshalJSON() ([]byte, error) {
 	rs.mu.RLock()
@@ -133,9 +181,11 @@ func (rs *RouteSelector) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		SelectedRoutes map[route.NetID]struct{} `json:"selected_routes"`
 		SelectAll      bool                     `json:"select_all"`
+		ExitNode       route.NetID              `json:"exit_node,omitempty"`
 	}{
 		SelectAll:      rs.selectAll,
 		SelectedRoutes: rs.selectedRoutes,
+		ExitNode:       rs.exitNode,
 	})
 }
 
@@ -149,12 +199,14 @@ func (rs *RouteSelector) UnmarshalJSON(data []byte) error {
 	if len(data) == 0 || string(data) == "null" {
 		rs.selectedRoutes = map[route.NetID]struct{}{}
 		rs.selectAll = true
+		rs.exitNode = ""
 		return nil
 	}
 
 	var temp struct {
 		SelectedRoutes map[route.NetID]struct{} `json:"selected_routes"`
 		SelectAll      bool                     `json:"select_all"`
+		ExitNode       route.NetID              `json:"exit_node"`
 	}
 
 	if err := json.Unmarshal(data, &temp); err != nil {
@@ -163,6 +215,7 @@ func (rs *RouteSelector) UnmarshalJSON(data []byte) error {
 
 	rs.selectedRoutes = temp.SelectedRoutes
 	rs.selectAll = temp.SelectAll
+	rs.exitNode = temp.ExitNode
 
 	if rs.selectedRoutes == nil {
 		rs.selectedRoutes = map[route.NetID]struct{}{}
@@ -274,6 +274,39 @@ func TestRouteSelector_FilterSelected(t *testing.T) {
 	}, filtered)
 }
 
+func TestRouteSelector_ExitNode(t *testing.T) {
+	rs := routeselector.NewRouteSelector()
+
+	exitNode := []*route.Route{{ExitNode: route.ExitNode{Enabled: true}}}
+	routes := route.HAMap{
+		"route1|10.0.0.0/8": {},
+		"exit1|0.0.0.0/0":   exitNode,
+		"exit2|0.0.0.0/0":   exitNode,
+	}
+
+	assert.Equal(t, route.HAMap{"route1|10.0.0.0/8": {}}, rs.FilterSelected(routes), "exit nodes should not be selected by default")
+
+	require.Error(t, rs.SelectExitNode("route3", []route.NetID{"exit1", "exit2"}))
+	require.NoError(t, rs.SelectExitNode("exit2", []route.NetID{"exit1", "exit2"}))
+	assert.Equal(t, route.NetID("exit2"), rs.SelectedExitNode())
+	assert.Equal(t, route.HAMap{
+		"route1|10.0.0.0/8": {},
+		"exit2|0.0.0.0/0":   exitNode,
+	}, rs.FilterSelected(routes))
+
+	rs.DeselectAllRoutes()
+	assert.Equal(t, route.HAMap{"exit2|0.0.0.0/0": exitNode}, rs.FilterSelected(routes), "exit node selection is independent of route selection")
+
+	data, err := rs.MarshalJSON()
+	require.NoError(t, err)
+	restored := routeselector.NewRouteSelector()
+	require.NoError(t, restored.UnmarshalJSON(data))
+	assert.Equal(t, route.NetID("exit2"), restored.SelectedExitNode())
+
+	rs.DeselectExitNode()
+	assert.Empty(t, rs.FilterSelected(routes))
+}
+
 func TestRouteSelector_NewRoutesBehavior(t *testing.T) {
 	initialRoutes := []route.NetID{"route1", "route2", "route3"}
 	newRoutes := []route.NetID{"route1", "route2", "route3", "route4", "route5"}
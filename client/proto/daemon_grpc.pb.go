@@ -37,6 +37,12 @@ type DaemonServiceClient interface {
 	SelectNetworks(ctx context.Context, in *SelectNetworksRequest, opts ...grpc.CallOption) (*SelectNetworksResponse, error)
 	// Deselect specific routes
 	DeselectNetworks(ctx context.Context, in *SelectNetworksRequest, opts ...grpc.CallOption) (*SelectNetworksResponse, error)
+	// List available exit nodes
+	ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error)
+	// Select the exit node to route all traffic through
+	SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error)
+	// Stop routing traffic through the selected exit node
+	DeselectExitNode(ctx context.Context, in *DeselectExitNodeRequest, opts ...grpc.CallOption) (*DeselectExitNodeResponse, error)
 	// DebugBundle creates a debug bundle
 	DebugBundle(ctx context.Context, in *DebugBundleRequest, opts ...grpc.CallOption) (*DebugBundleResponse, error)
 	// GetLogLevel gets the log level of the daemon
@@ -51,6 +57,12 @@ type DaemonServiceClient interface {
 	DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*DeleteStateResponse, error)
 	// SetNetworkMapPersistence enables or disables network map persistence
 	SetNetworkMapPersistence(ctx context.Context, in *SetNetworkMapPersistenceRequest, opts ...grpc.CallOption) (*SetNetworkMapPersistenceResponse, error)
+	// SetDNSQueryLog enables or disables the DNS query log
+	SetDNSQueryLog(ctx context.Context, in *SetDNSQueryLogRequest, opts ...grpc.CallOption) (*SetDNSQueryLogResponse, error)
+	// GetDNSQueries returns the queries of the DNS query log
+	GetDNSQueries(ctx context.Context, in *GetDNSQueriesRequest, opts ...grpc.CallOption) (*GetDNSQueriesResponse, error)
+	// DebugICE returns the ICE diagnostics of a peer
+	DebugICE(ctx context.Context, in *DebugICERequest, opts ...grpc.CallOption) (*DebugICEResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -142,6 +154,33 @@ func (c *daemonServiceClient) DeselectNetworks(ctx context.Context, in *SelectNe
 	return out, nil
 }
 
+func (c *daemonServiceClient) ListExitNodes(ctx context.Context, in *ListExitNodesRequest, opts ...grpc.CallOption) (*ListExitNodesResponse, error) {
+	out := new(ListExitNodesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/ListExitNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SelectExitNode(ctx context.Context, in *SelectExitNodeRequest, opts ...grpc.CallOption) (*SelectExitNodeResponse, error) {
+	out := new(SelectExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SelectExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) DeselectExitNode(ctx context.Context, in *DeselectExitNodeRequest, opts ...grpc.CallOption) (*DeselectExitNodeResponse, error) {
+	out := new(DeselectExitNodeResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DeselectExitNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) DebugBundle(ctx context.Context, in *DebugBundleRequest, opts ...grpc.CallOption) (*DebugBundleResponse, error) {
 	out := new(DebugBundleResponse)
 	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DebugBundle", in, out, opts...)
@@ -205,6 +244,33 @@ func (c *daemonServiceClient) SetNetworkMapPersistence(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *daemonServiceClient) SetDNSQueryLog(ctx context.Context, in *SetDNSQueryLogRequest, opts ...grpc.CallOption) (*SetDNSQueryLogResponse, error) {
+	out := new(SetDNSQueryLogResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/SetDNSQueryLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetDNSQueries(ctx context.Context, in *GetDNSQueriesRequest, opts ...grpc.CallOption) (*GetDNSQueriesResponse, error) {
+	out := new(GetDNSQueriesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/GetDNSQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) DebugICE(ctx context.Context, in *DebugICERequest, opts ...grpc.CallOption) (*DebugICEResponse, error) {
+	out := new(DebugICEResponse)
+	err := c.cc.Invoke(ctx, "/daemon.DaemonService/DebugICE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -228,6 +294,12 @@ type DaemonServiceServer interface {
 	SelectNetworks(context.Context, *SelectNetworksRequest) (*SelectNetworksResponse, error)
 	// Deselect specific routes
 	DeselectNetworks(context.Context, *SelectNetworksRequest) (*SelectNetworksResponse, error)
+	// List available exit nodes
+	ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error)
+	// Select the exit node to route all traffic through
+	SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error)
+	// Stop routing traffic through the selected exit node
+	DeselectExitNode(context.Context, *DeselectExitNodeRequest) (*DeselectExitNodeResponse, error)
 	// DebugBundle creates a debug bundle
 	DebugBundle(context.Context, *DebugBundleRequest) (*DebugBundleResponse, error)
 	// GetLogLevel gets the log level of the daemon
@@ -242,6 +314,12 @@ type DaemonServiceServer interface {
 	DeleteState(context.Context, *DeleteStateRequest) (*DeleteStateResponse, error)
 	// SetNetworkMapPersistence enables or disables network map persistence
 	SetNetworkMapPersistence(context.Context, *SetNetworkMapPersistenceRequest) (*SetNetworkMapPersistenceResponse, error)
+	// SetDNSQueryLog enables or disables the DNS query log
+	SetDNSQueryLog(context.Context, *SetDNSQueryLogRequest) (*SetDNSQueryLogResponse, error)
+	// GetDNSQueries returns the queries of the DNS query log
+	GetDNSQueries(context.Context, *GetDNSQueriesRequest) (*GetDNSQueriesResponse, error)
+	// DebugICE returns the ICE diagnostics of a peer
+	DebugICE(context.Context, *DebugICERequest) (*DebugICEResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -276,6 +354,15 @@ func (UnimplementedDaemonServiceServer) SelectNetworks(context.Context, *SelectN
 func (UnimplementedDaemonServiceServer) DeselectNetworks(context.Context, *SelectNetworksRequest) (*SelectNetworksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeselectNetworks not implemented")
 }
+func (UnimplementedDaemonServiceServer) ListExitNodes(context.Context, *ListExitNodesRequest) (*ListExitNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExitNodes not implemented")
+}
+func (UnimplementedDaemonServiceServer) SelectExitNode(context.Context, *SelectExitNodeRequest) (*SelectExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectExitNode not implemented")
+}
+func (UnimplementedDaemonServiceServer) DeselectExitNode(context.Context, *DeselectExitNodeRequest) (*DeselectExitNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeselectExitNode not implemented")
+}
 func (UnimplementedDaemonServiceServer) DebugBundle(context.Context, *DebugBundleRequest) (*DebugBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugBundle not implemented")
 }
@@ -297,6 +384,15 @@ func (UnimplementedDaemonServiceServer) DeleteState(context.Context, *DeleteStat
 func (UnimplementedDaemonServiceServer) SetNetworkMapPersistence(context.Context, *SetNetworkMapPersistenceRequest) (*SetNetworkMapPersistenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetNetworkMapPersistence not implemented")
 }
+func (UnimplementedDaemonServiceServer) SetDNSQueryLog(context.Context, *SetDNSQueryLogRequest) (*SetDNSQueryLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSQueryLog not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetDNSQueries(context.Context, *GetDNSQueriesRequest) (*GetDNSQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSQueries not implemented")
+}
+func (UnimplementedDaemonServiceServer) DebugICE(context.Context, *DebugICERequest) (*DebugICEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugICE not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -472,6 +568,60 @@ func _DaemonService_DeselectNetworks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_ListExitNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExitNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/ListExitNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListExitNodes(ctx, req.(*ListExitNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SelectExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SelectExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SelectExitNode(ctx, req.(*SelectExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_DeselectExitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeselectExitNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).DeselectExitNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/DeselectExitNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).DeselectExitNode(ctx, req.(*DeselectExitNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_DebugBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DebugBundleRequest)
 	if err := dec(in); err != nil {
@@ -598,6 +748,60 @@ func _DaemonService_SetNetworkMapPersistence_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_SetDNSQueryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSQueryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SetDNSQueryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/SetDNSQueryLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SetDNSQueryLog(ctx, req.(*SetDNSQueryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetDNSQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetDNSQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/GetDNSQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetDNSQueries(ctx, req.(*GetDNSQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_DebugICE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugICERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).DebugICE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.DaemonService/DebugICE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).DebugICE(ctx, req.(*DebugICERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -641,6 +845,18 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeselectNetworks",
 			Handler:    _DaemonService_DeselectNetworks_Handler,
 		},
+		{
+			MethodName: "ListExitNodes",
+			Handler:    _DaemonService_ListExitNodes_Handler,
+		},
+		{
+			MethodName: "SelectExitNode",
+			Handler:    _DaemonService_SelectExitNode_Handler,
+		},
+		{
+			MethodName: "DeselectExitNode",
+			Handler:    _DaemonService_DeselectExitNode_Handler,
+		},
 		{
 			MethodName: "DebugBundle",
 			Handler:    _DaemonService_DebugBundle_Handler,
@@ -669,6 +885,18 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetNetworkMapPersistence",
 			Handler:    _DaemonService_SetNetworkMapPersistence_Handler,
 		},
+		{
+			MethodName: "SetDNSQueryLog",
+			Handler:    _DaemonService_SetDNSQueryLog_Handler,
+		},
+		{
+			MethodName: "GetDNSQueries",
+			Handler:    _DaemonService_GetDNSQueries_Handler,
+		},
+		{
+			MethodName: "DebugICE",
+			Handler:    _DaemonService_DebugICE_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "daemon.proto",
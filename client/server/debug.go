@@ -45,6 +45,7 @@ nftables.txt: Anonymized nftables rules with packet counters, if --system-info f
 config.txt: Anonymized configuration information of the NetBird client.
 network_map.json: Anonymized network map containing peer configurations, routes, DNS settings, and firewall rules.
 state.json: Anonymized client state dump containing netbird states.
+dns_queries.txt: Anonymized recent DNS queries, if the DNS query log is enabled.
 
 
 Anonymization Process
@@ -87,6 +88,9 @@ The state file follows the same anonymization rules as other files:
 - Domain names are consistently anonymized
 - Technical identifiers and non-sensitive data remain unchanged
 
+DNS Queries
+The dns_queries.txt file contains the queries recorded by the DNS query log of the client, oldest first. Each line holds the time, type, name, handler kind (local, upstream, interceptor or none), upstream, response code and latency of a query. Query names and upstream addresses follow the same anonymization rules as described above.
+
 Routes
 For anonymized routes, the IP addresses are replaced as described above. The prefix length remains unchanged. Note that for prefixes, the anonymized IP might not be a network address, but the prefix length is still correct.
 
@@ -192,6 +196,10 @@ func (s *Server) createArchive(bundlePath *os.File, req *proto.DebugBundleReques
 		log.Errorf("Failed to add state file to debug bundle: %v", err)
 	}
 
+	if err := s.addDNSQueries(req, anonymizer, archive); err != nil {
+		log.Errorf("Failed to add DNS queries to debug bundle: %v", err)
+	}
+
 	if s.logFile != "console" {
 		if err := s.addLogfile(req, anonymizer, archive); err != nil {
 			return fmt.Errorf("add log file: %w", err)
@@ -403,6 +411,19 @@ func (s *Server) addStateFile(req *proto.DebugBundleRequest, anonymizer *anonymi
 	return nil
 }
 
+func (s *Server) addDNSQueries(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
+	queries := s.statusRecorder.GetDNSQueries()
+	if len(queries) == 0 {
+		return nil
+	}
+
+	queriesContent := formatDNSQueries(queries, req.GetAnonymize(), anonymizer)
+	if err := addFileToZip(archive, strings.NewReader(queriesContent), "dns_queries.txt"); err != nil {
+		return fmt.Errorf("add DNS queries file to zip: %w", err)
+	}
+	return nil
+}
+
 func (s *Server) addLogfile(req *proto.DebugBundleRequest, anonymizer *anonymize.Anonymizer, archive *zip.Writer) error {
 	logDir := filepath.Dir(s.logFile)
 
@@ -621,6 +642,39 @@ func formatRoute(builder *strings.Builder, route netip.Prefix, anonymize bool, a
 	}
 }
 
+func formatDNSQueries(queries []peer.DNSQuery, anonymize bool, anonymizer *anonymize.Anonymizer) string {
+	var builder strings.Builder
+	for _, query := range queries {
+		name := query.Name
+		upstream := query.Upstream
+		if anonymize {
+			name = anonymizer.AnonymizeDomain(name)
+			upstream = anonymizeUpstream(upstream, anonymizer)
+		}
+		if upstream == "" {
+			upstream = "-"
+		}
+		if query.Cached {
+			upstream += " (cached)"
+		}
+
+		builder.WriteString(fmt.Sprintf("%s %s %s handler=%s upstream=%s rcode=%s latency=%s\n",
+			query.Time.UTC().Format(time.RFC3339Nano), query.Type, name, query.Handler, upstream, query.Rcode, query.Latency))
+	}
+	return builder.String()
+}
+
+// anonymizeUpstream anonymizes an upstream given as address with port or as URI
+func anonymizeUpstream(upstream string, anonymizer *anonymize.Anonymizer) string {
+	if upstream == "" {
+		return ""
+	}
+	if addrPort, err := netip.ParseAddrPort(upstream); err == nil {
+		return netip.AddrPortFrom(anonymizer.AnonymizeIP(addrPort.Addr()), addrPort.Port()).String()
+	}
+	return anonymizer.AnonymizeURI(upstream)
+}
+
 func formatInterfaces(interfaces []net.Interface, anonymize bool, anonymizer *anonymize.Anonymizer) string {
 	sort.Slice(interfaces, func(i, j int) bool {
 		return interfaces[i].Name < interfaces[j].Name
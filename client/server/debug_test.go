@@ -5,11 +5,13 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal/peer"
 	mgmProto "github.com/netbirdio/netbird/management/proto"
 )
 
@@ -541,3 +543,40 @@ Chain OUTPUT (policy ACCEPT 0 packets, 0 bytes)
 	assert.Contains(t, anonNftables, "chain input {")
 	assert.Contains(t, anonNftables, "type filter hook input priority filter; policy accept;")
 }
+
+func TestFormatDNSQueries(t *testing.T) {
+	anonymizer := anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+	queries := []peer.DNSQuery{
+		{
+			Time:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Name:     "intranet.example.com.",
+			Type:     "A",
+			Handler:  "upstream",
+			Upstream: "203.0.113.53:53",
+			Rcode:    "NOERROR",
+			Latency:  12 * time.Millisecond,
+		},
+		{
+			Time:    time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC),
+			Name:    "peer.netbird.cloud.",
+			Type:    "AAAA",
+			Handler: "local",
+			Rcode:   "NOERROR",
+		},
+	}
+
+	content := formatDNSQueries(queries, true, anonymizer)
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	require.Len(t, lines, 2)
+
+	assert.NotContains(t, content, "intranet.example.com")
+	assert.NotContains(t, content, "203.0.113.53")
+	assert.Contains(t, lines[0], "2025-01-02T03:04:05Z A ")
+	assert.Contains(t, lines[0], "handler=upstream upstream=")
+	assert.Contains(t, lines[0], ":53 rcode=NOERROR latency=12ms")
+	assert.Contains(t, lines[1], "peer.netbird.cloud.", "netbird domains should not be anonymized")
+	assert.Contains(t, lines[1], "upstream=-")
+
+	plain := formatDNSQueries(queries, false, anonymizer)
+	assert.Contains(t, plain, "intranet.example.com. handler=upstream upstream=203.0.113.53:53")
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// SetDNSQueryLog enables or disables the DNS query log.
+func (s *Server) SetDNSQueryLog(_ context.Context, req *proto.SetDNSQueryLogRequest) (*proto.SetDNSQueryLogResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.statusRecorder == nil {
+		return nil, fmt.Errorf("client is not started")
+	}
+
+	s.statusRecorder.SetDNSQueryLogEnabled(req.GetEnabled())
+
+	return &proto.SetDNSQueryLogResponse{}, nil
+}
+
+// GetDNSQueries returns the queries of the DNS query log.
+func (s *Server) GetDNSQueries(context.Context, *proto.GetDNSQueriesRequest) (*proto.GetDNSQueriesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.statusRecorder == nil {
+		return nil, fmt.Errorf("client is not started")
+	}
+
+	queries := s.statusRecorder.GetDNSQueries()
+	pbQueries := make([]*proto.DNSQuery, 0, len(queries))
+	for _, query := range queries {
+		pbQueries = append(pbQueries, toProtoDNSQuery(query))
+	}
+
+	return &proto.GetDNSQueriesResponse{
+		Enabled: s.statusRecorder.DNSQueryLogEnabled(),
+		Queries: pbQueries,
+	}, nil
+}
+
+func toProtoDNSQuery(query peer.DNSQuery) *proto.DNSQuery {
+	return &proto.DNSQuery{
+		Time:     timestamppb.New(query.Time),
+		Name:     query.Name,
+		Type:     query.Type,
+		Handler:  query.Handler,
+		Upstream: query.Upstream,
+		Cached:   query.Cached,
+		Rcode:    query.Rcode,
+		Latency:  durationpb.New(query.Latency),
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/netbirdio/netbird/client/proto"
+	"github.com/netbirdio/netbird/route"
+)
+
+// ListExitNodes returns a list of all available exit nodes.
+func (s *Server) ListExitNodes(context.Context, *proto.ListExitNodesRequest) (*proto.ListExitNodesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	routeManager := engine.GetRouteManager()
+	selected := routeManager.GetRouteSelector().SelectedExitNode()
+
+	var exitNodes []*proto.ExitNode
+	for id, rt := range routeManager.GetClientRoutesWithNetID() {
+		if len(rt) == 0 || !rt[0].IsExitNode() {
+			continue
+		}
+		exitNodes = append(exitNodes, &proto.ExitNode{
+			ID:             string(id),
+			Range:          rt[0].Network.String(),
+			Selected:       id == selected,
+			AllowLanAccess: rt[0].ExitNode.AllowLANAccess,
+		})
+	}
+
+	sort.Slice(exitNodes, func(i, j int) bool {
+		return exitNodes[i].ID < exitNodes[j].ID
+	})
+
+	return &proto.ListExitNodesResponse{
+		ExitNodes: exitNodes,
+	}, nil
+}
+
+// SelectExitNode selects the exit node to route all traffic through.
+func (s *Server) SelectExitNode(_ context.Context, req *proto.SelectExitNodeRequest) (*proto.SelectExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	routeManager := engine.GetRouteManager()
+
+	var exitNodes []route.NetID
+	for id, rt := range routeManager.GetClientRoutesWithNetID() {
+		if len(rt) > 0 && rt[0].IsExitNode() {
+			exitNodes = append(exitNodes, id)
+		}
+	}
+
+	if err := routeManager.GetRouteSelector().SelectExitNode(route.NetID(req.GetExitNodeID()), exitNodes); err != nil {
+		return nil, fmt.Errorf("select exit node: %w", err)
+	}
+	routeManager.TriggerSelection(routeManager.GetClientRoutes())
+
+	return &proto.SelectExitNodeResponse{}, nil
+}
+
+// DeselectExitNode stops routing traffic through the selected exit node.
+func (s *Server) DeselectExitNode(context.Context, *proto.DeselectExitNodeRequest) (*proto.DeselectExitNodeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	engine := s.connectClient.Engine()
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	routeManager := engine.GetRouteManager()
+	routeManager.GetRouteSelector().DeselectExitNode()
+	routeManager.TriggerSelection(routeManager.GetClientRoutes())
+
+	return &proto.DeselectExitNodeResponse{}, nil
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// DebugICE returns the ICE diagnostics of a peer.
+func (s *Server) DebugICE(ctx context.Context, req *proto.DebugICERequest) (*proto.DebugICEResponse, error) {
+	s.mutex.Lock()
+	if s.connectClient == nil {
+		s.mutex.Unlock()
+		return nil, fmt.Errorf("not connected")
+	}
+	engine := s.connectClient.Engine()
+	s.mutex.Unlock()
+
+	if engine == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	// probing the STUN servers takes a while, the daemon must not be locked meanwhile
+	diag, err := engine.DiagnoseICE(ctx, req.GetPeer())
+	if err != nil {
+		return nil, err
+	}
+
+	var anonymizer *anonymize.Anonymizer
+	if req.GetAnonymize() {
+		anonymizer = anonymize.NewAnonymizer(anonymize.DefaultAddresses())
+	}
+	return toProtoICEDiagnostics(diag, anonymizer), nil
+}
+
+// toProtoICEDiagnostics converts the diagnostics, the addresses are anonymized if an anonymizer is given
+func toProtoICEDiagnostics(diag *internal.ICEDiagnostics, a *anonymize.Anonymizer) *proto.DebugICEResponse {
+	resp := &proto.DebugICEResponse{
+		PubKey:            diag.Peer.PubKey,
+		Ip:                diag.Peer.IP,
+		Fqdn:              diag.Peer.FQDN,
+		IceState:          diag.ICE.State,
+		IceAgentRunning:   diag.ICE.AgentRunning,
+		IceLastError:      diag.ICE.LastError,
+		NatType:           diag.NAT.Type.String(),
+		P2PFailureReasons: diag.P2PFailureReasons,
+	}
+	if !diag.ICE.StartedAt.IsZero() {
+		resp.IceStartedAt = timestamppb.New(diag.ICE.StartedAt)
+	}
+
+	switch {
+	case diag.ICE.P2PActive:
+		resp.ConnectionType = "P2P"
+	case diag.ICE.RelayActive:
+		resp.ConnectionType = "Relayed"
+	}
+
+	for _, c := range diag.ICE.LocalCandidates {
+		resp.LocalCandidates = append(resp.LocalCandidates, toProtoICECandidate(c, a))
+	}
+	for _, c := range diag.ICE.RemoteCandidates {
+		resp.RemoteCandidates = append(resp.RemoteCandidates, toProtoICECandidate(c, a))
+	}
+	for _, p := range diag.ICE.Pairs {
+		resp.Pairs = append(resp.Pairs, toProtoICECandidatePair(p, a))
+	}
+	if diag.ICE.SelectedPair != nil {
+		resp.SelectedPair = toProtoICECandidatePair(*diag.ICE.SelectedPair, a)
+	}
+
+	for _, r := range diag.STUNServers {
+		state := &proto.STUNServerState{
+			Uri:           r.URI,
+			Reachable:     r.Err == nil,
+			MappedAddress: r.Addr,
+		}
+		if r.Err != nil {
+			state.Error = r.Err.Error()
+		}
+		resp.StunServers = append(resp.StunServers, state)
+	}
+
+	for _, m := range diag.NAT.Mappings {
+		mapping := &proto.NATMapping{
+			Uri:           m.URI,
+			MappedAddress: m.Addr,
+		}
+		if m.Err != nil {
+			mapping.Error = m.Err.Error()
+		}
+		resp.NatMappings = append(resp.NatMappings, mapping)
+	}
+
+	if a != nil {
+		anonymizeICEResponse(resp, a)
+	}
+	return resp
+}
+
+func toProtoICECandidate(c peer.ICECandidateInfo, a *anonymize.Anonymizer) *proto.ICECandidate {
+	candidate := &proto.ICECandidate{
+		Type:          c.Type,
+		Network:       c.Network,
+		Address:       c.Address,
+		Port:          int32(c.Port),
+		Url:           c.URL,
+		RelayProtocol: c.RelayProtocol,
+		Ignored:       c.Ignored,
+	}
+	if a != nil {
+		candidate.Address = a.AnonymizeIPString(candidate.Address)
+		candidate.Url = a.AnonymizeURI(candidate.Url)
+	}
+	return candidate
+}
+
+func toProtoICECandidatePair(p peer.ICECandidatePairInfo, a *anonymize.Anonymizer) *proto.ICECandidatePair {
+	pair := &proto.ICECandidatePair{
+		Local:     toProtoICECandidate(p.Local, a),
+		Remote:    toProtoICECandidate(p.Remote, a),
+		State:     p.State,
+		Nominated: p.Nominated,
+		Selected:  p.Selected,
+	}
+	if p.RTT > 0 {
+		pair.Rtt = durationpb.New(p.RTT)
+	}
+	return pair
+}
+
+func anonymizeICEResponse(resp *proto.DebugICEResponse, a *anonymize.Anonymizer) {
+	resp.Ip = a.AnonymizeIPString(resp.Ip)
+	resp.Fqdn = a.AnonymizeDomain(resp.Fqdn)
+	resp.IceLastError = a.AnonymizeString(resp.IceLastError)
+
+	for _, s := range resp.StunServers {
+		s.Uri = a.AnonymizeURI(s.Uri)
+		s.MappedAddress = anonymizeAddrPort(a, s.MappedAddress)
+		s.Error = a.AnonymizeString(s.Error)
+	}
+	for _, m := range resp.NatMappings {
+		m.Uri = a.AnonymizeURI(m.Uri)
+		m.MappedAddress = anonymizeAddrPort(a, m.MappedAddress)
+		m.Error = a.AnonymizeString(m.Error)
+	}
+}
+
+func anonymizeAddrPort(a *anonymize.Anonymizer, addr string) string {
+	addrPort, err := netip.ParseAddrPort(addr)
+	if err != nil {
+		return a.AnonymizeIPString(addr)
+	}
+	return netip.AddrPortFrom(a.AnonymizeIP(addrPort.Addr()), addrPort.Port()).String()
+}
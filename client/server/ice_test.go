@@ -0,0 +1,74 @@
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/internal/relay"
+)
+
+func TestToProtoICEDiagnostics(t *testing.T) {
+	host := peer.ICECandidateInfo{Type: "host", Network: "udp4", Address: "192.168.1.10", Port: 51820}
+	srflx := peer.ICECandidateInfo{Type: "srflx", Network: "udp4", Address: "203.0.113.10", Port: 51820, URL: "stun:stun.example.com:3478"}
+	pair := peer.ICECandidatePairInfo{Local: srflx, Remote: srflx, State: "succeeded", Selected: true, RTT: 12 * time.Millisecond}
+
+	diag := &internal.ICEDiagnostics{
+		Peer: peer.State{PubKey: "key", IP: "100.64.0.2", FQDN: "peer-a.example.com"},
+		ICE: peer.ICEDiagnostics{
+			Started:          true,
+			State:            "Connected",
+			LocalCandidates:  []peer.ICECandidateInfo{host, srflx},
+			RemoteCandidates: []peer.ICECandidateInfo{srflx},
+			Pairs:            []peer.ICECandidatePairInfo{pair},
+			SelectedPair:     &pair,
+			P2PActive:        true,
+		},
+		STUNServers: []relay.ProbeResult{
+			{URI: "stun:stun.example.com:3478", Addr: "203.0.113.10:51820"},
+			{URI: "turn:turn.example.com:3478", Err: errors.New("allocate: timeout")},
+		},
+		NAT: relay.NATResult{
+			Type: relay.NATEndpointIndependent,
+			Mappings: []relay.NATMapping{
+				{URI: "stun:stun.example.com:3478", Addr: "203.0.113.10:40000"},
+			},
+		},
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		resp := toProtoICEDiagnostics(diag, nil)
+
+		assert.Equal(t, "P2P", resp.GetConnectionType())
+		assert.Equal(t, "endpoint-independent", resp.GetNatType())
+		require.Len(t, resp.GetLocalCandidates(), 2)
+		assert.Equal(t, "203.0.113.10", resp.GetLocalCandidates()[1].GetAddress())
+		require.NotNil(t, resp.GetSelectedPair())
+		assert.Equal(t, 12*time.Millisecond, resp.GetSelectedPair().GetRtt().AsDuration())
+		require.Len(t, resp.GetStunServers(), 2)
+		assert.True(t, resp.GetStunServers()[0].GetReachable())
+		assert.False(t, resp.GetStunServers()[1].GetReachable())
+		assert.Equal(t, "allocate: timeout", resp.GetStunServers()[1].GetError())
+		assert.Empty(t, resp.GetP2PFailureReasons())
+	})
+
+	t.Run("anonymized", func(t *testing.T) {
+		resp := toProtoICEDiagnostics(diag, anonymize.NewAnonymizer(anonymize.DefaultAddresses()))
+
+		// the private addresses are kept, the public ones are replaced consistently
+		assert.Equal(t, "192.168.1.10", resp.GetLocalCandidates()[0].GetAddress())
+		anonAddr := resp.GetLocalCandidates()[1].GetAddress()
+		assert.NotEqual(t, "203.0.113.10", anonAddr)
+		assert.Equal(t, anonAddr, resp.GetSelectedPair().GetLocal().GetAddress())
+		assert.Equal(t, anonAddr+":51820", resp.GetStunServers()[0].GetMappedAddress())
+		assert.Equal(t, anonAddr+":40000", resp.GetNatMappings()[0].GetMappedAddress())
+		assert.NotContains(t, resp.GetStunServers()[0].GetUri(), "example.com")
+		assert.NotContains(t, resp.GetFqdn(), "example.com")
+	})
+}
@@ -19,6 +19,7 @@ type selectRoute struct {
 	Network  netip.Prefix
 	Domains  domain.List
 	Selected bool
+	ExitNode bool
 }
 
 // ListNetworks returns a list of all available networks.
@@ -48,6 +49,10 @@ func (s *Server) ListNetworks(context.Context, *proto.ListNetworksRequest) (*pro
 			Network:  rt[0].Network,
 			Domains:  rt[0].Domains,
 			Selected: routeSelector.IsSelected(id),
+			ExitNode: rt[0].IsExitNode(),
+		}
+		if route.ExitNode {
+			route.Selected = routeSelector.SelectedExitNode() == id
 		}
 		routes = append(routes, route)
 	}
@@ -76,6 +81,7 @@ func (s *Server) ListNetworks(context.Context, *proto.ListNetworksRequest) (*pro
 			Domains:     route.Domains.ToSafeStringList(),
 			ResolvedIPs: map[string]*proto.IPList{},
 			Selected:    route.Selected,
+			ExitNode:    route.ExitNode,
 		}
 
 		// Group resolved IPs by their parent domain
@@ -846,6 +846,29 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 		pbFullStatus.DnsServers = append(pbFullStatus.DnsServers, pbDnsState)
 	}
 
+	pbFullStatus.DnsStats = &proto.DNSStats{
+		CacheEntries:    int64(fullStatus.DNSStats.Cache.Entries),
+		CacheHits:       fullStatus.DNSStats.Cache.Hits,
+		CacheMisses:     fullStatus.DNSStats.Cache.Misses,
+		CachePrefetches: fullStatus.DNSStats.Cache.Prefetches,
+		CacheEvictions:  fullStatus.DNSStats.Cache.Evictions,
+	}
+	for _, upstream := range fullStatus.DNSStats.Upstreams {
+		pbFullStatus.DnsStats.Upstreams = append(pbFullStatus.DnsStats.Upstreams, &proto.DNSUpstreamStats{
+			Upstream:    upstream.Upstream,
+			Queries:     upstream.Queries,
+			Failures:    upstream.Failures,
+			AvgLatency:  durationpb.New(upstream.AvgLatency),
+			LastLatency: durationpb.New(upstream.LastLatency),
+		})
+	}
+	for _, blocklist := range fullStatus.DNSStats.Blocklists {
+		pbFullStatus.DnsStats.Blocklists = append(pbFullStatus.DnsStats.Blocklists, &proto.DNSBlocklistStats{
+			Name:    blocklist.Name,
+			Blocked: blocklist.Blocked,
+		})
+	}
+
 	return &pbFullStatus
 }
 
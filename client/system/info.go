@@ -59,6 +59,8 @@ type Info struct {
 	SystemManufacturer string
 	Environment        Environment
 	Files              []File // for posture checks
+	// IPv6Supported indicates whether the client is able to configure an IPv6 overlay address
+	IPv6Supported bool
 
 	RosenpassEnabled    bool
 	RosenpassPermissive bool
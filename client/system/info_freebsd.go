@@ -47,6 +47,7 @@ func GetInfo(ctx context.Context) *Info {
 		Hostname:           extractDeviceName(ctx, systemHostname),
 		CPUs:               runtime.NumCPU(),
 		WiretrusteeVersion: version.NetbirdVersion(),
+		IPv6Supported:      true,
 		UIVersion:          extractUserAgent(ctx),
 		KernelVersion:      osInfo[1],
 		Environment:        env,
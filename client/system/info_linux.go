@@ -62,6 +62,7 @@ func GetInfo(ctx context.Context) *Info {
 		GoOS:               runtime.GOOS,
 		CPUs:               runtime.NumCPU(),
 		WiretrusteeVersion: version.NetbirdVersion(),
+		IPv6Supported:      true,
 		UIVersion:          extractUserAgent(ctx),
 		KernelVersion:      osInfo[1],
 		NetworkAddresses:   addrs,
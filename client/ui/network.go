@@ -103,6 +103,10 @@ func (s *serviceClient) updateNetworks(grid *fyne.Container, f filter) {
 		r := route
 
 		checkBox := widget.NewCheck(r.GetID(), func(checked bool) {
+			if r.GetExitNode() {
+				s.selectExitNode(r.ID, checked)
+				return
+			}
 			s.selectNetwork(r.ID, checked)
 		})
 		checkBox.Checked = route.Selected
@@ -191,7 +195,7 @@ func getOverlappingNetworks(routes []*proto.Network) []*proto.Network {
 func getExitNodeNetworks(routes []*proto.Network) []*proto.Network {
 	var filteredRoutes []*proto.Network
 	for _, route := range routes {
-		if route.Range == "0.0.0.0/0" {
+		if route.GetExitNode() {
 			filteredRoutes = append(filteredRoutes, route)
 		}
 	}
@@ -248,6 +252,31 @@ func (s *serviceClient) selectNetwork(id string, checked bool) {
 	}
 }
 
+func (s *serviceClient) selectExitNode(id string, checked bool) {
+	conn, err := s.getSrvClient(defaultFailTimeout)
+	if err != nil {
+		log.Errorf(getClientFMT, err)
+		s.showError(fmt.Errorf(getClientFMT, err))
+		return
+	}
+
+	if checked {
+		if _, err := conn.SelectExitNode(s.ctx, &proto.SelectExitNodeRequest{ExitNodeID: id}); err != nil {
+			log.Errorf("failed to select exit node: %v", err)
+			s.showError(fmt.Errorf("failed to select exit node: %v", err))
+			return
+		}
+		log.Infof("Exit node %s selected", id)
+	} else {
+		if _, err := conn.DeselectExitNode(s.ctx, &proto.DeselectExitNodeRequest{}); err != nil {
+			log.Errorf("failed to deselect exit node: %v", err)
+			s.showError(fmt.Errorf("failed to deselect exit node: %v", err))
+			return
+		}
+		log.Infof("Exit node %s deselected", id)
+	}
+}
+
 func (s *serviceClient) selectAllFilteredNetworks(f filter) {
 	conn, err := s.getSrvClient(defaultFailTimeout)
 	if err != nil {
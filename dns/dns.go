@@ -5,6 +5,7 @@ package dns
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"regexp"
 	"strings"
 
@@ -31,6 +32,24 @@ type Config struct {
 	NameServerGroups []*NameServerGroup
 	// CustomZones contains a list of custom zone
 	CustomZones []CustomZone
+	// Blocklists contains the domains the dns server should block
+	Blocklists []Blocklist
+}
+
+// Blocklist represents a list of domains that are blocked by the dns server, including their subdomains
+type Blocklist struct {
+	// ID of the blocklist
+	ID string
+	// Name of the blocklist, used to report the blocked queries
+	Name string
+	// Domains blocked by the list, normalized to lowercase without the trailing dot
+	Domains []string
+	// Sinkhole indicates matches should be answered with the sinkhole addresses instead of NXDOMAIN
+	Sinkhole bool
+	// SinkholeIPv4 is the address returned for blocked A queries
+	SinkholeIPv4 netip.Addr
+	// SinkholeIPv6 is the address returned for blocked AAAA queries
+	SinkholeIPv6 netip.Addr
 }
 
 // CustomZone represents a custom zone to be resolved by the dns server
@@ -39,13 +58,15 @@ type CustomZone struct {
 	Domain string
 	// Records custom zone records
 	Records []SimpleRecord
+	// SearchDomainDisabled indicates the zone should not be added to the search domains of the host, e.g. reverse zones
+	SearchDomainDisabled bool
 }
 
-// SimpleRecord provides a simple DNS record specification for CNAME, A and AAAA records
+// SimpleRecord provides a simple DNS record specification, RData holds the record data in zone file presentation format
 type SimpleRecord struct {
 	// Name domain name
 	Name string
-	// Type of record, 1 for A, 5 for CNAME, 28 for AAAA. see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
+	// Type of record, e.g. 1 for A, 5 for CNAME, 28 for AAAA. see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
 	Type int
 	// Class dns class, currently use the DefaultClass for all records
 	Class string
@@ -13,6 +13,10 @@ const (
 	InvalidNameServerType NameServerType = iota
 	// UDPNameServerType udp nameserver type
 	UDPNameServerType
+	// TLSNameServerType DNS-over-TLS nameserver type
+	TLSNameServerType
+	// HTTPSNameServerType DNS-over-HTTPS nameserver type
+	HTTPSNameServerType
 )
 
 const (
@@ -22,6 +26,12 @@ const (
 	InvalidNameServerTypeString = "invalid"
 	// UDPNameServerTypeString udp nameserver type as string
 	UDPNameServerTypeString = "udp"
+	// TLSNameServerTypeString DNS-over-TLS nameserver type as string
+	TLSNameServerTypeString = "tls"
+	// HTTPSNameServerTypeString DNS-over-HTTPS nameserver type as string
+	HTTPSNameServerTypeString = "https"
+	// DefaultHTTPSNameServerPath default URL path of DNS-over-HTTPS nameservers
+	DefaultHTTPSNameServerPath = "/dns-query"
 )
 
 // NameServerType nameserver type
@@ -32,6 +42,10 @@ func (n NameServerType) String() string {
 	switch n {
 	case UDPNameServerType:
 		return UDPNameServerTypeString
+	case TLSNameServerType:
+		return TLSNameServerTypeString
+	case HTTPSNameServerType:
+		return HTTPSNameServerTypeString
 	default:
 		return InvalidNameServerTypeString
 	}
@@ -42,6 +56,10 @@ func ToNameServerType(typeString string) NameServerType {
 	switch typeString {
 	case UDPNameServerTypeString:
 		return UDPNameServerType
+	case TLSNameServerTypeString:
+		return TLSNameServerType
+	case HTTPSNameServerTypeString:
+		return HTTPSNameServerType
 	default:
 		return InvalidNameServerType
 	}
@@ -69,6 +87,10 @@ type NameServerGroup struct {
 	Enabled bool
 	// SearchDomainsEnabled indicates whether to add match domains to search domains list or not
 	SearchDomainsEnabled bool
+	// DNSSECValidation indicates whether the clients validate the DNSSEC signatures of the upstream answers
+	DNSSECValidation bool
+	// DNSSECTrustAnchors DS records in presentation format to validate against instead of the root zone trust anchors
+	DNSSECTrustAnchors []string `gorm:"serializer:json"`
 }
 
 // NameServer represents a DNS nameserver
@@ -79,6 +101,33 @@ type NameServer struct {
 	NSType NameServerType
 	// Port nameserver listening port
 	Port int
+	// Hostname is used for SNI and certificate verification of encrypted nameservers, the IP is verified if empty
+	Hostname string `json:",omitempty"`
+	// Path is the URL path of DNS-over-HTTPS nameservers
+	Path string `json:",omitempty"`
+}
+
+// IsEncrypted returns true if the nameserver is queried over an encrypted transport
+func (n *NameServer) IsEncrypted() bool {
+	return n.NSType == TLSNameServerType || n.NSType == HTTPSNameServerType
+}
+
+// ServerName returns the name used for SNI and certificate verification of encrypted nameservers
+func (n *NameServer) ServerName() string {
+	if n.Hostname != "" {
+		return n.Hostname
+	}
+	return n.IP.String()
+}
+
+// URL returns the nameserver in the url format <type>://<ip>:<port>[/path]
+func (n *NameServer) URL() string {
+	nsURL := url.URL{
+		Scheme: n.NSType.String(),
+		Host:   netip.AddrPortFrom(n.IP, uint16(n.Port)).String(),
+		Path:   n.Path,
+	}
+	return nsURL.String()
 }
 
 // EventMeta returns activity event meta related to the nameserver group
@@ -89,9 +138,11 @@ func (g *NameServerGroup) EventMeta() map[string]any {
 // Copy copies a nameserver object
 func (n *NameServer) Copy() *NameServer {
 	return &NameServer{
-		IP:     n.IP,
-		NSType: n.NSType,
-		Port:   n.Port,
+		IP:       n.IP,
+		NSType:   n.NSType,
+		Port:     n.Port,
+		Hostname: n.Hostname,
+		Path:     n.Path,
 	}
 }
 
@@ -99,10 +150,13 @@ func (n *NameServer) Copy() *NameServer {
 func (n *NameServer) IsEqual(other *NameServer) bool {
 	return other.IP == n.IP &&
 		other.NSType == n.NSType &&
-		other.Port == n.Port
+		other.Port == n.Port &&
+		other.Hostname == n.Hostname &&
+		other.Path == n.Path
 }
 
-// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53
+// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>[/path], e.g., udp://1.1.1.1:53,
+// tls://1.1.1.1:853 or https://1.1.1.1:443/dns-query. The path is only allowed for https nameservers and defaults to /dns-query.
 func ParseNameServerURL(nsURL string) (NameServer, error) {
 	parsedURL, err := url.Parse(nsURL)
 	if err != nil {
@@ -129,6 +183,15 @@ func ParseNameServerURL(nsURL string) (NameServer, error) {
 
 	ns.IP = parsedAddr
 
+	switch {
+	case nsType == HTTPSNameServerType && (parsedURL.Path == "" || parsedURL.Path == "/"):
+		ns.Path = DefaultHTTPSNameServerPath
+	case nsType == HTTPSNameServerType:
+		ns.Path = parsedURL.Path
+	case parsedURL.Path != "" && parsedURL.Path != "/":
+		return NameServer{}, fmt.Errorf("nameserver url path is only supported for %s nameservers, got %s", HTTPSNameServerTypeString, parsedURL.Path)
+	}
+
 	return ns, nil
 }
 
@@ -144,11 +207,14 @@ func (g *NameServerGroup) Copy() *NameServerGroup {
 		Primary:              g.Primary,
 		Domains:              make([]string, len(g.Domains)),
 		SearchDomainsEnabled: g.SearchDomainsEnabled,
+		DNSSECValidation:     g.DNSSECValidation,
+		DNSSECTrustAnchors:   make([]string, len(g.DNSSECTrustAnchors)),
 	}
 
 	copy(nsGroup.NameServers, g.NameServers)
 	copy(nsGroup.Groups, g.Groups)
 	copy(nsGroup.Domains, g.Domains)
+	copy(nsGroup.DNSSECTrustAnchors, g.DNSSECTrustAnchors)
 
 	return nsGroup
 }
@@ -160,6 +226,8 @@ func (g *NameServerGroup) IsEqual(other *NameServerGroup) bool {
 		other.Description == g.Description &&
 		other.Primary == g.Primary &&
 		other.SearchDomainsEnabled == g.SearchDomainsEnabled &&
+		other.DNSSECValidation == g.DNSSECValidation &&
+		compareGroupsList(g.DNSSECTrustAnchors, other.DNSSECTrustAnchors) &&
 		compareNameServerList(g.NameServers, other.NameServers) &&
 		compareGroupsList(g.Groups, other.Groups) &&
 		compareGroupsList(g.Domains, other.Domains)
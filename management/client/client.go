@@ -21,4 +21,5 @@ type Client interface {
 	GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, error)
 	IsHealthy() bool
 	SyncMeta(sysInfo *system.Info) error
+	SendFlowEvents(events []*proto.FlowEvent) error
 }
@@ -18,6 +18,7 @@ type MockClient struct {
 	GetDeviceAuthorizationFlowFunc func(serverKey wgtypes.Key) (*proto.DeviceAuthorizationFlow, error)
 	GetPKCEAuthorizationFlowFunc   func(serverKey wgtypes.Key) (*proto.PKCEAuthorizationFlow, error)
 	SyncMetaFunc                   func(sysInfo *system.Info) error
+	SendFlowEventsFunc             func(events []*proto.FlowEvent) error
 }
 
 func (m *MockClient) IsHealthy() bool {
@@ -84,3 +85,10 @@ func (m *MockClient) SyncMeta(sysInfo *system.Info) error {
 	}
 	return m.SyncMetaFunc(sysInfo)
 }
+
+func (m *MockClient) SendFlowEvents(events []*proto.FlowEvent) error {
+	if m.SendFlowEventsFunc == nil {
+		return nil
+	}
+	return m.SendFlowEventsFunc(events)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/formatter"
+	"github.com/netbirdio/netbird/management/server"
+	activityStore "github.com/netbirdio/netbird/management/server/activity/store"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/util"
+)
+
+var shortActivityMigration = "Migrate the SQLite activity event store to the Postgres or MySQL activity store configured in the management config."
+
+var activityMigrationCmd = &cobra.Command{
+	Use:   "activity-migration [--config file] [--datadir directory] [--log-file console]",
+	Short: shortActivityMigration,
+	Long: shortActivityMigration +
+		"\n\n" +
+		"This command reads the events of {datadir}/events.db and copies them to the store selected by ActivityStoreConfig.Engine. " +
+		"The DSN is read from the NETBIRD_ACTIVITY_STORE_ENGINE_POSTGRES_DSN or NETBIRD_ACTIVITY_STORE_ENGINE_MYSQL_DSN environment variables. " +
+		"The migration can be run multiple times, events that were already migrated are skipped.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flag.Parse()
+		err := util.InitLog(logLevel, logFile)
+		if err != nil {
+			return fmt.Errorf("failed initializing log %v", err)
+		}
+
+		//nolint
+		ctx := context.WithValue(cmd.Context(), formatter.ExecutionContextKey, formatter.SystemSource)
+
+		config := &server.Config{}
+		if _, err = util.ReadJsonWithEnvSub(mgmtConfig, config); err != nil {
+			return fmt.Errorf("failed reading management config %s: %v", mgmtConfig, err)
+		}
+
+		if config.DataStoreEncryptionKey == "" {
+			return fmt.Errorf("the management config doesn't contain the activity store encryption key")
+		}
+
+		engine := config.ActivityStoreConfig.Engine
+		if engine != store.PostgresStoreEngine && engine != store.MysqlStoreEngine {
+			return fmt.Errorf("unsupported activity store engine %q, the migration target must be %s or %s", engine, store.PostgresStoreEngine, store.MysqlStoreEngine)
+		}
+
+		target, err := activityStore.NewSqlStore(ctx, engine, config.DataStoreEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("failed to open the %s activity store: %v", engine, err)
+		}
+		defer func() {
+			if err := target.Close(ctx); err != nil {
+				log.WithContext(ctx).Errorf("failed to close the %s activity store: %v", engine, err)
+			}
+		}()
+
+		if err = activityStore.MigrateFromSQLite(ctx, mgmtDataDir, config.DataStoreEncryptionKey, target); err != nil {
+			return err
+		}
+		log.WithContext(ctx).Info("Migration finished successfully")
+
+		return nil
+	},
+}
@@ -79,6 +79,11 @@ func init() {
 	migrationCmd.AddCommand(upCmd)
 
 	rootCmd.AddCommand(migrationCmd)
+
+	activityMigrationCmd.Flags().StringVar(&mgmtDataDir, "datadir", defaultMgmtDataDir, "server data directory location")
+	activityMigrationCmd.Flags().StringVar(&mgmtConfig, "config", defaultMgmtConfig, "Netbird config file location")
+
+	rootCmd.AddCommand(activityMigrationCmd)
 }
 
 // SetupCloseHandler handles SIGTERM signal and exits with success
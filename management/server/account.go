@@ -28,12 +28,18 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/server/activity"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	"github.com/netbirdio/netbird/management/server/geolocation"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/integrated_validator"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/scim"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
@@ -47,6 +53,11 @@ const (
 	CacheExpirationMin         = 3 * 24 * 3600 * time.Second // 3 days
 	emptyUserID                = "empty user ID in claims"
 	errorGettingDomainAccIDFmt = "error getting account ID by private domain: %v"
+
+	// eventRetentionInterval is the interval between the deletions of expired activity events of an account
+	eventRetentionInterval = 24 * time.Hour
+	// eventRetentionStartDelay delays the first deletion of expired activity events after startup or a settings change
+	eventRetentionStartDelay = time.Minute
 )
 
 type userLoggedInOnce bool
@@ -88,6 +99,8 @@ type AccountManager interface {
 	MarkPeerConnected(ctx context.Context, peerKey string, connected bool, realIP net.IP, account *types.Account) error
 	DeletePeer(ctx context.Context, accountID, peerID, userID string) error
 	UpdatePeer(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
+	ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error)
+	RejectPeer(ctx context.Context, accountID, userID, peerID string) error
 	GetNetworkMap(ctx context.Context, peerID string) (*types.NetworkMap, error)
 	GetPeerNetwork(ctx context.Context, peerID string) (*types.Network, error)
 	AddPeer(ctx context.Context, setupKey, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
@@ -110,18 +123,35 @@ type AccountManager interface {
 	DeletePolicy(ctx context.Context, accountID, policyID, userID string) error
 	ListPolicies(ctx context.Context, accountID, userID string) ([]*types.Policy, error)
 	GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
-	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, exitNode route.ExitNode) (*route.Route, error)
 	SaveRoute(ctx context.Context, accountID, userID string, route *route.Route) error
 	DeleteRoute(ctx context.Context, accountID string, routeID route.ID, userID string) error
 	ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error)
 	GetNameServerGroup(ctx context.Context, accountID, userID, nsGroupID string) (*nbdns.NameServerGroup, error)
-	CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool) (*nbdns.NameServerGroup, error)
+	CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool, dnssecValidation bool, dnssecTrustAnchors []string) (*nbdns.NameServerGroup, error)
 	SaveNameServerGroup(ctx context.Context, accountID, userID string, nsGroupToSave *nbdns.NameServerGroup) error
 	DeleteNameServerGroup(ctx context.Context, accountID, nsGroupID, userID string) error
 	ListNameServerGroups(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error)
 	GetDNSDomain() string
+	GetDNSRecord(ctx context.Context, accountID, userID, recordID string) (*types.DNSRecord, error)
+	ListDNSRecords(ctx context.Context, accountID, userID string) ([]*types.DNSRecord, error)
+	CreateDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error)
+	SaveDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error)
+	DeleteDNSRecord(ctx context.Context, accountID, userID, recordID string) error
+	GetDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) (*types.DNSBlocklist, error)
+	ListDNSBlocklists(ctx context.Context, accountID, userID string) ([]*types.DNSBlocklist, error)
+	CreateDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error)
+	SaveDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error)
+	DeleteDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) error
+	GetDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) (*types.DNSServiceName, error)
+	ListDNSServiceNames(ctx context.Context, accountID, userID string) ([]*types.DNSServiceName, error)
+	GetDNSServiceNamesHealthyPeers(ctx context.Context, accountID, userID string) (map[string][]string, error)
+	CreateDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error)
+	SaveDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error)
+	DeleteDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) error
 	StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
-	GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	GetEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, error)
+	ExportEvents(ctx context.Context, accountID, userID string, filter *activity.Filter, export func([]*activity.Event) error) error
 	GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error)
 	SaveDNSSettings(ctx context.Context, accountID string, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeer(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -147,6 +177,24 @@ type AccountManager interface {
 	GetAccountSettings(ctx context.Context, accountID string, userID string) (*types.Settings, error)
 	DeleteSetupKey(ctx context.Context, accountID, userID, keyID string) error
 	UpdateAccountPeers(ctx context.Context, accountID string)
+	GetCustomRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error)
+	GetCustomRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error)
+	SaveCustomRole(ctx context.Context, accountID, userID string, role *roles.CustomRole) (*roles.CustomRole, error)
+	DeleteCustomRole(ctx context.Context, accountID, userID, roleID string) error
+	CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error)
+	GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error)
+	DeleteSCIMToken(ctx context.Context, accountID, userID string) error
+	GetAccountIDFromSCIMToken(ctx context.Context, token string) (string, error)
+	GetSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error)
+	GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error)
+	CreateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error)
+	UpdateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error)
+	DeleteSCIMUser(ctx context.Context, accountID, userID string) error
+	GetSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error)
+	GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error)
+	CreateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error)
+	UpdateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error)
+	DeleteSCIMGroup(ctx context.Context, accountID, groupID string) error
 }
 
 type DefaultAccountManager struct {
@@ -177,12 +225,23 @@ type DefaultAccountManager struct {
 
 	peerInactivityExpiry Scheduler
 
+	// policyScheduleUpdates updates the account peers when a scheduled policy rule becomes active or inactive
+	policyScheduleUpdates Scheduler
+	// eventRetention deletes the activity events that are older than the retention period of the account
+	eventRetention Scheduler
+	// dnsBlocklistRefresh fetches the sources of the DNS blocklists periodically
+	dnsBlocklistRefresh Scheduler
+	// dnsServiceNameUpdates removes the DNS service name members from the account zone once their grace period ends
+	dnsServiceNameUpdates Scheduler
+
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
 
 	integratedPeerValidator integrated_validator.IntegratedValidator
 
 	metrics telemetry.AppMetrics
+
+	permissionsManager permissions.Manager
 }
 
 // getJWTGroupsChanges calculates the changes needed to sync a user's JWT groups.
@@ -248,6 +307,7 @@ func BuildManager(
 	userDeleteFromIDPEnabled bool,
 	integratedPeerValidator integrated_validator.IntegratedValidator,
 	metrics telemetry.AppMetrics,
+	permissionsManager permissions.Manager,
 ) (*DefaultAccountManager, error) {
 	am := &DefaultAccountManager{
 		Store:                    store,
@@ -261,10 +321,15 @@ func BuildManager(
 		eventStore:               eventStore,
 		peerLoginExpiry:          NewDefaultScheduler(),
 		peerInactivityExpiry:     NewDefaultScheduler(),
+		policyScheduleUpdates:    NewDefaultScheduler(),
+		eventRetention:           NewDefaultScheduler(),
+		dnsBlocklistRefresh:      NewDefaultScheduler(),
+		dnsServiceNameUpdates:    NewDefaultScheduler(),
 		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
 		integratedPeerValidator:  integratedPeerValidator,
 		metrics:                  metrics,
 		requestBuffer:            NewAccountRequestBuffer(ctx, store),
+		permissionsManager:       permissionsManager,
 	}
 	allAccounts := store.GetAllAccounts(ctx)
 	// enable single account mode only if configured by user and number of existing accounts is not grater than 1
@@ -299,6 +364,13 @@ func BuildManager(
 				return nil, err
 			}
 		}
+
+		am.checkAndScheduleEventRetention(ctx, account)
+		am.checkAndSchedulePolicyScheduleUpdates(ctx, account)
+
+		for _, blocklist := range account.DNSBlocklists {
+			am.checkAndScheduleDNSBlocklistRefresh(ctx, blocklist)
+		}
 	}
 
 	goCacheClient := gocache.New(CacheExpirationMax, 30*time.Minute)
@@ -350,6 +422,10 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, status.Errorf(status.InvalidArgument, "peer login expiration can't be smaller than one hour")
 	}
 
+	if newSettings.EventRetentionDays < 0 {
+		return nil, status.Errorf(status.InvalidArgument, "event retention days can't be negative")
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -358,15 +434,10 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, err
 	}
 
-	user, err := account.FindUser(userID)
-	if err != nil {
+	if err = am.validateUserPermissions(ctx, accountID, userID, modules.Settings, operations.Update); err != nil {
 		return nil, err
 	}
 
-	if !user.HasAdminPower() {
-		return nil, status.Errorf(status.PermissionDenied, "user is not allowed to update account")
-	}
-
 	err = am.integratedPeerValidator.ValidateExtraSettings(ctx, newSettings.Extra, account.Settings.Extra, account.Peers, userID, accountID)
 	if err != nil {
 		return nil, err
@@ -400,11 +471,29 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		account.Network.Serial++
 	}
 
+	if oldSettings.NetworkTrafficLogsEnabled != newSettings.NetworkTrafficLogsEnabled {
+		if newSettings.NetworkTrafficLogsEnabled {
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountNetworkTrafficLogsEnabled, nil)
+		} else {
+			am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountNetworkTrafficLogsDisabled, nil)
+		}
+		updateAccountPeers = true
+		account.Network.Serial++
+	}
+
 	err = am.handleInactivityExpirationSettings(ctx, account, oldSettings, newSettings, userID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = am.validatePeerApprovalSettings(ctx, accountID, newSettings); err != nil {
+		return nil, err
+	}
+
+	if oldSettings.EventRetentionDays != newSettings.EventRetentionDays {
+		am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountEventRetentionUpdated, map[string]any{"retention_days": newSettings.EventRetentionDays})
+	}
+
 	err = am.handleGroupsPropagationSettings(ctx, oldSettings, newSettings, userID, accountID)
 	if err != nil {
 		return nil, fmt.Errorf("groups propagation failed: %w", err)
@@ -417,6 +506,14 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, err
 	}
 
+	if oldSettings.EventRetentionDays != newSettings.EventRetentionDays || oldSettings.NetworkTrafficLogsEnabled != newSettings.NetworkTrafficLogsEnabled {
+		am.checkAndScheduleEventRetention(ctx, updatedAccount)
+	}
+
+	if am.handlePeerApprovalSettings(ctx, updatedAccount, oldSettings, newSettings, userID) {
+		updateAccountPeers = true
+	}
+
 	if updateAccountPeers {
 		go am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -533,6 +630,101 @@ func (am *DefaultAccountManager) checkAndSchedulePeerInactivityExpiration(ctx co
 	}
 }
 
+// policyScheduleJob sends updated network maps to the account peers when a scheduled policy rule
+// becomes active or inactive and returns the duration until the next schedule transition if found
+func (am *DefaultAccountManager) policyScheduleJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		err := am.Store.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID)
+		unlock()
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to increment network serial of account %s on policy schedule transition: %v", accountID, err)
+			return 0, false
+		}
+
+		log.WithContext(ctx).Debugf("policy schedule transition for account %s, updating peers", accountID)
+		am.UpdateAccountPeers(ctx, accountID)
+
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting account %s for the next policy schedule transition: %v", accountID, err)
+			return 0, false
+		}
+
+		return account.GetNextPolicyScheduleTransition()
+	}
+}
+
+// checkAndSchedulePolicyScheduleUpdates reschedules the account peers updates on the next policy schedule transition
+func (am *DefaultAccountManager) checkAndSchedulePolicyScheduleUpdates(ctx context.Context, account *types.Account) {
+	am.policyScheduleUpdates.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.GetNextPolicyScheduleTransition(); ok {
+		// scheduled synchronously, so a concurrent reschedule can't be overtaken by an outdated transition
+		jobCtx := context.WithoutCancel(ctx)
+		am.policyScheduleUpdates.Schedule(jobCtx, nextRun, account.Id, am.policyScheduleJob(jobCtx, account.Id))
+	}
+}
+
+// eventRetentionJob deletes the activity and network traffic events of the account that are older than its retention
+// period and returns the duration until the next cleanup. The network traffic events are kept for
+// flowTypes.DefaultRetentionDays if the account has no retention period.
+func (am *DefaultAccountManager) eventRetentionJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		settings, err := am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting settings of account %s for the event retention: %v", accountID, err)
+			if e, ok := status.FromError(err); ok && e.Type() == status.NotFound {
+				return 0, false
+			}
+			return eventRetentionInterval, true
+		}
+
+		if !needsEventRetention(settings) {
+			return 0, false
+		}
+
+		now := time.Now().UTC()
+		if settings.EventRetentionDays > 0 {
+			before := now.AddDate(0, 0, -settings.EventRetentionDays)
+			deleted, err := am.eventStore.DeleteOlderThan(ctx, accountID, before)
+			if err != nil {
+				log.WithContext(ctx).Errorf("failed deleting events of account %s older than %s: %v", accountID, before, err)
+				return eventRetentionInterval, true
+			}
+			log.WithContext(ctx).Debugf("deleted %d events of account %s older than %d days", deleted, accountID, settings.EventRetentionDays)
+		}
+
+		trafficRetentionDays := settings.EventRetentionDays
+		if trafficRetentionDays <= 0 {
+			trafficRetentionDays = flowTypes.DefaultRetentionDays
+		}
+		before := now.AddDate(0, 0, -trafficRetentionDays)
+		deleted, err := am.Store.DeleteNetworkTrafficEventsOlderThan(ctx, accountID, before)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed deleting network traffic events of account %s older than %s: %v", accountID, before, err)
+			return eventRetentionInterval, true
+		}
+		log.WithContext(ctx).Debugf("deleted %d network traffic events of account %s older than %d days", deleted, accountID, trafficRetentionDays)
+
+		return eventRetentionInterval, true
+	}
+}
+
+// needsEventRetention reports whether the account has events to clean up, the network traffic events are always
+// cleaned up while they are collected
+func needsEventRetention(settings *types.Settings) bool {
+	return settings != nil && (settings.EventRetentionDays > 0 || settings.NetworkTrafficLogsEnabled)
+}
+
+// checkAndScheduleEventRetention schedules the periodic cleanup of the account activity and network traffic events
+func (am *DefaultAccountManager) checkAndScheduleEventRetention(ctx context.Context, account *types.Account) {
+	am.eventRetention.Cancel(ctx, []string{account.Id})
+	if needsEventRetention(account.Settings) {
+		jobCtx := context.WithoutCancel(ctx)
+		go am.eventRetention.Schedule(jobCtx, eventRetentionStartDelay, account.Id, am.eventRetentionJob(jobCtx, account.Id))
+	}
+}
+
 // newAccount creates a new Account with a generated ID and generated default setup keys.
 // If ID is already in use (due to collision) we try one more time before returning error
 func (am *DefaultAccountManager) newAccount(ctx context.Context, userID, domain string) (*types.Account, error) {
@@ -646,6 +838,12 @@ func (am *DefaultAccountManager) DeleteAccount(ctx context.Context, accountID, u
 	}
 	// cancel peer login expiry job
 	am.peerLoginExpiry.Cancel(ctx, []string{account.Id})
+	am.policyScheduleUpdates.Cancel(ctx, []string{account.Id})
+	am.eventRetention.Cancel(ctx, []string{account.Id})
+	am.dnsServiceNameUpdates.Cancel(ctx, []string{account.Id})
+	for _, blocklist := range account.DNSBlocklists {
+		am.dnsBlocklistRefresh.Cancel(ctx, []string{blocklist.ID})
+	}
 
 	log.WithContext(ctx).Debugf("account %s deleted", accountID)
 	return nil
@@ -1725,15 +1923,10 @@ func (am *DefaultAccountManager) getFreeDNSLabel(ctx context.Context, s store.St
 }
 
 func (am *DefaultAccountManager) GetAccountSettings(ctx context.Context, accountID string, userID string) (*types.Settings, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Settings, operations.Read); err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID || (!user.HasAdminPower() && !user.IsServiceUser) {
-		return nil, status.Errorf(status.PermissionDenied, "the user has no permission to access account data")
-	}
-
 	return am.Store.GetAccountSettings(ctx, store.LockingStrengthShare, accountID)
 }
 
@@ -1884,3 +2077,17 @@ func separateGroups(autoGroups []string, allGroups []*types.Group) ([]string, ma
 
 	return newAutoGroups, jwtAutoGroups
 }
+
+// validateUserPermissions returns an error if the user is not allowed to perform the operation on the module
+func (am *DefaultAccountManager) validateUserPermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) error {
+	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, module, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@ type ExtraSettings struct {
 	// PeerApprovalEnabled enables or disables the need for peers bo be approved by an administrator
 	PeerApprovalEnabled bool
 
+	// PeerApprovalExemptSetupKeys list of setup key IDs whose peers are approved on registration
+	PeerApprovalExemptSetupKeys []string `gorm:"serializer:json"`
+
 	// IntegratedValidatorGroups list of group IDs to be used with integrated approval configurations
 	IntegratedValidatorGroups []string `gorm:"serializer:json"`
 }
@@ -11,9 +14,11 @@ type ExtraSettings struct {
 // Copy copies the ExtraSettings struct
 func (e *ExtraSettings) Copy() *ExtraSettings {
 	var cpGroup []string
+	var cpSetupKeys []string
 
 	return &ExtraSettings{
-		PeerApprovalEnabled:       e.PeerApprovalEnabled,
-		IntegratedValidatorGroups: append(cpGroup, e.IntegratedValidatorGroups...),
+		PeerApprovalEnabled:         e.PeerApprovalEnabled,
+		PeerApprovalExemptSetupKeys: append(cpSetupKeys, e.PeerApprovalExemptSetupKeys...),
+		IntegratedValidatorGroups:   append(cpGroup, e.IntegratedValidatorGroups...),
 	}
 }
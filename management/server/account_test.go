@@ -32,10 +32,12 @@ import (
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -385,8 +387,8 @@ func TestAccount_GetPeerNetworkMap(t *testing.T) {
 			validatedPeers[p] = struct{}{}
 		}
 
-		customZone := account.GetPeersCustomZone(context.Background(), "netbird.io")
-		networkMap := account.GetPeerNetworkMap(context.Background(), testCase.peerID, customZone, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil)
+		customZones := account.GetPeersCustomZones(context.Background(), "netbird.io")
+		networkMap := account.GetPeerNetworkMap(context.Background(), testCase.peerID, customZones, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil)
 		assert.Len(t, networkMap.Peers, len(testCase.expectedPeers))
 		assert.Len(t, networkMap.OfflinePeers, len(testCase.expectedOfflinePeers))
 	}
@@ -742,7 +744,8 @@ func TestAccountManager_GetAccountFromPAT(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store: store,
+		Store:              store,
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	account, user, pat, err := am.GetAccountFromPAT(context.Background(), token)
@@ -782,7 +785,8 @@ func TestDefaultAccountManager_MarkPATUsed(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store: store,
+		Store:              store,
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	err = am.MarkPATUsed(context.Background(), "tokenId")
@@ -1512,7 +1516,7 @@ func getEvent(t *testing.T, accountID string, manager AccountManager, eventType
 		case <-time.After(time.Second):
 			t.Fatal("no PeerAddedWithSetupKey event was generated")
 		default:
-			events, err := manager.GetEvents(context.Background(), accountID, userID)
+			events, err := manager.GetEvents(context.Background(), accountID, userID, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1670,6 +1674,71 @@ func TestAccount_GetRoutesToSync(t *testing.T) {
 	assert.Len(t, emptyRoutes, 0)
 }
 
+func TestAccount_GetRoutesToSyncExitNodes(t *testing.T) {
+	_, defaultPrefix, err := route.ParseNetwork("0.0.0.0/0")
+	require.NoError(t, err)
+
+	linuxMeta := nbpeer.PeerSystemMeta{GoOS: "linux"}
+	account := &types.Account{
+		Peers: map[string]*nbpeer.Peer{
+			"router":  {ID: "router", Key: "router", Meta: linuxMeta},
+			"peer-1":  {ID: "peer-1", Key: "peer-1", UserID: "user-1", Meta: linuxMeta},
+			"peer-2":  {ID: "peer-2", Key: "peer-2", UserID: "user-2", Meta: linuxMeta},
+			"peer-sk": {ID: "peer-sk", Key: "peer-sk", Meta: linuxMeta},
+		},
+		Users: map[string]*types.User{
+			"user-1": {Id: "user-1", AutoGroups: []string{"travellers"}},
+			"user-2": {Id: "user-2"},
+		},
+		Groups: map[string]*types.Group{
+			"all":        {ID: "all", Peers: []string{"router", "peer-1", "peer-2", "peer-sk"}},
+			"routers":    {ID: "routers", Peers: []string{"router"}},
+			"travellers": {ID: "travellers"},
+		},
+		Routes: map[route.ID]*route.Route{
+			"exit-restricted": {
+				ID:         "exit-restricted",
+				Network:    defaultPrefix,
+				NetID:      "exit-restricted",
+				PeerGroups: []string{"routers"},
+				Enabled:    true,
+				Groups:     []string{"all"},
+				ExitNode:   route.ExitNode{Enabled: true, UserGroups: []string{"travellers"}},
+			},
+			"exit-open": {
+				ID:         "exit-open",
+				Network:    defaultPrefix,
+				NetID:      "exit-open",
+				PeerGroups: []string{"routers"},
+				Enabled:    true,
+				Groups:     []string{"all"},
+				ExitNode:   route.ExitNode{Enabled: true},
+			},
+		},
+	}
+	aclPeers := []*nbpeer.Peer{account.Peers["router"]}
+
+	tests := []struct {
+		peerID   string
+		expected []route.ID
+	}{
+		{peerID: "peer-1", expected: []route.ID{"exit-restricted:router", "exit-open:router"}},
+		{peerID: "peer-2", expected: []route.ID{"exit-open:router"}},
+		{peerID: "peer-sk", expected: []route.ID{"exit-open:router"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.peerID, func(t *testing.T) {
+			routes := account.GetRoutesToSync(context.Background(), tc.peerID, aclPeers)
+			routeIDs := make([]route.ID, 0, len(routes))
+			for _, r := range routes {
+				routeIDs = append(routeIDs, r.ID)
+			}
+			assert.ElementsMatch(t, tc.expected, routeIDs)
+		})
+	}
+}
+
 func TestAccount_Copy(t *testing.T) {
 	account := &types.Account{
 		Id:                     "account1",
@@ -1740,13 +1809,33 @@ func TestAccount_Copy(t *testing.T) {
 		},
 		NameServerGroups: map[string]*nbdns.NameServerGroup{
 			"nsGroup1": {
-				ID:          "nsGroup1",
-				Domains:     []string{},
-				Groups:      []string{},
-				NameServers: []nbdns.NameServer{},
+				ID:                 "nsGroup1",
+				Domains:            []string{},
+				Groups:             []string{},
+				NameServers:        []nbdns.NameServer{},
+				DNSSECTrustAnchors: []string{},
 			},
 		},
 		DNSSettings: types.DNSSettings{DisabledManagementGroups: []string{}},
+		DNSRecords: []*types.DNSRecord{
+			{
+				ID: "record1",
+			},
+		},
+		DNSBlocklists: []*types.DNSBlocklist{
+			{
+				ID:           "blocklist1",
+				Domains:      []string{"ads.example.com"},
+				ExemptGroups: []string{"group1"},
+			},
+		},
+		DNSServiceNames: []*types.DNSServiceName{
+			{
+				ID:     "serviceName1",
+				Peers:  []string{"peer1"},
+				Groups: []string{"group1"},
+			},
+		},
 		PostureChecks: []*posture.Checks{
 			{
 				ID: "posture Checks1",
@@ -1942,6 +2031,57 @@ func TestDefaultAccountManager_MarkPeerConnected_PeerLoginExpiration(t *testing.
 	}
 }
 
+func TestDefaultAccountManager_PolicyScheduleUpdatesRestoredOnStartup(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err, "unable to create account manager")
+
+	accountID, err := manager.GetAccountIDByUserID(context.Background(), userID, "")
+	require.NoError(t, err, "unable to create an account")
+
+	key, err := wgtypes.GenerateKey()
+	require.NoError(t, err, "unable to generate WireGuard key")
+	_, _, _, err = manager.AddPeer(context.Background(), "", userID, &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "test-peer"},
+	})
+	require.NoError(t, err, "unable to add peer")
+
+	account, err := manager.Store.GetAccount(context.Background(), accountID)
+	require.NoError(t, err, "unable to get the account")
+	require.NotEmpty(t, account.Policies)
+	validUntil := time.Now().Add(time.Hour)
+	account.Policies[0].Rules[0].Schedule = &types.RuleSchedule{ValidUntil: &validUntil}
+
+	require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+	var calls []string
+	manager.policyScheduleUpdates = &MockScheduler{
+		CancelFunc: func(ctx context.Context, IDs []string) {
+			calls = append(calls, "cancel")
+		},
+		ScheduleFunc: func(ctx context.Context, in time.Duration, ID string, job func() (nextRunIn time.Duration, reschedule bool)) {
+			calls = append(calls, "schedule")
+		},
+	}
+
+	// connecting peers don't replace the scheduled job of the account
+	err = manager.MarkPeerConnected(context.Background(), key.PublicKey().String(), true, nil, account)
+	require.NoError(t, err, "unable to mark peer connected")
+	assert.Empty(t, calls)
+
+	// the jobs are restored on startup
+	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
+	require.NoError(t, err)
+	restarted, err := BuildManager(context.Background(), manager.Store, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", &activity.InMemoryEventStore{}, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(manager.Store, users.NewManager(manager.Store)))
+	require.NoError(t, err, "unable to restart the account manager")
+	scheduler := restarted.policyScheduleUpdates.(*DefaultScheduler)
+	defer scheduler.Cancel(context.Background(), []string{accountID})
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	assert.Contains(t, scheduler.jobs, accountID, "the next policy schedule transition should be scheduled on startup")
+}
+
 func TestDefaultAccountManager_UpdateAccountSettings_PeerLoginExpiration(t *testing.T) {
 	manager, err := createManager(t)
 	require.NoError(t, err, "unable to create account manager")
@@ -2907,7 +3047,7 @@ func createManager(t TB) (*DefaultAccountManager, error) {
 		return nil, err
 	}
 
-	manager, err := BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	manager, err := BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(store, users.NewManager(store)))
 	if err != nil {
 		return nil, err
 	}
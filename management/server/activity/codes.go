@@ -169,6 +169,34 @@ const (
 
 	ResourceAddedToGroup     Activity = 82
 	ResourceRemovedFromGroup Activity = 83
+
+	AccountNetworkTrafficLogsEnabled  Activity = 84
+	AccountNetworkTrafficLogsDisabled Activity = 85
+
+	CustomRoleCreated Activity = 86
+	CustomRoleUpdated Activity = 87
+	CustomRoleDeleted Activity = 88
+
+	AccountEventRetentionUpdated Activity = 89
+
+	SCIMTokenCreated Activity = 90
+	SCIMTokenDeleted Activity = 91
+	UserProvisioned  Activity = 92
+
+	PeerRejected          Activity = 93
+	PeerApprovalRequested Activity = 94
+
+	DNSRecordCreated Activity = 95
+	DNSRecordUpdated Activity = 96
+	DNSRecordDeleted Activity = 97
+
+	DNSBlocklistCreated Activity = 98
+	DNSBlocklistUpdated Activity = 99
+	DNSBlocklistDeleted Activity = 100
+
+	DNSServiceNameCreated Activity = 101
+	DNSServiceNameUpdated Activity = 102
+	DNSServiceNameDeleted Activity = 103
 )
 
 var activityMap = map[Activity]Code{
@@ -264,6 +292,34 @@ var activityMap = map[Activity]Code{
 
 	ResourceAddedToGroup:     {"Resource added to group", "resource.group.add"},
 	ResourceRemovedFromGroup: {"Resource removed from group", "resource.group.delete"},
+
+	AccountNetworkTrafficLogsEnabled:  {"Account network traffic logs enabled", "account.setting.network.traffic.logs.enable"},
+	AccountNetworkTrafficLogsDisabled: {"Account network traffic logs disabled", "account.setting.network.traffic.logs.disable"},
+
+	CustomRoleCreated: {"Custom role created", "role.create"},
+	CustomRoleUpdated: {"Custom role updated", "role.update"},
+	CustomRoleDeleted: {"Custom role deleted", "role.delete"},
+
+	AccountEventRetentionUpdated: {"Account event retention updated", "account.setting.event.retention.update"},
+
+	SCIMTokenCreated: {"SCIM token created", "scim.token.create"},
+	SCIMTokenDeleted: {"SCIM token deleted", "scim.token.delete"},
+	UserProvisioned:  {"User provisioned", "user.scim.provision"},
+
+	PeerRejected:          {"Peer rejected", "peer.reject"},
+	PeerApprovalRequested: {"Peer approval requested", "peer.approval.request"},
+
+	DNSRecordCreated: {"DNS record created", "dns.record.add"},
+	DNSRecordUpdated: {"DNS record updated", "dns.record.update"},
+	DNSRecordDeleted: {"DNS record deleted", "dns.record.delete"},
+
+	DNSBlocklistCreated: {"DNS blocklist created", "dns.blocklist.add"},
+	DNSBlocklistUpdated: {"DNS blocklist updated", "dns.blocklist.update"},
+	DNSBlocklistDeleted: {"DNS blocklist deleted", "dns.blocklist.delete"},
+
+	DNSServiceNameCreated: {"DNS service name created", "dns.service_name.add"},
+	DNSServiceNameUpdated: {"DNS service name updated", "dns.service_name.update"},
+	DNSServiceNameDeleted: {"DNS service name deleted", "dns.service_name.delete"},
 }
 
 // StringCode returns a string code of the activity
@@ -282,6 +338,16 @@ func (a Activity) Message() string {
 	return "UNKNOWN_ACTIVITY"
 }
 
+// FromStringCode returns the activity of the given string code
+func FromStringCode(code string) (Activity, bool) {
+	for activity, c := range activityMap {
+		if c.Code == code {
+			return activity, true
+		}
+	}
+	return 0, false
+}
+
 // RegisterActivityMap adds new codes to the activity map
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
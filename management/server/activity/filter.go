@@ -0,0 +1,77 @@
+package activity
+
+import (
+	"slices"
+	"time"
+)
+
+// Filter narrows down the events returned by Store.Get. Empty fields are not applied.
+type Filter struct {
+	// Activities returns only events of the given activities
+	Activities []Activity
+	// InitiatorID returns only events initiated by the given ID
+	InitiatorID string
+	// TargetID returns only events that affected the given ID
+	TargetID string
+	// PeerID returns only events that were initiated by or affected the given peer
+	PeerID string
+	// StartDate returns only events that happened at or after the given time
+	StartDate time.Time
+	// EndDate returns only events that happened at or before the given time
+	EndDate time.Time
+	// After returns only events that follow the cursor in the ascending order, used to page through the events
+	After *Cursor
+}
+
+// Cursor is the position of an event in the order by timestamp and ID
+type Cursor struct {
+	Timestamp time.Time
+	ID        uint64
+}
+
+// IsEmpty returns true if the filter doesn't restrict the events
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.Activities) == 0 && f.InitiatorID == "" && f.TargetID == "" && f.PeerID == "" &&
+		f.StartDate.IsZero() && f.EndDate.IsZero() && f.After == nil)
+}
+
+// Matches returns true if the event passes the filter
+func (f *Filter) Matches(event *Event) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if len(f.Activities) > 0 {
+		operation, ok := event.Activity.(Activity)
+		if !ok || !slices.Contains(f.Activities, operation) {
+			return false
+		}
+	}
+
+	if f.InitiatorID != "" && event.InitiatorID != f.InitiatorID {
+		return false
+	}
+
+	if f.TargetID != "" && event.TargetID != f.TargetID {
+		return false
+	}
+
+	if f.PeerID != "" && event.InitiatorID != f.PeerID && event.TargetID != f.PeerID {
+		return false
+	}
+
+	if !f.StartDate.IsZero() && event.Timestamp.Before(f.StartDate) {
+		return false
+	}
+
+	if !f.EndDate.IsZero() && event.Timestamp.After(f.EndDate) {
+		return false
+	}
+
+	if f.After != nil && (event.Timestamp.Before(f.After.Timestamp) ||
+		event.Timestamp.Equal(f.After.Timestamp) && event.ID <= f.After.ID) {
+		return false
+	}
+
+	return true
+}
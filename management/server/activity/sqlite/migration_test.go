@@ -71,7 +71,7 @@ func TestMigrate(t *testing.T) {
 	store, err := createStore(crypt, db)
 	require.NoError(t, err, "Failed to create store")
 
-	events, err := store.Get(context.Background(), "accountID", 0, 1, false)
+	events, err := store.Get(context.Background(), "accountID", 0, 1, false, nil)
 	require.NoError(t, err, "Failed to get events")
 
 	require.Len(t, events, 1, "Should have one event")
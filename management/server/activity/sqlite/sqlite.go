@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -28,7 +29,7 @@ const (
 
 	creatTableDeletedUsersQuery = `CREATE TABLE IF NOT EXISTS deleted_users (id TEXT NOT NULL, email TEXT NOT NULL, name TEXT, enc_algo TEXT NOT NULL);`
 
-	selectDescQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
+	selectQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
 		FROM events 
 		LEFT JOIN (
 		    SELECT id, MAX(name) as name, MAX(email) as email 
@@ -40,23 +41,9 @@ const (
 		    FROM deleted_users
 		    GROUP BY id
 		) t ON events.target_id = t.id
-		WHERE account_id = ? 
-		ORDER BY timestamp DESC LIMIT ? OFFSET ?;`
+		WHERE account_id = ?`
 
-	selectAscQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
-		FROM events 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) i ON events.initiator_id = i.id 
-		LEFT JOIN (
-		    SELECT id, MAX(name) as name, MAX(email) as email 
-		    FROM deleted_users
-		    GROUP BY id
-		) t ON events.target_id = t.id
-		WHERE account_id = ? 
-		ORDER BY timestamp ASC LIMIT ? OFFSET ?;`
+	deleteOlderThanQuery = "DELETE FROM events WHERE account_id = ? AND timestamp < ?"
 
 	insertQuery = "INSERT INTO events(activity, timestamp, initiator_id, target_id, account_id, meta) " +
 		"VALUES(?, ?, ?, ?, ?, ?)"
@@ -82,10 +69,8 @@ type Store struct {
 	db           *sql.DB
 	fieldEncrypt *FieldEncrypt
 
-	insertStatement     *sql.Stmt
-	selectAscStatement  *sql.Stmt
-	selectDescStatement *sql.Stmt
-	deleteUserStmt      *sql.Stmt
+	insertStatement *sql.Stmt
+	deleteUserStmt  *sql.Stmt
 }
 
 // NewSQLiteStore creates a new Store with an event table if not exists.
@@ -198,14 +183,11 @@ func (store *Store) processResult(ctx context.Context, result *sql.Rows) ([]*act
 	return events, nil
 }
 
-// Get returns "limit" number of events from index ordered descending or ascending by a timestamp
-func (store *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*activity.Event, error) {
-	stmt := store.selectDescStatement
-	if !descending {
-		stmt = store.selectAscStatement
-	}
+// Get returns "limit" number of events matching the filter from index ordered descending or ascending by a timestamp
+func (store *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool, filter *activity.Filter) ([]*activity.Event, error) {
+	query, args := buildSelectQuery(accountID, offset, limit, descending, filter)
 
-	result, err := stmt.Query(accountID, limit, offset)
+	result, err := store.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +196,67 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, result)
 }
 
+// buildSelectQuery appends the filter conditions, order and pagination to the select query
+func buildSelectQuery(accountID string, offset, limit int, descending bool, filter *activity.Filter) (string, []any) {
+	var query strings.Builder
+	query.WriteString(selectQuery)
+	args := []any{accountID}
+
+	if !filter.IsEmpty() {
+		if len(filter.Activities) > 0 {
+			placeholders := make([]string, 0, len(filter.Activities))
+			for _, a := range filter.Activities {
+				placeholders = append(placeholders, "?")
+				args = append(args, a)
+			}
+			query.WriteString(" AND activity IN (" + strings.Join(placeholders, ", ") + ")")
+		}
+		if filter.InitiatorID != "" {
+			query.WriteString(" AND initiator_id = ?")
+			args = append(args, filter.InitiatorID)
+		}
+		if filter.TargetID != "" {
+			query.WriteString(" AND target_id = ?")
+			args = append(args, filter.TargetID)
+		}
+		if filter.PeerID != "" {
+			query.WriteString(" AND (initiator_id = ? OR target_id = ?)")
+			args = append(args, filter.PeerID, filter.PeerID)
+		}
+		if !filter.StartDate.IsZero() {
+			query.WriteString(" AND timestamp >= ?")
+			args = append(args, filter.StartDate.UTC())
+		}
+		if !filter.EndDate.IsZero() {
+			query.WriteString(" AND timestamp <= ?")
+			args = append(args, filter.EndDate.UTC())
+		}
+		if filter.After != nil {
+			query.WriteString(" AND (timestamp > ? OR (timestamp = ? AND events.id > ?))")
+			args = append(args, filter.After.Timestamp.UTC(), filter.After.Timestamp.UTC(), filter.After.ID)
+		}
+	}
+
+	if descending {
+		query.WriteString(" ORDER BY timestamp DESC, events.id DESC")
+	} else {
+		query.WriteString(" ORDER BY timestamp ASC, events.id ASC")
+	}
+	query.WriteString(" LIMIT ? OFFSET ?;")
+	args = append(args, limit, offset)
+
+	return query.String(), args
+}
+
+// DeleteOlderThan deletes the events of the account that happened before the given time
+func (store *Store) DeleteOlderThan(ctx context.Context, accountID string, before time.Time) (int64, error) {
+	result, err := store.db.ExecContext(ctx, deleteOlderThanQuery, accountID, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 // Save an event in the SQLite events table end encrypt the "email" element in meta map
 func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
 	var jsonMeta string
@@ -296,18 +339,6 @@ func createStore(crypt *FieldEncrypt, db *sql.DB) (*Store, error) {
 		return nil, err
 	}
 
-	selectDescStmt, err := db.Prepare(selectDescQuery)
-	if err != nil {
-		_ = db.Close()
-		return nil, err
-	}
-
-	selectAscStmt, err := db.Prepare(selectAscQuery)
-	if err != nil {
-		_ = db.Close()
-		return nil, err
-	}
-
 	deleteUserStmt, err := db.Prepare(insertDeleteUserQuery)
 	if err != nil {
 		_ = db.Close()
@@ -315,12 +346,10 @@ func createStore(crypt *FieldEncrypt, db *sql.DB) (*Store, error) {
 	}
 
 	return &Store{
-		db:                  db,
-		fieldEncrypt:        crypt,
-		insertStatement:     insertStmt,
-		selectDescStatement: selectDescStmt,
-		selectAscStatement:  selectAscStmt,
-		deleteUserStmt:      deleteUserStmt,
+		db:              db,
+		fieldEncrypt:    crypt,
+		insertStatement: insertStmt,
+		deleteUserStmt:  deleteUserStmt,
 	}, nil
 }
 
@@ -37,7 +37,7 @@ func TestNewSQLiteStore(t *testing.T) {
 		}
 	}
 
-	result, err := store.Get(context.Background(), accountID, 0, 10, false)
+	result, err := store.Get(context.Background(), accountID, 0, 10, false, nil)
 	if err != nil {
 		t.Fatal(err)
 		return
@@ -46,7 +46,7 @@ func TestNewSQLiteStore(t *testing.T) {
 	assert.Len(t, result, 10)
 	assert.True(t, result[0].Timestamp.Before(result[len(result)-1].Timestamp))
 
-	result, err = store.Get(context.Background(), accountID, 0, 5, true)
+	result, err = store.Get(context.Background(), accountID, 0, 5, true, nil)
 	if err != nil {
 		t.Fatal(err)
 		return
@@ -55,3 +55,166 @@ func TestNewSQLiteStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestSQLiteStore_GetFiltered(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 10; i++ {
+		operation := activity.PeerAddedByUser
+		if i%2 == 0 {
+			operation = activity.PolicyUpdated
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * 24 * time.Hour),
+			Activity:    operation,
+			InitiatorID: "user_" + fmt.Sprint(i%3),
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+		})
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+
+	tt := []struct {
+		name     string
+		filter   *activity.Filter
+		expected int
+	}{
+		{name: "empty filter", filter: &activity.Filter{}, expected: 10},
+		{name: "by activity", filter: &activity.Filter{Activities: []activity.Activity{activity.PolicyUpdated}}, expected: 5},
+		{name: "by multiple activities", filter: &activity.Filter{Activities: []activity.Activity{activity.PolicyUpdated, activity.PeerAddedByUser}}, expected: 10},
+		{name: "by initiator", filter: &activity.Filter{InitiatorID: "user_0"}, expected: 4},
+		{name: "by target", filter: &activity.Filter{TargetID: "peer_3"}, expected: 1},
+		{name: "by peer", filter: &activity.Filter{PeerID: "peer_3"}, expected: 1},
+		{name: "by start date", filter: &activity.Filter{StartDate: start.Add(5 * 24 * time.Hour)}, expected: 5},
+		{name: "by time range", filter: &activity.Filter{StartDate: start.Add(24 * time.Hour), EndDate: start.Add(3 * 24 * time.Hour)}, expected: 3},
+		{
+			name: "combined",
+			filter: &activity.Filter{
+				Activities: []activity.Activity{activity.PolicyUpdated},
+				StartDate:  start.Add(2 * 24 * time.Hour),
+				EndDate:    start.Add(6 * 24 * time.Hour),
+			},
+			expected: 3,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := store.Get(context.Background(), accountID, 0, 100, false, tc.filter)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+			assert.Len(t, result, tc.expected)
+			for _, event := range result {
+				assert.True(t, tc.filter.Matches(event), "event %d doesn't match the filter", event.ID)
+			}
+		})
+	}
+}
+
+func TestSQLiteStore_DeleteOlderThan(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer store.Close(context.Background()) //nolint
+
+	now := time.Now().UTC()
+	for _, accountID := range []string{"account_1", "account_2"} {
+		for i := 0; i < 10; i++ {
+			_, err = store.Save(context.Background(), &activity.Event{
+				Timestamp:   now.Add(-time.Duration(i) * 24 * time.Hour),
+				Activity:    activity.PeerAddedByUser,
+				InitiatorID: "user",
+				TargetID:    "peer",
+				AccountID:   accountID,
+			})
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+		}
+	}
+
+	deleted, err := store.DeleteOlderThan(context.Background(), "account_1", now.Add(-7*24*time.Hour+time.Minute))
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, int64(3), deleted)
+
+	result, err := store.Get(context.Background(), "account_1", 0, 100, false, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Len(t, result, 7)
+
+	result, err = store.Get(context.Background(), "account_2", 0, 100, false, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Len(t, result, 10, "events of other accounts must be kept")
+}
+
+func TestSQLiteStore_GetAfterCursor(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the events sharing a timestamp are ordered by ID
+	for i := 0; i < 10; i++ {
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp: start.Add(time.Duration(i/5) * time.Hour),
+			Activity:  activity.PeerAddedByUser,
+			AccountID: accountID,
+		})
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+
+	filter := &activity.Filter{}
+	var ids []uint64
+	for {
+		result, err := store.Get(context.Background(), accountID, 0, 3, false, filter)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		for _, event := range result {
+			ids = append(ids, event.ID)
+		}
+		if len(result) < 3 {
+			break
+		}
+		last := result[len(result)-1]
+		filter.After = &activity.Cursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+}
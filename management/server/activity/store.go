@@ -3,14 +3,17 @@ package activity
 import (
 	"context"
 	"sync"
+	"time"
 )
 
 // Store provides an interface to store or stream events.
 type Store interface {
 	// Save an event in the store
 	Save(ctx context.Context, event *Event) (*Event, error)
-	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
-	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// Get returns "limit" number of events matching the filter from the "offset" index ordered descending or ascending by a timestamp
+	Get(ctx context.Context, accountID string, offset, limit int, descending bool, filter *Filter) ([]*Event, error)
+	// DeleteOlderThan deletes the events of the account that happened before the given time and returns the number of deleted events
+	DeleteOlderThan(ctx context.Context, accountID string, before time.Time) (int64, error)
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -35,19 +38,36 @@ func (store *InMemoryEventStore) Save(_ context.Context, event *Event) (*Event,
 	return event, nil
 }
 
-// Get returns a list of ALL events that belong to the given accountID without taking offset, limit and order into consideration
-func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error) {
+// Get returns a list of ALL events that belong to the given accountID and match the filter without taking offset, limit and order into consideration
+func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset, limit int, descending bool, filter *Filter) ([]*Event, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 	events := make([]*Event, 0)
 	for _, event := range store.events {
-		if event.AccountID == accountID {
+		if event.AccountID == accountID && filter.Matches(event) {
 			events = append(events, event)
 		}
 	}
 	return events, nil
 }
 
+// DeleteOlderThan removes the events of the account that happened before the given time
+func (store *InMemoryEventStore) DeleteOlderThan(_ context.Context, accountID string, before time.Time) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var deleted int64
+	events := make([]*Event, 0, len(store.events))
+	for _, event := range store.events {
+		if event.AccountID == accountID && event.Timestamp.Before(before) {
+			deleted++
+			continue
+		}
+		events = append(events, event)
+	}
+	store.events = events
+	return deleted, nil
+}
+
 // Close cleans up the event list
 func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+
+	activitySqlite "github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/util"
+)
+
+const (
+	sqliteEventsDB     = "events.db"
+	migrationBatchSize = 1000
+)
+
+// MigrateFromSQLite copies the events and deleted users of the SQLite activity store in the data directory to the given store.
+// Encrypted fields are copied as they are, so the target store has to use the same encryption key.
+// The migration can be repeated, events that already exist in the target store are skipped.
+func MigrateFromSQLite(ctx context.Context, dataDir, encryptionKey string, target *Store) error {
+	dbFile := filepath.Join(dataDir, sqliteEventsDB)
+	if !util.FileExists(dbFile) {
+		return fmt.Errorf("activity store %s doesn't exist", dbFile)
+	}
+
+	// opening the SQLite store applies its pending migrations, e.g. the re-encryption of legacy deleted users
+	sqliteStore, err := activitySqlite.NewSQLiteStore(ctx, dataDir, encryptionKey)
+	if err != nil {
+		return fmt.Errorf("open sqlite activity store: %w", err)
+	}
+	if err = sqliteStore.Close(ctx); err != nil {
+		return fmt.Errorf("close sqlite activity store: %w", err)
+	}
+
+	source, err := gorm.Open(sqlite.Open(dbFile), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return fmt.Errorf("open sqlite activity database: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := source.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	if err = migrateDeletedUsers(ctx, source, target.db); err != nil {
+		return err
+	}
+
+	if err = migrateEvents(ctx, source, target.db); err != nil {
+		return err
+	}
+
+	return target.resetEventsSequence(ctx)
+}
+
+func migrateDeletedUsers(ctx context.Context, source, target *gorm.DB) error {
+	var users []deletedUser
+	// the sqlite table may contain duplicates, the latest entry of a user wins
+	if err := source.WithContext(ctx).Order("rowid ASC").Find(&users).Error; err != nil {
+		return fmt.Errorf("read deleted users: %w", err)
+	}
+
+	latest := make(map[string]deletedUser, len(users))
+	for _, user := range users {
+		latest[user.ID] = user
+	}
+
+	for _, user := range latest {
+		if err := target.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&user).Error; err != nil {
+			return fmt.Errorf("save deleted user %s: %w", user.ID, err)
+		}
+	}
+
+	log.WithContext(ctx).Infof("migrated %d deleted users", len(latest))
+
+	return nil
+}
+
+func migrateEvents(ctx context.Context, source, target *gorm.DB) error {
+	var migrated int
+	var batch []event
+
+	result := source.WithContext(ctx).Order("id ASC").FindInBatches(&batch, migrationBatchSize, func(tx *gorm.DB, _ int) error {
+		if err := target.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&batch).Error; err != nil {
+			return fmt.Errorf("save events: %w", err)
+		}
+		migrated += len(batch)
+		return nil
+	})
+	if result.Error != nil {
+		return fmt.Errorf("migrate events: %w", result.Error)
+	}
+
+	log.WithContext(ctx).Infof("migrated %d events", migrated)
+
+	return nil
+}
+
+// resetEventsSequence moves the Postgres ID sequence past the migrated event IDs. MySQL adjusts its auto increment itself.
+func (s *Store) resetEventsSequence(ctx context.Context) error {
+	if s.engine != store.PostgresStoreEngine {
+		return nil
+	}
+
+	err := s.db.WithContext(ctx).
+		Exec("SELECT setval(pg_get_serial_sequence('events', 'id'), COALESCE((SELECT MAX(id) FROM events), 0) + 1, false)").Error
+	if err != nil {
+		return fmt.Errorf("reset events id sequence: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	postgresDsnEnv = "NETBIRD_ACTIVITY_STORE_ENGINE_POSTGRES_DSN"
+	mysqlDsnEnv    = "NETBIRD_ACTIVITY_STORE_ENGINE_MYSQL_DSN"
+
+	// the DSNs of the main store are used when no dedicated activity store DSN is set
+	storePostgresDsnEnv = "NETBIRD_STORE_ENGINE_POSTGRES_DSN"
+	storeMysqlDsnEnv    = "NETBIRD_STORE_ENGINE_MYSQL_DSN"
+
+	fallbackName  = "unknown"
+	fallbackEmail = "unknown@unknown.com"
+
+	gcmEncAlgo = "GCM"
+)
+
+// event is the database model of an activity event, it matches the sqlite events table
+type event struct {
+	ID          uint64            `gorm:"primaryKey;autoIncrement"`
+	Activity    activity.Activity `gorm:"index"`
+	Timestamp   time.Time         `gorm:"index"`
+	InitiatorID string
+	TargetID    string
+	AccountID   string `gorm:"index"`
+	Meta        string
+}
+
+func (event) TableName() string {
+	return "events"
+}
+
+// deletedUser keeps the encrypted email and name of deleted users referenced by events
+type deletedUser struct {
+	ID      string `gorm:"primaryKey"`
+	Email   string `gorm:"not null"`
+	Name    string
+	EncAlgo string `gorm:"not null"`
+}
+
+func (deletedUser) TableName() string {
+	return "deleted_users"
+}
+
+// eventWithNames is an event joined with the encrypted names and emails of deleted initiators and targets
+type eventWithNames struct {
+	Event          event `gorm:"embedded"`
+	InitiatorName  *string
+	InitiatorEmail *string
+	TargetName     *string
+	TargetEmail    *string
+}
+
+// Store is the implementation of the activity.Store interface backed by a Postgres or MySQL database
+type Store struct {
+	db           *gorm.DB
+	engine       store.Engine
+	fieldEncrypt *sqlite.FieldEncrypt
+}
+
+// NewSqlStore creates a new activity store for the given engine. The DSN is read from the environment.
+func NewSqlStore(ctx context.Context, engine store.Engine, encryptionKey string) (*Store, error) {
+	var dialector gorm.Dialector
+	switch engine {
+	case store.PostgresStoreEngine:
+		dsn, err := getDsn(postgresDsnEnv, storePostgresDsnEnv)
+		if err != nil {
+			return nil, err
+		}
+		dialector = postgres.Open(dsn)
+	case store.MysqlStoreEngine:
+		dsn, err := getDsn(mysqlDsnEnv, storeMysqlDsnEnv)
+		if err != nil {
+			return nil, err
+		}
+		dialector = mysql.Open(dsn + "?charset=utf8&parseTime=True&loc=Local")
+	default:
+		return nil, fmt.Errorf("unsupported activity store engine: %s", engine)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("open activity database: %w", err)
+	}
+
+	log.WithContext(ctx).Infof("using %s activity store engine", engine)
+
+	return newSqlStore(ctx, db, engine, encryptionKey)
+}
+
+func newSqlStore(ctx context.Context, db *gorm.DB, engine store.Engine, encryptionKey string) (*Store, error) {
+	crypt, err := sqlite.NewFieldEncrypt(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.WithContext(ctx).AutoMigrate(&event{}, &deletedUser{}); err != nil {
+		return nil, fmt.Errorf("events database migration: %w", err)
+	}
+
+	return &Store{
+		db:           db,
+		engine:       engine,
+		fieldEncrypt: crypt,
+	}, nil
+}
+
+func getDsn(envs ...string) (string, error) {
+	for _, env := range envs {
+		if dsn, ok := os.LookupEnv(env); ok && dsn != "" {
+			return dsn, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not set", envs[0])
+}
+
+// Save an event in the events table and store the email and name of deleted users encrypted
+func (s *Store) Save(ctx context.Context, e *activity.Event) (*activity.Event, error) {
+	eventCopy := e.Copy()
+
+	meta, err := s.saveDeletedUserEmailAndNameInEncrypted(ctx, eventCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMeta string
+	if meta != nil {
+		metaBytes, err := json.Marshal(meta)
+		if err != nil {
+			return nil, err
+		}
+		jsonMeta = string(metaBytes)
+	}
+
+	operation, ok := e.Activity.(activity.Activity)
+	if !ok {
+		return nil, fmt.Errorf("unsupported activity type %T", e.Activity)
+	}
+
+	record := &event{
+		Activity:    operation,
+		Timestamp:   e.Timestamp,
+		InitiatorID: e.InitiatorID,
+		TargetID:    e.TargetID,
+		AccountID:   e.AccountID,
+		Meta:        jsonMeta,
+	}
+
+	if err = s.db.WithContext(ctx).Create(record).Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to save activity event: %v", err)
+		return nil, fmt.Errorf("failed to save activity event")
+	}
+
+	// the email and name of a deleted user are only returned with the event if the meta holds nothing else, as in the
+	// SQLite store
+	eventCopy.ID = record.ID
+	return eventCopy, nil
+}
+
+// saveDeletedUserEmailAndNameInEncrypted if the meta contains email and name then store it in encrypted way and
+// remove these items from meta
+func (s *Store) saveDeletedUserEmailAndNameInEncrypted(ctx context.Context, e *activity.Event) (map[string]any, error) {
+	email, ok := e.Meta["email"]
+	if !ok {
+		return e.Meta, nil
+	}
+
+	name, ok := e.Meta["name"]
+	if !ok {
+		return e.Meta, nil
+	}
+
+	encryptedEmail, err := s.fieldEncrypt.Encrypt(fmt.Sprintf("%s", email))
+	if err != nil {
+		return nil, err
+	}
+	encryptedName, err := s.fieldEncrypt.Encrypt(fmt.Sprintf("%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	user := &deletedUser{
+		ID:      e.TargetID,
+		Email:   encryptedEmail,
+		Name:    encryptedName,
+		EncAlgo: gcmEncAlgo,
+	}
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(user).Error
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to save deleted user: %v", err)
+		return nil, fmt.Errorf("failed to save deleted user")
+	}
+
+	if len(e.Meta) == 2 {
+		return nil, nil // nolint
+	}
+	delete(e.Meta, "email")
+	delete(e.Meta, "name")
+	return e.Meta, nil
+}
+
+// Get returns "limit" number of events matching the filter from the "offset" index ordered descending or ascending by a timestamp
+func (s *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool, filter *activity.Filter) ([]*activity.Event, error) {
+	order := "events.timestamp ASC, events.id ASC"
+	if descending {
+		order = "events.timestamp DESC, events.id DESC"
+	}
+
+	query := s.db.WithContext(ctx).
+		Table("events").
+		Select("events.*, i.name AS initiator_name, i.email AS initiator_email, t.name AS target_name, t.email AS target_email").
+		Joins("LEFT JOIN deleted_users i ON events.initiator_id = i.id").
+		Joins("LEFT JOIN deleted_users t ON events.target_id = t.id").
+		Where("events.account_id = ?", accountID)
+
+	var records []eventWithNames
+	err := applyFilter(query, filter).
+		Order(order).
+		Offset(offset).
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get activity events: %v", err)
+		return nil, fmt.Errorf("failed to get activity events")
+	}
+
+	events := make([]*activity.Event, 0, len(records))
+	for _, record := range records {
+		e, err := s.toActivityEvent(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// applyFilter adds the conditions of the filter to the events query
+func applyFilter(query *gorm.DB, filter *activity.Filter) *gorm.DB {
+	if filter.IsEmpty() {
+		return query
+	}
+
+	if len(filter.Activities) > 0 {
+		query = query.Where("events.activity IN ?", filter.Activities)
+	}
+	if filter.InitiatorID != "" {
+		query = query.Where("events.initiator_id = ?", filter.InitiatorID)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("events.target_id = ?", filter.TargetID)
+	}
+	if filter.PeerID != "" {
+		query = query.Where("(events.initiator_id = ? OR events.target_id = ?)", filter.PeerID, filter.PeerID)
+	}
+	if !filter.StartDate.IsZero() {
+		query = query.Where("events.timestamp >= ?", filter.StartDate.UTC())
+	}
+	if !filter.EndDate.IsZero() {
+		query = query.Where("events.timestamp <= ?", filter.EndDate.UTC())
+	}
+	if filter.After != nil {
+		query = query.Where("(events.timestamp > ? OR (events.timestamp = ? AND events.id > ?))",
+			filter.After.Timestamp.UTC(), filter.After.Timestamp.UTC(), filter.After.ID)
+	}
+
+	return query
+}
+
+// DeleteOlderThan deletes the events of the account that happened before the given time
+func (s *Store) DeleteOlderThan(ctx context.Context, accountID string, before time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("account_id = ? AND timestamp < ?", accountID, before.UTC()).Delete(&event{})
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete activity events: %v", result.Error)
+		return 0, fmt.Errorf("failed to delete activity events")
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *Store) toActivityEvent(ctx context.Context, record eventWithNames) (*activity.Event, error) {
+	meta := make(map[string]any)
+	if record.Event.Meta != "" {
+		if err := json.Unmarshal([]byte(record.Event.Meta), &meta); err != nil {
+			return nil, err
+		}
+	}
+
+	e := &activity.Event{
+		Timestamp:   record.Event.Timestamp,
+		Activity:    record.Event.Activity,
+		ID:          record.Event.ID,
+		InitiatorID: record.Event.InitiatorID,
+		TargetID:    record.Event.TargetID,
+		AccountID:   record.Event.AccountID,
+		Meta:        meta,
+	}
+
+	if record.TargetName != nil {
+		meta["username"] = s.decrypt(ctx, *record.TargetName, fallbackName, "username", record.Event.TargetID)
+	}
+	if record.TargetEmail != nil {
+		meta["email"] = s.decrypt(ctx, *record.TargetEmail, fallbackEmail, "email address", record.Event.TargetID)
+	}
+	if record.InitiatorName != nil {
+		e.InitiatorName = s.decrypt(ctx, *record.InitiatorName, fallbackName, "username", record.Event.InitiatorID)
+	}
+	if record.InitiatorEmail != nil {
+		e.InitiatorEmail = s.decrypt(ctx, *record.InitiatorEmail, fallbackEmail, "email address", record.Event.InitiatorID)
+	}
+
+	return e, nil
+}
+
+// decrypt returns the decrypted value or the fallback when the value can't be decrypted
+func (s *Store) decrypt(ctx context.Context, value, fallback, field, userID string) string {
+	decrypted, err := s.fieldEncrypt.Decrypt(value)
+	if err != nil {
+		log.WithContext(ctx).Warnf("failed to decrypt %s of user %s", field, userID)
+		return fallback
+	}
+	return decrypted
+}
+
+// Close the Store
+func (s *Store) Close(_ context.Context) error {
+	sql, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sql.Close()
+}
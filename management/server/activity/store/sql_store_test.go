@@ -0,0 +1,330 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	activitySqlite "github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/testutil"
+)
+
+// newTestStore creates a store for the engine set in NETBIRD_STORE_ENGINE, SQLite by default. Postgres and MySQL run
+// in test containers.
+func newTestStore(t *testing.T, key string) *Store {
+	t.Helper()
+
+	ctx := context.Background()
+	engine := store.Engine(strings.ToLower(os.Getenv("NETBIRD_STORE_ENGINE")))
+
+	var s *Store
+	switch engine {
+	case store.PostgresStoreEngine, store.MysqlStoreEngine:
+		if (os.Getenv("CI") == "true" && runtime.GOOS == "darwin") || runtime.GOOS == "windows" {
+			t.Skip("skip CI tests on darwin and windows")
+		}
+
+		createContainer, dsnEnv := testutil.CreatePostgresTestContainer, postgresDsnEnv
+		if engine == store.MysqlStoreEngine {
+			createContainer, dsnEnv = testutil.CreateMysqlTestContainer, mysqlDsnEnv
+		}
+		cleanUp, err := createContainer()
+		require.NoError(t, err)
+		t.Cleanup(cleanUp)
+		// the container DSN of the main store is used
+		t.Setenv(dsnEnv, "")
+
+		s, err = NewSqlStore(ctx, engine, key)
+		require.NoError(t, err)
+	default:
+		db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "activity.db")), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+		require.NoError(t, err)
+
+		s, err = newSqlStore(ctx, db, store.SqliteStoreEngine, key)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_ = s.Close(ctx)
+	})
+
+	return s
+}
+
+func TestSqlStore_SaveAndGet(t *testing.T) {
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+	s := newTestStore(t, key)
+
+	accountID := "account_1"
+	start := time.Now().UTC()
+
+	for i := 0; i < 10; i++ {
+		saved, err := s.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Second),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user_" + fmt.Sprint(i),
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"ip": "100.64.0.1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(i+1), saved.ID)
+	}
+
+	result, err := s.Get(context.Background(), accountID, 0, 10, false, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 10)
+	assert.True(t, result[0].Timestamp.Before(result[len(result)-1].Timestamp))
+	assert.Equal(t, activity.PeerAddedByUser, result[0].Activity)
+	assert.Equal(t, "100.64.0.1", result[0].Meta["ip"])
+
+	result, err = s.Get(context.Background(), accountID, 0, 5, true, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 5)
+	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
+
+	result, err = s.Get(context.Background(), "other_account", 0, 10, true, nil)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestSqlStore_DeletedUsersAreEncrypted(t *testing.T) {
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+	s := newTestStore(t, key)
+
+	_, err = s.Save(context.Background(), &activity.Event{
+		Timestamp:   time.Now().UTC(),
+		Activity:    activity.UserDeleted,
+		InitiatorID: "admin",
+		TargetID:    "deleted_user",
+		AccountID:   "account_1",
+		Meta:        map[string]any{"email": "user@example.com", "name": "User", "is_service_user": false},
+	})
+	require.NoError(t, err)
+
+	_, err = s.Save(context.Background(), &activity.Event{
+		Timestamp:   time.Now().UTC(),
+		Activity:    activity.PeerRemovedByUser,
+		InitiatorID: "deleted_user",
+		TargetID:    "peer",
+		AccountID:   "account_1",
+	})
+	require.NoError(t, err)
+
+	var user deletedUser
+	require.NoError(t, s.db.First(&user, "id = ?", "deleted_user").Error)
+	assert.NotEqual(t, "user@example.com", user.Email, "email should be stored encrypted")
+	assert.Equal(t, gcmEncAlgo, user.EncAlgo)
+
+	events, err := s.Get(context.Background(), "account_1", 0, 10, false, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "user@example.com", events[0].Meta["email"])
+	assert.Equal(t, "User", events[0].Meta["username"])
+	assert.Equal(t, false, events[0].Meta["is_service_user"])
+	assert.Equal(t, "user@example.com", events[1].InitiatorEmail)
+	assert.Equal(t, "User", events[1].InitiatorName)
+}
+
+func TestMigrateFromSQLite(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+
+	source, err := activitySqlite.NewSQLiteStore(ctx, dataDir, key)
+	require.NoError(t, err)
+
+	// MySQL keeps the timestamps with millisecond precision
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	for i := 0; i < 5; i++ {
+		_, err = source.Save(ctx, &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Second),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user",
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   "account_1",
+		})
+		require.NoError(t, err)
+	}
+	_, err = source.Save(ctx, &activity.Event{
+		Timestamp:   start.Add(10 * time.Second),
+		Activity:    activity.UserDeleted,
+		InitiatorID: "admin",
+		TargetID:    "user",
+		AccountID:   "account_1",
+		Meta:        map[string]any{"email": "user@example.com", "name": "User"},
+	})
+	require.NoError(t, err)
+
+	expected, err := source.Get(ctx, "account_1", 0, 100, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, source.Close(ctx))
+
+	target := newTestStore(t, key)
+	require.NoError(t, MigrateFromSQLite(ctx, dataDir, key, target))
+	// migrating again doesn't duplicate events
+	require.NoError(t, MigrateFromSQLite(ctx, dataDir, key, target))
+
+	migrated, err := target.Get(ctx, "account_1", 0, 100, false, nil)
+	require.NoError(t, err)
+	require.Len(t, migrated, len(expected))
+
+	for i := range expected {
+		assert.Equal(t, expected[i].ID, migrated[i].ID)
+		assert.Equal(t, expected[i].Activity, migrated[i].Activity)
+		assert.Equal(t, expected[i].TargetID, migrated[i].TargetID)
+		assert.Equal(t, expected[i].InitiatorEmail, migrated[i].InitiatorEmail)
+		assert.True(t, expected[i].Timestamp.Equal(migrated[i].Timestamp))
+	}
+	assert.Equal(t, "user@example.com", migrated[0].InitiatorEmail)
+
+	// new events continue after the migrated ones
+	saved, err := target.Save(ctx, &activity.Event{Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account_1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(expected)+1), saved.ID)
+}
+
+func TestMigrateFromSQLite_MissingDatabase(t *testing.T) {
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+
+	err = MigrateFromSQLite(context.Background(), t.TempDir(), key, newTestStore(t, key))
+	assert.Error(t, err)
+}
+
+func TestSqlStore_GetFilteredAndDeleteOlderThan(t *testing.T) {
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+	s := newTestStore(t, key)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for i := 0; i < 10; i++ {
+		operation := activity.PeerAddedByUser
+		if i%2 == 0 {
+			operation = activity.PolicyUpdated
+		}
+		_, err = s.Save(ctx, &activity.Event{
+			Timestamp:   now.Add(-time.Duration(i) * 24 * time.Hour),
+			Activity:    operation,
+			InitiatorID: "user",
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   "account_1",
+		})
+		require.NoError(t, err)
+	}
+
+	result, err := s.Get(ctx, "account_1", 0, 100, true, &activity.Filter{
+		Activities: []activity.Activity{activity.PolicyUpdated},
+		StartDate:  now.Add(-5 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	for _, event := range result {
+		assert.Equal(t, activity.PolicyUpdated, event.Activity)
+	}
+
+	result, err = s.Get(ctx, "account_1", 0, 100, true, &activity.Filter{PeerID: "peer_1"})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	deleted, err := s.DeleteOlderThan(ctx, "account_1", now.Add(-5*24*time.Hour+time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), deleted)
+
+	result, err = s.Get(ctx, "account_1", 0, 100, true, nil)
+	require.NoError(t, err)
+	assert.Len(t, result, 5)
+}
+
+func TestSqlStore_SaveReturnsSameMetaAsSQLite(t *testing.T) {
+	ctx := context.Background()
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+
+	sqliteStore, err := activitySqlite.NewSQLiteStore(ctx, t.TempDir(), key)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sqliteStore.Close(ctx)
+	})
+	s := newTestStore(t, key)
+
+	tests := []struct {
+		name string
+		meta map[string]any
+	}{
+		{name: "no meta"},
+		{name: "without deleted user", meta: map[string]any{"ip": "100.64.0.1"}},
+		{name: "deleted user only", meta: map[string]any{"email": "user@example.com", "name": "User"}},
+		{name: "deleted user and other meta", meta: map[string]any{"email": "user@example.com", "name": "User", "is_service_user": false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newEvent := func() *activity.Event {
+				e := &activity.Event{
+					Timestamp:   time.Now().UTC(),
+					Activity:    activity.UserDeleted,
+					InitiatorID: "admin",
+					TargetID:    "deleted_user",
+					AccountID:   "account_1",
+				}
+				if tt.meta != nil {
+					e.Meta = make(map[string]any, len(tt.meta))
+					for k, v := range tt.meta {
+						e.Meta[k] = v
+					}
+				}
+				return e
+			}
+
+			expected, err := sqliteStore.Save(ctx, newEvent())
+			require.NoError(t, err)
+
+			event := newEvent()
+			saved, err := s.Save(ctx, event)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Meta, saved.Meta)
+			assert.Equal(t, tt.meta, event.Meta, "the meta of the given event should not be modified")
+		})
+	}
+}
+
+func TestSqlStore_ResetEventsSequence(t *testing.T) {
+	ctx := context.Background()
+	key, err := activitySqlite.GenerateKey()
+	require.NoError(t, err)
+	s := newTestStore(t, key)
+
+	// events inserted with their IDs, as the migration does, don't advance the Postgres sequence
+	for _, id := range []uint64{5, 9} {
+		require.NoError(t, s.db.Create(&event{ID: id, Activity: activity.PeerAddedByUser, Timestamp: time.Now().UTC(), AccountID: "account_1"}).Error)
+	}
+	require.NoError(t, s.resetEventsSequence(ctx))
+
+	saved, err := s.Save(ctx, &activity.Event{Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account_1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), saved.ID)
+
+	// resetting again keeps the sequence past the saved event
+	require.NoError(t, s.resetEventsSequence(ctx))
+	saved, err = s.Save(ctx, &activity.Event{Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account_1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), saved.ID)
+}
@@ -0,0 +1,111 @@
+package stream
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/netbirdio/netbird/util"
+)
+
+// SinkType is the type of destination activity events are streamed to
+type SinkType string
+
+const (
+	// SinkTypeWebhook delivers events as signed JSON HTTP POST requests
+	SinkTypeWebhook SinkType = "webhook"
+	// SinkTypeSyslog delivers events as CEF formatted syslog messages
+	SinkTypeSyslog SinkType = "syslog"
+	// SinkTypeFile appends events as JSON lines to a local file
+	SinkTypeFile SinkType = "file"
+)
+
+// Config contains the activity event streaming configuration
+type Config struct {
+	Sinks []SinkConfig
+}
+
+// SinkConfig configures a single event sink
+type SinkConfig struct {
+	// Name uniquely identifies the sink, it is used to name the buffer of undelivered events
+	Name string
+	Type SinkType
+	// Events limits the sink to the given activity codes, e.g. "user.peer.add". All events are streamed when empty.
+	Events []string
+
+	Webhook *WebhookConfig
+	Syslog  *SyslogConfig
+	File    *FileConfig
+}
+
+// WebhookConfig configures a webhook sink
+type WebhookConfig struct {
+	URL string
+	// Secret is used to sign the request body with HMAC-SHA256, the signature is sent in the signature header
+	Secret  string
+	Headers map[string]string
+	Timeout util.Duration
+}
+
+// SyslogConfig configures a syslog sink
+type SyslogConfig struct {
+	// Network is either udp or tcp
+	Network string
+	Address string
+}
+
+// FileConfig configures a JSON lines file sink
+type FileConfig struct {
+	Path string
+}
+
+// Validate checks the sink configurations
+func (c *Config) Validate() error {
+	names := make([]string, 0, len(c.Sinks))
+	for _, sink := range c.Sinks {
+		if err := sink.validate(); err != nil {
+			return fmt.Errorf("invalid sink %s: %w", sink.Name, err)
+		}
+		if slices.Contains(names, sink.Name) {
+			return fmt.Errorf("duplicate sink name %s", sink.Name)
+		}
+		names = append(names, sink.Name)
+	}
+	return nil
+}
+
+func (c *SinkConfig) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("sink name can't be empty")
+	}
+
+	switch c.Type {
+	case SinkTypeWebhook:
+		if c.Webhook == nil {
+			return fmt.Errorf("missing webhook configuration")
+		}
+		if _, err := url.ParseRequestURI(c.Webhook.URL); err != nil {
+			return fmt.Errorf("invalid webhook url: %w", err)
+		}
+	case SinkTypeSyslog:
+		if c.Syslog == nil || c.Syslog.Address == "" {
+			return fmt.Errorf("missing syslog address")
+		}
+		if c.Syslog.Network != "" && c.Syslog.Network != "udp" && c.Syslog.Network != "tcp" {
+			return fmt.Errorf("unsupported syslog network %s", c.Syslog.Network)
+		}
+	case SinkTypeFile:
+		if c.File == nil || c.File.Path == "" {
+			return fmt.Errorf("missing file path")
+		}
+	default:
+		return fmt.Errorf("unknown sink type %s", c.Type)
+	}
+
+	return nil
+}
+
+// matches returns true if the sink should receive events with the given activity code
+func (c *SinkConfig) matches(code string) bool {
+	return len(c.Events) == 0 || slices.Contains(c.Events, code)
+}
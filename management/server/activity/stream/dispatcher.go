@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+const sendTimeout = 30 * time.Second
+
+// dispatcher delivers the events of a single sink in order, retrying with an exponential backoff while the sink is down
+type dispatcher struct {
+	config SinkConfig
+	sink   Sink
+	spool  *spool
+	notify chan struct{}
+}
+
+func newDispatcher(config SinkConfig, sink Sink, spool *spool) *dispatcher {
+	return &dispatcher{
+		config: config,
+		sink:   sink,
+		spool:  spool,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// enqueue persists the event if the sink is interested in it and wakes up the delivery loop
+func (d *dispatcher) enqueue(event *Event) {
+	if !d.config.matches(event.ActivityCode) {
+		return
+	}
+
+	if err := d.spool.push(event); err != nil {
+		log.Errorf("failed to buffer event %d for sink %s: %v", event.ID, d.config.Name, err)
+		return
+	}
+
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (d *dispatcher) run(ctx context.Context) {
+	retry := &backoff.ExponentialBackOff{
+		InitialInterval:     time.Second,
+		RandomizationFactor: backoff.DefaultRandomizationFactor,
+		Multiplier:          backoff.DefaultMultiplier,
+		MaxInterval:         5 * time.Minute,
+		MaxElapsedTime:      0,
+		Stop:                backoff.Stop,
+		Clock:               backoff.SystemClock,
+	}
+	retry.Reset()
+
+	// fires immediately to deliver the events buffered by a previous run
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var retrying bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.notify:
+			// new events wait for the next retry while the sink is down
+			if retrying {
+				continue
+			}
+		case <-timer.C:
+			retrying = false
+		}
+
+		if err := d.flush(ctx); err != nil {
+			next := retry.NextBackOff()
+			log.Warnf("failed to deliver events to sink %s, retrying in %s: %v", d.config.Name, next, err)
+			retrying = true
+			timer.Reset(next)
+			continue
+		}
+		retry.Reset()
+	}
+}
+
+// flush sends the buffered events in order and stops at the first failure
+func (d *dispatcher) flush(ctx context.Context) error {
+	events := d.spool.pending()
+
+	sent := 0
+	defer func() {
+		if err := d.spool.ack(events[:sent]); err != nil {
+			log.Errorf("failed to remove delivered events from the buffer of sink %s: %v", d.config.Name, err)
+		}
+	}()
+
+	for _, event := range events {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := d.sink.Send(sendCtx, event)
+		cancel()
+		if err != nil {
+			return err
+		}
+		sent++
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+// Event is the representation of an activity event sent to the sinks
+type Event struct {
+	ID           uint64         `json:"id"`
+	Timestamp    time.Time      `json:"timestamp"`
+	Activity     string         `json:"activity"`
+	ActivityCode string         `json:"activity_code"`
+	InitiatorID  string         `json:"initiator_id"`
+	TargetID     string         `json:"target_id"`
+	AccountID    string         `json:"account_id"`
+	Meta         map[string]any `json:"meta,omitempty"`
+}
+
+func newEvent(event *activity.Event) *Event {
+	e := &Event{
+		ID:          event.ID,
+		Timestamp:   event.Timestamp,
+		InitiatorID: event.InitiatorID,
+		TargetID:    event.TargetID,
+		AccountID:   event.AccountID,
+		Meta:        event.Copy().Meta,
+	}
+	if event.Activity != nil {
+		e.Activity = event.Activity.Message()
+		e.ActivityCode = event.Activity.StringCode()
+	}
+	return e
+}
@@ -0,0 +1,36 @@
+package stream
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink_AppendsEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "events.jsonl")
+
+	sink, err := newFileSink(&FileConfig{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), &Event{ID: 1, ActivityCode: "user.join", Meta: map[string]any{"email": "a@b.c"}}))
+	require.NoError(t, sink.Send(context.Background(), &Event{ID: 2, ActivityCode: "user.block"}))
+	require.NoError(t, sink.Close())
+
+	// the events of a previous run are kept
+	sink, err = newFileSink(&FileConfig{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), &Event{ID: 3, ActivityCode: "user.unblock"}))
+	require.NoError(t, sink.Close())
+
+	events := readEvents(t, path)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		assert.Equal(t, uint64(i+1), event.ID)
+	}
+	assert.Equal(t, "user.join", events[0].ActivityCode)
+	assert.Equal(t, "a@b.c", events[0].Meta["email"])
+
+	assert.Error(t, sink.Send(context.Background(), &Event{ID: 4}), "sending to a closed sink should fail")
+}
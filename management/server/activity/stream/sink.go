@@ -0,0 +1,27 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+)
+
+// Sink delivers activity events to an external destination
+type Sink interface {
+	// Send delivers the event, an error means the event should be retried later
+	Send(ctx context.Context, event *Event) error
+	// Close releases the resources held by the sink
+	Close() error
+}
+
+func newSink(config SinkConfig) (Sink, error) {
+	switch config.Type {
+	case SinkTypeWebhook:
+		return newWebhookSink(config.Webhook), nil
+	case SinkTypeSyslog:
+		return newSyslogSink(config.Syslog), nil
+	case SinkTypeFile:
+		return newFileSink(config.File)
+	default:
+		return nil, fmt.Errorf("unknown sink type %s", config.Type)
+	}
+}
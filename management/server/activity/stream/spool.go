@@ -0,0 +1,272 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// spoolCompactSize is the size the delivered events at the start of the spool file must exceed before they are removed
+const spoolCompactSize = 1 << 20
+
+// spool is a file backed FIFO queue keeping the events that were not yet delivered to a sink.
+// Events survive sink outages and management restarts. New events are appended to the spool file and the position of
+// the first undelivered event is kept in an offset file, the delivered events are removed once they fill most of the file.
+type spool struct {
+	mu         sync.Mutex
+	path       string
+	maxEvents  int
+	file       *os.File
+	offsetFile *os.File
+	events     []*Event
+	// sizes holds the length of the spool file line of each queued event
+	sizes []int64
+	// offset is the position of the first queued event in the spool file
+	offset int64
+	// size is the length of the spool file
+	size int64
+}
+
+func newSpool(path string, maxEvents int) (*spool, error) {
+	s := &spool{
+		path:      path,
+		maxEvents: maxEvents,
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open spool file: %w", err)
+	}
+	s.file = file
+
+	offsetFile, err := os.OpenFile(path+".offset", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("open spool offset file: %w", err)
+	}
+	s.offsetFile = offsetFile
+
+	if err := s.load(); err != nil {
+		_ = s.close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load reads the undelivered events persisted by a previous run
+func (s *spool) load() error {
+	info, err := s.file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat spool file: %w", err)
+	}
+	s.size = info.Size()
+
+	s.offset = s.readOffset()
+	if s.offset < 0 || s.offset > s.size {
+		// the spool file was emptied before the offset was reset
+		s.offset = 0
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(s.file, s.offset, s.size-s.offset))
+	pos := s.offset
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				// a partially written line after a crash, new events are appended in its place
+				log.Warnf("removing partially written event from spool %s", s.path)
+				if err := s.file.Truncate(pos); err != nil {
+					return fmt.Errorf("truncate spool file: %w", err)
+				}
+				s.size = pos
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read spool file: %w", err)
+		}
+		pos += int64(len(line))
+
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			log.Warnf("skipping corrupted event in spool %s: %v", s.path, err)
+			// the line is removed together with the event before it
+			if len(s.sizes) == 0 {
+				s.offset = pos
+			} else {
+				s.sizes[len(s.sizes)-1] += int64(len(line))
+			}
+			continue
+		}
+		s.events = append(s.events, &event)
+		s.sizes = append(s.sizes, int64(len(line)))
+	}
+
+	if s.maxEvents > 0 && len(s.events) > s.maxEvents {
+		dropped := len(s.events) - s.maxEvents
+		log.Warnf("event spool %s holds more than %d events, dropping the %d oldest events", s.path, s.maxEvents, dropped)
+		s.remove(dropped)
+	}
+
+	return s.commit()
+}
+
+// push appends the event to the queue, dropping the oldest event when the queue is full
+func (s *spool) push(event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.file.WriteAt(line, s.size); err != nil {
+		// drop what was written, the next event takes its place
+		_ = s.file.Truncate(s.size)
+		return fmt.Errorf("write event to spool: %w", err)
+	}
+	s.size += int64(len(line))
+	s.events = append(s.events, event)
+	s.sizes = append(s.sizes, int64(len(line)))
+
+	if s.maxEvents > 0 && len(s.events) > s.maxEvents {
+		log.Warnf("event spool %s is full, dropping the oldest event", s.path)
+		s.remove(1)
+		return s.commit()
+	}
+
+	return nil
+}
+
+// pending returns a snapshot of the queued events
+func (s *spool) pending() []*Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := make([]*Event, len(s.events))
+	copy(events, s.events)
+	return events
+}
+
+// ack removes the delivered events from the queue. The events may have been dropped in the meantime if the queue overflowed.
+func (s *spool) ack(delivered []*Event) error {
+	if len(delivered) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := delivered[len(delivered)-1]
+	index := slices.Index(s.events, last)
+	if index < 0 {
+		return nil
+	}
+	s.remove(index + 1)
+
+	return s.commit()
+}
+
+// remove drops the first n events of the queue, commit persists the removal
+func (s *spool) remove(n int) {
+	for _, size := range s.sizes[:n] {
+		s.offset += size
+	}
+	s.events = s.events[n:]
+	s.sizes = s.sizes[n:]
+}
+
+// commit persists the position of the first queued event. The spool file is emptied once every event is delivered and
+// compacted once the removed events fill most of it.
+func (s *spool) commit() error {
+	switch {
+	case len(s.events) == 0 && s.size > 0:
+		// truncated before the offset is reset, an offset beyond the end of the file is ignored on load
+		if err := s.file.Truncate(0); err != nil {
+			return fmt.Errorf("truncate spool file: %w", err)
+		}
+		s.size = 0
+		s.offset = 0
+	case s.offset > spoolCompactSize && s.offset > s.size/2:
+		return s.compact()
+	}
+
+	return s.writeOffset()
+}
+
+// compact replaces the spool file with one holding the queued events only
+func (s *spool) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("create spool file: %w", err)
+	}
+
+	if _, err = io.Copy(tmp, io.NewSectionReader(s.file, s.offset, s.size-s.offset)); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write spool file: %w", err)
+	}
+
+	// the offset is reset before the file is replaced, a crash in between delivers the removed events again instead of
+	// losing the queued ones
+	offset := s.offset
+	s.offset = 0
+	if err = s.writeOffset(); err != nil {
+		s.offset = offset
+		_ = tmp.Close()
+		return err
+	}
+
+	if err = os.Rename(tmpPath, s.path); err != nil {
+		_ = tmp.Close()
+		s.offset = offset
+		if offsetErr := s.writeOffset(); offsetErr != nil {
+			log.Errorf("failed to restore the offset of spool %s: %v", s.path, offsetErr)
+		}
+		return fmt.Errorf("replace spool file: %w", err)
+	}
+
+	if err = s.file.Close(); err != nil {
+		log.Debugf("failed to close spool file %s: %v", s.path, err)
+	}
+	s.file = tmp
+	s.size -= offset
+
+	return nil
+}
+
+// readOffset returns the persisted position of the first queued event, the events are read from the start of the
+// file if it is missing
+func (s *spool) readOffset() int64 {
+	buf := make([]byte, 8)
+	if _, err := s.offsetFile.ReadAt(buf, 0); err != nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(buf))
+}
+
+func (s *spool) writeOffset() error {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(s.offset))
+	if _, err := s.offsetFile.WriteAt(buf, 0); err != nil {
+		return fmt.Errorf("write spool offset: %w", err)
+	}
+	return nil
+}
+
+func (s *spool) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(s.file.Close(), s.offsetFile.Close())
+}
@@ -0,0 +1,149 @@
+package stream
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool_KeepsUndeliveredEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+
+	s, err := newSpool(path, 10)
+	require.NoError(t, err)
+	pushEvents(t, s, 1, 3)
+	require.NoError(t, s.ack(s.pending()[:1]))
+	assert.Equal(t, []uint64{2, 3}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, eventIDs(s.pending()))
+
+	pushEvents(t, s, 4, 4)
+	require.NoError(t, s.ack(s.pending()))
+	assert.Empty(t, s.pending())
+	require.NoError(t, s.close())
+
+	// the file is emptied once every event is delivered
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Zero(t, info.Size())
+
+	s, err = newSpool(path, 10)
+	require.NoError(t, err)
+	assert.Empty(t, s.pending())
+	require.NoError(t, s.close())
+}
+
+func TestSpool_DropsOldestEventsWhenFull(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+
+	s, err := newSpool(path, 2)
+	require.NoError(t, err)
+	pushEvents(t, s, 1, 4)
+	assert.Equal(t, []uint64{3, 4}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+}
+
+func TestSpool_TrimsToMaxEventsOnLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+
+	s, err := newSpool(path, 10)
+	require.NoError(t, err)
+	pushEvents(t, s, 1, 5)
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 5}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 5}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+}
+
+func TestSpool_SkipsDamagedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+
+	s, err := newSpool(path, 10)
+	require.NoError(t, err)
+	pushEvents(t, s, 1, 1)
+	require.NoError(t, s.close())
+
+	// a corrupted line and a line partially written before a crash
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString("not json\n{\"id\":2,\"activ")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	s, err = newSpool(path, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, eventIDs(s.pending()))
+
+	pushEvents(t, s, 3, 3)
+	require.NoError(t, s.ack(s.pending()[:1]))
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+}
+
+func TestSpool_CompactsDeliveredEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+
+	s, err := newSpool(path, 0)
+	require.NoError(t, err)
+
+	meta := map[string]any{"data": strings.Repeat("a", 1024)}
+	count := spoolCompactSize/1024 + 10
+	for i := 1; i <= count; i++ {
+		require.NoError(t, s.push(&Event{ID: uint64(i), Meta: meta}))
+	}
+
+	pending := s.pending()
+	require.NoError(t, s.ack(pending[:len(pending)-1]))
+	assert.Equal(t, []uint64{uint64(count)}, eventIDs(s.pending()))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), int64(2048), "the delivered events should be removed from the file")
+
+	pushEvents(t, s, uint64(count+1), uint64(count+1))
+	require.NoError(t, s.close())
+
+	s, err = newSpool(path, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{uint64(count), uint64(count + 1)}, eventIDs(s.pending()))
+	require.NoError(t, s.close())
+}
+
+func pushEvents(t *testing.T, s *spool, from, to uint64) {
+	t.Helper()
+
+	for id := from; id <= to; id++ {
+		require.NoError(t, s.push(&Event{ID: id, ActivityCode: "user.join"}))
+	}
+}
+
+func eventIDs(events []*Event) []uint64 {
+	ids := make([]uint64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
@@ -0,0 +1,112 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+const (
+	spoolDir = "events-stream"
+	// maxSpoolEvents limits the number of events buffered per sink while it is down
+	maxSpoolEvents = 100000
+)
+
+var unsafeNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// Store wraps an activity.Store and streams every saved event to the configured sinks
+type Store struct {
+	activity.Store
+
+	dispatchers []*dispatcher
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+}
+
+// NewStore creates a streaming store on top of the given store. Undelivered events are buffered in the data directory.
+func NewStore(ctx context.Context, store activity.Store, config *Config, dataDir string) (*Store, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(dataDir, spoolDir)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("create events stream directory: %w", err)
+	}
+
+	s := &Store{Store: store}
+
+	for _, sinkConfig := range config.Sinks {
+		sink, err := newSink(sinkConfig)
+		if err != nil {
+			_ = s.closeDispatchers()
+			return nil, fmt.Errorf("create sink %s: %w", sinkConfig.Name, err)
+		}
+
+		queue, err := newSpool(filepath.Join(dir, unsafeNameChars.ReplaceAllString(sinkConfig.Name, "_")+".jsonl"), maxSpoolEvents)
+		if err != nil {
+			_ = sink.Close()
+			_ = s.closeDispatchers()
+			return nil, fmt.Errorf("create buffer for sink %s: %w", sinkConfig.Name, err)
+		}
+
+		s.dispatchers = append(s.dispatchers, newDispatcher(sinkConfig, sink, queue))
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, d := range s.dispatchers {
+		s.wg.Add(1)
+		go func(d *dispatcher) {
+			defer s.wg.Done()
+			d.run(ctx)
+		}(d)
+	}
+
+	log.WithContext(ctx).Infof("streaming activity events to %d sinks", len(s.dispatchers))
+
+	return s, nil
+}
+
+// Save stores the event in the underlying store and queues it for delivery to the sinks
+func (s *Store) Save(ctx context.Context, event *activity.Event) (*activity.Event, error) {
+	saved, err := s.Store.Save(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	streamEvent := newEvent(saved)
+	for _, d := range s.dispatchers {
+		d.enqueue(streamEvent)
+	}
+
+	return saved, nil
+}
+
+// Close stops the delivery of events and closes the underlying store. Undelivered events stay buffered.
+func (s *Store) Close(ctx context.Context) error {
+	s.cancel()
+	s.wg.Wait()
+
+	return errors.Join(s.closeDispatchers(), s.Store.Close(ctx))
+}
+
+func (s *Store) closeDispatchers() error {
+	var errs error
+	for _, d := range s.dispatchers {
+		if err := d.sink.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("close sink %s: %w", d.config.Name, err))
+		}
+		if err := d.spool.close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("close buffer of sink %s: %w", d.config.Name, err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,171 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+func TestStore_StreamsEventsToMatchingSinks(t *testing.T) {
+	dataDir := t.TempDir()
+	allEventsPath := filepath.Join(dataDir, "all.jsonl")
+	peerEventsPath := filepath.Join(dataDir, "peers.jsonl")
+
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, &Config{
+		Sinks: []SinkConfig{
+			{Name: "all", Type: SinkTypeFile, File: &FileConfig{Path: allEventsPath}},
+			{Name: "peers", Type: SinkTypeFile, File: &FileConfig{Path: peerEventsPath}, Events: []string{activity.PeerAddedByUser.StringCode()}},
+		},
+	}, dataDir)
+	require.NoError(t, err)
+
+	_, err = store.Save(context.Background(), &activity.Event{Activity: activity.PeerAddedByUser, AccountID: "account", InitiatorID: "user", TargetID: "peer"})
+	require.NoError(t, err)
+	_, err = store.Save(context.Background(), &activity.Event{Activity: activity.UserJoined, AccountID: "account", InitiatorID: "user"})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool { return len(readEvents(t, allEventsPath)) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool { return len(readEvents(t, peerEventsPath)) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	peerEvents := readEvents(t, peerEventsPath)
+	assert.Equal(t, activity.PeerAddedByUser.StringCode(), peerEvents[0].ActivityCode)
+	assert.Equal(t, "peer", peerEvents[0].TargetID)
+
+	require.NoError(t, store.Close(context.Background()))
+}
+
+func TestStore_BuffersEventsWhileSinkIsDown(t *testing.T) {
+	var available atomic.Bool
+	var mu sync.Mutex
+	received := make([]*Event, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		event := &Event{}
+		_ = json.Unmarshal(body, event)
+		mu.Lock()
+		received = append(received, event)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	dataDir := t.TempDir()
+	config := &Config{Sinks: []SinkConfig{{Name: "siem", Type: SinkTypeWebhook, Webhook: &WebhookConfig{URL: server.URL}}}}
+
+	store, err := NewStore(context.Background(), &activity.InMemoryEventStore{}, config, dataDir)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = store.Save(context.Background(), &activity.Event{Activity: activity.UserJoined, AccountID: "account"})
+		require.NoError(t, err)
+	}
+
+	// the undelivered events are kept on disk and delivered after a restart
+	require.NoError(t, store.Close(context.Background()))
+	available.Store(true)
+
+	store, err = NewStore(context.Background(), &activity.InMemoryEventStore{}, config, dataDir)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint:errcheck
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i, event := range received {
+		assert.Equal(t, uint64(i), event.ID, "events should be delivered in order")
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:   "valid config",
+			config: &Config{Sinks: []SinkConfig{{Name: "file", Type: SinkTypeFile, File: &FileConfig{Path: "/tmp/events.jsonl"}}}},
+		},
+		{
+			name:    "missing name",
+			config:  &Config{Sinks: []SinkConfig{{Type: SinkTypeFile, File: &FileConfig{Path: "/tmp/events.jsonl"}}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			config: &Config{Sinks: []SinkConfig{
+				{Name: "file", Type: SinkTypeFile, File: &FileConfig{Path: "/tmp/events.jsonl"}},
+				{Name: "file", Type: SinkTypeFile, File: &FileConfig{Path: "/tmp/other.jsonl"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid webhook url",
+			config:  &Config{Sinks: []SinkConfig{{Name: "hook", Type: SinkTypeWebhook, Webhook: &WebhookConfig{URL: "not a url"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported syslog network",
+			config:  &Config{Sinks: []SinkConfig{{Name: "syslog", Type: SinkTypeSyslog, Syslog: &SyslogConfig{Network: "unix", Address: "/dev/log"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			config:  &Config{Sinks: []SinkConfig{{Name: "kafka", Type: "kafka"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func readEvents(t *testing.T, path string) []*Event {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	events := make([]*Event, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event := &Event{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), event))
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,122 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netbirdio/netbird/version"
+)
+
+const (
+	// syslogPriority is the local0 facility with the notice severity
+	syslogPriority = 16*8 + 5
+	cefSeverity    = 3
+	dialTimeout    = 10 * time.Second
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// syslogSink sends events as CEF messages wrapped in RFC 5424 syslog frames
+type syslogSink struct {
+	config   *SyslogConfig
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSink(config *SyslogConfig) *syslogSink {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	return &syslogSink{
+		config:   config,
+		hostname: hostname,
+	}
+}
+
+func (s *syslogSink) Send(ctx context.Context, event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		network := s.config.Network
+		if network == "" {
+			network = "udp"
+		}
+		dialer := net.Dialer{Timeout: dialTimeout}
+		conn, err := dialer.DialContext(ctx, network, s.config.Address)
+		if err != nil {
+			return fmt.Errorf("dial syslog server: %w", err)
+		}
+		s.conn = conn
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s netbird-management - %s - %s\n",
+		syslogPriority, event.Timestamp.UTC().Format(time.RFC3339Nano), s.hostname, event.ActivityCode, formatCEF(event))
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		// reconnect on the next attempt
+		_ = s.conn.Close()
+		s.conn = nil
+		return fmt.Errorf("write syslog message: %w", err)
+	}
+
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// formatCEF formats the event in the ArcSight Common Event Format
+func formatCEF(event *Event) string {
+	extensions := []string{
+		"rt=" + strconv.FormatInt(event.Timestamp.UnixMilli(), 10),
+		"externalId=" + strconv.FormatUint(event.ID, 10),
+		"act=" + cefExtensionEscaper.Replace(event.ActivityCode),
+		"suid=" + cefExtensionEscaper.Replace(event.InitiatorID),
+		"duid=" + cefExtensionEscaper.Replace(event.TargetID),
+		"cs1Label=accountId",
+		"cs1=" + cefExtensionEscaper.Replace(event.AccountID),
+	}
+
+	if len(event.Meta) > 0 {
+		meta, err := json.Marshal(event.Meta)
+		if err == nil {
+			extensions = append(extensions, "cs2Label=meta", "cs2="+cefExtensionEscaper.Replace(string(meta)))
+		}
+	}
+
+	return fmt.Sprintf("CEF:0|NetBird|Management|%s|%s|%s|%d|%s",
+		cefHeaderEscaper.Replace(version.NetbirdVersion()),
+		cefHeaderEscaper.Replace(event.ActivityCode),
+		cefHeaderEscaper.Replace(event.Activity),
+		cefSeverity,
+		strings.Join(extensions, " "),
+	)
+}
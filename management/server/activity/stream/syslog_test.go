@@ -0,0 +1,102 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogSink_SendsCEFMessages(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink := newSyslogSink(&SyslogConfig{Network: "udp", Address: conn.LocalAddr().String()})
+	defer sink.Close()
+
+	err = sink.Send(context.Background(), &Event{
+		ID:           7,
+		Timestamp:    time.Now(),
+		Activity:     "Peer added",
+		ActivityCode: "user.peer.add",
+		InitiatorID:  "user",
+		TargetID:     "peer",
+		AccountID:    "account",
+		Meta:         map[string]any{"name": "a=b"},
+	})
+	require.NoError(t, err)
+
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<133>1 "), msg)
+	assert.Contains(t, msg, "|user.peer.add|Peer added|3|")
+	assert.Contains(t, msg, "externalId=7 act=user.peer.add suid=user duid=peer cs1Label=accountId cs1=account")
+	assert.Contains(t, msg, `a\=b`)
+}
+
+func TestSyslogSink_SendsFramesOverTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	sink := newSyslogSink(&SyslogConfig{Network: "tcp", Address: listener.Addr().String()})
+	defer sink.Close()
+
+	for i := uint64(1); i <= 2; i++ {
+		require.NoError(t, sink.Send(context.Background(), &Event{ID: i, Timestamp: time.Now(), ActivityCode: "user.join"}))
+	}
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	// both messages are sent over the same connection, one frame per line
+	reader := bufio.NewReader(conn)
+	for _, id := range []string{"externalId=1 ", "externalId=2 "} {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(line, "<133>1 "), line)
+		assert.Contains(t, line, id)
+	}
+}
+
+func TestSyslogSink_FailsWhenServerIsDown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	sink := newSyslogSink(&SyslogConfig{Network: "tcp", Address: address})
+	defer sink.Close()
+
+	err = sink.Send(context.Background(), &Event{ID: 1, Timestamp: time.Now()})
+	assert.Error(t, err)
+}
+
+func TestFormatCEF_EscapesFields(t *testing.T) {
+	cef := formatCEF(&Event{
+		ID:           3,
+		Timestamp:    time.UnixMilli(1700000000000),
+		Activity:     "Group a|b renamed",
+		ActivityCode: "group.update",
+		InitiatorID:  `user\1`,
+		TargetID:     "line\nbreak",
+		AccountID:    "account",
+	})
+
+	assert.True(t, strings.HasPrefix(cef, "CEF:0|NetBird|Management|"), cef)
+	assert.Contains(t, cef, `|group.update|Group a\|b renamed|3|rt=1700000000000 externalId=3 act=group.update`)
+	assert.Contains(t, cef, `suid=user\\1 duid=line\nbreak cs1Label=accountId cs1=account`)
+	assert.NotContains(t, cef, "cs2Label=meta")
+}
@@ -0,0 +1,93 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of the timestamp and the request body
+	SignatureHeader = "X-NetBird-Signature"
+	// TimestampHeader contains the unix timestamp used when computing the signature
+	TimestampHeader = "X-NetBird-Timestamp"
+
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+type webhookSink struct {
+	config *WebhookConfig
+	client *http.Client
+}
+
+func newWebhookSink(config *WebhookConfig) *webhookSink {
+	timeout := config.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	return &webhookSink{
+		config: config,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts the event as JSON to the webhook URL. Non 2xx responses are treated as delivery failures.
+func (w *webhookSink) Send(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if w.config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, "sha256="+Sign(w.config.Secret, timestamp, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the timestamp and body joined by a dot.
+// Receivers can use it to verify the authenticity of webhook requests.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,51 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_SignsRequests(t *testing.T) {
+	const secret = "top-secret"
+
+	var body []byte
+	var signature, timestamp, custom string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+		timestamp = r.Header.Get(TimestampHeader)
+		custom = r.Header.Get("X-Custom")
+	}))
+	defer server.Close()
+
+	sink := newWebhookSink(&WebhookConfig{URL: server.URL, Secret: secret, Headers: map[string]string{"X-Custom": "value"}})
+	defer sink.Close()
+
+	err := sink.Send(context.Background(), &Event{ID: 1, ActivityCode: "user.join", Timestamp: time.Now()})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, timestamp)
+	assert.Equal(t, "sha256="+Sign(secret, timestamp, body), signature)
+	assert.Equal(t, "value", custom)
+	assert.Contains(t, string(body), `"activity_code":"user.join"`)
+}
+
+func TestWebhookSink_FailsOnErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := newWebhookSink(&WebhookConfig{URL: server.URL})
+	defer sink.Close()
+
+	err := sink.Send(context.Background(), &Event{ID: 1})
+	assert.Error(t, err)
+}
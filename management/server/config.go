@@ -4,6 +4,7 @@ import (
 	"net/netip"
 	"net/url"
 
+	"github.com/netbirdio/netbird/management/server/activity/stream"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/util"
@@ -51,7 +52,13 @@ type Config struct {
 
 	StoreConfig StoreConfig
 
+	// ActivityStoreConfig selects the engine of the activity event store, SQLite is used when not set
+	ActivityStoreConfig StoreConfig
+
 	ReverseProxy ReverseProxy
+
+	// EventStreaming configures the sinks activity events are streamed to
+	EventStreaming *stream.Config
 }
 
 // GetAuthAudiences returns the audience from the http config and device authorization flow config
@@ -81,6 +88,9 @@ type Relay struct {
 	Addresses      []string
 	CredentialsTTL util.Duration
 	Secret         string
+	// Regions optionally maps the relay addresses to a region name. The clients migrate their home relay only to a
+	// faster relay of another region.
+	Regions map[string]string
 }
 
 // HttpServerConfig is a config of the HTTP Management service server
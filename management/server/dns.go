@@ -10,6 +10,8 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -20,6 +22,7 @@ import (
 type DNSConfigCache struct {
 	CustomZones      sync.Map
 	NameServerGroups sync.Map
+	Blocklists       sync.Map
 }
 
 // GetCustomZone retrieves a cached custom zone
@@ -60,19 +63,30 @@ func (c *DNSConfigCache) SetNameServerGroup(key string, value *proto.NameServerG
 	c.NameServerGroups.Store(key, value)
 }
 
-// GetDNSSettings validates a user role and returns the DNS settings for the provided account ID
-func (am *DefaultAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return nil, err
+// GetBlocklist retrieves a cached blocklist
+func (c *DNSConfigCache) GetBlocklist(key string) (*proto.DNSBlocklist, bool) {
+	if c == nil {
+		return nil, false
 	}
+	if value, ok := c.Blocklists.Load(key); ok {
+		return value.(*proto.DNSBlocklist), true
+	}
+	return nil, false
+}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+// SetBlocklist stores a blocklist in the cache
+func (c *DNSConfigCache) SetBlocklist(key string, value *proto.DNSBlocklist) {
+	if c == nil {
+		return
 	}
+	c.Blocklists.Store(key, value)
+}
 
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
+// GetDNSSettings validates a user role and returns the DNS settings for the provided account ID
+func (am *DefaultAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
 	}
 
 	return am.Store.GetAccountDNSSettings(ctx, store.LockingStrengthShare, accountID)
@@ -84,19 +98,11 @@ func (am *DefaultAccountManager) SaveDNSSettings(ctx context.Context, accountID
 		return status.Errorf(status.InvalidArgument, "the dns settings provided are nil")
 	}
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if !user.HasAdminPower() {
-		return status.NewAdminPermissionError()
-	}
-
 	var updateAccountPeers bool
 	var eventsToStore []func()
 
@@ -217,6 +223,7 @@ func toProtocolDNSConfig(update nbdns.Config, cache *DNSConfigCache) *proto.DNSC
 		ServiceEnable:    update.ServiceEnable,
 		CustomZones:      make([]*proto.CustomZone, 0, len(update.CustomZones)),
 		NameServerGroups: make([]*proto.NameServerGroup, 0, len(update.NameServerGroups)),
+		Blocklists:       make([]*proto.DNSBlocklist, 0, len(update.Blocklists)),
 	}
 
 	for _, zone := range update.CustomZones {
@@ -241,14 +248,26 @@ func toProtocolDNSConfig(update nbdns.Config, cache *DNSConfigCache) *proto.DNSC
 		}
 	}
 
+	for _, blocklist := range update.Blocklists {
+		cacheKey := blocklist.ID
+		if cachedBlocklist, exists := cache.GetBlocklist(cacheKey); exists {
+			protoUpdate.Blocklists = append(protoUpdate.Blocklists, cachedBlocklist)
+		} else {
+			protoBlocklist := convertToProtoBlocklist(blocklist)
+			cache.SetBlocklist(cacheKey, protoBlocklist)
+			protoUpdate.Blocklists = append(protoUpdate.Blocklists, protoBlocklist)
+		}
+	}
+
 	return protoUpdate
 }
 
 // Helper function to convert nbdns.CustomZone to proto.CustomZone
 func convertToProtoCustomZone(zone nbdns.CustomZone) *proto.CustomZone {
 	protoZone := &proto.CustomZone{
-		Domain:  zone.Domain,
-		Records: make([]*proto.SimpleRecord, 0, len(zone.Records)),
+		Domain:               zone.Domain,
+		Records:              make([]*proto.SimpleRecord, 0, len(zone.Records)),
+		SearchDomainDisabled: zone.SearchDomainDisabled,
 	}
 	for _, record := range zone.Records {
 		protoZone.Records = append(protoZone.Records, &proto.SimpleRecord{
@@ -268,14 +287,35 @@ func convertToProtoNameServerGroup(nsGroup *nbdns.NameServerGroup) *proto.NameSe
 		Primary:              nsGroup.Primary,
 		Domains:              nsGroup.Domains,
 		SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+		DNSSECValidation:     nsGroup.DNSSECValidation,
+		DNSSECTrustAnchors:   nsGroup.DNSSECTrustAnchors,
 		NameServers:          make([]*proto.NameServer, 0, len(nsGroup.NameServers)),
 	}
 	for _, ns := range nsGroup.NameServers {
 		protoGroup.NameServers = append(protoGroup.NameServers, &proto.NameServer{
-			IP:     ns.IP.String(),
-			Port:   int64(ns.Port),
-			NSType: int64(ns.NSType),
+			IP:       ns.IP.String(),
+			Port:     int64(ns.Port),
+			NSType:   int64(ns.NSType),
+			Hostname: ns.Hostname,
+			Path:     ns.Path,
 		})
 	}
 	return protoGroup
 }
+
+// Helper function to convert nbdns.Blocklist to proto.DNSBlocklist
+func convertToProtoBlocklist(blocklist nbdns.Blocklist) *proto.DNSBlocklist {
+	protoBlocklist := &proto.DNSBlocklist{
+		ID:       blocklist.ID,
+		Name:     blocklist.Name,
+		Domains:  blocklist.Domains,
+		Sinkhole: blocklist.Sinkhole,
+	}
+	if blocklist.SinkholeIPv4.IsValid() {
+		protoBlocklist.SinkholeIPv4 = blocklist.SinkholeIPv4.String()
+	}
+	if blocklist.SinkholeIPv6.IsValid() {
+		protoBlocklist.SinkholeIPv6 = blocklist.SinkholeIPv6.String()
+	}
+	return protoBlocklist
+}
@@ -0,0 +1,464 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+const (
+	// dnsBlocklistRefreshInterval is the interval between the fetches of a blocklist source
+	dnsBlocklistRefreshInterval = 24 * time.Hour
+	// dnsBlocklistRetryInterval is the interval before fetching a blocklist source again after a failure
+	dnsBlocklistRetryInterval = time.Hour
+	// dnsBlocklistRefreshStartDelay delays the first fetch of blocklist sources that are due after startup
+	dnsBlocklistRefreshStartDelay = time.Minute
+	// dnsBlocklistFetchTimeout limits the time spent downloading a blocklist source
+	dnsBlocklistFetchTimeout = time.Minute
+)
+
+var (
+	// dnsBlocklistAllowPrivateSources allows fetching blocklist sources from non-public addresses, only used by tests
+	dnsBlocklistAllowPrivateSources = false
+
+	// cgnatPrefix is the shared address space NetBird assigns the peer addresses from
+	cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+	dnsBlocklistHTTPClient = &http.Client{
+		Timeout: dnsBlocklistFetchTimeout,
+		Transport: &http.Transport{
+			// no proxy, the dialer must see the address of the source to check it
+			Proxy: nil,
+			DialContext: (&net.Dialer{
+				Timeout: 30 * time.Second,
+				// the check runs for every connection, the redirects included
+				Control: dnsBlocklistSourceControl,
+			}).DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+)
+
+// GetDNSBlocklist gets a DNS blocklist of the account
+func (am *DefaultAccountManager) GetDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) (*types.DNSBlocklist, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetDNSBlocklistByID(ctx, store.LockingStrengthShare, accountID, blocklistID)
+}
+
+// ListDNSBlocklists returns the DNS blocklists of the account
+func (am *DefaultAccountManager) ListDNSBlocklists(ctx context.Context, accountID, userID string) ([]*types.DNSBlocklist, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountDNSBlocklists(ctx, store.LockingStrengthShare, accountID)
+}
+
+// CreateDNSBlocklist creates a DNS blocklist, fetching its source if one is set, and distributes it to the peers
+func (am *DefaultAccountManager) CreateDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error) {
+	if blocklist == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS blocklist provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	newBlocklist := blocklist.Copy()
+	newBlocklist.ID = xid.New().String()
+	newBlocklist.AccountID = accountID
+
+	// the source is fetched before locking the account as the download can take a while
+	if err = compileDNSBlocklist(ctx, newBlocklist); err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err = validateDNSBlocklistGroups(ctx, transaction, newBlocklist); err != nil {
+			return err
+		}
+
+		if err = validateDNSBlocklistsSize(ctx, transaction, newBlocklist); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSBlocklist(ctx, store.LockingStrengthUpdate, newBlocklist)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, newBlocklist.ID, accountID, activity.DNSBlocklistCreated, newBlocklist.EventMeta())
+
+	am.checkAndScheduleDNSBlocklistRefresh(ctx, newBlocklist)
+
+	if newBlocklist.Enabled {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return newBlocklist.Copy(), nil
+}
+
+// SaveDNSBlocklist updates a DNS blocklist, fetching its source again if one is set, and distributes it to the peers
+func (am *DefaultAccountManager) SaveDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error) {
+	if blocklist == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS blocklist provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	blocklistToSave := blocklist.Copy()
+	blocklistToSave.AccountID = accountID
+
+	if err = compileDNSBlocklist(ctx, blocklistToSave); err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var updateAccountPeers bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		oldBlocklist, err := transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, accountID, blocklistToSave.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = validateDNSBlocklistGroups(ctx, transaction, blocklistToSave); err != nil {
+			return err
+		}
+
+		if err = validateDNSBlocklistsSize(ctx, transaction, blocklistToSave); err != nil {
+			return err
+		}
+
+		updateAccountPeers = oldBlocklist.Enabled || blocklistToSave.Enabled
+		if updateAccountPeers {
+			if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+				return err
+			}
+		}
+
+		return transaction.SaveDNSBlocklist(ctx, store.LockingStrengthUpdate, blocklistToSave)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, blocklistToSave.ID, accountID, activity.DNSBlocklistUpdated, blocklistToSave.EventMeta())
+
+	am.checkAndScheduleDNSBlocklistRefresh(ctx, blocklistToSave)
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return blocklistToSave.Copy(), nil
+}
+
+// DeleteDNSBlocklist removes a DNS blocklist from the account and its peers
+func (am *DefaultAccountManager) DeleteDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Delete)
+	if err != nil {
+		return err
+	}
+
+	var blocklist *types.DNSBlocklist
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		blocklist, err = transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, accountID, blocklistID)
+		if err != nil {
+			return err
+		}
+
+		if blocklist.Enabled {
+			if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+				return err
+			}
+		}
+
+		return transaction.DeleteDNSBlocklist(ctx, store.LockingStrengthUpdate, accountID, blocklistID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.dnsBlocklistRefresh.Cancel(ctx, []string{blocklistID})
+
+	am.StoreEvent(ctx, userID, blocklist.ID, accountID, activity.DNSBlocklistDeleted, blocklist.EventMeta())
+
+	if blocklist.Enabled {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// compileDNSBlocklist validates the blocklist and compiles its inline domains with the domains of its source
+func compileDNSBlocklist(ctx context.Context, blocklist *types.DNSBlocklist) error {
+	blocklist.Normalize()
+	if err := blocklist.Validate(); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+
+	var sourceDomains []string
+	blocklist.SourceError = ""
+	blocklist.SourceUpdatedAt = time.Time{}
+
+	if blocklist.SourceURL != "" {
+		domains, err := fetchDNSBlocklistSource(ctx, blocklist.SourceURL)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "failed to fetch blocklist source %s: %s", blocklist.SourceURL, err)
+		}
+		sourceDomains = domains
+		blocklist.SourceUpdatedAt = time.Now().UTC()
+	}
+
+	if err := blocklist.Compile(sourceDomains); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+
+	return nil
+}
+
+func validateDNSBlocklistGroups(ctx context.Context, transaction store.Store, blocklist *types.DNSBlocklist) error {
+	if len(blocklist.ExemptGroups) == 0 {
+		return nil
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthShare, blocklist.AccountID, blocklist.ExemptGroups)
+	if err != nil {
+		return err
+	}
+
+	return validateGroups(blocklist.ExemptGroups, groups)
+}
+
+// validateDNSBlocklistsSize checks that the enabled blocklists of the account with the given blocklist saved fit into
+// the size limit of the network map
+func validateDNSBlocklistsSize(ctx context.Context, transaction store.Store, blocklist *types.DNSBlocklist) error {
+	if !blocklist.Enabled {
+		return nil
+	}
+
+	blocklists, err := transaction.GetAccountDNSBlocklists(ctx, store.LockingStrengthShare, blocklist.AccountID)
+	if err != nil {
+		return err
+	}
+
+	blocklists = slices.DeleteFunc(blocklists, func(b *types.DNSBlocklist) bool {
+		return b.ID == blocklist.ID
+	})
+	if err = types.ValidateDNSBlocklistsSize(append(blocklists, blocklist)); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+	return nil
+}
+
+// fetchDNSBlocklistSource downloads and parses the domains of a blocklist source
+func fetchDNSBlocklistSource(ctx context.Context, sourceURL string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, dnsBlocklistFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dnsBlocklistHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithContext(ctx).Debugf("failed to close blocklist source response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	body := io.LimitReader(resp.Body, types.MaxDNSBlocklistSourceSize+1)
+	counter := &countingReader{reader: body}
+
+	domains, err := types.ParseDNSBlocklist(counter)
+	if err != nil {
+		return nil, err
+	}
+
+	if counter.read > types.MaxDNSBlocklistSourceSize {
+		return nil, fmt.Errorf("blocklist source is larger than %d bytes", types.MaxDNSBlocklistSourceSize)
+	}
+
+	return domains, nil
+}
+
+// dnsBlocklistSourceControl refuses connections to loopback, private, link-local, shared and other non-public
+// addresses, so a blocklist source can't reach the internal network of the management server or a cloud metadata
+// service
+func dnsBlocklistSourceControl(_, address string, _ syscall.RawConn) error {
+	if dnsBlocklistAllowPrivateSources {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse address %s: %w", address, err)
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || cgnatPrefix.Contains(addr) {
+		return fmt.Errorf("blocklist source address %s is not public", addr)
+	}
+	return nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	return n, err
+}
+
+// dnsBlocklistRefreshJob fetches the source of the blocklist again and distributes the changes to the peers
+func (am *DefaultAccountManager) dnsBlocklistRefreshJob(ctx context.Context, accountID, blocklistID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		blocklist, err := am.Store.GetDNSBlocklistByID(ctx, store.LockingStrengthShare, accountID, blocklistID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting DNS blocklist %s of account %s for the refresh: %v", blocklistID, accountID, err)
+			if e, ok := status.FromError(err); ok && e.Type() == status.NotFound {
+				return 0, false
+			}
+			return dnsBlocklistRetryInterval, true
+		}
+
+		if blocklist.SourceURL == "" {
+			return 0, false
+		}
+
+		sourceDomains, fetchErr := fetchDNSBlocklistSource(ctx, blocklist.SourceURL)
+		if fetchErr != nil {
+			log.WithContext(ctx).Warnf("failed fetching source %s of DNS blocklist %s: %v", blocklist.SourceURL, blocklistID, fetchErr)
+		}
+
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		defer unlock()
+
+		var updateAccountPeers bool
+
+		err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+			current, err := transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, accountID, blocklistID)
+			if err != nil {
+				return err
+			}
+
+			// the blocklist was updated while fetching, the update fetched the source already
+			if current.SourceURL != blocklist.SourceURL {
+				return nil
+			}
+
+			if fetchErr != nil {
+				current.SourceError = fetchErr.Error()
+				return transaction.SaveDNSBlocklist(ctx, store.LockingStrengthUpdate, current)
+			}
+
+			previousDomains := current.CompiledDomains
+			if err = current.Compile(sourceDomains); err == nil {
+				err = validateDNSBlocklistsSize(ctx, transaction, current)
+			}
+			if err != nil {
+				current.CompiledDomains = previousDomains
+				fetchErr = err
+				current.SourceError = err.Error()
+				return transaction.SaveDNSBlocklist(ctx, store.LockingStrengthUpdate, current)
+			}
+			current.SourceError = ""
+			current.SourceUpdatedAt = time.Now().UTC()
+
+			updateAccountPeers = current.Enabled && !slices.Equal(previousDomains, current.CompiledDomains)
+			if updateAccountPeers {
+				if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+					return err
+				}
+			}
+
+			return transaction.SaveDNSBlocklist(ctx, store.LockingStrengthUpdate, current)
+		})
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed saving refreshed DNS blocklist %s of account %s: %v", blocklistID, accountID, err)
+			if e, ok := status.FromError(err); ok && e.Type() == status.NotFound {
+				return 0, false
+			}
+			return dnsBlocklistRetryInterval, true
+		}
+
+		if updateAccountPeers {
+			log.WithContext(ctx).Debugf("DNS blocklist %s of account %s changed, updating peers", blocklistID, accountID)
+			go am.UpdateAccountPeers(ctx, accountID)
+		}
+
+		if fetchErr != nil {
+			return dnsBlocklistRetryInterval, true
+		}
+
+		return dnsBlocklistRefreshInterval, true
+	}
+}
+
+// checkAndScheduleDNSBlocklistRefresh schedules the periodic fetch of the blocklist source if one is set
+func (am *DefaultAccountManager) checkAndScheduleDNSBlocklistRefresh(ctx context.Context, blocklist *types.DNSBlocklist) {
+	am.dnsBlocklistRefresh.Cancel(ctx, []string{blocklist.ID})
+	if blocklist.SourceURL == "" {
+		return
+	}
+
+	nextRun := time.Until(blocklist.SourceUpdatedAt.Add(dnsBlocklistRefreshInterval))
+	if blocklist.SourceError != "" {
+		nextRun = dnsBlocklistRetryInterval
+	}
+	nextRun = max(nextRun, dnsBlocklistRefreshStartDelay)
+
+	jobCtx := context.WithoutCancel(ctx)
+	go am.dnsBlocklistRefresh.Schedule(jobCtx, nextRun, blocklist.ID, am.dnsBlocklistRefreshJob(jobCtx, blocklist.AccountID, blocklist.ID))
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// allowPrivateDNSBlocklistSources lets the test fetch the blocklist sources from the local test servers
+func allowPrivateDNSBlocklistSources(t *testing.T) {
+	t.Helper()
+
+	dnsBlocklistAllowPrivateSources = true
+	t.Cleanup(func() {
+		dnsBlocklistAllowPrivateSources = false
+	})
+}
+
+func TestDNSBlocklistLifecycle(t *testing.T) {
+	allowPrivateDNSBlocklistSources(t)
+
+	var fetches atomic.Int32
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := fetches.Add(1)
+		_, _ = fmt.Fprintf(w, "# hosts\n0.0.0.0 localhost\n0.0.0.0 ads.example.com tracker.example.com\n0.0.0.0 fetch%d.example.com\n", n)
+	}))
+	defer source.Close()
+
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	blocklist, err := am.CreateDNSBlocklist(context.Background(), account.Id, testUserID, &types.DNSBlocklist{
+		Name:         "Ads",
+		Enabled:      true,
+		Domains:      []string{"*.Inline.example.com.", "ads.example.com"},
+		SourceURL:    source.URL,
+		ExemptGroups: []string{group2ID},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, blocklist.ID)
+	assert.Equal(t, types.DNSBlocklistActionNXDomain, blocklist.Action, "action should default to NXDOMAIN")
+	assert.Equal(t, []string{"ads.example.com", "fetch1.example.com", "inline.example.com", "tracker.example.com"}, blocklist.CompiledDomains)
+	assert.False(t, blocklist.SourceUpdatedAt.IsZero())
+
+	blocklists, err := am.ListDNSBlocklists(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	require.Len(t, blocklists, 1)
+
+	nextRun, reschedule := am.dnsBlocklistRefreshJob(context.Background(), account.Id, blocklist.ID)()
+	assert.True(t, reschedule)
+	assert.Equal(t, dnsBlocklistRefreshInterval, nextRun)
+
+	refreshed, err := am.GetDNSBlocklist(context.Background(), account.Id, testUserID, blocklist.ID)
+	require.NoError(t, err)
+	assert.Contains(t, refreshed.CompiledDomains, "fetch2.example.com", "refresh should recompile the source domains")
+	assert.NotContains(t, refreshed.CompiledDomains, "fetch1.example.com")
+
+	err = am.DeleteGroup(context.Background(), account.Id, testUserID, group2ID)
+	var linkErr *GroupLinkError
+	require.ErrorAs(t, err, &linkErr, "exempt groups should not be deletable")
+	assert.Equal(t, "DNS blocklist", linkErr.Resource)
+
+	refreshed.SourceURL = ""
+	refreshed.Action = types.DNSBlocklistActionSinkhole
+	saved, err := am.SaveDNSBlocklist(context.Background(), account.Id, testUserID, refreshed)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ads.example.com", "inline.example.com"}, saved.CompiledDomains)
+	assert.Equal(t, types.DefaultDNSBlocklistSinkholeIPv4, saved.SinkholeIPv4)
+
+	_, reschedule = am.dnsBlocklistRefreshJob(context.Background(), account.Id, blocklist.ID)()
+	assert.False(t, reschedule, "blocklists without a source should not be refreshed")
+
+	err = am.DeleteDNSBlocklist(context.Background(), account.Id, testUserID, blocklist.ID)
+	require.NoError(t, err)
+
+	_, err = am.GetDNSBlocklist(context.Background(), account.Id, testUserID, blocklist.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+}
+
+func TestCreateDNSBlocklistValidation(t *testing.T) {
+	allowPrivateDNSBlocklistSources(t)
+
+	failingSource := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failingSource.Close()
+
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	testCases := []struct {
+		name      string
+		blocklist *types.DNSBlocklist
+	}{
+		{name: "no domains", blocklist: &types.DNSBlocklist{Name: "empty"}},
+		{name: "invalid domain", blocklist: &types.DNSBlocklist{Name: "invalid", Domains: []string{"bad domain"}}},
+		{name: "invalid action", blocklist: &types.DNSBlocklist{Name: "action", Action: "drop", Domains: []string{"example.com"}}},
+		{name: "invalid sinkhole", blocklist: &types.DNSBlocklist{Name: "sinkhole", SinkholeIPv4: "::1", Domains: []string{"example.com"}}},
+		{name: "unknown exempt group", blocklist: &types.DNSBlocklist{Name: "groups", Domains: []string{"example.com"}, ExemptGroups: []string{"missing"}}},
+		{name: "failing source", blocklist: &types.DNSBlocklist{Name: "source", SourceURL: failingSource.URL}},
+		{name: "source with unsupported scheme", blocklist: &types.DNSBlocklist{Name: "scheme", SourceURL: "file:///etc/hosts"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := am.CreateDNSBlocklist(context.Background(), account.Id, testUserID, tc.blocklist)
+			sErr, ok := status.FromError(err)
+			require.True(t, ok, "expected a status error, got %v", err)
+			assert.Equal(t, status.InvalidArgument, sErr.Type())
+		})
+	}
+
+	blocklists, err := am.Store.GetAccountDNSBlocklists(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	assert.Empty(t, blocklists)
+}
+
+func TestCreateDNSBlocklistAccountSizeLimit(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	domains := func(prefix string) []string {
+		domains := make([]string, 30000)
+		for i := range domains {
+			domains[i] = fmt.Sprintf("%s%d.example.com", prefix, i)
+		}
+		return domains
+	}
+
+	_, err = am.CreateDNSBlocklist(context.Background(), account.Id, testUserID, &types.DNSBlocklist{
+		Name: "first", Enabled: true, Domains: domains("first"),
+	})
+	require.NoError(t, err)
+
+	_, err = am.CreateDNSBlocklist(context.Background(), account.Id, testUserID, &types.DNSBlocklist{
+		Name: "second", Enabled: true, Domains: domains("second"),
+	})
+	sErr, ok := status.FromError(err)
+	require.True(t, ok, "expected a status error, got %v", err)
+	assert.Equal(t, status.InvalidArgument, sErr.Type(), "the enabled blocklists should not exceed the account size limit")
+
+	disabled, err := am.CreateDNSBlocklist(context.Background(), account.Id, testUserID, &types.DNSBlocklist{
+		Name: "disabled", Domains: domains("second"),
+	})
+	require.NoError(t, err, "disabled blocklists are not distributed to the peers")
+
+	disabled.Enabled = true
+	_, err = am.SaveDNSBlocklist(context.Background(), account.Id, testUserID, disabled)
+	require.Error(t, err, "enabling the blocklist should exceed the account size limit")
+}
+
+func TestFetchDNSBlocklistSourceRejectsNonPublicAddresses(t *testing.T) {
+	var fetches atomic.Int32
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = fmt.Fprintln(w, "internal.example.com")
+	}))
+	defer internal.Close()
+
+	_, err := fetchDNSBlocklistSource(context.Background(), internal.URL)
+	require.Error(t, err, "loopback sources should be refused")
+	assert.Zero(t, fetches.Load())
+
+	for _, address := range []string{
+		"127.0.0.1:80", "10.0.0.1:80", "192.168.1.1:443", "169.254.169.254:80", "100.64.0.1:80",
+		"[::1]:80", "[fd00:ec2::254]:80", "[fe80::1]:80", "[::ffff:127.0.0.1]:80", "0.0.0.0:80",
+	} {
+		assert.Error(t, dnsBlocklistSourceControl("tcp", address, nil), address)
+	}
+	for _, address := range []string{"203.0.113.10:443", "[2001:db8::1]:443"} {
+		assert.NoError(t, dnsBlocklistSourceControl("tcp", address, nil), address)
+	}
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// GetDNSRecord gets a custom DNS record of the account zone
+func (am *DefaultAccountManager) GetDNSRecord(ctx context.Context, accountID, userID, recordID string) (*types.DNSRecord, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetDNSRecordByID(ctx, store.LockingStrengthShare, accountID, recordID)
+}
+
+// ListDNSRecords returns the custom DNS records of the account zone
+func (am *DefaultAccountManager) ListDNSRecords(ctx context.Context, accountID, userID string) ([]*types.DNSRecord, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountDNSRecords(ctx, store.LockingStrengthShare, accountID)
+}
+
+// CreateDNSRecord creates a custom DNS record and publishes it in the account zone
+func (am *DefaultAccountManager) CreateDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if record == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS record provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	newRecord := record.Copy()
+	newRecord.ID = xid.New().String()
+	newRecord.AccountID = accountID
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err = am.validateDNSRecord(ctx, transaction, newRecord); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSRecord(ctx, store.LockingStrengthUpdate, newRecord)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, newRecord.ID, accountID, activity.DNSRecordCreated, newRecord.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return newRecord.Copy(), nil
+}
+
+// SaveDNSRecord updates a custom DNS record of the account zone
+func (am *DefaultAccountManager) SaveDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if record == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS record provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	recordToSave := record.Copy()
+	recordToSave.AccountID = accountID
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if _, err = transaction.GetDNSRecordByID(ctx, store.LockingStrengthUpdate, accountID, recordToSave.ID); err != nil {
+			return err
+		}
+
+		if err = am.validateDNSRecord(ctx, transaction, recordToSave); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSRecord(ctx, store.LockingStrengthUpdate, recordToSave)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, recordToSave.ID, accountID, activity.DNSRecordUpdated, recordToSave.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return recordToSave.Copy(), nil
+}
+
+// DeleteDNSRecord removes a custom DNS record from the account zone
+func (am *DefaultAccountManager) DeleteDNSRecord(ctx context.Context, accountID, userID, recordID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Delete)
+	if err != nil {
+		return err
+	}
+
+	var record *types.DNSRecord
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		record, err = transaction.GetDNSRecordByID(ctx, store.LockingStrengthUpdate, accountID, recordID)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.DeleteDNSRecord(ctx, store.LockingStrengthUpdate, accountID, recordID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, record.ID, accountID, activity.DNSRecordDeleted, record.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return nil
+}
+
+// validateDNSRecord checks the record content and that it doesn't conflict with the peer records
+// or the other custom records of the zone
+func (am *DefaultAccountManager) validateDNSRecord(ctx context.Context, transaction store.Store, record *types.DNSRecord) error {
+	record.Name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(record.Name), "."))
+	if record.TTL == 0 {
+		record.TTL = types.DefaultDNSRecordTTL
+	}
+
+	network, err := transaction.GetAccountNetwork(ctx, store.LockingStrengthShare, record.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err = record.Validate(am.dnsDomain, network); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+
+	if record.Type != "PTR" {
+		labels, err := transaction.GetPeerLabelsInAccount(ctx, store.LockingStrengthShare, record.AccountID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(labels, record.Name) {
+			return status.Errorf(status.InvalidArgument, "record name %s is already used by a peer", record.Name)
+		}
+	}
+
+	records, err := transaction.GetAccountDNSRecords(ctx, store.LockingStrengthShare, record.AccountID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range records {
+		if existing.ID == record.ID || existing.Name != record.Name {
+			continue
+		}
+
+		if existing.Type == record.Type && existing.Content == record.Content {
+			return status.Errorf(status.AlreadyExists, "%s record %s with the same content already exists", record.Type, record.Name)
+		}
+
+		// a CNAME record can't coexist with other records of the same name, see RFC 1034 section 3.6.2
+		if (existing.Type == "CNAME" || record.Type == "CNAME") && (existing.Type != "PTR" && record.Type != "PTR") {
+			return status.Errorf(status.InvalidArgument, "record name %s is already used by a %s record, "+
+				"CNAME records can't be combined with other records", record.Name, existing.Type)
+		}
+	}
+
+	return nil
+}
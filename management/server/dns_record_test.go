@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDNSRecordLifecycle(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	record, err := am.CreateDNSRecord(context.Background(), account.Id, testUserID, &types.DNSRecord{
+		Name:    "_sip._tcp",
+		Type:    "SRV",
+		Content: "10 5 5060 sip",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, record.ID)
+	assert.Equal(t, types.DefaultDNSRecordTTL, record.TTL, "TTL should default when not set")
+
+	records, err := am.ListDNSRecords(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	record.Content = "20 5 5060 sip"
+	_, err = am.SaveDNSRecord(context.Background(), account.Id, testUserID, record)
+	require.NoError(t, err)
+
+	saved, err := am.GetDNSRecord(context.Background(), account.Id, testUserID, record.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "20 5 5060 sip", saved.Content)
+
+	storedAccount, err := am.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err)
+	zones := storedAccount.GetPeersCustomZones(context.Background(), am.GetDNSDomain())
+	require.NotEmpty(t, zones)
+
+	var found bool
+	for _, zoneRecord := range zones[0].Records {
+		if zoneRecord.Type == int(dns.TypeSRV) {
+			found = true
+			assert.Equal(t, "_sip._tcp."+am.GetDNSDomain()+".", zoneRecord.Name)
+			assert.Equal(t, "20 5 5060 sip."+am.GetDNSDomain()+".", zoneRecord.RData)
+		}
+	}
+	assert.True(t, found, "custom record should be merged into the peers zone")
+
+	err = am.DeleteDNSRecord(context.Background(), account.Id, testUserID, record.ID)
+	require.NoError(t, err)
+
+	_, err = am.GetDNSRecord(context.Background(), account.Id, testUserID, record.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+}
+
+func TestCreateDNSRecordValidation(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	labels, err := am.Store.GetPeerLabelsInAccount(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, labels)
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, testUserID, &types.DNSRecord{
+		Name: "web", Type: "A", TTL: 300, Content: "10.0.0.1",
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		record       *types.DNSRecord
+		expectedType status.Type
+	}{
+		{
+			name:         "invalid content",
+			record:       &types.DNSRecord{Name: "mail", Type: "MX", TTL: 300, Content: "mail"},
+			expectedType: status.InvalidArgument,
+		},
+		{
+			name:         "name used by a peer",
+			record:       &types.DNSRecord{Name: labels[0], Type: "A", TTL: 300, Content: "10.0.0.2"},
+			expectedType: status.InvalidArgument,
+		},
+		{
+			name:         "duplicate record",
+			record:       &types.DNSRecord{Name: "WEB", Type: "A", TTL: 300, Content: "10.0.0.1"},
+			expectedType: status.AlreadyExists,
+		},
+		{
+			name:         "CNAME next to other records",
+			record:       &types.DNSRecord{Name: "web", Type: "CNAME", TTL: 300, Content: "example.com."},
+			expectedType: status.InvalidArgument,
+		},
+		{
+			name:         "PTR outside of the account network",
+			record:       &types.DNSRecord{Name: "192.168.0.1", Type: "PTR", TTL: 300, Content: "web"},
+			expectedType: status.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := am.CreateDNSRecord(context.Background(), account.Id, testUserID, testCase.record)
+			require.Error(t, err)
+
+			sErr, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedType, sErr.Type())
+		})
+	}
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, testUserID, &types.DNSRecord{
+		Name: "web", Type: "A", TTL: 300, Content: "10.0.0.2",
+	})
+	assert.NoError(t, err, "records of the same name and type should form a set")
+}
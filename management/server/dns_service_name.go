@@ -0,0 +1,298 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// GetDNSServiceName gets a DNS service name of the account zone
+func (am *DefaultAccountManager) GetDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) (*types.DNSServiceName, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetDNSServiceNameByID(ctx, store.LockingStrengthShare, accountID, serviceNameID)
+}
+
+// ListDNSServiceNames returns the DNS service names of the account zone
+func (am *DefaultAccountManager) ListDNSServiceNames(ctx context.Context, accountID, userID string) ([]*types.DNSServiceName, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountDNSServiceNames(ctx, store.LockingStrengthShare, accountID)
+}
+
+// GetDNSServiceNamesHealthyPeers returns the IDs of the members currently published for each DNS service name of the account
+func (am *DefaultAccountManager) GetDNSServiceNamesHealthyPeers(ctx context.Context, accountID, userID string) (map[string][]string, error) {
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	healthyPeers := make(map[string][]string, len(account.DNSServiceNames))
+	for _, serviceName := range account.DNSServiceNames {
+		peerIDs := []string{}
+		for _, peer := range account.GetDNSServiceNameHealthyPeers(serviceName, now) {
+			peerIDs = append(peerIDs, peer.ID)
+		}
+		healthyPeers[serviceName.ID] = peerIDs
+	}
+	return healthyPeers, nil
+}
+
+// CreateDNSServiceName creates a DNS service name and publishes its healthy members in the account zone
+func (am *DefaultAccountManager) CreateDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if serviceName == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS service name provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	newServiceName := serviceName.Copy()
+	newServiceName.ID = xid.New().String()
+	newServiceName.AccountID = accountID
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err = am.validateDNSServiceName(ctx, transaction, newServiceName); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSServiceName(ctx, store.LockingStrengthUpdate, newServiceName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, newServiceName.ID, accountID, activity.DNSServiceNameCreated, newServiceName.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+	am.checkAndScheduleDNSServiceNameUpdates(ctx, accountID)
+
+	return newServiceName.Copy(), nil
+}
+
+// SaveDNSServiceName updates a DNS service name of the account zone
+func (am *DefaultAccountManager) SaveDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if serviceName == nil {
+		return nil, status.Errorf(status.InvalidArgument, "DNS service name provided is nil")
+	}
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceNameToSave := serviceName.Copy()
+	serviceNameToSave.AccountID = accountID
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if _, err = transaction.GetDNSServiceNameByID(ctx, store.LockingStrengthUpdate, accountID, serviceNameToSave.ID); err != nil {
+			return err
+		}
+
+		if err = am.validateDNSServiceName(ctx, transaction, serviceNameToSave); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveDNSServiceName(ctx, store.LockingStrengthUpdate, serviceNameToSave)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, serviceNameToSave.ID, accountID, activity.DNSServiceNameUpdated, serviceNameToSave.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+	am.checkAndScheduleDNSServiceNameUpdates(ctx, accountID)
+
+	return serviceNameToSave.Copy(), nil
+}
+
+// DeleteDNSServiceName removes a DNS service name from the account zone
+func (am *DefaultAccountManager) DeleteDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Delete)
+	if err != nil {
+		return err
+	}
+
+	var serviceName *types.DNSServiceName
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		serviceName, err = transaction.GetDNSServiceNameByID(ctx, store.LockingStrengthUpdate, accountID, serviceNameID)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.DeleteDNSServiceName(ctx, store.LockingStrengthUpdate, accountID, serviceNameID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, serviceName.ID, accountID, activity.DNSServiceNameDeleted, serviceName.EventMeta())
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return nil
+}
+
+// validateDNSServiceName checks the service name content, its members and that it doesn't conflict
+// with the peer records or the custom records of the zone
+func (am *DefaultAccountManager) validateDNSServiceName(ctx context.Context, transaction store.Store, serviceName *types.DNSServiceName) error {
+	serviceName.Name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(serviceName.Name), "."))
+	if serviceName.Peers == nil {
+		serviceName.Peers = []string{}
+	}
+	if serviceName.Groups == nil {
+		serviceName.Groups = []string{}
+	}
+
+	if err := serviceName.Validate(); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+
+	if len(serviceName.Peers) > 0 {
+		peers, err := transaction.GetPeersByIDs(ctx, store.LockingStrengthShare, serviceName.AccountID, serviceName.Peers)
+		if err != nil {
+			return err
+		}
+		for _, peerID := range serviceName.Peers {
+			if _, ok := peers[peerID]; !ok {
+				return status.Errorf(status.InvalidArgument, "peer with ID %s not found", peerID)
+			}
+		}
+	}
+
+	if len(serviceName.Groups) > 0 {
+		groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthShare, serviceName.AccountID, serviceName.Groups)
+		if err != nil {
+			return err
+		}
+		for _, groupID := range serviceName.Groups {
+			if _, ok := groups[groupID]; !ok {
+				return status.Errorf(status.InvalidArgument, "group with ID %s not found", groupID)
+			}
+		}
+	}
+
+	labels, err := transaction.GetPeerLabelsInAccount(ctx, store.LockingStrengthShare, serviceName.AccountID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(labels, serviceName.Name) {
+		return status.Errorf(status.InvalidArgument, "service name %s is already used by a peer", serviceName.Name)
+	}
+
+	records, err := transaction.GetAccountDNSRecords(ctx, store.LockingStrengthShare, serviceName.AccountID)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Type != "PTR" && record.Name == serviceName.Name {
+			return status.Errorf(status.InvalidArgument, "service name %s is already used by a %s record", serviceName.Name, record.Type)
+		}
+	}
+
+	serviceNames, err := transaction.GetAccountDNSServiceNames(ctx, store.LockingStrengthShare, serviceName.AccountID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range serviceNames {
+		if existing.ID != serviceName.ID && existing.Name == serviceName.Name {
+			return status.Errorf(status.AlreadyExists, "service name %s already exists", serviceName.Name)
+		}
+	}
+
+	return nil
+}
+
+// dnsServiceNameUpdateJob sends updated network maps to the account peers when a disconnected DNS service name
+// member leaves its grace period and returns the duration until the next member transition if found
+func (am *DefaultAccountManager) dnsServiceNameUpdateJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		err := am.Store.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID)
+		unlock()
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to increment network serial of account %s on DNS service name member transition: %v", accountID, err)
+			return 0, false
+		}
+
+		log.WithContext(ctx).Debugf("DNS service name member transition for account %s, updating peers", accountID)
+		am.UpdateAccountPeers(ctx, accountID)
+
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting account %s for the next DNS service name member transition: %v", accountID, err)
+			return 0, false
+		}
+
+		return account.GetNextDNSServiceNameTransition()
+	}
+}
+
+// checkAndScheduleDNSServiceNameUpdates reschedules the account peers updates on the next DNS service name member transition
+func (am *DefaultAccountManager) checkAndScheduleDNSServiceNameUpdates(ctx context.Context, accountID string) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting account %s for the next DNS service name member transition: %v", accountID, err)
+		return
+	}
+
+	am.scheduleDNSServiceNameUpdates(ctx, account)
+}
+
+// scheduleDNSServiceNameUpdates replaces the pending DNS service name update of the account with the next member transition
+func (am *DefaultAccountManager) scheduleDNSServiceNameUpdates(ctx context.Context, account *types.Account) {
+	am.dnsServiceNameUpdates.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.GetNextDNSServiceNameTransition(); ok {
+		// scheduled synchronously, so a concurrent reschedule can't be overtaken by an outdated transition
+		jobCtx := context.WithoutCancel(ctx)
+		am.dnsServiceNameUpdates.Schedule(jobCtx, nextRun, account.Id, am.dnsServiceNameUpdateJob(jobCtx, account.Id))
+	}
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDNSServiceNameLifecycle(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	peer1, err := am.Store.GetPeerByPeerPubKey(context.Background(), store.LockingStrengthShare, nsGroupPeer1Key)
+	require.NoError(t, err)
+	peer2, err := am.Store.GetPeerByPeerPubKey(context.Background(), store.LockingStrengthShare, nsGroupPeer2Key)
+	require.NoError(t, err)
+
+	group := &types.Group{ID: group2ID, Name: group2ID, Peers: []string{peer2.ID}}
+	require.NoError(t, am.SaveGroup(context.Background(), account.Id, testUserID, group))
+
+	serviceName, err := am.CreateDNSServiceName(context.Background(), account.Id, testUserID, &types.DNSServiceName{
+		Name:    "DB.",
+		Enabled: true,
+		Peers:   []string{peer1.ID},
+		Groups:  []string{group2ID},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, serviceName.ID)
+	assert.Equal(t, "db", serviceName.Name, "name should be normalized")
+
+	healthyPeers, err := am.GetDNSServiceNamesHealthyPeers(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{peer1.ID, peer2.ID}, healthyPeers[serviceName.ID], "recently added peers should be healthy")
+
+	err = am.Store.SavePeerStatus(account.Id, peer2.ID, nbpeer.PeerStatus{LastSeen: time.Now().UTC().Add(-time.Hour)})
+	require.NoError(t, err)
+
+	healthyPeers, err = am.GetDNSServiceNamesHealthyPeers(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{peer1.ID}, healthyPeers[serviceName.ID], "peers seen before the grace period should not be published")
+
+	network, err := am.Store.GetAccountNetwork(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+
+	fullAccount, err := am.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err)
+	err = am.MarkPeerConnected(context.Background(), nsGroupPeer2Key, true, nil, fullAccount)
+	require.NoError(t, err)
+
+	healthyPeers, err = am.GetDNSServiceNamesHealthyPeers(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{peer1.ID, peer2.ID}, healthyPeers[serviceName.ID], "connected peers should be published again")
+
+	updatedNetwork, err := am.Store.GetAccountNetwork(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	assert.Greater(t, updatedNetwork.Serial, network.Serial, "recovered members should update the account peers")
+
+	err = am.DeleteGroup(context.Background(), account.Id, testUserID, group2ID)
+	var linkErr *GroupLinkError
+	require.ErrorAs(t, err, &linkErr, "groups serving a service name should not be deletable")
+	assert.Equal(t, "DNS service name", linkErr.Resource)
+
+	serviceName.Groups = nil
+	saved, err := am.SaveDNSServiceName(context.Background(), account.Id, testUserID, serviceName)
+	require.NoError(t, err)
+	assert.Empty(t, saved.Groups)
+
+	serviceNames, err := am.ListDNSServiceNames(context.Background(), account.Id, testUserID)
+	require.NoError(t, err)
+	require.Len(t, serviceNames, 1)
+
+	err = am.DeleteDNSServiceName(context.Background(), account.Id, testUserID, serviceName.ID)
+	require.NoError(t, err)
+
+	_, err = am.GetDNSServiceName(context.Background(), account.Id, testUserID, serviceName.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+}
+
+func TestDNSServiceNameUpdatesScheduledSynchronously(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	peer, err := am.Store.GetPeerByPeerPubKey(context.Background(), store.LockingStrengthShare, nsGroupPeer1Key)
+	require.NoError(t, err)
+
+	_, err = am.CreateDNSServiceName(context.Background(), account.Id, testUserID, &types.DNSServiceName{
+		Name:    "db",
+		Enabled: true,
+		Peers:   []string{peer.ID},
+	})
+	require.NoError(t, err)
+
+	var calls []string
+	var jobCtx context.Context
+	am.dnsServiceNameUpdates = &MockScheduler{
+		CancelFunc: func(ctx context.Context, IDs []string) {
+			calls = append(calls, "cancel")
+		},
+		ScheduleFunc: func(ctx context.Context, in time.Duration, ID string, job func() (nextRunIn time.Duration, reschedule bool)) {
+			calls = append(calls, "schedule")
+			jobCtx = ctx
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fullAccount, err := am.Store.GetAccount(context.Background(), account.Id)
+	require.NoError(t, err)
+
+	// the disconnected member is removed once its grace period ends, the job is replaced before MarkPeerConnected returns
+	err = am.MarkPeerConnected(ctx, nsGroupPeer1Key, false, nil, fullAccount)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cancel", "schedule"}, calls)
+	require.NotNil(t, jobCtx)
+	assert.NoError(t, jobCtx.Err(), "the job must not be bound to the context of the peer connection")
+}
+
+func TestCreateDNSServiceNameValidation(t *testing.T) {
+	am, err := createNSManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestNSAccount(t, am)
+	require.NoError(t, err, "failed to init testing account")
+
+	peer1, err := am.Store.GetPeerByPeerPubKey(context.Background(), store.LockingStrengthShare, nsGroupPeer1Key)
+	require.NoError(t, err)
+
+	_, err = am.CreateDNSRecord(context.Background(), account.Id, testUserID, &types.DNSRecord{Name: "web", Type: "A", Content: "10.0.0.1"})
+	require.NoError(t, err)
+
+	_, err = am.CreateDNSServiceName(context.Background(), account.Id, testUserID, &types.DNSServiceName{Name: "db", Peers: []string{peer1.ID}})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		serviceName *types.DNSServiceName
+		errType     status.Type
+	}{
+		{name: "no members", serviceName: &types.DNSServiceName{Name: "api"}, errType: status.InvalidArgument},
+		{name: "unknown peer", serviceName: &types.DNSServiceName{Name: "api", Peers: []string{"missing"}}, errType: status.InvalidArgument},
+		{name: "unknown group", serviceName: &types.DNSServiceName{Name: "api", Groups: []string{"missing"}}, errType: status.InvalidArgument},
+		{name: "peer name", serviceName: &types.DNSServiceName{Name: peer1.DNSLabel, Peers: []string{peer1.ID}}, errType: status.InvalidArgument},
+		{name: "custom record name", serviceName: &types.DNSServiceName{Name: "web", Peers: []string{peer1.ID}}, errType: status.InvalidArgument},
+		{name: "duplicate", serviceName: &types.DNSServiceName{Name: "DB", Groups: []string{group1ID}}, errType: status.AlreadyExists},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := am.CreateDNSServiceName(context.Background(), account.Id, testUserID, tc.serviceName)
+			sErr, ok := status.FromError(err)
+			require.True(t, ok, "expected a status error, got %v", err)
+			assert.Equal(t, tc.errType, sErr.Type())
+		})
+	}
+
+	serviceNames, err := am.Store.GetAccountDNSServiceNames(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	assert.Len(t, serviceNames, 1)
+}
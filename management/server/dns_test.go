@@ -20,7 +20,9 @@ import (
 	"github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/users"
 )
 
 const (
@@ -176,7 +178,7 @@ func TestGetNetworkMap_DNSConfigSync(t *testing.T) {
 
 	newAccountDNSConfig, err := am.GetNetworkMap(context.Background(), peer1.ID)
 	require.NoError(t, err)
-	require.Len(t, newAccountDNSConfig.DNSConfig.CustomZones, 1, "default DNS config should have one custom zone for peers")
+	require.Len(t, newAccountDNSConfig.DNSConfig.CustomZones, 2, "default DNS config should have the peers zone and its reverse zone")
 	require.True(t, newAccountDNSConfig.DNSConfig.ServiceEnable, "default DNS config should have local DNS service enabled")
 	require.Len(t, newAccountDNSConfig.DNSConfig.NameServerGroups, 0, "updated DNS config should have no nameserver groups since peer 1 is NS for the only existing NS group")
 
@@ -192,7 +194,7 @@ func TestGetNetworkMap_DNSConfigSync(t *testing.T) {
 	require.False(t, updatedAccountDNSConfig.DNSConfig.ServiceEnable, "updated DNS config should have local DNS service disabled when peer belongs to a disabled group")
 	peer2AccountDNSConfig, err := am.GetNetworkMap(context.Background(), peer2.ID)
 	require.NoError(t, err)
-	require.Len(t, peer2AccountDNSConfig.DNSConfig.CustomZones, 1, "DNS config should have one custom zone for peers not in the disabled group")
+	require.Len(t, peer2AccountDNSConfig.DNSConfig.CustomZones, 2, "DNS config should have the peers zones for peers not in the disabled group")
 	require.True(t, peer2AccountDNSConfig.DNSConfig.ServiceEnable, "DNS config should have DNS service enabled for peers not in the disabled group")
 	require.Len(t, peer2AccountDNSConfig.DNSConfig.NameServerGroups, 1, "updated DNS config should have 1 nameserver groups since peer 2 is part of the group All")
 }
@@ -208,7 +210,7 @@ func createDNSManager(t *testing.T) (*DefaultAccountManager, error) {
 	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
 	require.NoError(t, err)
 
-	return BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.test", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	return BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.test", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(store, users.NewManager(store)))
 }
 
 func createDNSStore(t *testing.T) (store.Store, error) {
@@ -538,7 +540,7 @@ func TestDNSAccountPeersUpdate(t *testing.T) {
 				Port:   dns.DefaultDNSPort,
 			}},
 			[]string{"groupB"},
-			true, []string{}, true, userID, false,
+			true, []string{}, true, userID, false, false, nil,
 		)
 		assert.NoError(t, err)
 
@@ -571,7 +573,7 @@ func TestDNSAccountPeersUpdate(t *testing.T) {
 				Port:   dns.DefaultDNSPort,
 			}},
 			[]string{"groupA"},
-			true, []string{}, true, userID, false,
+			true, []string{}, true, userID, false, false, nil,
 		)
 		assert.NoError(t, err)
 
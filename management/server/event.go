@@ -9,7 +9,8 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server/activity"
-	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 )
 
 func isEnabled() bool {
@@ -17,34 +18,67 @@ func isEnabled() bool {
 	return response == "" || response == "true"
 }
 
-// GetEvents returns a list of activity events of an account
-func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
-	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
-	defer unlock()
+const (
+	// maxEventsLimit is the maximum number of events returned by GetEvents
+	maxEventsLimit = 10000
+	// exportBatchSize is the number of events read from the event store at once during an export
+	exportBatchSize = 1000
+)
 
-	account, err := am.Store.GetAccount(ctx, accountID)
-	if err != nil {
+// GetEvents returns a list of activity events of an account matching the filter
+func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read); err != nil {
 		return nil, err
 	}
 
-	user, err := account.FindUser(userID)
+	events, err := am.eventStore.Get(ctx, accountID, 0, maxEventsLimit, true, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	if !(user.HasAdminPower() || user.IsServiceUser) {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view events")
+	return filterDuplicateEvents(events, make(map[string]struct{})), nil
+}
+
+// ExportEvents reads all activity events of an account matching the filter in ascending order and passes them in batches to the export function.
+// The batches continue after the last event of the previous one, so events stored or deleted meanwhile don't shift them.
+func (am *DefaultAccountManager) ExportEvents(ctx context.Context, accountID, userID string, filter *activity.Filter, export func([]*activity.Event) error) error {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read); err != nil {
+		return err
 	}
 
-	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
-	if err != nil {
-		return nil, err
+	batchFilter := &activity.Filter{}
+	if filter != nil {
+		*batchFilter = *filter
 	}
 
-	// this is a workaround for duplicate activity.UserJoined events that might occur when a user redeems invite.
-	// we will need to find a better way to handle this.
-	filtered := make([]*activity.Event, 0)
 	dups := make(map[string]struct{})
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		events, err := am.eventStore.Get(ctx, accountID, 0, exportBatchSize, false, batchFilter)
+		if err != nil {
+			return err
+		}
+
+		if err = export(filterDuplicateEvents(events, dups)); err != nil {
+			return err
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+
+		last := events[len(events)-1]
+		batchFilter.After = &activity.Cursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+}
+
+// filterDuplicateEvents is a workaround for duplicate activity.UserJoined events that might occur when a user redeems invite.
+// we will need to find a better way to handle this.
+func filterDuplicateEvents(events []*activity.Event, dups map[string]struct{}) []*activity.Event {
+	filtered := make([]*activity.Event, 0, len(events))
 	for _, event := range events {
 		if event.Activity == activity.UserJoined {
 			key := event.TargetID + event.InitiatorID + event.AccountID + fmt.Sprint(event.Activity)
@@ -58,7 +92,7 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		filtered = append(filtered, event)
 	}
 
-	return filtered, nil
+	return filtered
 }
 
 func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
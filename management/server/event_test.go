@@ -2,12 +2,16 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/management/server/store"
 )
 
 func generateAndStoreEvents(t *testing.T, manager *DefaultAccountManager, typ activity.Activity, initiatorID, targetID,
@@ -36,7 +40,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 	accountID := "accountID"
 
 	t.Run("get empty events list", func(t *testing.T) {
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, nil)
 		if err != nil {
 			return
 		}
@@ -46,7 +50,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 
 	t.Run("get events", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, nil)
 		if err != nil {
 			return
 		}
@@ -57,7 +61,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 
 	t.Run("get events without duplicates", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.UserJoined, userID, "", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, nil)
 		if err != nil {
 			return
 		}
@@ -65,3 +69,103 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 		_ = manager.eventStore.Close(context.Background()) //nolint
 	})
 }
+
+func TestDefaultAccountManager_EventRetentionJob(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	account := newAccountWithId(ctx, "retention_account", userID, "")
+	account.Settings.EventRetentionDays = 30
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	for _, age := range []time.Duration{time.Hour, 29 * 24 * time.Hour, 31 * 24 * time.Hour, 90 * 24 * time.Hour} {
+		_, err = manager.eventStore.Save(ctx, &activity.Event{
+			Timestamp: time.Now().UTC().Add(-age),
+			Activity:  activity.PeerAddedByUser,
+			AccountID: account.Id,
+		})
+		require.NoError(t, err)
+	}
+
+	nextRun, reschedule := manager.eventRetentionJob(ctx, account.Id)()
+	assert.True(t, reschedule)
+	assert.Equal(t, eventRetentionInterval, nextRun)
+
+	events, err := manager.eventStore.Get(ctx, account.Id, 0, 100, true, nil)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	account.Settings.EventRetentionDays = 0
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	_, reschedule = manager.eventRetentionJob(ctx, account.Id)()
+	assert.False(t, reschedule, "the job should stop when the retention is disabled")
+}
+
+func TestDefaultAccountManager_EventRetentionJob_NetworkTrafficEvents(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	account := newAccountWithId(ctx, "traffic_retention_account", userID, "")
+	account.Settings.NetworkTrafficLogsEnabled = true
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	now := time.Now().UTC()
+	var events []*flowTypes.Event
+	for i, age := range []int{1, flowTypes.DefaultRetentionDays - 1, flowTypes.DefaultRetentionDays + 1} {
+		events = append(events, &flowTypes.Event{
+			ID:        fmt.Sprintf("event-%d", i),
+			AccountID: account.Id,
+			Timestamp: now.AddDate(0, 0, -age),
+		})
+	}
+	require.NoError(t, manager.Store.SaveNetworkTrafficEvents(ctx, events))
+
+	_, reschedule := manager.eventRetentionJob(ctx, account.Id)()
+	assert.True(t, reschedule, "the network traffic events should be cleaned up without an event retention period")
+
+	_, total, err := manager.Store.GetNetworkTrafficEvents(ctx, store.LockingStrengthShare, account.Id, &flowTypes.Filter{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+
+	account.Settings.EventRetentionDays = 7
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	_, reschedule = manager.eventRetentionJob(ctx, account.Id)()
+	assert.True(t, reschedule)
+
+	_, total, err = manager.Store.GetNetworkTrafficEvents(ctx, store.LockingStrengthShare, account.Id, &flowTypes.Filter{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total, "the account retention period should apply to the network traffic events")
+}
+
+func TestDefaultAccountManager_ExportEvents(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	account := newAccountWithId(ctx, "export_account", userID, "")
+	require.NoError(t, manager.Store.SaveAccount(ctx, account))
+
+	count := exportBatchSize + 1
+	generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", account.Id, count)
+
+	var exported int
+	done := make(chan error, 1)
+	go func() {
+		done <- manager.ExportEvents(ctx, account.Id, userID, nil, func(events []*activity.Event) error {
+			exported += len(events)
+			return nil
+		})
+	}()
+
+	select {
+	case err = <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the export didn't finish")
+	}
+	assert.Equal(t, count, exported, "every event should be exported once")
+}
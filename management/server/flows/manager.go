@@ -0,0 +1,168 @@
+package flows
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/proto"
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+type Manager interface {
+	SaveEvents(ctx context.Context, peerKey string, events []*proto.FlowEvent) error
+	GetEvents(ctx context.Context, accountID, userID string, filter *types.Filter) ([]*types.Event, int64, error)
+}
+
+type managerImpl struct {
+	store              store.Store
+	permissionsManager permissions.Manager
+}
+
+type mockManager struct {
+}
+
+func NewManager(store store.Store, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		permissionsManager: permissionsManager,
+	}
+}
+
+// SaveEvents stores the events reported by the peer with the given key.
+// Events are discarded if network traffic logs are disabled for the peer account.
+func (m *managerImpl) SaveEvents(ctx context.Context, peerKey string, events []*proto.FlowEvent) error {
+	peer, err := m.store.GetPeerByPeerPubKey(ctx, store.LockingStrengthShare, peerKey)
+	if err != nil {
+		return err
+	}
+
+	settings, err := m.store.GetAccountSettings(ctx, store.LockingStrengthShare, peer.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account settings: %w", err)
+	}
+
+	if !settings.NetworkTrafficLogsEnabled {
+		log.WithContext(ctx).Debugf("network traffic logs are disabled for account %s, discarding %d events from peer %s", peer.AccountID, len(events), peer.ID)
+		return nil
+	}
+
+	receivedAt := time.Now().UTC()
+	toSave := make([]*types.Event, 0, len(events))
+	for _, event := range events {
+		flowEvent, err := toEvent(event)
+		if err != nil {
+			log.WithContext(ctx).Debugf("skipping invalid flow event from peer %s: %v", peer.ID, err)
+			continue
+		}
+		flowEvent.AccountID = peer.AccountID
+		flowEvent.PeerID = peer.ID
+		flowEvent.ReceivedAt = receivedAt
+		toSave = append(toSave, flowEvent)
+	}
+
+	return m.store.SaveNetworkTrafficEvents(ctx, toSave)
+}
+
+func (m *managerImpl) GetEvents(ctx context.Context, accountID, userID string, filter *types.Filter) ([]*types.Event, int64, error) {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read)
+	if err != nil {
+		return nil, 0, status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return nil, 0, status.NewPermissionDeniedError()
+	}
+
+	filter.Normalize()
+
+	return m.store.GetNetworkTrafficEvents(ctx, store.LockingStrengthShare, accountID, filter)
+}
+
+func toEvent(event *proto.FlowEvent) (*types.Event, error) {
+	eventID, err := uuid.FromBytes(event.GetEventId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	flowID, err := uuid.FromBytes(event.GetFlowId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid flow ID: %w", err)
+	}
+
+	if event.GetTimestamp() == nil {
+		return nil, fmt.Errorf("missing timestamp")
+	}
+
+	return &types.Event{
+		ID:              eventID.String(),
+		Timestamp:       event.GetTimestamp().AsTime().UTC(),
+		FlowID:          flowID.String(),
+		Type:            toEventType(event.GetType()),
+		Direction:       toEventDirection(event.GetDirection()),
+		Protocol:        int(event.GetProtocol()),
+		PolicyID:        string(event.GetRuleId()),
+		SourceIP:        toIPString(event.GetSourceIp()),
+		SourcePort:      int(event.GetSourcePort()),
+		DestinationIP:   toIPString(event.GetDestIp()),
+		DestinationPort: int(event.GetDestPort()),
+		ICMPType:        int(event.GetIcmpType()),
+		ICMPCode:        int(event.GetIcmpCode()),
+		RxPackets:       event.GetRxPackets(),
+		TxPackets:       event.GetTxPackets(),
+		RxBytes:         event.GetRxBytes(),
+		TxBytes:         event.GetTxBytes(),
+	}, nil
+}
+
+func toEventType(t proto.FlowEvent_Type) string {
+	switch t {
+	case proto.FlowEvent_TYPE_START:
+		return types.EventTypeStart
+	case proto.FlowEvent_TYPE_END:
+		return types.EventTypeEnd
+	case proto.FlowEvent_TYPE_DROP:
+		return types.EventTypeDrop
+	default:
+		return ""
+	}
+}
+
+func toEventDirection(d proto.FlowEvent_Direction) string {
+	switch d {
+	case proto.FlowEvent_INGRESS:
+		return types.DirectionIngress
+	case proto.FlowEvent_EGRESS:
+		return types.DirectionEgress
+	default:
+		return ""
+	}
+}
+
+func toIPString(ip []byte) string {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return ""
+	}
+	return addr.Unmap().String()
+}
+
+func NewManagerMock() Manager {
+	return &mockManager{}
+}
+
+func (m *mockManager) SaveEvents(ctx context.Context, peerKey string, events []*proto.FlowEvent) error {
+	return nil
+}
+
+func (m *mockManager) GetEvents(ctx context.Context, accountID, userID string, filter *types.Filter) ([]*types.Event, int64, error) {
+	return []*types.Event{}, 0, nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+const (
+	EventTypeStart = "start"
+	EventTypeEnd   = "end"
+	EventTypeDrop  = "drop"
+
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+)
+
+const (
+	// DefaultPageSize is the number of events returned when the page size is not set
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of events returned in a single page
+	MaxPageSize = 1000
+	// DefaultRetentionDays is the number of days the events are kept when the account has no event retention period
+	DefaultRetentionDays = 30
+)
+
+// Event is a network traffic event reported by a peer
+type Event struct {
+	// ID is the event ID generated by the reporting peer
+	ID         string    `gorm:"primaryKey"`
+	AccountID  string    `gorm:"index"`
+	PeerID     string    `gorm:"index"`
+	Timestamp  time.Time `gorm:"index"`
+	ReceivedAt time.Time
+	// FlowID is shared by the start and end events of the same connection
+	FlowID    string `gorm:"index"`
+	Type      string
+	Direction string
+	Protocol  int
+	// PolicyID is the ID of the policy that matched the flow, if any
+	PolicyID        string
+	SourceIP        string
+	SourcePort      int
+	DestinationIP   string
+	DestinationPort int
+	ICMPType        int
+	ICMPCode        int
+	RxPackets       uint64
+	TxPackets       uint64
+	RxBytes         uint64
+	TxBytes         uint64
+}
+
+// TableName returns the name of the table used to store the events
+func (*Event) TableName() string {
+	return "network_traffic_events"
+}
+
+// ToAPIResponse converts the event to the API representation
+func (e *Event) ToAPIResponse() *api.NetworkTrafficEvent {
+	return &api.NetworkTrafficEvent{
+		Id:              e.ID,
+		FlowId:          e.FlowID,
+		PeerId:          e.PeerID,
+		Timestamp:       e.Timestamp,
+		Type:            api.NetworkTrafficEventType(e.Type),
+		Direction:       api.NetworkTrafficEventDirection(e.Direction),
+		Protocol:        e.Protocol,
+		SourceIp:        e.SourceIP,
+		SourcePort:      e.SourcePort,
+		DestinationIp:   e.DestinationIP,
+		DestinationPort: e.DestinationPort,
+		IcmpType:        e.ICMPType,
+		IcmpCode:        e.ICMPCode,
+		PolicyId:        e.PolicyID,
+		RxPackets:       int64(e.RxPackets),
+		TxPackets:       int64(e.TxPackets),
+		RxBytes:         int64(e.RxBytes),
+		TxBytes:         int64(e.TxBytes),
+	}
+}
+
+// Filter narrows down the events returned from the store
+type Filter struct {
+	PeerID    string
+	PolicyID  string
+	Type      string
+	Direction string
+	Protocol  int
+	StartDate time.Time
+	EndDate   time.Time
+	Page      int
+	PageSize  int
+}
+
+// Offset returns the number of events to skip for the filter page
+func (f *Filter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
+
+// Normalize sets the default pagination values
+func (f *Filter) Normalize() {
+	if f.Page < 1 {
+		f.Page = 1
+	}
+	if f.PageSize < 1 {
+		f.PageSize = DefaultPageSize
+	}
+	if f.PageSize > MaxPageSize {
+		f.PageSize = MaxPageSize
+	}
+}
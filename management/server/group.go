@@ -16,6 +16,8 @@ import (
 	"github.com/netbirdio/netbird/route"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -30,20 +32,7 @@ func (e *GroupLinkError) Error() string {
 
 // CheckGroupPermissions validates if a user has the necessary permissions to view groups
 func (am *DefaultAccountManager) CheckGroupPermissions(ctx context.Context, accountID, userID string) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return err
-	}
-
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
-	}
-
-	return nil
+	return am.validateUserPermissions(ctx, accountID, userID, modules.Groups, operations.Read)
 }
 
 // GetGroup returns a specific group by groupID in an account
@@ -78,17 +67,17 @@ func (am *DefaultAccountManager) SaveGroup(ctx context.Context, accountID, userI
 // Note: This function does not acquire the global lock.
 // It is the caller's responsibility to ensure proper locking is in place before invoking this method.
 func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, userID string, groups []*types.Group) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return err
-	}
-
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
+	operation := operations.Update
+	for _, group := range groups {
+		if group.ID == "" {
+			operation = operations.Create
+			break
+		}
 	}
 
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Groups, operation)
+	if err != nil {
+		return err
 	}
 
 	var eventsToStore []func()
@@ -210,19 +199,11 @@ func (am *DefaultAccountManager) DeleteGroup(ctx context.Context, accountID, use
 // If an error occurs while deleting a group, the function skips it and continues deleting other groups.
 // Errors are collected and returned at the end.
 func (am *DefaultAccountManager) DeleteGroups(ctx context.Context, accountID, userID string, groupIDs []string) error {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Groups, operations.Delete)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
-	}
-
 	var allErrors error
 	var groupIDsToDelete []string
 	var deletedGroups []*types.Group
@@ -486,6 +467,14 @@ func validateDeleteGroup(ctx context.Context, transaction store.Store, group *ty
 		return &GroupLinkError{"name server groups", linkedDns.Name}
 	}
 
+	if isLinked, linkedBlocklist := isGroupLinkedToDNSBlocklist(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"DNS blocklist", linkedBlocklist.Name}
+	}
+
+	if isLinked, linkedServiceName := isGroupLinkedToDNSServiceName(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"DNS service name", linkedServiceName.Name}
+	}
+
 	if isLinked, linkedPolicy := isGroupLinkedToPolicy(ctx, transaction, group.AccountID, group.ID); isLinked {
 		return &GroupLinkError{"policy", linkedPolicy.Name}
 	}
@@ -581,6 +570,40 @@ func isGroupLinkedToDns(ctx context.Context, transaction store.Store, accountID
 	return false, nil
 }
 
+// isGroupLinkedToDNSBlocklist checks if a group is exempted from any DNS blocklist in the account.
+func isGroupLinkedToDNSBlocklist(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.DNSBlocklist) {
+	blocklists, err := transaction.GetAccountDNSBlocklists(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving DNS blocklists while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, blocklist := range blocklists {
+		if slices.Contains(blocklist.ExemptGroups, groupID) {
+			return true, blocklist
+		}
+	}
+
+	return false, nil
+}
+
+// isGroupLinkedToDNSServiceName checks if a group serves any DNS service name in the account.
+func isGroupLinkedToDNSServiceName(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.DNSServiceName) {
+	serviceNames, err := transaction.GetAccountDNSServiceNames(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving DNS service names while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, serviceName := range serviceNames {
+		if slices.Contains(serviceName.Groups, groupID) {
+			return true, serviceName
+		}
+	}
+
+	return false, nil
+}
+
 // isGroupLinkedToSetupKey checks if a group is linked to any setup key in the account.
 func isGroupLinkedToSetupKey(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.SetupKey) {
 	setupKeys, err := transaction.GetAccountSetupKeys(ctx, store.LockingStrengthShare, accountID)
@@ -631,6 +654,12 @@ func areGroupChangesAffectPeers(ctx context.Context, transaction store.Store, ac
 		if linked, _ := isGroupLinkedToDns(ctx, transaction, accountID, groupID); linked {
 			return true, nil
 		}
+		if linked, _ := isGroupLinkedToDNSBlocklist(ctx, transaction, accountID, groupID); linked {
+			return true, nil
+		}
+		if linked, _ := isGroupLinkedToDNSServiceName(ctx, transaction, accountID, groupID); linked {
+			return true, nil
+		}
 		if linked, _ := isGroupLinkedToPolicy(ctx, transaction, accountID, groupID); linked {
 			return true, nil
 		}
@@ -582,7 +582,7 @@ func TestGroupAccountPeersUpdate(t *testing.T) {
 				Port:   nbdns.DefaultDNSPort,
 			}},
 			[]string{"groupC"},
-			true, nil, true, userID, false,
+			true, nil, true, userID, false, false, nil,
 		)
 		assert.NoError(t, err)
 
@@ -623,7 +623,7 @@ func TestGroupAccountPeersUpdate(t *testing.T) {
 		_, err := manager.CreateRoute(
 			context.Background(), account.Id, newRoute.Network, newRoute.NetworkType, newRoute.Domains, newRoute.Peer,
 			newRoute.PeerGroups, newRoute.Description, newRoute.NetID, newRoute.Masquerade, newRoute.Metric,
-			newRoute.Groups, []string{}, true, userID, newRoute.KeepRoute,
+			newRoute.Groups, []string{}, true, userID, newRoute.KeepRoute, route.ExitNode{},
 		)
 		require.NoError(t, err)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 	pb "github.com/golang/protobuf/proto" // nolint
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/realip"
+	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"google.golang.org/grpc/codes"
@@ -20,6 +22,7 @@ import (
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/management/proto"
 	nbContext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/flows"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
@@ -42,6 +45,7 @@ type GRPCServer struct {
 	jwtClaimsExtractor *jwtclaims.ClaimsExtractor
 	appMetrics         telemetry.AppMetrics
 	ephemeralManager   *EphemeralManager
+	flowManager        flows.Manager
 	peerLocks          sync.Map
 }
 
@@ -55,6 +59,7 @@ func NewServer(
 	secretsManager SecretsManager,
 	appMetrics telemetry.AppMetrics,
 	ephemeralManager *EphemeralManager,
+	flowManager flows.Manager,
 ) (*GRPCServer, error) {
 	key, err := wgtypes.GeneratePrivateKey()
 	if err != nil {
@@ -110,6 +115,7 @@ func NewServer(
 		jwtClaimsExtractor: jwtClaimsExtractor,
 		appMetrics:         appMetrics,
 		ephemeralManager:   ephemeralManager,
+		flowManager:        flowManager,
 	}, nil
 }
 
@@ -392,7 +398,8 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 			Cloud:    meta.GetEnvironment().GetCloud(),
 			Platform: meta.GetEnvironment().GetPlatform(),
 		},
-		Files: files,
+		Files:         files,
+		IPv6Supported: meta.GetIpv6Supported(),
 	}
 }
 
@@ -490,7 +497,7 @@ func (s *GRPCServer) Login(ctx context.Context, req *proto.EncryptedMessage) (*p
 	// if peer has reached this point then it has logged in
 	loginResp := &proto.LoginResponse{
 		WiretrusteeConfig: toWiretrusteeConfig(s.config, nil, relayToken),
-		PeerConfig:        toPeerConfig(peer, netMap.Network, s.accountManager.GetDNSDomain(), false),
+		PeerConfig:        toPeerConfig(peer, netMap.Network, s.accountManager.GetDNSDomain(), nil),
 		Checks:            toProtocolChecks(ctx, postureChecks),
 	}
 	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, loginResp)
@@ -586,7 +593,8 @@ func toWiretrusteeConfig(config *Config, turnCredentials *Token, relayToken *Tok
 	var relayCfg *proto.RelayConfig
 	if config.Relay != nil && len(config.Relay.Addresses) > 0 {
 		relayCfg = &proto.RelayConfig{
-			Urls: config.Relay.Addresses,
+			Urls:    config.Relay.Addresses,
+			Regions: config.Relay.Regions,
 		}
 
 		if relayToken != nil {
@@ -606,21 +614,35 @@ func toWiretrusteeConfig(config *Config, turnCredentials *Token, relayToken *Tok
 	}
 }
 
-func toPeerConfig(peer *nbpeer.Peer, network *types.Network, dnsName string, dnsResolutionOnRoutingPeerEnabled bool) *proto.PeerConfig {
+// toPeerConfig converts the peer to the protocol peer config. Settings dependent fields are left unset if settings is nil.
+func toPeerConfig(peer *nbpeer.Peer, network *types.Network, dnsName string, settings *types.Settings) *proto.PeerConfig {
 	netmask, _ := network.Net.Mask.Size()
 	fqdn := peer.FQDN(dnsName)
-	return &proto.PeerConfig{
-		Address:                         fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
-		SshConfig:                       &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
-		Fqdn:                            fqdn,
-		RoutingPeerDnsResolutionEnabled: dnsResolutionOnRoutingPeerEnabled,
+	peerConfig := &proto.PeerConfig{
+		Address:   fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
+		SshConfig: &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
+		Fqdn:      fqdn,
 	}
+
+	if peer.SupportsIPv6() && network.HasNetV6() {
+		netmaskV6, _ := network.NetV6.Mask.Size()
+		peerConfig.AddressV6 = fmt.Sprintf("%s/%d", peer.IPv6.String(), netmaskV6)
+	}
+
+	if settings != nil {
+		peerConfig.RoutingPeerDnsResolutionEnabled = settings.RoutingPeerDNSResolutionEnabled
+		peerConfig.FlowConfig = &proto.FlowConfig{
+			Enabled: settings.NetworkTrafficLogsEnabled,
+		}
+	}
+
+	return peerConfig
 }
 
-func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *types.NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache, dnsResolutionOnRoutingPeerEnbled bool) *proto.SyncResponse {
+func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *types.NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache, settings *types.Settings) *proto.SyncResponse {
 	response := &proto.SyncResponse{
 		WiretrusteeConfig: toWiretrusteeConfig(config, turnCredentials, relayCredentials),
-		PeerConfig:        toPeerConfig(peer, networkMap.Network, dnsName, dnsResolutionOnRoutingPeerEnbled),
+		PeerConfig:        toPeerConfig(peer, networkMap.Network, dnsName, settings),
 		NetworkMap: &proto.NetworkMap{
 			Serial:    networkMap.Network.CurrentSerial(),
 			Routes:    toProtocolRoutes(networkMap.Routes),
@@ -629,16 +651,21 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 		Checks: toProtocolChecks(ctx, checks),
 	}
 
+	ipv6Enabled := peer.SupportsIPv6()
+	if !ipv6Enabled {
+		response.NetworkMap.DNSConfig = withoutIPv6Records(response.NetworkMap.DNSConfig)
+	}
+
 	response.NetworkMap.PeerConfig = response.PeerConfig
 
 	allPeers := make([]*proto.RemotePeerConfig, 0, len(networkMap.Peers)+len(networkMap.OfflinePeers))
-	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, dnsName)
+	allPeers = appendRemotePeerConfig(allPeers, networkMap.Peers, dnsName, ipv6Enabled)
 	response.RemotePeers = allPeers
 	response.NetworkMap.RemotePeers = allPeers
 	response.RemotePeersIsEmpty = len(allPeers) == 0
 	response.NetworkMap.RemotePeersIsEmpty = response.RemotePeersIsEmpty
 
-	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName)
+	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName, ipv6Enabled)
 
 	firewallRules := toProtocolFirewallRules(networkMap.FirewallRules)
 	response.NetworkMap.FirewallRules = firewallRules
@@ -651,11 +678,18 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 	return response
 }
 
-func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string) []*proto.RemotePeerConfig {
+// appendRemotePeerConfig converts the peers to remote peer configs. IPv6 addresses are only included if ipv6Enabled is true,
+// as older clients expect a single allowed IP.
+func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string, ipv6Enabled bool) []*proto.RemotePeerConfig {
 	for _, rPeer := range peers {
+		allowedIPs := []string{fmt.Sprintf(types.AllowedIPsFormat, rPeer.IP)}
+		if ipv6Enabled && rPeer.SupportsIPv6() {
+			allowedIPs = append(allowedIPs, fmt.Sprintf(types.AllowedIPsV6Format, rPeer.IPv6))
+		}
+
 		dst = append(dst, &proto.RemotePeerConfig{
 			WgPubKey:   rPeer.Key,
-			AllowedIps: []string{rPeer.IP.String() + "/32"},
+			AllowedIps: allowedIPs,
 			SshConfig:  &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey)},
 			Fqdn:       rPeer.FQDN(dnsName),
 		})
@@ -663,6 +697,27 @@ func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer,
 	return dst
 }
 
+// withoutIPv6Records returns a copy of the DNS config without AAAA records for peers that can't reach IPv6 addresses.
+// The config might be shared through the DNS config cache and must not be modified.
+func withoutIPv6Records(dnsConfig *proto.DNSConfig) *proto.DNSConfig {
+	if dnsConfig == nil {
+		return nil
+	}
+
+	filtered, ok := pb.Clone(dnsConfig).(*proto.DNSConfig)
+	if !ok {
+		return dnsConfig
+	}
+
+	for _, zone := range filtered.CustomZones {
+		zone.Records = slices.DeleteFunc(zone.Records, func(record *proto.SimpleRecord) bool {
+			return record.Type == int64(dns.TypeAAAA)
+		})
+	}
+
+	return filtered
+}
+
 // IsHealthy indicates whether the service is healthy
 func (s *GRPCServer) IsHealthy(ctx context.Context, req *proto.Empty) (*proto.Empty, error) {
 	return &proto.Empty{}, nil
@@ -693,7 +748,7 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 		return status.Errorf(codes.Internal, "error handling request")
 	}
 
-	plainResp := toSyncResponse(ctx, s.config, peer, turnToken, relayToken, networkMap, s.accountManager.GetDNSDomain(), postureChecks, nil, settings.RoutingPeerDNSResolutionEnabled)
+	plainResp := toSyncResponse(ctx, s.config, peer, turnToken, relayToken, networkMap, s.accountManager.GetDNSDomain(), postureChecks, nil, settings)
 
 	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, plainResp)
 	if err != nil {
@@ -838,6 +893,24 @@ func (s *GRPCServer) SyncMeta(ctx context.Context, req *proto.EncryptedMessage)
 	return &proto.Empty{}, nil
 }
 
+// SendFlowEvents stores the network traffic events collected by the peer
+func (s *GRPCServer) SendFlowEvents(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	flowEventsReq := &proto.FlowEventsRequest{}
+	peerKey, err := s.parseRequest(ctx, req, flowEventsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithContext(ctx).Tracef("received %d flow events from peer [%s]", len(flowEventsReq.GetEvents()), peerKey.String())
+
+	err = s.flowManager.SaveEvents(ctx, peerKey.String(), flowEventsReq.GetEvents())
+	if err != nil {
+		return nil, mapError(ctx, err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 // toProtocolChecks converts posture checks to protocol checks.
 func toProtocolChecks(ctx context.Context, postureChecks []*posture.Checks) []*proto.Checks {
 	protoChecks := make([]*proto.Checks, 0, len(postureChecks))
@@ -12,6 +12,40 @@ const (
 	TokenAuthScopes  = "TokenAuth.Scopes"
 )
 
+// Defines values for DNSBlocklistAction.
+const (
+	DNSBlocklistActionNxdomain DNSBlocklistAction = "nxdomain"
+	DNSBlocklistActionSinkhole DNSBlocklistAction = "sinkhole"
+)
+
+// Defines values for DNSBlocklistRequestAction.
+const (
+	DNSBlocklistRequestActionNxdomain DNSBlocklistRequestAction = "nxdomain"
+	DNSBlocklistRequestActionSinkhole DNSBlocklistRequestAction = "sinkhole"
+)
+
+// Defines values for DNSRecordType.
+const (
+	DNSRecordTypeA     DNSRecordType = "A"
+	DNSRecordTypeAAAA  DNSRecordType = "AAAA"
+	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	DNSRecordTypeMX    DNSRecordType = "MX"
+	DNSRecordTypePTR   DNSRecordType = "PTR"
+	DNSRecordTypeSRV   DNSRecordType = "SRV"
+	DNSRecordTypeTXT   DNSRecordType = "TXT"
+)
+
+// Defines values for DNSRecordRequestType.
+const (
+	DNSRecordRequestTypeA     DNSRecordRequestType = "A"
+	DNSRecordRequestTypeAAAA  DNSRecordRequestType = "AAAA"
+	DNSRecordRequestTypeCNAME DNSRecordRequestType = "CNAME"
+	DNSRecordRequestTypeMX    DNSRecordRequestType = "MX"
+	DNSRecordRequestTypePTR   DNSRecordRequestType = "PTR"
+	DNSRecordRequestTypeSRV   DNSRecordRequestType = "SRV"
+	DNSRecordRequestTypeTXT   DNSRecordRequestType = "TXT"
+)
+
 // Defines values for EventActivityCode.
 const (
 	EventActivityCodeAccountCreate                            EventActivityCode = "account.create"
@@ -74,6 +108,7 @@ const (
 	GroupIssuedApi         GroupIssued = "api"
 	GroupIssuedIntegration GroupIssued = "integration"
 	GroupIssuedJwt         GroupIssued = "jwt"
+	GroupIssuedScim        GroupIssued = "scim"
 )
 
 // Defines values for GroupMinimumIssued.
@@ -81,11 +116,14 @@ const (
 	GroupMinimumIssuedApi         GroupMinimumIssued = "api"
 	GroupMinimumIssuedIntegration GroupMinimumIssued = "integration"
 	GroupMinimumIssuedJwt         GroupMinimumIssued = "jwt"
+	GroupMinimumIssuedScim        GroupMinimumIssued = "scim"
 )
 
 // Defines values for NameserverNsType.
 const (
-	NameserverNsTypeUdp NameserverNsType = "udp"
+	NameserverNsTypeHttps NameserverNsType = "https"
+	NameserverNsTypeTls   NameserverNsType = "tls"
+	NameserverNsTypeUdp   NameserverNsType = "udp"
 )
 
 // Defines values for NetworkResourceType.
@@ -95,6 +133,19 @@ const (
 	NetworkResourceTypeSubnet NetworkResourceType = "subnet"
 )
 
+// Defines values for NetworkTrafficEventDirection.
+const (
+	NetworkTrafficEventDirectionEgress  NetworkTrafficEventDirection = "egress"
+	NetworkTrafficEventDirectionIngress NetworkTrafficEventDirection = "ingress"
+)
+
+// Defines values for NetworkTrafficEventType.
+const (
+	NetworkTrafficEventTypeDrop  NetworkTrafficEventType = "drop"
+	NetworkTrafficEventTypeEnd   NetworkTrafficEventType = "end"
+	NetworkTrafficEventTypeStart NetworkTrafficEventType = "start"
+)
+
 // Defines values for PeerNetworkRangeCheckAction.
 const (
 	PeerNetworkRangeCheckActionAllow PeerNetworkRangeCheckAction = "allow"
@@ -150,6 +201,41 @@ const (
 	ResourceTypeSubnet ResourceType = "subnet"
 )
 
+// Defines values for RolePermissionModule.
+const (
+	RolePermissionModuleDns           RolePermissionModule = "dns"
+	RolePermissionModuleEvents        RolePermissionModule = "events"
+	RolePermissionModuleGroups        RolePermissionModule = "groups"
+	RolePermissionModuleNetworks      RolePermissionModule = "networks"
+	RolePermissionModulePats          RolePermissionModule = "pats"
+	RolePermissionModulePeers         RolePermissionModule = "peers"
+	RolePermissionModulePolicies      RolePermissionModule = "policies"
+	RolePermissionModulePostureChecks RolePermissionModule = "posture_checks"
+	RolePermissionModuleRoutes        RolePermissionModule = "routes"
+	RolePermissionModuleSettings      RolePermissionModule = "settings"
+	RolePermissionModuleSetupKeys     RolePermissionModule = "setup_keys"
+	RolePermissionModuleUsers         RolePermissionModule = "users"
+)
+
+// Defines values for RolePermissionOperations.
+const (
+	RolePermissionOperationsCreate RolePermissionOperations = "create"
+	RolePermissionOperationsDelete RolePermissionOperations = "delete"
+	RolePermissionOperationsRead   RolePermissionOperations = "read"
+	RolePermissionOperationsUpdate RolePermissionOperations = "update"
+)
+
+// Defines values for ScheduleWindowDays.
+const (
+	ScheduleWindowDaysFriday    ScheduleWindowDays = "friday"
+	ScheduleWindowDaysMonday    ScheduleWindowDays = "monday"
+	ScheduleWindowDaysSaturday  ScheduleWindowDays = "saturday"
+	ScheduleWindowDaysSunday    ScheduleWindowDays = "sunday"
+	ScheduleWindowDaysThursday  ScheduleWindowDays = "thursday"
+	ScheduleWindowDaysTuesday   ScheduleWindowDays = "tuesday"
+	ScheduleWindowDaysWednesday ScheduleWindowDays = "wednesday"
+)
+
 // Defines values for UserStatus.
 const (
 	UserStatusActive  UserStatus = "active"
@@ -164,6 +250,25 @@ const (
 	UserPermissionsDashboardViewLimited UserPermissionsDashboardView = "limited"
 )
 
+// Defines values for GetApiEventsExportParamsFormat.
+const (
+	GetApiEventsExportParamsFormatCsv    GetApiEventsExportParamsFormat = "csv"
+	GetApiEventsExportParamsFormatNdjson GetApiEventsExportParamsFormat = "ndjson"
+)
+
+// Defines values for GetApiEventsNetworkTrafficParamsType.
+const (
+	GetApiEventsNetworkTrafficParamsTypeDrop  GetApiEventsNetworkTrafficParamsType = "drop"
+	GetApiEventsNetworkTrafficParamsTypeEnd   GetApiEventsNetworkTrafficParamsType = "end"
+	GetApiEventsNetworkTrafficParamsTypeStart GetApiEventsNetworkTrafficParamsType = "start"
+)
+
+// Defines values for GetApiEventsNetworkTrafficParamsDirection.
+const (
+	GetApiEventsNetworkTrafficParamsDirectionEgress  GetApiEventsNetworkTrafficParamsDirection = "egress"
+	GetApiEventsNetworkTrafficParamsDirectionIngress GetApiEventsNetworkTrafficParamsDirection = "ingress"
+)
+
 // AccessiblePeer defines model for AccessiblePeer.
 type AccessiblePeer struct {
 	// CityName Commonly used English name of the city
@@ -187,6 +292,9 @@ type AccessiblePeer struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's IPv6 overlay address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// LastSeen Last time peer connected to Netbird's management service
 	LastSeen time.Time `json:"last_seen"`
 
@@ -209,8 +317,11 @@ type Account struct {
 
 // AccountExtraSettings defines model for AccountExtraSettings.
 type AccountExtraSettings struct {
-	// PeerApprovalEnabled (Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
+	// PeerApprovalEnabled Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
 	PeerApprovalEnabled *bool `json:"peer_approval_enabled,omitempty"`
+
+	// PeerApprovalExemptSetupKeys Setup key IDs whose peers are approved on registration when peer approval is enabled
+	PeerApprovalExemptSetupKeys *[]string `json:"peer_approval_exempt_setup_keys,omitempty"`
 }
 
 // AccountRequest defines model for AccountRequest.
@@ -220,7 +331,9 @@ type AccountRequest struct {
 
 // AccountSettings defines model for AccountSettings.
 type AccountSettings struct {
-	Extra *AccountExtraSettings `json:"extra,omitempty"`
+	// EventRetentionDays Number of days the activity and network traffic events are kept, 0 keeps the activity events forever and the network traffic events for 30 days
+	EventRetentionDays *int                  `json:"event_retention_days,omitempty"`
+	Extra              *AccountExtraSettings `json:"extra,omitempty"`
 
 	// GroupsPropagationEnabled Allows propagate the new user auto groups to peers that belongs to the user
 	GroupsPropagationEnabled *bool `json:"groups_propagation_enabled,omitempty"`
@@ -234,6 +347,9 @@ type AccountSettings struct {
 	// JwtGroupsEnabled Allows extract groups from JWT claim and add it to account groups.
 	JwtGroupsEnabled *bool `json:"jwt_groups_enabled,omitempty"`
 
+	// NetworkTrafficLogsEnabled Enables or disables the collection of network traffic events from the peers
+	NetworkTrafficLogsEnabled *bool `json:"network_traffic_logs_enabled,omitempty"`
+
 	// PeerInactivityExpiration Period of time of inactivity after which peer session expires (seconds).
 	PeerInactivityExpiration int `json:"peer_inactivity_expiration"`
 
@@ -316,6 +432,171 @@ type CreateSetupKeyRequest struct {
 	UsageLimit int `json:"usage_limit"`
 }
 
+// DNSBlocklist defines model for DNSBlocklist.
+type DNSBlocklist struct {
+	// Action Answer for blocked queries, NXDOMAIN or the sinkhole addresses
+	Action *DNSBlocklistAction `json:"action,omitempty"`
+
+	// Description Blocklist description
+	Description *string `json:"description,omitempty"`
+
+	// Domains Blocked domains, their subdomains are blocked as well
+	Domains []string `json:"domains"`
+
+	// DomainsCount Number of domains the blocklist blocks, including the domains of its source
+	DomainsCount int `json:"domains_count"`
+
+	// Enabled Blocklist status
+	Enabled bool `json:"enabled"`
+
+	// ExemptGroups Peer group IDs the blocklist is not applied to
+	ExemptGroups []string `json:"exempt_groups"`
+
+	// Id DNS blocklist ID
+	Id string `json:"id"`
+
+	// Name Blocklist name
+	Name string `json:"name"`
+
+	// SinkholeIpv4 Address returned for blocked A queries with the sinkhole action, defaults to 0.0.0.0
+	SinkholeIpv4 *string `json:"sinkhole_ipv4,omitempty"`
+
+	// SinkholeIpv6 Address returned for blocked AAAA queries with the sinkhole action, defaults to ::
+	SinkholeIpv6 *string `json:"sinkhole_ipv6,omitempty"`
+
+	// SourceError Error of the last source fetch, empty if it succeeded
+	SourceError string `json:"source_error"`
+
+	// SourceUpdatedAt Last time the source was fetched successfully
+	SourceUpdatedAt *time.Time `json:"source_updated_at,omitempty"`
+
+	// SourceUrl URL of a hosts file, domain list or adblock list that is fetched by the management server daily
+	SourceUrl *string `json:"source_url,omitempty"`
+}
+
+// DNSBlocklistAction Answer for blocked queries, NXDOMAIN or the sinkhole addresses
+type DNSBlocklistAction string
+
+// DNSBlocklistRequest defines model for DNSBlocklistRequest.
+type DNSBlocklistRequest struct {
+	// Action Answer for blocked queries, NXDOMAIN or the sinkhole addresses
+	Action *DNSBlocklistRequestAction `json:"action,omitempty"`
+
+	// Description Blocklist description
+	Description *string `json:"description,omitempty"`
+
+	// Domains Blocked domains, their subdomains are blocked as well
+	Domains []string `json:"domains"`
+
+	// Enabled Blocklist status
+	Enabled bool `json:"enabled"`
+
+	// ExemptGroups Peer group IDs the blocklist is not applied to
+	ExemptGroups []string `json:"exempt_groups"`
+
+	// Name Blocklist name
+	Name string `json:"name"`
+
+	// SinkholeIpv4 Address returned for blocked A queries with the sinkhole action, defaults to 0.0.0.0
+	SinkholeIpv4 *string `json:"sinkhole_ipv4,omitempty"`
+
+	// SinkholeIpv6 Address returned for blocked AAAA queries with the sinkhole action, defaults to ::
+	SinkholeIpv6 *string `json:"sinkhole_ipv6,omitempty"`
+
+	// SourceUrl URL of a hosts file, domain list or adblock list that is fetched by the management server daily
+	SourceUrl *string `json:"source_url,omitempty"`
+}
+
+// DNSBlocklistRequestAction Answer for blocked queries, NXDOMAIN or the sinkhole addresses
+type DNSBlocklistRequestAction string
+
+// DNSRecord defines model for DNSRecord.
+type DNSRecord struct {
+	// Content Record data in zone file presentation format. Relative names are resolved against the account DNS zone.
+	Content string `json:"content"`
+
+	// Fqdn Fully qualified name of the record
+	Fqdn string `json:"fqdn"`
+
+	// Id DNS record ID
+	Id string `json:"id"`
+
+	// Name Record name relative to the account DNS zone. Use "@" for the zone apex and a leading "*." for wildcard records. PTR records are named by the IPv4 address of the account network they resolve.
+	Name string `json:"name"`
+
+	// Ttl Record time-to-live in seconds, defaults to 300
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Type Record type
+	Type DNSRecordType `json:"type"`
+}
+
+// DNSRecordType Record type
+type DNSRecordType string
+
+// DNSRecordRequest defines model for DNSRecordRequest.
+type DNSRecordRequest struct {
+	// Content Record data in zone file presentation format. Relative names are resolved against the account DNS zone.
+	Content string `json:"content"`
+
+	// Name Record name relative to the account DNS zone. Use "@" for the zone apex and a leading "*." for wildcard records. PTR records are named by the IPv4 address of the account network they resolve.
+	Name string `json:"name"`
+
+	// Ttl Record time-to-live in seconds, defaults to 300
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Type Record type
+	Type DNSRecordRequestType `json:"type"`
+}
+
+// DNSRecordRequestType Record type
+type DNSRecordRequestType string
+
+// DNSServiceName defines model for DNSServiceName.
+type DNSServiceName struct {
+	// Description Service name description
+	Description *string `json:"description,omitempty"`
+
+	// Enabled Service name status
+	Enabled bool `json:"enabled"`
+
+	// Fqdn Fully qualified service name
+	Fqdn string `json:"fqdn"`
+
+	// Groups Peer group IDs whose peers serve the name
+	Groups []string `json:"groups"`
+
+	// HealthyPeers IDs of the connected or recently seen peers the name currently resolves to
+	HealthyPeers []string `json:"healthy_peers"`
+
+	// Id DNS service name ID
+	Id string `json:"id"`
+
+	// Name Service name relative to the account DNS domain
+	Name string `json:"name"`
+
+	// Peers Peer IDs serving the name
+	Peers []string `json:"peers"`
+}
+
+// DNSServiceNameRequest defines model for DNSServiceNameRequest.
+type DNSServiceNameRequest struct {
+	// Description Service name description
+	Description *string `json:"description,omitempty"`
+
+	// Enabled Service name status
+	Enabled bool `json:"enabled"`
+
+	// Groups Peer group IDs whose peers serve the name
+	Groups []string `json:"groups"`
+
+	// Name Service name relative to the account DNS domain
+	Name string `json:"name"`
+
+	// Peers Peer IDs serving the name
+	Peers []string `json:"peers"`
+}
+
 // DNSSettings defines model for DNSSettings.
 type DNSSettings struct {
 	// DisabledManagementGroups Groups whose DNS management is disabled
@@ -372,7 +653,7 @@ type Group struct {
 	// Id Group ID
 	Id string `json:"id"`
 
-	// Issued How the group was issued (api, integration, jwt)
+	// Issued How the group was issued (api, integration, jwt, scim)
 	Issued *GroupIssued `json:"issued,omitempty"`
 
 	// Name Group Name identifier
@@ -389,7 +670,7 @@ type Group struct {
 	ResourcesCount int `json:"resources_count"`
 }
 
-// GroupIssued How the group was issued (api, integration, jwt)
+// GroupIssued How the group was issued (api, integration, jwt, scim)
 type GroupIssued string
 
 // GroupMinimum defines model for GroupMinimum.
@@ -397,7 +678,7 @@ type GroupMinimum struct {
 	// Id Group ID
 	Id string `json:"id"`
 
-	// Issued How the group was issued (api, integration, jwt)
+	// Issued How the group was issued (api, integration, jwt, scim)
 	Issued *GroupMinimumIssued `json:"issued,omitempty"`
 
 	// Name Group Name identifier
@@ -410,7 +691,7 @@ type GroupMinimum struct {
 	ResourcesCount int `json:"resources_count"`
 }
 
-// GroupMinimumIssued How the group was issued (api, integration, jwt)
+// GroupMinimumIssued How the group was issued (api, integration, jwt, scim)
 type GroupMinimumIssued string
 
 // GroupRequest defines model for GroupRequest.
@@ -449,12 +730,18 @@ type NBVersionCheck = MinVersionCheck
 
 // Nameserver defines model for Nameserver.
 type Nameserver struct {
+	// Hostname Hostname used for SNI and certificate verification of tls and https nameservers. The IP is verified if empty
+	Hostname *string `json:"hostname,omitempty"`
+
 	// Ip Nameserver IP
 	Ip string `json:"ip"`
 
 	// NsType Nameserver Type
 	NsType NameserverNsType `json:"ns_type"`
 
+	// Path URL path of https nameservers
+	Path *string `json:"path,omitempty"`
+
 	// Port Nameserver Port
 	Port int `json:"port"`
 }
@@ -467,6 +754,12 @@ type NameserverGroup struct {
 	// Description Description of the nameserver group
 	Description string `json:"description"`
 
+	// DnssecTrustAnchors DS records in presentation format that are used as trust anchors instead of the root zone trust anchors. It should be empty if DNSSEC validation is disabled.
+	DnssecTrustAnchors *[]string `json:"dnssec_trust_anchors,omitempty"`
+
+	// DnssecValidation Defines if peers validate the DNSSEC signatures of the answers of this nameserver group. Answers that fail validation are answered with SERVFAIL.
+	DnssecValidation *bool `json:"dnssec_validation,omitempty"`
+
 	// Domains Match domain list. It should be empty only if primary is true.
 	Domains []string `json:"domains"`
 
@@ -497,6 +790,12 @@ type NameserverGroupRequest struct {
 	// Description Description of the nameserver group
 	Description string `json:"description"`
 
+	// DnssecTrustAnchors DS records in presentation format that are used as trust anchors instead of the root zone trust anchors. It should be empty if DNSSEC validation is disabled.
+	DnssecTrustAnchors *[]string `json:"dnssec_trust_anchors,omitempty"`
+
+	// DnssecValidation Defines if peers validate the DNSSEC signatures of the answers of this nameserver group. Answers that fail validation are answered with SERVFAIL.
+	DnssecValidation *bool `json:"dnssec_validation,omitempty"`
+
 	// Domains Match domain list. It should be empty only if primary is true.
 	Domains []string `json:"domains"`
 
@@ -524,6 +823,9 @@ type Network struct {
 	// Description Network description
 	Description *string `json:"description,omitempty"`
 
+	// DnsUpstreams DNS resolvers the routing peers relay the queries of the network domain resources to, as IP or IP:port. The system resolvers of the routing peers are used when empty
+	DnsUpstreams *[]string `json:"dns_upstreams,omitempty"`
+
 	// Id Network ID
 	Id string `json:"id"`
 
@@ -548,6 +850,9 @@ type NetworkRequest struct {
 	// Description Network description
 	Description *string `json:"description,omitempty"`
 
+	// DnsUpstreams DNS resolvers the routing peers relay the queries of the network domain resources to, as IP or IP:port. The system resolvers of the routing peers are used when empty
+	DnsUpstreams *[]string `json:"dns_upstreams,omitempty"`
+
 	// Name Network name
 	Name string `json:"name"`
 }
@@ -651,6 +956,87 @@ type NetworkRouterRequest struct {
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
 }
 
+// NetworkTrafficEvent defines model for NetworkTrafficEvent.
+type NetworkTrafficEvent struct {
+	// DestinationIp Destination IP address of the flow
+	DestinationIp string `json:"destination_ip"`
+
+	// DestinationPort Destination port of the flow. Only set for TCP and UDP.
+	DestinationPort int `json:"destination_port"`
+
+	// Direction Direction of the flow relative to the reporting peer
+	Direction NetworkTrafficEventDirection `json:"direction"`
+
+	// FlowId ID of the flow the event belongs to. Start and end events of the same connection share this ID.
+	FlowId string `json:"flow_id"`
+
+	// IcmpCode ICMP code of the flow. Only set for ICMP.
+	IcmpCode int `json:"icmp_code"`
+
+	// IcmpType ICMP type of the flow. Only set for ICMP.
+	IcmpType int `json:"icmp_type"`
+
+	// Id Event ID
+	Id string `json:"id"`
+
+	// PeerId ID of the peer that reported the event
+	PeerId string `json:"peer_id"`
+
+	// PolicyId ID of the policy that matched the flow. Empty if no policy matched.
+	PolicyId string `json:"policy_id"`
+
+	// Protocol IANA protocol number of the flow
+	Protocol int `json:"protocol"`
+
+	// RxBytes Number of bytes received by the reporting peer. Only set for end events.
+	RxBytes int64 `json:"rx_bytes"`
+
+	// RxPackets Number of packets received by the reporting peer. Only set for end events.
+	RxPackets int64 `json:"rx_packets"`
+
+	// SourceIp Source IP address of the flow
+	SourceIp string `json:"source_ip"`
+
+	// SourcePort Source port of the flow. Only set for TCP and UDP.
+	SourcePort int `json:"source_port"`
+
+	// Timestamp Timestamp of the event as reported by the peer
+	Timestamp time.Time `json:"timestamp"`
+
+	// TxBytes Number of bytes sent by the reporting peer. Only set for end events.
+	TxBytes int64 `json:"tx_bytes"`
+
+	// TxPackets Number of packets sent by the reporting peer. Only set for end events.
+	TxPackets int64 `json:"tx_packets"`
+
+	// Type Type of the event
+	Type NetworkTrafficEventType `json:"type"`
+}
+
+// NetworkTrafficEventDirection Direction of the flow relative to the reporting peer
+type NetworkTrafficEventDirection string
+
+// NetworkTrafficEventType Type of the event
+type NetworkTrafficEventType string
+
+// NetworkTrafficEventsResponse defines model for NetworkTrafficEventsResponse.
+type NetworkTrafficEventsResponse struct {
+	// Data List of network traffic events
+	Data []NetworkTrafficEvent `json:"data"`
+
+	// Page Current page number
+	Page int `json:"page"`
+
+	// PageSize Number of items per page
+	PageSize int `json:"page_size"`
+
+	// TotalPages Total number of pages available
+	TotalPages int `json:"total_pages"`
+
+	// TotalRecords Total number of events matching the filters
+	TotalRecords int `json:"total_records"`
+}
+
 // OSVersionCheck Posture check for the version of operating system
 type OSVersionCheck struct {
 	// Android Posture check for the version of operating system
@@ -671,7 +1057,7 @@ type OSVersionCheck struct {
 
 // Peer defines model for Peer.
 type Peer struct {
-	// ApprovalRequired (Cloud only) Indicates whether peer needs approval
+	// ApprovalRequired Indicates whether peer needs approval
 	ApprovalRequired bool `json:"approval_required"`
 
 	// CityName Commonly used English name of the city
@@ -707,6 +1093,9 @@ type Peer struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's IPv6 overlay address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
@@ -749,7 +1138,7 @@ type PeerBatch struct {
 	// AccessiblePeersCount Number of accessible peers
 	AccessiblePeersCount int `json:"accessible_peers_count"`
 
-	// ApprovalRequired (Cloud only) Indicates whether peer needs approval
+	// ApprovalRequired Indicates whether peer needs approval
 	ApprovalRequired bool `json:"approval_required"`
 
 	// CityName Commonly used English name of the city
@@ -785,6 +1174,9 @@ type PeerBatch struct {
 	// Ip Peer's IP address
 	Ip string `json:"ip"`
 
+	// Ipv6 Peer's IPv6 overlay address
+	Ipv6 *string `json:"ipv6,omitempty"`
+
 	// KernelVersion Peer's operating system kernel version
 	KernelVersion string `json:"kernel_version"`
 
@@ -845,7 +1237,7 @@ type PeerNetworkRangeCheckAction string
 
 // PeerRequest defines model for PeerRequest.
 type PeerRequest struct {
-	// ApprovalRequired (Cloud only) Indicates whether peer needs approval
+	// ApprovalRequired Indicates whether peer needs approval
 	ApprovalRequired            *bool  `json:"approval_required,omitempty"`
 	InactivityExpirationEnabled bool   `json:"inactivity_expiration_enabled"`
 	LoginExpirationEnabled      bool   `json:"login_expiration_enabled"`
@@ -973,8 +1365,11 @@ type PolicyRule struct {
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
-	Protocol       PolicyRuleProtocol `json:"protocol"`
-	SourceResource *Resource          `json:"sourceResource,omitempty"`
+	Protocol PolicyRuleProtocol `json:"protocol"`
+
+	// Schedule Limits the time when the policy rule is applied. The rule is always applied if not set.
+	Schedule       *RuleSchedule `json:"schedule,omitempty"`
+	SourceResource *Resource     `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
 	Sources *[]GroupMinimum `json:"sources,omitempty"`
@@ -1011,6 +1406,9 @@ type PolicyRuleMinimum struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleMinimumProtocol `json:"protocol"`
+
+	// Schedule Limits the time when the policy rule is applied. The rule is always applied if not set.
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
 }
 
 // PolicyRuleMinimumAction Policy rule accept or drops packets
@@ -1050,8 +1448,11 @@ type PolicyRuleUpdate struct {
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
-	Protocol       PolicyRuleUpdateProtocol `json:"protocol"`
-	SourceResource *Resource                `json:"sourceResource,omitempty"`
+	Protocol PolicyRuleUpdateProtocol `json:"protocol"`
+
+	// Schedule Limits the time when the policy rule is applied. The rule is always applied if not set.
+	Schedule       *RuleSchedule `json:"schedule,omitempty"`
+	SourceResource *Resource     `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
 	Sources *[]string `json:"sources,omitempty"`
@@ -1135,6 +1536,48 @@ type Resource struct {
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// Role defines model for Role.
+type Role struct {
+	// Description Role description
+	Description *string `json:"description,omitempty"`
+
+	// Id Role ID. Assign the role to a user by setting the user role to this ID.
+	Id string `json:"id"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted to the users with this role
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// RolePermission defines model for RolePermission.
+type RolePermission struct {
+	// Module Resource type the operations are granted for
+	Module RolePermissionModule `json:"module"`
+
+	// Operations Operations allowed on the resource type
+	Operations []RolePermissionOperations `json:"operations"`
+}
+
+// RolePermissionModule Resource type the operations are granted for
+type RolePermissionModule string
+
+// RolePermissionOperations defines model for RolePermission.Operations.
+type RolePermissionOperations string
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	// Description Role description
+	Description *string `json:"description,omitempty"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted to the users with this role
+	Permissions []RolePermission `json:"permissions"`
+}
+
 // Route defines model for Route.
 type Route struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -1149,6 +1592,9 @@ type Route struct {
 	// Enabled Route status
 	Enabled bool `json:"enabled"`
 
+	// ExitNode Exit node settings of a default route (0.0.0.0/0 or ::/0). Peers can explicitly choose an exit node.
+	ExitNode *RouteExitNode `json:"exit_node,omitempty"`
+
 	// Groups Group IDs containing routing peers
 	Groups []string `json:"groups"`
 
@@ -1180,6 +1626,18 @@ type Route struct {
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
 }
 
+// RouteExitNode Exit node settings of a default route (0.0.0.0/0 or ::/0). Peers can explicitly choose an exit node.
+type RouteExitNode struct {
+	// AllowLanAccess Indicate if peers using the exit node can access the local networks of the routing peer
+	AllowLanAccess *bool `json:"allow_lan_access,omitempty"`
+
+	// Enabled Indicate if the route is an exit node
+	Enabled bool `json:"enabled"`
+
+	// UserGroups Group IDs of users allowed to use the exit node. Empty allows all users
+	UserGroups *[]string `json:"user_groups,omitempty"`
+}
+
 // RouteRequest defines model for RouteRequest.
 type RouteRequest struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -1194,6 +1652,9 @@ type RouteRequest struct {
 	// Enabled Route status
 	Enabled bool `json:"enabled"`
 
+	// ExitNode Exit node settings of a default route (0.0.0.0/0 or ::/0). Peers can explicitly choose an exit node.
+	ExitNode *RouteExitNode `json:"exit_node,omitempty"`
+
 	// Groups Group IDs containing routing peers
 	Groups []string `json:"groups"`
 
@@ -1228,6 +1689,58 @@ type RulePortRange struct {
 	Start int `json:"start"`
 }
 
+// RuleSchedule Limits the time when the policy rule is applied. The rule is always applied if not set.
+type RuleSchedule struct {
+	// Timezone IANA time zone name the windows are evaluated in. UTC is used if empty.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// ValidFrom Time from which the rule is applied
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+
+	// ValidUntil Time until which the rule is applied
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+
+	// Windows Recurring weekly periods when the rule is applied. If empty, the rule is applied at any time within the validity period.
+	Windows []ScheduleWindow `json:"windows"`
+}
+
+// SCIMToken defines model for SCIMToken.
+type SCIMToken struct {
+	// CreatedAt Date the token was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User ID of the user who created the token
+	CreatedBy string `json:"created_by"`
+
+	// Id ID of the token
+	Id string `json:"id"`
+
+	// LastUsed Date the identity provider last used the token
+	LastUsed *time.Time `json:"last_used,omitempty"`
+}
+
+// SCIMTokenGenerated defines model for SCIMTokenGenerated.
+type SCIMTokenGenerated struct {
+	// PlainToken Plain text representation of the generated token. It is only returned once.
+	PlainToken string    `json:"plain_token"`
+	ScimToken  SCIMToken `json:"scim_token"`
+}
+
+// ScheduleWindow Recurring daily period of time on a set of week days
+type ScheduleWindow struct {
+	// Days Days of the week the window applies to. If empty, the window applies to every day.
+	Days []ScheduleWindowDays `json:"days"`
+
+	// End End of the window in HH:MM format. If not after the start, the window ends on the following day.
+	End string `json:"end"`
+
+	// Start Start of the window in HH:MM format
+	Start string `json:"start"`
+}
+
+// ScheduleWindowDays defines model for ScheduleWindow.Days.
+type ScheduleWindowDays string
+
 // SetupKey defines model for SetupKey.
 type SetupKey struct {
 	// AutoGroups List of group IDs to auto-assign to peers registered with this key
@@ -1399,7 +1912,7 @@ type User struct {
 	Name        string           `json:"name"`
 	Permissions *UserPermissions `json:"permissions,omitempty"`
 
-	// Role User's NetBird account role
+	// Role User's NetBird account role. Either one of the predefined roles (owner, admin, user, billing_admin) or a custom role ID
 	Role string `json:"role"`
 
 	// Status User's status
@@ -1423,7 +1936,7 @@ type UserCreateRequest struct {
 	// Name User's full name
 	Name *string `json:"name,omitempty"`
 
-	// Role User's NetBird account role
+	// Role User's NetBird account role. Either one of the predefined roles (owner, admin, user, billing_admin) or a custom role ID
 	Role string `json:"role"`
 }
 
@@ -1444,10 +1957,94 @@ type UserRequest struct {
 	// IsBlocked If set to true then user is blocked and can't use the system
 	IsBlocked bool `json:"is_blocked"`
 
-	// Role User's NetBird account role
+	// Role User's NetBird account role. Either one of the predefined roles (owner, admin, user, billing_admin) or a custom role ID
 	Role string `json:"role"`
 }
 
+// GetApiEventsParams defines parameters for GetApiEvents.
+type GetApiEventsParams struct {
+	// ActivityCode Filters events by activity code, can be repeated or contain comma separated codes
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filters events initiated by the given user, peer or setup key
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filters events that affected the given object
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// PeerId Filters events that were initiated by or affected the given peer
+	PeerId *string `form:"peer_id,omitempty" json:"peer_id,omitempty"`
+
+	// StartDate Returns events that happened at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns events that happened at or before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetApiEventsExportParams defines parameters for GetApiEventsExport.
+type GetApiEventsExportParams struct {
+	// ActivityCode Filters events by activity code, can be repeated or contain comma separated codes
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filters events initiated by the given user, peer or setup key
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filters events that affected the given object
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// PeerId Filters events that were initiated by or affected the given peer
+	PeerId *string `form:"peer_id,omitempty" json:"peer_id,omitempty"`
+
+	// StartDate Returns events that happened at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns events that happened at or before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Format Export format
+	Format *GetApiEventsExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiEventsExportParamsFormat defines parameters for GetApiEventsExport.
+type GetApiEventsExportParamsFormat string
+
+// GetApiEventsNetworkTrafficParams defines parameters for GetApiEventsNetworkTraffic.
+type GetApiEventsNetworkTrafficParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Number of items per page
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PeerId Filters events reported by the given peer
+	PeerId *string `form:"peer_id,omitempty" json:"peer_id,omitempty"`
+
+	// PolicyId Filters events matched by the given policy
+	PolicyId *string `form:"policy_id,omitempty" json:"policy_id,omitempty"`
+
+	// Type Filters events by type
+	Type *GetApiEventsNetworkTrafficParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Direction Filters events by direction
+	Direction *GetApiEventsNetworkTrafficParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Protocol Filters events by IANA protocol number
+	Protocol *int `form:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// StartDate Returns events that happened at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns events that happened at or before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetApiEventsNetworkTrafficParamsType defines parameters for GetApiEventsNetworkTraffic.
+type GetApiEventsNetworkTrafficParamsType string
+
+// GetApiEventsNetworkTrafficParamsDirection defines parameters for GetApiEventsNetworkTraffic.
+type GetApiEventsNetworkTrafficParamsDirection string
+
 // GetApiUsersParams defines parameters for GetApiUsers.
 type GetApiUsersParams struct {
 	// ServiceUser Filters users and returns either regular users or service users
@@ -1457,12 +2054,30 @@ type GetApiUsersParams struct {
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
+// PostApiDnsBlocklistsJSONRequestBody defines body for PostApiDnsBlocklists for application/json ContentType.
+type PostApiDnsBlocklistsJSONRequestBody = DNSBlocklistRequest
+
+// PutApiDnsBlocklistsBlocklistIdJSONRequestBody defines body for PutApiDnsBlocklistsBlocklistId for application/json ContentType.
+type PutApiDnsBlocklistsBlocklistIdJSONRequestBody = DNSBlocklistRequest
+
 // PostApiDnsNameserversJSONRequestBody defines body for PostApiDnsNameservers for application/json ContentType.
 type PostApiDnsNameserversJSONRequestBody = NameserverGroupRequest
 
 // PutApiDnsNameserversNsgroupIdJSONRequestBody defines body for PutApiDnsNameserversNsgroupId for application/json ContentType.
 type PutApiDnsNameserversNsgroupIdJSONRequestBody = NameserverGroupRequest
 
+// PostApiDnsRecordsJSONRequestBody defines body for PostApiDnsRecords for application/json ContentType.
+type PostApiDnsRecordsJSONRequestBody = DNSRecordRequest
+
+// PutApiDnsRecordsRecordIdJSONRequestBody defines body for PutApiDnsRecordsRecordId for application/json ContentType.
+type PutApiDnsRecordsRecordIdJSONRequestBody = DNSRecordRequest
+
+// PostApiDnsServiceNamesJSONRequestBody defines body for PostApiDnsServiceNames for application/json ContentType.
+type PostApiDnsServiceNamesJSONRequestBody = DNSServiceNameRequest
+
+// PutApiDnsServiceNamesServiceNameIdJSONRequestBody defines body for PutApiDnsServiceNamesServiceNameId for application/json ContentType.
+type PutApiDnsServiceNamesServiceNameIdJSONRequestBody = DNSServiceNameRequest
+
 // PutApiDnsSettingsJSONRequestBody defines body for PutApiDnsSettings for application/json ContentType.
 type PutApiDnsSettingsJSONRequestBody = DNSSettings
 
@@ -1505,6 +2120,12 @@ type PostApiPostureChecksJSONRequestBody = PostureCheckUpdate
 // PutApiPostureChecksPostureCheckIdJSONRequestBody defines body for PutApiPostureChecksPostureCheckId for application/json ContentType.
 type PutApiPostureChecksPostureCheckIdJSONRequestBody = PostureCheckUpdate
 
+// PostApiRolesJSONRequestBody defines body for PostApiRoles for application/json ContentType.
+type PostApiRolesJSONRequestBody = RoleRequest
+
+// PutApiRolesRoleIdJSONRequestBody defines body for PutApiRolesRoleId for application/json ContentType.
+type PutApiRolesRoleIdJSONRequestBody = RoleRequest
+
 // PostApiRoutesJSONRequestBody defines body for PostApiRoutes for application/json ContentType.
 type PostApiRoutesJSONRequestBody = RouteRequest
 
@@ -93,7 +93,13 @@ func (h *handler) updateAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if req.Settings.Extra != nil {
-		settings.Extra = &account.ExtraSettings{PeerApprovalEnabled: *req.Settings.Extra.PeerApprovalEnabled}
+		settings.Extra = &account.ExtraSettings{}
+		if req.Settings.Extra.PeerApprovalEnabled != nil {
+			settings.Extra.PeerApprovalEnabled = *req.Settings.Extra.PeerApprovalEnabled
+		}
+		if req.Settings.Extra.PeerApprovalExemptSetupKeys != nil {
+			settings.Extra.PeerApprovalExemptSetupKeys = *req.Settings.Extra.PeerApprovalExemptSetupKeys
+		}
 	}
 
 	if req.Settings.JwtGroupsEnabled != nil {
@@ -111,6 +117,12 @@ func (h *handler) updateAccount(w http.ResponseWriter, r *http.Request) {
 	if req.Settings.RoutingPeerDnsResolutionEnabled != nil {
 		settings.RoutingPeerDNSResolutionEnabled = *req.Settings.RoutingPeerDnsResolutionEnabled
 	}
+	if req.Settings.NetworkTrafficLogsEnabled != nil {
+		settings.NetworkTrafficLogsEnabled = *req.Settings.NetworkTrafficLogsEnabled
+	}
+	if req.Settings.EventRetentionDays != nil {
+		settings.EventRetentionDays = *req.Settings.EventRetentionDays
+	}
 
 	updatedAccount, err := h.accountManager.UpdateAccountSettings(r.Context(), accountID, userID, settings)
 	if err != nil {
@@ -159,10 +171,15 @@ func toAccountResponse(accountID string, settings *types.Settings) *api.Account
 		JwtAllowGroups:                  &jwtAllowGroups,
 		RegularUsersViewBlocked:         settings.RegularUsersViewBlocked,
 		RoutingPeerDnsResolutionEnabled: &settings.RoutingPeerDNSResolutionEnabled,
+		NetworkTrafficLogsEnabled:       &settings.NetworkTrafficLogsEnabled,
+		EventRetentionDays:              &settings.EventRetentionDays,
 	}
 
 	if settings.Extra != nil {
-		apiSettings.Extra = &api.AccountExtraSettings{PeerApprovalEnabled: &settings.Extra.PeerApprovalEnabled}
+		apiSettings.Extra = &api.AccountExtraSettings{
+			PeerApprovalEnabled:         &settings.Extra.PeerApprovalEnabled,
+			PeerApprovalExemptSetupKeys: &settings.Extra.PeerApprovalExemptSetupKeys,
+		}
 	}
 
 	return &api.Account{
@@ -62,6 +62,7 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 
 	sr := func(v string) *string { return &v }
 	br := func(v bool) *bool { return &v }
+	ir := func(v int) *int { return &v }
 
 	handler := initAccountsTestData(&types.Account{
 		Id:      accountID,
@@ -103,6 +104,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				NetworkTrafficLogsEnabled:       br(false),
+				EventRetentionDays:              ir(0),
 			},
 			expectedArray: true,
 			expectedID:    accountID,
@@ -123,6 +126,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         false,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				NetworkTrafficLogsEnabled:       br(false),
+				EventRetentionDays:              ir(0),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -143,6 +148,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{"test"},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				NetworkTrafficLogsEnabled:       br(false),
+				EventRetentionDays:              ir(0),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -163,6 +170,30 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				JwtAllowGroups:                  &[]string{},
 				RegularUsersViewBlocked:         true,
 				RoutingPeerDnsResolutionEnabled: br(false),
+				NetworkTrafficLogsEnabled:       br(false),
+				EventRetentionDays:              ir(0),
+			},
+			expectedArray: false,
+			expectedID:    accountID,
+		},
+		{
+			name:           "PutAccount OK with event retention",
+			expectedBody:   true,
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID,
+			requestBody:    bytes.NewBufferString("{\"settings\": {\"peer_login_expiration\": 15552000,\"peer_login_expiration_enabled\": true,\"event_retention_days\": 90}}"),
+			expectedStatus: http.StatusOK,
+			expectedSettings: api.AccountSettings{
+				PeerLoginExpiration:             15552000,
+				PeerLoginExpirationEnabled:      true,
+				GroupsPropagationEnabled:        br(false),
+				JwtGroupsClaimName:              sr(""),
+				JwtGroupsEnabled:                br(false),
+				JwtAllowGroups:                  &[]string{},
+				RegularUsersViewBlocked:         false,
+				RoutingPeerDnsResolutionEnabled: br(false),
+				NetworkTrafficLogsEnabled:       br(false),
+				EventRetentionDays:              ir(90),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
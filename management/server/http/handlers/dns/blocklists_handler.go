@@ -0,0 +1,225 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/configs"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// blocklistsHandler is the handler of the account DNS blocklists
+type blocklistsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+func addDNSBlocklistsEndpoint(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
+	blocklistsHandler := newBlocklistsHandler(accountManager, authCfg)
+	router.HandleFunc("/dns/blocklists", blocklistsHandler.getAllBlocklists).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/blocklists", blocklistsHandler.createBlocklist).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.updateBlocklist).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.getBlocklist).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.deleteBlocklist).Methods("DELETE", "OPTIONS")
+}
+
+// newBlocklistsHandler returns a new instance of blocklistsHandler handler
+func newBlocklistsHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *blocklistsHandler {
+	return &blocklistsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// getAllBlocklists returns the list of DNS blocklists of the account
+func (h *blocklistsHandler) getAllBlocklists(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	blocklists, err := h.accountManager.ListDNSBlocklists(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	apiBlocklists := make([]*api.DNSBlocklist, 0, len(blocklists))
+	for _, blocklist := range blocklists {
+		apiBlocklists = append(apiBlocklists, toDNSBlocklistResponse(blocklist))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiBlocklists)
+}
+
+// createBlocklist handles DNS blocklist creation request
+func (h *blocklistsHandler) createBlocklist(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiDnsBlocklistsJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	blocklist, err := h.accountManager.CreateDNSBlocklist(r.Context(), accountID, userID, toDNSBlocklist("", req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSBlocklistResponse(blocklist))
+}
+
+// updateBlocklist handles update to a DNS blocklist identified by a given ID
+func (h *blocklistsHandler) updateBlocklist(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS blocklist ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsBlocklistsBlocklistIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	blocklist, err := h.accountManager.SaveDNSBlocklist(r.Context(), accountID, userID, toDNSBlocklist(blocklistID, req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSBlocklistResponse(blocklist))
+}
+
+// deleteBlocklist handles DNS blocklist deletion request
+func (h *blocklistsHandler) deleteBlocklist(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS blocklist ID"), w)
+		return
+	}
+
+	err = h.accountManager.DeleteDNSBlocklist(r.Context(), accountID, userID, blocklistID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// getBlocklist handles a DNS blocklist Get request identified by ID
+func (h *blocklistsHandler) getBlocklist(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS blocklist ID"), w)
+		return
+	}
+
+	blocklist, err := h.accountManager.GetDNSBlocklist(r.Context(), accountID, userID, blocklistID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSBlocklistResponse(blocklist))
+}
+
+func toDNSBlocklist(blocklistID string, req api.DNSBlocklistRequest) *types.DNSBlocklist {
+	blocklist := &types.DNSBlocklist{
+		ID:           blocklistID,
+		Name:         req.Name,
+		Enabled:      req.Enabled,
+		Domains:      req.Domains,
+		ExemptGroups: req.ExemptGroups,
+	}
+	if req.Description != nil {
+		blocklist.Description = *req.Description
+	}
+	if req.Action != nil {
+		blocklist.Action = string(*req.Action)
+	}
+	if req.SinkholeIpv4 != nil {
+		blocklist.SinkholeIPv4 = *req.SinkholeIpv4
+	}
+	if req.SinkholeIpv6 != nil {
+		blocklist.SinkholeIPv6 = *req.SinkholeIpv6
+	}
+	if req.SourceUrl != nil {
+		blocklist.SourceURL = *req.SourceUrl
+	}
+	return blocklist
+}
+
+func toDNSBlocklistResponse(blocklist *types.DNSBlocklist) *api.DNSBlocklist {
+	action := api.DNSBlocklistAction(blocklist.Action)
+	response := &api.DNSBlocklist{
+		Id:           blocklist.ID,
+		Name:         blocklist.Name,
+		Description:  &blocklist.Description,
+		Enabled:      blocklist.Enabled,
+		Action:       &action,
+		SinkholeIpv4: &blocklist.SinkholeIPv4,
+		SinkholeIpv6: &blocklist.SinkholeIPv6,
+		Domains:      blocklist.Domains,
+		ExemptGroups: blocklist.ExemptGroups,
+		DomainsCount: len(blocklist.CompiledDomains),
+		SourceError:  blocklist.SourceError,
+	}
+	if response.Domains == nil {
+		response.Domains = []string{}
+	}
+	if response.ExemptGroups == nil {
+		response.ExemptGroups = []string{}
+	}
+	if blocklist.SourceURL != "" {
+		response.SourceUrl = &blocklist.SourceURL
+	}
+	if !blocklist.SourceUpdatedAt.IsZero() {
+		response.SourceUpdatedAt = &blocklist.SourceUpdatedAt
+	}
+	return response
+}
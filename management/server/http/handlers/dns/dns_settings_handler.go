@@ -24,6 +24,9 @@ type dnsSettingsHandler struct {
 func AddEndpoints(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
 	addDNSSettingEndpoint(accountManager, authCfg, router)
 	addDNSNameserversEndpoint(accountManager, authCfg, router)
+	addDNSRecordsEndpoint(accountManager, authCfg, router)
+	addDNSBlocklistsEndpoint(accountManager, authCfg, router)
+	addDNSServiceNamesEndpoint(accountManager, authCfg, router)
 }
 
 func addDNSSettingEndpoint(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -89,7 +90,9 @@ func (h *nameserversHandler) createNameserverGroup(w http.ResponseWriter, r *htt
 		return
 	}
 
-	nsGroup, err := h.accountManager.CreateNameServerGroup(r.Context(), accountID, req.Name, req.Description, nsList, req.Groups, req.Primary, req.Domains, req.Enabled, userID, req.SearchDomainsEnabled)
+	dnssecValidation, dnssecTrustAnchors := toServerDNSSECSettings(req.DnssecValidation, req.DnssecTrustAnchors)
+
+	nsGroup, err := h.accountManager.CreateNameServerGroup(r.Context(), accountID, req.Name, req.Description, nsList, req.Groups, req.Primary, req.Domains, req.Enabled, userID, req.SearchDomainsEnabled, dnssecValidation, dnssecTrustAnchors)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -139,6 +142,7 @@ func (h *nameserversHandler) updateNameserverGroup(w http.ResponseWriter, r *htt
 		Enabled:              req.Enabled,
 		SearchDomainsEnabled: req.SearchDomainsEnabled,
 	}
+	updatedNSGroup.DNSSECValidation, updatedNSGroup.DNSSECTrustAnchors = toServerDNSSECSettings(req.DnssecValidation, req.DnssecTrustAnchors)
 
 	err = h.accountManager.SaveNameServerGroup(r.Context(), accountID, userID, updatedNSGroup)
 	if err != nil {
@@ -205,10 +209,17 @@ func (h *nameserversHandler) getNameserverGroup(w http.ResponseWriter, r *http.R
 func toServerNSList(apiNSList []api.Nameserver) ([]nbdns.NameServer, error) {
 	var nsList []nbdns.NameServer
 	for _, apiNS := range apiNSList {
-		parsed, err := nbdns.ParseNameServerURL(fmt.Sprintf("%s://%s:%d", apiNS.NsType, apiNS.Ip, apiNS.Port))
+		var path string
+		if apiNS.Path != nil && *apiNS.Path != "" {
+			path = "/" + strings.TrimPrefix(*apiNS.Path, "/")
+		}
+		parsed, err := nbdns.ParseNameServerURL(fmt.Sprintf("%s://%s:%d%s", apiNS.NsType, apiNS.Ip, apiNS.Port, path))
 		if err != nil {
 			return nil, err
 		}
+		if apiNS.Hostname != nil {
+			parsed.Hostname = *apiNS.Hostname
+		}
 		nsList = append(nsList, parsed)
 	}
 
@@ -223,10 +234,16 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 			NsType: api.NameserverNsType(ns.NSType.String()),
 			Port:   ns.Port,
 		}
+		if ns.Hostname != "" {
+			apiNS.Hostname = &ns.Hostname
+		}
+		if ns.Path != "" {
+			apiNS.Path = &ns.Path
+		}
 		nsList = append(nsList, apiNS)
 	}
 
-	return &api.NameserverGroup{
+	resp := &api.NameserverGroup{
 		Id:                   serverNSGroup.ID,
 		Name:                 serverNSGroup.Name,
 		Description:          serverNSGroup.Description,
@@ -237,4 +254,20 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 		Enabled:              serverNSGroup.Enabled,
 		SearchDomainsEnabled: serverNSGroup.SearchDomainsEnabled,
 	}
+	if serverNSGroup.DNSSECValidation {
+		resp.DnssecValidation = &serverNSGroup.DNSSECValidation
+	}
+	if len(serverNSGroup.DNSSECTrustAnchors) > 0 {
+		resp.DnssecTrustAnchors = &serverNSGroup.DNSSECTrustAnchors
+	}
+
+	return resp
+}
+
+func toServerDNSSECSettings(validation *bool, trustAnchors *[]string) (bool, []string) {
+	var anchors []string
+	if trustAnchors != nil {
+		anchors = *trustAnchors
+	}
+	return validation != nil && *validation, anchors
 }
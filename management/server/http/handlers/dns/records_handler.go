@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/configs"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// recordsHandler is the handler of the custom records of the account DNS zone
+type recordsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+func addDNSRecordsEndpoint(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
+	recordsHandler := newRecordsHandler(accountManager, authCfg)
+	router.HandleFunc("/dns/records", recordsHandler.getAllRecords).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/records", recordsHandler.createRecord).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/records/{recordId}", recordsHandler.updateRecord).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/records/{recordId}", recordsHandler.getRecord).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/records/{recordId}", recordsHandler.deleteRecord).Methods("DELETE", "OPTIONS")
+}
+
+// newRecordsHandler returns a new instance of recordsHandler handler
+func newRecordsHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *recordsHandler {
+	return &recordsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// getAllRecords returns the list of custom DNS records of the account
+func (h *recordsHandler) getAllRecords(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	records, err := h.accountManager.ListDNSRecords(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	dnsDomain := h.accountManager.GetDNSDomain()
+	apiRecords := make([]*api.DNSRecord, 0, len(records))
+	for _, record := range records {
+		apiRecords = append(apiRecords, toDNSRecordResponse(record, dnsDomain))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiRecords)
+}
+
+// createRecord handles DNS record creation request
+func (h *recordsHandler) createRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiDnsRecordsJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	record, err := h.accountManager.CreateDNSRecord(r.Context(), accountID, userID, toDNSRecord("", req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record, h.accountManager.GetDNSDomain()))
+}
+
+// updateRecord handles update to a DNS record identified by a given ID
+func (h *recordsHandler) updateRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID := mux.Vars(r)["recordId"]
+	if len(recordID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS record ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsRecordsRecordIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	record, err := h.accountManager.SaveDNSRecord(r.Context(), accountID, userID, toDNSRecord(recordID, req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record, h.accountManager.GetDNSDomain()))
+}
+
+// deleteRecord handles DNS record deletion request
+func (h *recordsHandler) deleteRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID := mux.Vars(r)["recordId"]
+	if len(recordID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS record ID"), w)
+		return
+	}
+
+	err = h.accountManager.DeleteDNSRecord(r.Context(), accountID, userID, recordID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// getRecord handles a DNS record Get request identified by ID
+func (h *recordsHandler) getRecord(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	recordID := mux.Vars(r)["recordId"]
+	if len(recordID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS record ID"), w)
+		return
+	}
+
+	record, err := h.accountManager.GetDNSRecord(r.Context(), accountID, userID, recordID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSRecordResponse(record, h.accountManager.GetDNSDomain()))
+}
+
+func toDNSRecord(recordID string, req api.DNSRecordRequest) *types.DNSRecord {
+	record := &types.DNSRecord{
+		ID:      recordID,
+		Name:    req.Name,
+		Type:    string(req.Type),
+		Content: req.Content,
+	}
+	if req.Ttl != nil {
+		record.TTL = *req.Ttl
+	}
+	return record
+}
+
+func toDNSRecordResponse(record *types.DNSRecord, dnsDomain string) *api.DNSRecord {
+	ttl := record.TTL
+	return &api.DNSRecord{
+		Id:      record.ID,
+		Name:    record.Name,
+		Fqdn:    record.FQDN(dnsDomain),
+		Type:    api.DNSRecordType(record.Type),
+		Ttl:     &ttl,
+		Content: record.Content,
+	}
+}
@@ -0,0 +1,224 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/configs"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// serviceNamesHandler is the handler of the service names of the account DNS zone
+type serviceNamesHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+func addDNSServiceNamesEndpoint(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
+	serviceNamesHandler := newServiceNamesHandler(accountManager, authCfg)
+	router.HandleFunc("/dns/service-names", serviceNamesHandler.getAllServiceNames).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/service-names", serviceNamesHandler.createServiceName).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/service-names/{serviceNameId}", serviceNamesHandler.updateServiceName).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/service-names/{serviceNameId}", serviceNamesHandler.getServiceName).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/service-names/{serviceNameId}", serviceNamesHandler.deleteServiceName).Methods("DELETE", "OPTIONS")
+}
+
+// newServiceNamesHandler returns a new instance of serviceNamesHandler handler
+func newServiceNamesHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *serviceNamesHandler {
+	return &serviceNamesHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// getAllServiceNames returns the list of DNS service names of the account
+func (h *serviceNamesHandler) getAllServiceNames(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceNames, err := h.accountManager.ListDNSServiceNames(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	healthyPeers, err := h.accountManager.GetDNSServiceNamesHealthyPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	dnsDomain := h.accountManager.GetDNSDomain()
+	apiServiceNames := make([]*api.DNSServiceName, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		apiServiceNames = append(apiServiceNames, toDNSServiceNameResponse(serviceName, dnsDomain, healthyPeers[serviceName.ID]))
+	}
+
+	util.WriteJSONObject(r.Context(), w, apiServiceNames)
+}
+
+// createServiceName handles DNS service name creation request
+func (h *serviceNamesHandler) createServiceName(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiDnsServiceNamesJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	serviceName, err := h.accountManager.CreateDNSServiceName(r.Context(), accountID, userID, toDNSServiceName("", req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	healthyPeers, err := h.accountManager.GetDNSServiceNamesHealthyPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSServiceNameResponse(serviceName, h.accountManager.GetDNSDomain(), healthyPeers[serviceName.ID]))
+}
+
+// updateServiceName handles update to a DNS service name identified by a given ID
+func (h *serviceNamesHandler) updateServiceName(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceNameID := mux.Vars(r)["serviceNameId"]
+	if len(serviceNameID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS service name ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsServiceNamesServiceNameIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	serviceName, err := h.accountManager.SaveDNSServiceName(r.Context(), accountID, userID, toDNSServiceName(serviceNameID, req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	healthyPeers, err := h.accountManager.GetDNSServiceNamesHealthyPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSServiceNameResponse(serviceName, h.accountManager.GetDNSDomain(), healthyPeers[serviceName.ID]))
+}
+
+// deleteServiceName handles DNS service name deletion request
+func (h *serviceNamesHandler) deleteServiceName(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceNameID := mux.Vars(r)["serviceNameId"]
+	if len(serviceNameID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS service name ID"), w)
+		return
+	}
+
+	err = h.accountManager.DeleteDNSServiceName(r.Context(), accountID, userID, serviceNameID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+// getServiceName handles a DNS service name Get request identified by ID
+func (h *serviceNamesHandler) getServiceName(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	serviceNameID := mux.Vars(r)["serviceNameId"]
+	if len(serviceNameID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid DNS service name ID"), w)
+		return
+	}
+
+	serviceName, err := h.accountManager.GetDNSServiceName(r.Context(), accountID, userID, serviceNameID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	healthyPeers, err := h.accountManager.GetDNSServiceNamesHealthyPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toDNSServiceNameResponse(serviceName, h.accountManager.GetDNSDomain(), healthyPeers[serviceName.ID]))
+}
+
+func toDNSServiceName(serviceNameID string, req api.DNSServiceNameRequest) *types.DNSServiceName {
+	serviceName := &types.DNSServiceName{
+		ID:      serviceNameID,
+		Name:    req.Name,
+		Enabled: req.Enabled,
+		Peers:   req.Peers,
+		Groups:  req.Groups,
+	}
+	if req.Description != nil {
+		serviceName.Description = *req.Description
+	}
+	return serviceName
+}
+
+func toDNSServiceNameResponse(serviceName *types.DNSServiceName, dnsDomain string, healthyPeers []string) *api.DNSServiceName {
+	if healthyPeers == nil {
+		healthyPeers = []string{}
+	}
+	return &api.DNSServiceName{
+		Id:           serviceName.ID,
+		Name:         serviceName.Name,
+		Fqdn:         serviceName.FQDN(dnsDomain),
+		Description:  &serviceName.Description,
+		Enabled:      serviceName.Enabled,
+		Peers:        serviceName.Peers,
+		Groups:       serviceName.Groups,
+		HealthyPeers: healthyPeers,
+	}
+}
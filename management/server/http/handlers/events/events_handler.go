@@ -4,33 +4,44 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/flows"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/configs"
 	"github.com/netbirdio/netbird/management/server/http/util"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
 )
 
 // handler HTTP handler
 type handler struct {
 	accountManager  server.AccountManager
+	flowManager     flows.Manager
 	claimsExtractor *jwtclaims.ClaimsExtractor
 }
 
-func AddEndpoints(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
-	eventsHandler := newHandler(accountManager, authCfg)
+func AddEndpoints(accountManager server.AccountManager, flowManager flows.Manager, authCfg configs.AuthCfg, router *mux.Router) {
+	eventsHandler := newHandler(accountManager, flowManager, authCfg)
 	router.HandleFunc("/events", eventsHandler.getAllEvents).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/export", eventsHandler.exportEvents).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/network-traffic", eventsHandler.getNetworkTrafficEvents).Methods("GET", "OPTIONS")
 }
 
 // newHandler creates a new events handler
-func newHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *handler {
+func newHandler(accountManager server.AccountManager, flowManager flows.Manager, authCfg configs.AuthCfg) *handler {
 	return &handler{
 		accountManager: accountManager,
+		flowManager:    flowManager,
 		claimsExtractor: jwtclaims.NewClaimsExtractor(
 			jwtclaims.WithAudience(authCfg.Audience),
 			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
@@ -48,7 +59,13 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID)
+	filter, err := parseEventsFilter(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID, filter)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -67,26 +84,244 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
-func (h *handler) fillEventsWithUserInfo(ctx context.Context, events []*api.Event, accountId, userId string) error {
+// exportEvents streams all events of the given account matching the filter as CSV or NDJSON
+func (h *handler) exportEvents(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	filter, err := parseEventsFilter(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	format := api.GetApiEventsExportParamsFormatCsv
+	if value := r.URL.Query().Get("format"); value != "" {
+		format = api.GetApiEventsExportParamsFormat(value)
+	}
+	if format != api.GetApiEventsExportParamsFormatCsv && format != api.GetApiEventsExportParamsFormatNdjson {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid format %q", format), w)
+		return
+	}
+
+	var writer eventWriter
+	err = h.accountManager.ExportEvents(r.Context(), accountID, userID, filter, func(accountEvents []*activity.Event) error {
+		// the response is started with the first batch, so errors before it can still be returned as JSON
+		if writer == nil {
+			infos, err := h.getUserInfos(r.Context(), accountID, userID)
+			if err != nil {
+				return err
+			}
+			if writer, err = newEventWriter(w, format, infos); err != nil {
+				return err
+			}
+		}
+
+		events := make([]*api.Event, len(accountEvents))
+		for i, e := range accountEvents {
+			events[i] = toEventResponse(e)
+		}
+		return writer.write(r.Context(), events)
+	})
+	if err != nil {
+		if writer == nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+		log.WithContext(r.Context()).Errorf("failed to export events of account %s: %v", accountID, err)
+	}
+}
+
+// parseEventsFilter reads the activity events filter from the query parameters
+func parseEventsFilter(r *http.Request) (*activity.Filter, error) {
+	query := r.URL.Query()
+	filter := &activity.Filter{
+		InitiatorID: query.Get("initiator_id"),
+		TargetID:    query.Get("target_id"),
+		PeerID:      query.Get("peer_id"),
+	}
+
+	for _, value := range query["activity_code"] {
+		for _, code := range strings.Split(value, ",") {
+			code = strings.TrimSpace(code)
+			if code == "" {
+				continue
+			}
+			operation, ok := activity.FromStringCode(code)
+			if !ok {
+				return nil, status.Errorf(status.InvalidArgument, "invalid activity_code %q", code)
+			}
+			filter.Activities = append(filter.Activities, operation)
+		}
+	}
+
+	var err error
+	if filter.StartDate, err = parseTimeParam(query, "start_date"); err != nil {
+		return nil, err
+	}
+	if filter.EndDate, err = parseTimeParam(query, "end_date"); err != nil {
+		return nil, err
+	}
+	if !filter.StartDate.IsZero() && !filter.EndDate.IsZero() && filter.EndDate.Before(filter.StartDate) {
+		return nil, status.Errorf(status.InvalidArgument, "end_date can't be before start_date")
+	}
+
+	return filter, nil
+}
+
+// getNetworkTrafficEvents returns a page of the network traffic events of the given account
+func (h *handler) getNetworkTrafficEvents(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	filter, err := parseNetworkTrafficFilter(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	trafficEvents, total, err := h.flowManager.GetEvents(r.Context(), accountID, userID, filter)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	events := make([]api.NetworkTrafficEvent, 0, len(trafficEvents))
+	for _, e := range trafficEvents {
+		events = append(events, *e.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, &api.NetworkTrafficEventsResponse{
+		Data:         events,
+		Page:         filter.Page,
+		PageSize:     filter.PageSize,
+		TotalRecords: int(total),
+		TotalPages:   int((total + int64(filter.PageSize) - 1) / int64(filter.PageSize)),
+	})
+}
+
+func parseNetworkTrafficFilter(r *http.Request) (*flowTypes.Filter, error) {
+	query := r.URL.Query()
+	filter := &flowTypes.Filter{
+		PeerID:    query.Get("peer_id"),
+		PolicyID:  query.Get("policy_id"),
+		Type:      query.Get("type"),
+		Direction: query.Get("direction"),
+	}
+
+	var err error
+	if filter.Page, err = parseIntParam(query, "page"); err != nil {
+		return nil, err
+	}
+	if filter.PageSize, err = parseIntParam(query, "page_size"); err != nil {
+		return nil, err
+	}
+	if filter.PageSize > flowTypes.MaxPageSize {
+		return nil, status.Errorf(status.InvalidArgument, "page_size can't be greater than %d", flowTypes.MaxPageSize)
+	}
+	if filter.Protocol, err = parseIntParam(query, "protocol"); err != nil {
+		return nil, err
+	}
+	if filter.StartDate, err = parseTimeParam(query, "start_date"); err != nil {
+		return nil, err
+	}
+	if filter.EndDate, err = parseTimeParam(query, "end_date"); err != nil {
+		return nil, err
+	}
+
+	switch filter.Type {
+	case "", flowTypes.EventTypeStart, flowTypes.EventTypeEnd, flowTypes.EventTypeDrop:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "invalid type %q", filter.Type)
+	}
+
+	switch filter.Direction {
+	case "", flowTypes.DirectionIngress, flowTypes.DirectionEgress:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "invalid direction %q", filter.Direction)
+	}
+
+	// the page size of the response must not depend on the flow manager applying the defaults
+	filter.Normalize()
+
+	return filter, nil
+}
+
+func parseIntParam(query url.Values, name string) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return 0, status.Errorf(status.InvalidArgument, "invalid %s %q", name, value)
+	}
+	return parsed, nil
+}
+
+func parseTimeParam(query url.Values, name string) (time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(status.InvalidArgument, "invalid %s %q, expected RFC3339 format", name, value)
+	}
+	return parsed, nil
+}
+
+// userInfos holds the emails and names of the account users by user ID
+type userInfos struct {
+	emails map[string]string
+	names  map[string]string
+}
+
+func (h *handler) getUserInfos(ctx context.Context, accountId, userId string) (*userInfos, error) {
 	// build email, name maps based on users
-	userInfos, err := h.accountManager.GetUsersFromAccount(ctx, accountId, userId)
+	accountUsers, err := h.accountManager.GetUsersFromAccount(ctx, accountId, userId)
 	if err != nil {
 		log.WithContext(ctx).Errorf("failed to get users from account: %s", err)
-		return err
+		return nil, err
 	}
 
-	emails := make(map[string]string)
-	names := make(map[string]string)
-	for _, ui := range userInfos {
-		emails[ui.ID] = ui.Email
-		names[ui.ID] = ui.Name
+	infos := &userInfos{
+		emails: make(map[string]string),
+		names:  make(map[string]string),
 	}
+	for _, ui := range accountUsers {
+		infos.emails[ui.ID] = ui.Email
+		infos.names[ui.ID] = ui.Name
+	}
+	return infos, nil
+}
 
+func (h *handler) fillEventsWithUserInfo(ctx context.Context, events []*api.Event, accountId, userId string) error {
+	infos, err := h.getUserInfos(ctx, accountId, userId)
+	if err != nil {
+		return err
+	}
+
+	infos.fill(ctx, events)
+	return nil
+}
+
+func (u *userInfos) fill(ctx context.Context, events []*api.Event) {
 	var ok bool
 	for _, event := range events {
 		// fill initiator
 		if event.InitiatorEmail == "" {
-			event.InitiatorEmail, ok = emails[event.InitiatorId]
+			event.InitiatorEmail, ok = u.emails[event.InitiatorId]
 			if !ok {
 				log.WithContext(ctx).Warnf("failed to resolve email for initiator: %s", event.InitiatorId)
 			}
@@ -94,23 +329,22 @@ func (h *handler) fillEventsWithUserInfo(ctx context.Context, events []*api.Even
 
 		if event.InitiatorName == "" {
 			// here to allowed to be empty because in the first release we did not store the name
-			event.InitiatorName = names[event.InitiatorId]
+			event.InitiatorName = u.names[event.InitiatorId]
 		}
 
 		// fill target meta
-		email, ok := emails[event.TargetId]
+		email, ok := u.emails[event.TargetId]
 		if !ok {
 			continue
 		}
 		event.Meta["email"] = email
 
-		username, ok := names[event.TargetId]
+		username, ok := u.names[event.TargetId]
 		if !ok {
 			continue
 		}
 		event.Meta["username"] = username
 	}
-	return nil
 }
 
 func toEventResponse(event *activity.Event) *api.Event {
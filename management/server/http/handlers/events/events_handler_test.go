@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -12,8 +13,11 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	"github.com/netbirdio/netbird/management/server/mock_server"
@@ -23,11 +27,33 @@ import (
 func initEventsTestData(account string, events ...*activity.Event) *handler {
 	return &handler{
 		accountManager: &mock_server.MockAccountManager{
-			GetEventsFunc: func(_ context.Context, accountID, userID string) ([]*activity.Event, error) {
-				if accountID == account {
-					return events, nil
+			GetEventsFunc: func(_ context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, error) {
+				filtered := []*activity.Event{}
+				if accountID != account {
+					return filtered, nil
 				}
-				return []*activity.Event{}, nil
+				for _, event := range events {
+					if filter.Matches(event) {
+						filtered = append(filtered, event)
+					}
+				}
+				return filtered, nil
+			},
+			ExportEventsFunc: func(_ context.Context, accountID, userID string, filter *activity.Filter, export func([]*activity.Event) error) error {
+				// export one event per batch to cover the streaming of multiple batches
+				exported := false
+				for _, event := range events {
+					if accountID == account && filter.Matches(event) {
+						exported = true
+						if err := export([]*activity.Event{event}); err != nil {
+							return err
+						}
+					}
+				}
+				if !exported {
+					return export([]*activity.Event{})
+				}
+				return nil
 			},
 			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
 				return claims.AccountId, claims.UserId, nil
@@ -246,3 +272,265 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_GetEventsFiltered(t *testing.T) {
+	accountID := "test_account"
+	adminUser := types.NewAdminUser("test_user")
+	events := generateEvents(accountID, adminUser.Id)
+	handler := initEventsTestData(accountID, events...)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/events", handler.getAllEvents).Methods("GET")
+
+	tt := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedIDs    []string
+	}{
+		{
+			name:           "by activity code",
+			query:          "activity_code=group.add",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"3"},
+		},
+		{
+			name:           "by comma separated activity codes",
+			query:          "activity_code=group.add,user.peer.add",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"1", "3"},
+		},
+		{
+			name:           "by peer",
+			query:          "peer_id=100.64.0.2",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"1"},
+		},
+		{
+			name:           "by time range",
+			query:          "start_date=" + time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{},
+		},
+		{
+			name:           "unknown activity code",
+			query:          "activity_code=unknown",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "end before start",
+			query:          "start_date=2024-02-01T00:00:00Z&end_date=2024-01-01T00:00:00Z",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/events?"+tc.query, nil)
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code, recorder.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var got []*api.Event
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+
+			ids := make([]string, 0, len(got))
+			for _, event := range got {
+				ids = append(ids, event.Id)
+			}
+			assert.ElementsMatch(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestEvents_ExportEvents(t *testing.T) {
+	accountID := "test_account"
+	adminUser := types.NewAdminUser("test_user")
+	events := generateEvents(accountID, adminUser.Id)
+	handler := initEventsTestData(accountID, events...)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/events/export", handler.exportEvents).Methods("GET")
+
+	t.Run("csv", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/events/export?activity_code=group.add", nil)
+		router.ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), ".csv")
+
+		records, err := csv.NewReader(recorder.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, csvHeader, records[0])
+		assert.Equal(t, "3", records[1][0])
+		assert.Equal(t, activity.GroupCreated.StringCode(), records[1][2])
+		assert.Equal(t, `{"some":"meta"}`, records[1][8])
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/events/export?format=ndjson", nil)
+		router.ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+
+		decoder := json.NewDecoder(recorder.Body)
+		var count int
+		for decoder.More() {
+			var event api.Event
+			require.NoError(t, decoder.Decode(&event))
+			assert.Equal(t, strconv.FormatUint(events[count].ID, 10), event.Id)
+			count++
+		}
+		assert.Equal(t, len(events), count)
+	})
+
+	t.Run("empty export has the csv header", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/events/export?initiator_id=nobody", nil)
+		router.ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		records, err := csv.NewReader(recorder.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{csvHeader}, records)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/events/export?format=xml", nil)
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+}
+
+type flowManagerStub struct {
+	events []*flowTypes.Event
+	filter *flowTypes.Filter
+}
+
+func (m *flowManagerStub) SaveEvents(_ context.Context, _ string, _ []*proto.FlowEvent) error {
+	return nil
+}
+
+func (m *flowManagerStub) GetEvents(_ context.Context, _, _ string, filter *flowTypes.Filter) ([]*flowTypes.Event, int64, error) {
+	m.filter = filter
+	return m.events, int64(len(m.events)), nil
+}
+
+func TestEvents_GetNetworkTrafficEvents(t *testing.T) {
+	accountID := "test_account"
+	flowManager := &flowManagerStub{
+		events: []*flowTypes.Event{
+			{
+				ID:              "event-1",
+				FlowID:          "flow-1",
+				PeerID:          "peer-1",
+				Timestamp:       time.Now().UTC(),
+				Type:            flowTypes.EventTypeEnd,
+				Direction:       flowTypes.DirectionIngress,
+				Protocol:        6,
+				PolicyID:        "policy-1",
+				SourceIP:        "100.64.0.2",
+				SourcePort:      51234,
+				DestinationIP:   "100.64.0.1",
+				DestinationPort: 443,
+				RxBytes:         1024,
+				TxBytes:         2048,
+			},
+		},
+	}
+	handler := initEventsTestData(accountID)
+	handler.flowManager = flowManager
+
+	tt := []struct {
+		name           string
+		requestPath    string
+		expectedStatus int
+		expectedFilter *flowTypes.Filter
+	}{
+		{
+			name:           "default pagination",
+			requestPath:    "/api/events/network-traffic",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &flowTypes.Filter{Page: 1, PageSize: flowTypes.DefaultPageSize},
+		},
+		{
+			name:           "with filters",
+			requestPath:    "/api/events/network-traffic?page=2&page_size=10&peer_id=peer-1&type=end&direction=ingress&protocol=6&start_date=2024-01-01T00:00:00Z",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &flowTypes.Filter{
+				PeerID:    "peer-1",
+				Type:      flowTypes.EventTypeEnd,
+				Direction: flowTypes.DirectionIngress,
+				Protocol:  6,
+				StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Page:      2,
+				PageSize:  10,
+			},
+		},
+		{
+			name:           "zero page size",
+			requestPath:    "/api/events/network-traffic?page=0&page_size=0",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &flowTypes.Filter{Page: 1, PageSize: flowTypes.DefaultPageSize},
+		},
+		{
+			name:           "invalid type",
+			requestPath:    "/api/events/network-traffic?type=unknown",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid start date",
+			requestPath:    "/api/events/network-traffic?start_date=yesterday",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "page size too large",
+			requestPath:    "/api/events/network-traffic?page_size=5000",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			flowManager.filter = nil
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.requestPath, nil)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/events/network-traffic", handler.getNetworkTrafficEvents).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tc.expectedFilter, flowManager.filter)
+
+			var got api.NetworkTrafficEventsResponse
+			err := json.Unmarshal(recorder.Body.Bytes(), &got)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedFilter.Page, got.Page)
+			assert.Equal(t, tc.expectedFilter.PageSize, got.PageSize)
+			assert.Equal(t, 1, got.TotalRecords)
+			assert.Equal(t, 1, got.TotalPages)
+			if assert.Len(t, got.Data, 1) {
+				assert.Equal(t, "event-1", got.Data[0].Id)
+				assert.Equal(t, api.NetworkTrafficEventTypeEnd, got.Data[0].Type)
+				assert.Equal(t, "policy-1", got.Data[0].PolicyId)
+				assert.Equal(t, int64(2048), got.Data[0].TxBytes)
+			}
+		})
+	}
+}
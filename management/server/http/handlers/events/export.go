@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+var csvHeader = []string{"id", "timestamp", "activity_code", "activity", "initiator_id", "initiator_name", "initiator_email", "target_id", "meta"}
+
+// eventWriter streams exported events to the response
+type eventWriter interface {
+	write(ctx context.Context, events []*api.Event) error
+}
+
+// newEventWriter starts the export response in the given format
+func newEventWriter(w http.ResponseWriter, format api.GetApiEventsExportParamsFormat, infos *userInfos) (eventWriter, error) {
+	filename := fmt.Sprintf("events-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	switch format {
+	case api.GetApiEventsExportParamsFormatNdjson:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		return &ndjsonWriter{
+			encoder:   json.NewEncoder(w),
+			flusher:   newFlusher(w),
+			userInfos: infos,
+		}, nil
+	default:
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		writer := &csvWriter{
+			writer:    csv.NewWriter(w),
+			flusher:   newFlusher(w),
+			userInfos: infos,
+		}
+		if err := writer.writer.Write(csvHeader); err != nil {
+			return nil, err
+		}
+		return writer, nil
+	}
+}
+
+// newFlusher returns a function flushing the response to the client if supported by the writer
+func newFlusher(w http.ResponseWriter) func() {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return func() {}
+	}
+	return flusher.Flush
+}
+
+type csvWriter struct {
+	writer    *csv.Writer
+	flusher   func()
+	userInfos *userInfos
+}
+
+func (c *csvWriter) write(ctx context.Context, events []*api.Event) error {
+	c.userInfos.fill(ctx, events)
+
+	for _, event := range events {
+		meta, err := json.Marshal(event.Meta)
+		if err != nil {
+			return err
+		}
+
+		err = c.writer.Write([]string{
+			event.Id,
+			event.Timestamp.UTC().Format(time.RFC3339),
+			string(event.ActivityCode),
+			event.Activity,
+			event.InitiatorId,
+			event.InitiatorName,
+			event.InitiatorEmail,
+			event.TargetId,
+			string(meta),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	c.writer.Flush()
+	if err := c.writer.Error(); err != nil {
+		return err
+	}
+	c.flusher()
+	return nil
+}
+
+type ndjsonWriter struct {
+	encoder   *json.Encoder
+	flusher   func()
+	userInfos *userInfos
+}
+
+func (n *ndjsonWriter) write(ctx context.Context, events []*api.Event) error {
+	n.userInfos.fill(ctx, events)
+
+	for _, event := range events {
+		if err := n.encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	n.flusher()
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/types"
 
 	"github.com/stretchr/testify/assert"
@@ -66,6 +67,30 @@ func initTestMetaData(peers ...*nbpeer.Peer) *Handler {
 			GetPeersFunc: func(_ context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
 				return peers, nil
 			},
+			ApprovePeerFunc: func(_ context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error) {
+				for _, peer := range peers {
+					if peer.ID == peerID {
+						if !peer.Status.RequiresApproval {
+							return nil, status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+						}
+						p := peer.Copy()
+						p.Status.RequiresApproval = false
+						return p, nil
+					}
+				}
+				return nil, status.NewPeerNotFoundError(peerID)
+			},
+			RejectPeerFunc: func(_ context.Context, accountID, userID, peerID string) error {
+				for _, peer := range peers {
+					if peer.ID == peerID {
+						if !peer.Status.RequiresApproval {
+							return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+						}
+						return nil
+					}
+				}
+				return status.NewPeerNotFoundError(peerID)
+			},
 			GetDNSDomainFunc: func() string {
 				return "netbird.selfhosted"
 			},
@@ -426,3 +451,81 @@ func TestGetAccessiblePeers(t *testing.T) {
 		})
 	}
 }
+
+func TestApproveAndRejectPeer(t *testing.T) {
+	pendingPeer := &nbpeer.Peer{
+		ID:     "pending",
+		Key:    "key1",
+		IP:     net.ParseIP("100.64.0.1"),
+		Status: &nbpeer.PeerStatus{RequiresApproval: true},
+		Name:   "pending",
+	}
+
+	approvedPeer := &nbpeer.Peer{
+		ID:     "approved",
+		Key:    "key2",
+		IP:     net.ParseIP("100.64.0.2"),
+		Status: &nbpeer.PeerStatus{},
+		Name:   "approved",
+	}
+
+	p := initTestMetaData(pendingPeer, approvedPeer)
+
+	tt := []struct {
+		name           string
+		requestPath    string
+		expectedStatus int
+	}{
+		{
+			name:           "approve pending peer",
+			requestPath:    "/api/peers/pending/approve",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "approve approved peer",
+			requestPath:    "/api/peers/approved/approve",
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "approve unknown peer",
+			requestPath:    "/api/peers/unknown/approve",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "reject pending peer",
+			requestPath:    "/api/peers/pending/reject",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reject approved peer",
+			requestPath:    "/api/peers/approved/reject",
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, tc.requestPath, nil)
+			ctx := context.WithValue(context.Background(), userIDKey, adminUser)
+			req = req.WithContext(ctx)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/peers/{peerId}/approve", p.ApprovePeer).Methods("POST")
+			router.HandleFunc("/api/peers/{peerId}/reject", p.RejectPeer).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			if tc.expectedStatus == http.StatusOK && tc.requestPath == "/api/peers/pending/approve" {
+				got := &api.Peer{}
+				err := json.NewDecoder(res.Body).Decode(got)
+				assert.NoError(t, err)
+				assert.Equal(t, pendingPeer.ID, got.Id)
+				assert.False(t, got.ApprovalRequired)
+			}
+		})
+	}
+}
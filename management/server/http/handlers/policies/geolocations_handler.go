@@ -115,7 +115,7 @@ func (l *geolocationsHandler) authenticateUser(r *http.Request) error {
 		return err
 	}
 
-	if !user.HasAdminPower() {
+	if !user.HasAdminPower() && !user.HasCustomRole() {
 		return status.Errorf(status.PermissionDenied, "user is not allowed to perform this action")
 	}
 	return nil
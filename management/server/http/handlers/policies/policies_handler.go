@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -17,6 +19,16 @@ import (
 	"github.com/netbirdio/netbird/management/server/types"
 )
 
+var weekdays = map[api.ScheduleWindowDays]time.Weekday{
+	api.ScheduleWindowDaysSunday:    time.Sunday,
+	api.ScheduleWindowDaysMonday:    time.Monday,
+	api.ScheduleWindowDaysTuesday:   time.Tuesday,
+	api.ScheduleWindowDaysWednesday: time.Wednesday,
+	api.ScheduleWindowDaysThursday:  time.Thursday,
+	api.ScheduleWindowDaysFriday:    time.Friday,
+	api.ScheduleWindowDaysSaturday:  time.Saturday,
+}
+
 // handler is a handler that returns policy of the account
 type handler struct {
 	accountManager  server.AccountManager
@@ -206,6 +218,15 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 			pr.Description = *rule.Description
 		}
 
+		if rule.Schedule != nil {
+			schedule, err := toRuleSchedule(rule.Schedule)
+			if err != nil {
+				util.WriteError(r.Context(), err, w)
+				return
+			}
+			pr.Schedule = schedule
+		}
+
 		switch rule.Action {
 		case api.PolicyRuleUpdateActionAccept:
 			pr.Action = types.PolicyTrafficActionAccept
@@ -410,6 +431,8 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 			rule.PortRanges = &portRanges
 		}
 
+		rule.Schedule = toRuleScheduleResponse(r.Schedule)
+
 		var sources []api.GroupMinimum
 		for _, gid := range r.Sources {
 			_, ok := cache[gid]
@@ -452,3 +475,57 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 	}
 	return ap
 }
+
+func toRuleSchedule(schedule *api.RuleSchedule) (*types.RuleSchedule, error) {
+	rs := &types.RuleSchedule{
+		ValidFrom:  schedule.ValidFrom,
+		ValidUntil: schedule.ValidUntil,
+	}
+	if schedule.Timezone != nil {
+		rs.Timezone = *schedule.Timezone
+	}
+
+	for _, window := range schedule.Windows {
+		sw := types.ScheduleWindow{
+			Start: window.Start,
+			End:   window.End,
+		}
+		for _, day := range window.Days {
+			weekday, ok := weekdays[day]
+			if !ok {
+				return nil, status.Errorf(status.InvalidArgument, "unknown schedule day: %s", day)
+			}
+			sw.Days = append(sw.Days, weekday)
+		}
+		rs.Windows = append(rs.Windows, sw)
+	}
+
+	return rs, nil
+}
+
+func toRuleScheduleResponse(schedule *types.RuleSchedule) *api.RuleSchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	timezone := schedule.Timezone
+	resp := &api.RuleSchedule{
+		Timezone:   &timezone,
+		ValidFrom:  schedule.ValidFrom,
+		ValidUntil: schedule.ValidUntil,
+		Windows:    make([]api.ScheduleWindow, 0, len(schedule.Windows)),
+	}
+	for _, window := range schedule.Windows {
+		days := make([]api.ScheduleWindowDays, 0, len(window.Days))
+		for _, day := range window.Days {
+			days = append(days, api.ScheduleWindowDays(strings.ToLower(day.String())))
+		}
+		resp.Windows = append(resp.Windows, api.ScheduleWindow{
+			Days:  days,
+			Start: window.Start,
+			End:   window.End,
+		})
+	}
+
+	return resp
+}
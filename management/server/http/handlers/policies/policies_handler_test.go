@@ -211,6 +211,79 @@ func TestPoliciesWritePolicy(t *testing.T) {
 			expectedStatus: http.StatusUnprocessableEntity,
 			expectedBody:   false,
 		},
+		{
+			name:        "WritePolicy POST with schedule OK",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Business Hours Policy",
+                    "Rules":[
+                        {
+                            "Name":"Business Hours Rule",
+                            "Description": "Description",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+							"Sources": ["F"],
+							"Destinations": ["G"],
+							"Schedule": {
+								"timezone": "Europe/Berlin",
+								"windows": [{"days": ["monday", "friday"], "start": "09:00", "end": "17:00"}]
+							}
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:          str("id-was-set"),
+				Name:        "Business Hours Policy",
+				Description: &emptyString,
+				Rules: []api.PolicyRule{
+					{
+						Id:            str("id-was-set"),
+						Name:          "Business Hours Rule",
+						Description:   str("Description"),
+						Protocol:      "tcp",
+						Action:        "accept",
+						Bidirectional: true,
+						Sources:       &[]api.GroupMinimum{{Id: "F"}},
+						Destinations:  &[]api.GroupMinimum{{Id: "G"}},
+						Schedule: &api.RuleSchedule{
+							Timezone: str("Europe/Berlin"),
+							Windows: []api.ScheduleWindow{
+								{
+									Days:  []api.ScheduleWindowDays{api.ScheduleWindowDaysMonday, api.ScheduleWindowDaysFriday},
+									Start: "09:00",
+									End:   "17:00",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST Invalid Schedule Day",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Business Hours Policy",
+                    "Rules":[
+                        {
+                            "Name":"Business Hours Rule",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+							"Sources": ["F"],
+							"Destinations": ["G"],
+							"Schedule": {"windows": [{"days": ["someday"], "start": "09:00", "end": "17:00"}]}
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   false,
+		},
 		{
 			name:        "WritePolicy PUT OK",
 			requestType: http.MethodPut,
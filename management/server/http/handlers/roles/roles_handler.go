@@ -0,0 +1,157 @@
+package roles
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/configs"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// handler is a handler that returns custom roles of the account
+type handler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+func AddEndpoints(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
+	rolesHandler := newHandler(accountManager, authCfg)
+	router.HandleFunc("/roles", rolesHandler.getAllRoles).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles", rolesHandler.createRole).Methods("POST", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.getRole).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.updateRole).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.deleteRole).Methods("DELETE", "OPTIONS")
+}
+
+// newHandler creates a new roles handler
+func newHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *handler {
+	return &handler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// getAllRoles returns the list of custom roles for the account
+func (h *handler) getAllRoles(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountRoles, err := h.accountManager.GetCustomRoles(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	rolesResponse := make([]*api.Role, 0, len(accountRoles))
+	for _, role := range accountRoles {
+		rolesResponse = append(rolesResponse, role.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, rolesResponse)
+}
+
+// createRole handles custom role creation request
+func (h *handler) createRole(w http.ResponseWriter, r *http.Request) {
+	h.saveRole(w, r, "")
+}
+
+// updateRole handles update to a custom role identified by a given ID
+func (h *handler) updateRole(w http.ResponseWriter, r *http.Request) {
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	h.saveRole(w, r, roleID)
+}
+
+// saveRole handles custom role creation and update
+func (h *handler) saveRole(w http.ResponseWriter, r *http.Request, roleID string) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.RoleRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	role := &roles.CustomRole{ID: roleID}
+	role.FromAPIRequest(&req)
+
+	role, err = h.accountManager.SaveCustomRole(r.Context(), accountID, userID, role)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+// getRole handles a custom role Get request identified by ID
+func (h *handler) getRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	role, err := h.accountManager.GetCustomRole(r.Context(), accountID, userID, roleID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+// deleteRole handles custom role deletion request
+func (h *handler) deleteRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	roleID := mux.Vars(r)["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	err = h.accountManager.DeleteCustomRole(r.Context(), accountID, userID, roleID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
@@ -0,0 +1,200 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	testAccountID = "test_id"
+	testUserID    = "test_user"
+	existingRole  = "existing_role"
+)
+
+func initRolesTestData() *handler {
+	testRole := &roles.CustomRole{
+		ID:        existingRole,
+		AccountID: testAccountID,
+		Name:      "network operator",
+		Permissions: roles.Permissions{
+			modules.Routes: {operations.Read, operations.Update},
+		},
+	}
+
+	return &handler{
+		accountManager: &mock_server.MockAccountManager{
+			GetCustomRolesFunc: func(_ context.Context, _, _ string) ([]*roles.CustomRole, error) {
+				return []*roles.CustomRole{testRole}, nil
+			},
+			GetCustomRoleFunc: func(_ context.Context, _, _, roleID string) (*roles.CustomRole, error) {
+				if roleID != existingRole {
+					return nil, status.NewCustomRoleNotFoundError(roleID)
+				}
+				return testRole, nil
+			},
+			SaveCustomRoleFunc: func(_ context.Context, _, _ string, role *roles.CustomRole) (*roles.CustomRole, error) {
+				if err := role.Validate(); err != nil {
+					return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+				}
+				if role.ID == "" {
+					role.ID = "new_role"
+				}
+				return role, nil
+			},
+			DeleteCustomRoleFunc: func(_ context.Context, _, _, roleID string) error {
+				if roleID != existingRole {
+					return status.NewCustomRoleNotFoundError(roleID)
+				}
+				return nil
+			},
+			GetAccountIDFromTokenFunc: func(_ context.Context, _ jwtclaims.AuthorizationClaims) (string, string, error) {
+				return testAccountID, testUserID, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    testUserID,
+					Domain:    "hotmail.com",
+					AccountId: testAccountID,
+				}
+			}),
+		),
+	}
+}
+
+func TestRolesHandlers(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    io.Reader
+		expectedStatus int
+		expectedRole   *api.Role
+	}{
+		{
+			name:           "Get Existing Role",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/roles/" + existingRole,
+			expectedStatus: http.StatusOK,
+			expectedRole: &api.Role{
+				Id:   existingRole,
+				Name: "network operator",
+				Permissions: []api.RolePermission{
+					{Module: api.RolePermissionModuleRoutes, Operations: []api.RolePermissionOperations{"read", "update"}},
+				},
+			},
+		},
+		{
+			name:           "Get Not Existing Role",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/roles/not_existing",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create Role",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/roles",
+			requestBody:    bytes.NewBufferString(`{"name":"dns admin","permissions":[{"module":"dns","operations":["read","update"]}]}`),
+			expectedStatus: http.StatusOK,
+			expectedRole: &api.Role{
+				Id:   "new_role",
+				Name: "dns admin",
+				Permissions: []api.RolePermission{
+					{Module: api.RolePermissionModuleDns, Operations: []api.RolePermissionOperations{"read", "update"}},
+				},
+			},
+		},
+		{
+			name:           "Create Role With Unknown Module",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/roles",
+			requestBody:    bytes.NewBufferString(`{"name":"billing","permissions":[{"module":"billing","operations":["read"]}]}`),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Update Role",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/roles/" + existingRole,
+			requestBody:    bytes.NewBufferString(`{"name":"route reader","permissions":[{"module":"routes","operations":["read"]}]}`),
+			expectedStatus: http.StatusOK,
+			expectedRole: &api.Role{
+				Id:   existingRole,
+				Name: "route reader",
+				Permissions: []api.RolePermission{
+					{Module: api.RolePermissionModuleRoutes, Operations: []api.RolePermissionOperations{"read"}},
+				},
+			},
+		},
+		{
+			name:           "Delete Role",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/roles/" + existingRole,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Delete Not Existing Role",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/roles/not_existing",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			h := initRolesTestData()
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, tc.requestBody)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/roles", h.getAllRoles).Methods("GET")
+			router.HandleFunc("/api/roles", h.createRole).Methods("POST")
+			router.HandleFunc("/api/roles/{roleId}", h.getRole).Methods("GET")
+			router.HandleFunc("/api/roles/{roleId}", h.updateRole).Methods("PUT")
+			router.HandleFunc("/api/roles/{roleId}", h.deleteRole).Methods("DELETE")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			content, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("failed to read response body: %v", err)
+			}
+
+			if !assert.Equal(t, tc.expectedStatus, recorder.Code, "content: %s", string(content)) {
+				return
+			}
+
+			if tc.expectedRole == nil {
+				return
+			}
+
+			got := &api.Role{}
+			if err = json.Unmarshal(content, got); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+
+			assert.Equal(t, tc.expectedRole.Id, got.Id)
+			assert.Equal(t, tc.expectedRole.Name, got.Name)
+			assert.Equal(t, tc.expectedRole.Permissions, got.Permissions)
+		})
+	}
+}
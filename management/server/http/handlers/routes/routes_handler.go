@@ -133,7 +133,7 @@ func (h *handler) createRoute(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newRoute, err := h.accountManager.CreateRoute(r.Context(), accountID, newPrefix, networkType, domains, peerId, peerGroupIds,
-		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute)
+		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute, toExitNode(req.ExitNode))
 
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -252,6 +252,8 @@ func (h *handler) updateRoute(w http.ResponseWriter, r *http.Request) {
 		newRoute.AccessControlGroups = *req.AccessControlGroups
 	}
 
+	newRoute.ExitNode = toExitNode(req.ExitNode)
+
 	err = h.accountManager.SaveRoute(r.Context(), accountID, userID, newRoute)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -348,9 +350,39 @@ func toRouteResponse(serverRoute *route.Route) (*api.Route, error) {
 	if len(serverRoute.AccessControlGroups) > 0 {
 		route.AccessControlGroups = &serverRoute.AccessControlGroups
 	}
+	if serverRoute.ExitNode.Enabled {
+		route.ExitNode = toExitNodeResponse(serverRoute.ExitNode)
+	}
 	return route, nil
 }
 
+func toExitNode(req *api.RouteExitNode) route.ExitNode {
+	if req == nil {
+		return route.ExitNode{}
+	}
+
+	exitNode := route.ExitNode{Enabled: req.Enabled}
+	if req.UserGroups != nil {
+		exitNode.UserGroups = *req.UserGroups
+	}
+	if req.AllowLanAccess != nil {
+		exitNode.AllowLANAccess = *req.AllowLanAccess
+	}
+	return exitNode
+}
+
+func toExitNodeResponse(exitNode route.ExitNode) *api.RouteExitNode {
+	userGroups := exitNode.UserGroups
+	if userGroups == nil {
+		userGroups = []string{}
+	}
+	return &api.RouteExitNode{
+		Enabled:        exitNode.Enabled,
+		UserGroups:     &userGroups,
+		AllowLanAccess: &exitNode.AllowLANAccess,
+	}
+}
+
 // validateDomains checks if each domain in the list is valid and returns a punycode-encoded DomainList.
 func validateDomains(domains []string) (domain.List, error) {
 	if len(domains) == 0 {
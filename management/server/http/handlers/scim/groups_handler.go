@@ -0,0 +1,146 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// getAllGroups returns the provisioned groups matching the filter of the request
+func (h *handler) getAllGroups(w http.ResponseWriter, r *http.Request, accountID string) {
+	groups, err := h.accountManager.GetSCIMGroups(r.Context(), accountID)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	base := baseURL(r)
+	resources := make([]any, 0, len(groups))
+	for _, group := range groups {
+		resources = append(resources, scim.NewGroupResource(group, base))
+	}
+
+	response, err := listResponse(r, resources)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	// identity providers exclude the members when looking up groups by name to avoid large responses
+	if excludesMembers(r) {
+		for _, resource := range response.Resources {
+			resource.(*scim.GroupResource).Members = nil
+		}
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, response)
+}
+
+// getGroup returns a provisioned group by ID
+func (h *handler) getGroup(w http.ResponseWriter, r *http.Request, accountID string) {
+	group, err := h.accountManager.GetSCIMGroup(r.Context(), accountID, mux.Vars(r)["groupId"])
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	resource := scim.NewGroupResource(group, baseURL(r))
+	if excludesMembers(r) {
+		resource.Members = nil
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, resource)
+}
+
+// createGroup provisions a new group
+func (h *handler) createGroup(w http.ResponseWriter, r *http.Request, accountID string) {
+	var req scim.GroupResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	group, err := req.ToGroup()
+	if err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "%v", err))
+		return
+	}
+
+	group, err = h.accountManager.CreateSCIMGroup(r.Context(), accountID, group)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusCreated, scim.NewGroupResource(group, baseURL(r)))
+}
+
+// replaceGroup replaces the name and members of a provisioned group
+func (h *handler) replaceGroup(w http.ResponseWriter, r *http.Request, accountID string) {
+	var req scim.GroupResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	h.updateGroup(w, r, accountID, &req)
+}
+
+// patchGroup modifies the name or members of a provisioned group
+func (h *handler) patchGroup(w http.ResponseWriter, r *http.Request, accountID string) {
+	group, err := h.accountManager.GetSCIMGroup(r.Context(), accountID, mux.Vars(r)["groupId"])
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	var patched scim.GroupResource
+	if err = patchResource(r, scim.NewGroupResource(group, baseURL(r)), &patched); err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	h.updateGroup(w, r, accountID, &patched)
+}
+
+func (h *handler) updateGroup(w http.ResponseWriter, r *http.Request, accountID string, req *scim.GroupResource) {
+	group, err := req.ToGroup()
+	if err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "%v", err))
+		return
+	}
+	group.ID = mux.Vars(r)["groupId"]
+
+	group, err = h.accountManager.UpdateSCIMGroup(r.Context(), accountID, group)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, scim.NewGroupResource(group, baseURL(r)))
+}
+
+// deleteGroup deletes a provisioned group
+func (h *handler) deleteGroup(w http.ResponseWriter, r *http.Request, accountID string) {
+	if err := h.accountManager.DeleteSCIMGroup(r.Context(), accountID, mux.Vars(r)["groupId"]); err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// excludesMembers returns true if the request excludes the members attribute
+func excludesMembers(r *http.Request) bool {
+	for _, attribute := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attribute), "members") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,293 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/configs"
+	"github.com/netbirdio/netbird/management/server/http/middleware/bypass"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// scimPathPrefix is the path of the SCIM API relative to the API router
+	scimPathPrefix = "/scim/v2"
+	contentType    = "application/scim+json"
+
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+// handler serves the SCIM 2.0 API for identity providers and the management of the SCIM token
+type handler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// scimHandlerFunc is a SCIM request handler for the account the SCIM token of the request belongs to
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, accountID string)
+
+func AddEndpoints(accountManager server.AccountManager, authCfg configs.AuthCfg, router *mux.Router) {
+	scimHandler := newHandler(accountManager, authCfg)
+	router.HandleFunc("/scim/token", scimHandler.getToken).Methods("GET", "OPTIONS")
+	router.HandleFunc("/scim/token", scimHandler.createToken).Methods("POST", "OPTIONS")
+	router.HandleFunc("/scim/token", scimHandler.deleteToken).Methods("DELETE", "OPTIONS")
+
+	// the SCIM API authenticates requests with the SCIM token instead of user credentials
+	for _, path := range []string{"/api" + scimPathPrefix + "/*", "/api" + scimPathPrefix + "/*/*"} {
+		if err := bypass.AddBypassPath(path); err != nil {
+			log.Errorf("failed to add SCIM bypass path %s: %v", path, err)
+		}
+	}
+
+	scimRouter := router.PathPrefix(scimPathPrefix).Subrouter()
+	scimRouter.HandleFunc("/ServiceProviderConfig", scimHandler.authenticate(scimHandler.getServiceProviderConfig)).Methods("GET")
+	scimRouter.HandleFunc("/ResourceTypes", scimHandler.authenticate(scimHandler.getResourceTypes)).Methods("GET")
+	scimRouter.HandleFunc("/Users", scimHandler.authenticate(scimHandler.getAllUsers)).Methods("GET")
+	scimRouter.HandleFunc("/Users", scimHandler.authenticate(scimHandler.createUser)).Methods("POST")
+	scimRouter.HandleFunc("/Users/{userId}", scimHandler.authenticate(scimHandler.getUser)).Methods("GET")
+	scimRouter.HandleFunc("/Users/{userId}", scimHandler.authenticate(scimHandler.replaceUser)).Methods("PUT")
+	scimRouter.HandleFunc("/Users/{userId}", scimHandler.authenticate(scimHandler.patchUser)).Methods("PATCH")
+	scimRouter.HandleFunc("/Users/{userId}", scimHandler.authenticate(scimHandler.deleteUser)).Methods("DELETE")
+	scimRouter.HandleFunc("/Groups", scimHandler.authenticate(scimHandler.getAllGroups)).Methods("GET")
+	scimRouter.HandleFunc("/Groups", scimHandler.authenticate(scimHandler.createGroup)).Methods("POST")
+	scimRouter.HandleFunc("/Groups/{groupId}", scimHandler.authenticate(scimHandler.getGroup)).Methods("GET")
+	scimRouter.HandleFunc("/Groups/{groupId}", scimHandler.authenticate(scimHandler.replaceGroup)).Methods("PUT")
+	scimRouter.HandleFunc("/Groups/{groupId}", scimHandler.authenticate(scimHandler.patchGroup)).Methods("PATCH")
+	scimRouter.HandleFunc("/Groups/{groupId}", scimHandler.authenticate(scimHandler.deleteGroup)).Methods("DELETE")
+}
+
+// newHandler creates a new SCIM handler
+func newHandler(accountManager server.AccountManager, authCfg configs.AuthCfg) *handler {
+	return &handler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// authenticate resolves the account of the SCIM bearer token before calling the handler
+func (h *handler) authenticate(next scimHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authHeader := strings.Split(r.Header.Get("Authorization"), " ")
+		if len(authHeader) != 2 || !strings.EqualFold(authHeader[0], "Bearer") {
+			writeError(r.Context(), w, status.Errorf(status.Unauthorized, "missing SCIM bearer token"))
+			return
+		}
+
+		accountID, err := h.accountManager.GetAccountIDFromSCIMToken(r.Context(), authHeader[1])
+		if err != nil {
+			writeError(r.Context(), w, err)
+			return
+		}
+
+		next(w, r, accountID)
+	}
+}
+
+func (h *handler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request, _ string) {
+	config := map[string]any{
+		"schemas":          []string{scim.ServiceProviderConfigSchema},
+		"documentationUri": "https://docs.netbird.io",
+		"patch":            map[string]any{"supported": true},
+		"bulk":             map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword":   map[string]any{"supported": false},
+		"sort":             map[string]any{"supported": false},
+		"etag":             map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the SCIM token of the account",
+			"primary":     true,
+		}},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     baseURL(r) + "/ServiceProviderConfig",
+		},
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, config)
+}
+
+func (h *handler) getResourceTypes(w http.ResponseWriter, r *http.Request, _ string) {
+	location := baseURL(r) + "/ResourceTypes/"
+	resourceTypes := []any{
+		map[string]any{
+			"schemas":  []string{scim.ResourceTypeSchema},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   scim.UserSchema,
+			"meta":     map[string]any{"resourceType": "ResourceType", "location": location + "User"},
+		},
+		map[string]any{
+			"schemas":  []string{scim.ResourceTypeSchema},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   scim.GroupSchema,
+			"meta":     map[string]any{"resourceType": "ResourceType", "location": location + "Group"},
+		},
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, scim.NewListResponse(resourceTypes, len(resourceTypes), 1))
+}
+
+// listResponse filters the resources and returns the requested page
+func listResponse(r *http.Request, resources []any) (*scim.ListResponse, error) {
+	query := r.URL.Query()
+
+	startIndex := 1
+	if value := query.Get("startIndex"); value != "" {
+		index, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid startIndex %s", value)
+		}
+		startIndex = max(index, 1)
+	}
+
+	count := defaultCount
+	if value := query.Get("count"); value != "" {
+		c, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid count %s", value)
+		}
+		count = min(max(c, 0), maxCount)
+	}
+
+	if expression := query.Get("filter"); expression != "" {
+		filter, err := scim.ParseFilter(expression)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid filter: %v", err)
+		}
+
+		filtered := make([]any, 0, len(resources))
+		for _, resource := range resources {
+			attributes, err := toAttributes(resource)
+			if err != nil {
+				return nil, err
+			}
+			if filter.Matches(attributes) {
+				filtered = append(filtered, resource)
+			}
+		}
+		resources = filtered
+	}
+
+	total := len(resources)
+	start := min(startIndex-1, total)
+	end := min(start+count, total)
+
+	return scim.NewListResponse(resources[start:end], total, startIndex), nil
+}
+
+// toAttributes returns the JSON representation of a resource to evaluate filters and apply patches
+func toAttributes(resource any) (map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, status.Errorf(status.Internal, "failed to encode resource: %v", err)
+	}
+
+	var attributes map[string]any
+	if err = json.Unmarshal(data, &attributes); err != nil {
+		return nil, status.Errorf(status.Internal, "failed to decode resource: %v", err)
+	}
+	return attributes, nil
+}
+
+// patchResource applies the PATCH request of the body to the JSON representation of the resource and decodes the result into patched
+func patchResource(r *http.Request, resource any, patched any) error {
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return status.Errorf(status.InvalidArgument, "couldn't parse JSON request")
+	}
+
+	attributes, err := toAttributes(resource)
+	if err != nil {
+		return err
+	}
+
+	if err = scim.ApplyPatch(attributes, req.Operations); err != nil {
+		return status.Errorf(status.InvalidArgument, "%v", err)
+	}
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return status.Errorf(status.Internal, "failed to encode resource: %v", err)
+	}
+
+	if err = json.Unmarshal(data, patched); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid patched resource: %v", err)
+	}
+	return nil
+}
+
+// baseURL returns the URL of the SCIM API used for resource locations
+func baseURL(r *http.Request) string {
+	scheme := "https"
+	if r.TLS == nil {
+		scheme = "http"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + r.Host + "/api" + scimPathPrefix
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, code int, obj any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if obj == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		log.WithContext(ctx).Errorf("failed to encode SCIM response: %v", err)
+	}
+}
+
+// writeError writes the error in the SCIM error format
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	scimType := ""
+	detail := "internal server error"
+
+	if s, ok := status.FromError(err); ok && s != nil {
+		detail = s.Message
+		switch s.Type() {
+		case status.NotFound:
+			code = http.StatusNotFound
+		case status.AlreadyExists, status.UserAlreadyExists:
+			code = http.StatusConflict
+			scimType = "uniqueness"
+		case status.InvalidArgument, status.BadRequest:
+			code = http.StatusBadRequest
+			scimType = "invalidValue"
+		case status.PreconditionFailed:
+			code = http.StatusConflict
+		case status.Unauthorized, status.Unauthenticated:
+			code = http.StatusUnauthorized
+		case status.PermissionDenied:
+			code = http.StatusForbidden
+		default:
+			detail = "internal server error"
+		}
+	}
+
+	if code == http.StatusInternalServerError {
+		log.WithContext(ctx).Errorf("SCIM request failed: %v", err)
+	}
+
+	writeResponse(ctx, w, code, scim.NewErrorResponse(code, scimType, detail))
+}
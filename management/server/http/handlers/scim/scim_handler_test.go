@@ -0,0 +1,454 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	testAccountID = "test_id"
+	testUserID    = "test_user"
+	testToken     = "nbs_valid"
+)
+
+type testData struct {
+	users  map[string]*scim.User
+	groups map[string]*scim.Group
+}
+
+func initSCIMTestData() (*handler, *testData) {
+	data := &testData{
+		users: map[string]*scim.User{
+			"alice": {ID: "alice", UserName: "alice@example.com", DisplayName: "Alice", Email: "alice@example.com", Active: true},
+			"bob":   {ID: "bob", UserName: "bob@example.com", DisplayName: "Bob", Active: false},
+		},
+		groups: map[string]*scim.Group{
+			"engineering": {ID: "engineering", DisplayName: "Engineering", Members: []scim.Reference{{ID: "alice"}}},
+		},
+	}
+
+	sortedUsers := func() []*scim.User {
+		return []*scim.User{data.users["alice"], data.users["bob"]}
+	}
+
+	return &handler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromSCIMTokenFunc: func(_ context.Context, token string) (string, error) {
+				if token != testToken {
+					return "", status.Errorf(status.Unauthorized, "invalid SCIM token")
+				}
+				return testAccountID, nil
+			},
+			GetAccountIDFromTokenFunc: func(_ context.Context, _ jwtclaims.AuthorizationClaims) (string, string, error) {
+				return testAccountID, testUserID, nil
+			},
+			CreateSCIMTokenFunc: func(_ context.Context, _, userID string) (*scim.TokenGenerated, error) {
+				return scim.NewToken(testAccountID, userID)
+			},
+			GetSCIMUsersFunc: func(_ context.Context, _ string) ([]*scim.User, error) {
+				return sortedUsers(), nil
+			},
+			GetSCIMUserFunc: func(_ context.Context, _, userID string) (*scim.User, error) {
+				user, ok := data.users[userID]
+				if !ok {
+					return nil, status.NewUserNotFoundError(userID)
+				}
+				return user.Copy(), nil
+			},
+			CreateSCIMUserFunc: func(_ context.Context, _ string, user *scim.User) (*scim.User, error) {
+				for _, existing := range data.users {
+					if existing.UserName == user.UserName {
+						return nil, status.Errorf(status.AlreadyExists, "user with user name %s already exists", user.UserName)
+					}
+				}
+				user.ID = user.ExternalID
+				data.users[user.ID] = user
+				return user, nil
+			},
+			UpdateSCIMUserFunc: func(_ context.Context, _ string, user *scim.User) (*scim.User, error) {
+				if _, ok := data.users[user.ID]; !ok {
+					return nil, status.NewUserNotFoundError(user.ID)
+				}
+				data.users[user.ID] = user
+				return user, nil
+			},
+			DeleteSCIMUserFunc: func(_ context.Context, _, userID string) error {
+				if _, ok := data.users[userID]; !ok {
+					return status.NewUserNotFoundError(userID)
+				}
+				delete(data.users, userID)
+				return nil
+			},
+			GetSCIMGroupsFunc: func(_ context.Context, _ string) ([]*scim.Group, error) {
+				return []*scim.Group{data.groups["engineering"]}, nil
+			},
+			GetSCIMGroupFunc: func(_ context.Context, _, groupID string) (*scim.Group, error) {
+				group, ok := data.groups[groupID]
+				if !ok {
+					return nil, status.NewGroupNotFoundError(groupID)
+				}
+				return group.Copy(), nil
+			},
+			UpdateSCIMGroupFunc: func(_ context.Context, _ string, group *scim.Group) (*scim.Group, error) {
+				data.groups[group.ID] = group
+				return group, nil
+			},
+			DeleteSCIMGroupFunc: func(_ context.Context, _, groupID string) error {
+				return status.Errorf(status.PreconditionFailed, "group has been linked to policy: default")
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    testUserID,
+					Domain:    "hotmail.com",
+					AccountId: testAccountID,
+				}
+			}),
+		),
+	}, data
+}
+
+func newSCIMRouter(h *handler) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/scim/token", h.createToken).Methods("POST")
+	scimRouter := router.PathPrefix("/api" + scimPathPrefix).Subrouter()
+	scimRouter.HandleFunc("/ServiceProviderConfig", h.authenticate(h.getServiceProviderConfig)).Methods("GET")
+	scimRouter.HandleFunc("/Users", h.authenticate(h.getAllUsers)).Methods("GET")
+	scimRouter.HandleFunc("/Users", h.authenticate(h.createUser)).Methods("POST")
+	scimRouter.HandleFunc("/Users/{userId}", h.authenticate(h.getUser)).Methods("GET")
+	scimRouter.HandleFunc("/Users/{userId}", h.authenticate(h.replaceUser)).Methods("PUT")
+	scimRouter.HandleFunc("/Users/{userId}", h.authenticate(h.patchUser)).Methods("PATCH")
+	scimRouter.HandleFunc("/Users/{userId}", h.authenticate(h.deleteUser)).Methods("DELETE")
+	scimRouter.HandleFunc("/Groups", h.authenticate(h.getAllGroups)).Methods("GET")
+	scimRouter.HandleFunc("/Groups/{groupId}", h.authenticate(h.patchGroup)).Methods("PATCH")
+	scimRouter.HandleFunc("/Groups/{groupId}", h.authenticate(h.deleteGroup)).Methods("DELETE")
+	return router
+}
+
+func TestSCIMHandler_Authentication(t *testing.T) {
+	h, _ := initSCIMTestData()
+	router := newSCIMRouter(h)
+
+	tt := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+	}{
+		{name: "missing token", authorization: "", expectedStatus: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer nbs_invalid", expectedStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", authorization: "Token " + testToken, expectedStatus: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer " + testToken, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/scim/v2/ServiceProviderConfig", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, contentType, recorder.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestSCIMHandler_Users(t *testing.T) {
+	tt := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+		check          func(t *testing.T, body []byte, data *testData)
+	}{
+		{
+			name:           "list users",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response listResponseBody[scim.UserResource]
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 2, response.TotalResults)
+				assert.Len(t, response.Resources, 2)
+			},
+		},
+		{
+			name:           "filter users by user name",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users?filter=" + urlEncode(`userName eq "ALICE@example.com"`),
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response listResponseBody[scim.UserResource]
+				require.NoError(t, json.Unmarshal(body, &response))
+				require.Equal(t, 1, response.TotalResults)
+				assert.Equal(t, "alice", response.Resources[0].ID)
+				assert.Equal(t, "http://example.com/api/scim/v2/Users/alice", response.Resources[0].Meta.Location)
+			},
+		},
+		{
+			name:           "paginate users",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users?startIndex=2&count=5",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response listResponseBody[scim.UserResource]
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, 2, response.TotalResults)
+				assert.Equal(t, 2, response.StartIndex)
+				require.Len(t, response.Resources, 1)
+				assert.Equal(t, "bob", response.Resources[0].ID)
+			},
+		},
+		{
+			name:           "invalid filter",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users?filter=" + urlEncode(`userName xx "alice"`),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "get user",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users/alice",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response scim.UserResource
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, "alice@example.com", response.UserName)
+				require.Len(t, response.Emails, 1)
+				assert.Equal(t, "alice@example.com", response.Emails[0].Value)
+			},
+		},
+		{
+			name:           "get unknown user",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Users/unknown",
+			expectedStatus: http.StatusNotFound,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response scim.ErrorResponse
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, []string{scim.ErrorSchema}, response.Schemas)
+				assert.Equal(t, "404", response.Status)
+			},
+		},
+		{
+			name:   "create user",
+			method: http.MethodPost,
+			path:   "/api/scim/v2/Users",
+			body: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"externalId":"carol","userName":"carol@example.com",
+				"name":{"givenName":"Carol","familyName":"Smith"},"emails":[{"value":"carol@home.example"},{"value":"carol@example.com","primary":true}],"active":true}`,
+			expectedStatus: http.StatusCreated,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				user := data.users["carol"]
+				require.NotNil(t, user)
+				assert.Equal(t, "carol@example.com", user.Email)
+				assert.Equal(t, "Smith", user.FamilyName)
+				assert.True(t, user.Active)
+			},
+		},
+		{
+			name:           "create duplicate user",
+			method:         http.MethodPost,
+			path:           "/api/scim/v2/Users",
+			body:           `{"userName":"alice@example.com"}`,
+			expectedStatus: http.StatusConflict,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response scim.ErrorResponse
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, "uniqueness", response.ScimType)
+			},
+		},
+		{
+			name:           "create user without user name",
+			method:         http.MethodPost,
+			path:           "/api/scim/v2/Users",
+			body:           `{"displayName":"nobody"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "replace user",
+			method:         http.MethodPut,
+			path:           "/api/scim/v2/Users/bob",
+			body:           `{"userName":"robert@example.com","active":true}`,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				assert.Equal(t, "robert@example.com", data.users["bob"].UserName)
+				assert.True(t, data.users["bob"].Active)
+			},
+		},
+		{
+			name:   "deactivate user with patch",
+			method: http.MethodPatch,
+			path:   "/api/scim/v2/Users/alice",
+			body: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"Replace","path":"active","value":"False"},{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice@corp.example"}]}`,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				user := data.users["alice"]
+				assert.False(t, user.Active)
+				assert.Equal(t, "alice@corp.example", user.Email)
+				assert.Equal(t, "alice@example.com", user.UserName)
+			},
+		},
+		{
+			name:           "patch with unsupported operation",
+			method:         http.MethodPatch,
+			path:           "/api/scim/v2/Users/alice",
+			body:           `{"Operations":[{"op":"move","path":"active"}]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "delete user",
+			method:         http.MethodDelete,
+			path:           "/api/scim/v2/Users/bob",
+			expectedStatus: http.StatusNoContent,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				assert.NotContains(t, data.users, "bob")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			h, data := initSCIMTestData()
+			body, code := doSCIMRequest(t, newSCIMRouter(h), tc.method, tc.path, tc.body)
+			assert.Equal(t, tc.expectedStatus, code, string(body))
+			if tc.check != nil {
+				tc.check(t, body, data)
+			}
+		})
+	}
+}
+
+func TestSCIMHandler_Groups(t *testing.T) {
+	tt := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+		check          func(t *testing.T, body []byte, data *testData)
+	}{
+		{
+			name:           "list groups without members",
+			method:         http.MethodGet,
+			path:           "/api/scim/v2/Groups?excludedAttributes=members&filter=" + urlEncode(`displayName eq "Engineering"`),
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte, _ *testData) {
+				var response listResponseBody[scim.GroupResource]
+				require.NoError(t, json.Unmarshal(body, &response))
+				require.Len(t, response.Resources, 1)
+				assert.Empty(t, response.Resources[0].Members)
+			},
+		},
+		{
+			name:   "add and remove members with patch",
+			method: http.MethodPatch,
+			path:   "/api/scim/v2/Groups/engineering",
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"bob"}]},
+				{"op":"remove","path":"members[value eq \"alice\"]"}]}`,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				assert.Equal(t, []string{"bob"}, data.groups["engineering"].MemberIDs())
+			},
+		},
+		{
+			name:           "rename group with patch",
+			method:         http.MethodPatch,
+			path:           "/api/scim/v2/Groups/engineering",
+			body:           `{"Operations":[{"op":"replace","value":{"displayName":"Platform"}}]}`,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, _ []byte, data *testData) {
+				group := data.groups["engineering"]
+				assert.Equal(t, "Platform", group.DisplayName)
+				assert.True(t, slices.Contains(group.MemberIDs(), "alice"))
+			},
+		},
+		{
+			name:           "delete linked group",
+			method:         http.MethodDelete,
+			path:           "/api/scim/v2/Groups/engineering",
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			h, data := initSCIMTestData()
+			body, code := doSCIMRequest(t, newSCIMRouter(h), tc.method, tc.path, tc.body)
+			assert.Equal(t, tc.expectedStatus, code, string(body))
+			if tc.check != nil {
+				tc.check(t, body, data)
+			}
+		})
+	}
+}
+
+func TestSCIMHandler_CreateToken(t *testing.T) {
+	h, _ := initSCIMTestData()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/scim/token", nil)
+	recorder := httptest.NewRecorder()
+	newSCIMRouter(h).ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var response api.SCIMTokenGenerated
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+	assert.NoError(t, scim.ValidateToken(response.PlainToken))
+	assert.Equal(t, testUserID, response.ScimToken.CreatedBy)
+}
+
+type listResponseBody[T any] struct {
+	TotalResults int `json:"totalResults"`
+	StartIndex   int `json:"startIndex"`
+	Resources    []T `json:"Resources"`
+}
+
+func doSCIMRequest(t *testing.T, router http.Handler, method, path, body string) ([]byte, int) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Authorization", "Bearer "+testToken)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	defer res.Body.Close()
+
+	content, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return content, res.StatusCode
+}
+
+func urlEncode(value string) string {
+	return url.QueryEscape(value)
+}
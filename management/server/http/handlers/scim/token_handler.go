@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/scim"
+)
+
+// getToken returns the SCIM token of the account
+func (h *handler) getToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	token, err := h.accountManager.GetSCIMToken(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toSCIMTokenResponse(token))
+}
+
+// createToken creates the SCIM token of the account and returns it in plain text
+func (h *handler) createToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	token, err := h.accountManager.CreateSCIMToken(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, &api.SCIMTokenGenerated{
+		PlainToken: token.PlainToken,
+		ScimToken:  *toSCIMTokenResponse(&token.Token),
+	})
+}
+
+// deleteToken deletes the SCIM token of the account
+func (h *handler) deleteToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if err = h.accountManager.DeleteSCIMToken(r.Context(), accountID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func toSCIMTokenResponse(token *scim.Token) *api.SCIMToken {
+	return &api.SCIMToken{
+		Id:        token.ID,
+		CreatedBy: token.CreatedBy,
+		CreatedAt: token.CreatedAt,
+		LastUsed:  token.LastUsed,
+	}
+}
@@ -0,0 +1,123 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// getAllUsers returns the provisioned users matching the filter of the request
+func (h *handler) getAllUsers(w http.ResponseWriter, r *http.Request, accountID string) {
+	users, err := h.accountManager.GetSCIMUsers(r.Context(), accountID)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	base := baseURL(r)
+	resources := make([]any, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, scim.NewUserResource(user, base))
+	}
+
+	response, err := listResponse(r, resources)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, response)
+}
+
+// getUser returns a provisioned user by ID
+func (h *handler) getUser(w http.ResponseWriter, r *http.Request, accountID string) {
+	user, err := h.accountManager.GetSCIMUser(r.Context(), accountID, mux.Vars(r)["userId"])
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, scim.NewUserResource(user, baseURL(r)))
+}
+
+// createUser provisions a new user
+func (h *handler) createUser(w http.ResponseWriter, r *http.Request, accountID string) {
+	var req scim.UserResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	user, err := req.ToUser()
+	if err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "%v", err))
+		return
+	}
+
+	user, err = h.accountManager.CreateSCIMUser(r.Context(), accountID, user)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusCreated, scim.NewUserResource(user, baseURL(r)))
+}
+
+// replaceUser replaces the attributes of a provisioned user
+func (h *handler) replaceUser(w http.ResponseWriter, r *http.Request, accountID string) {
+	var req scim.UserResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "couldn't parse JSON request"))
+		return
+	}
+
+	h.updateUser(w, r, accountID, &req)
+}
+
+// patchUser modifies the attributes of a provisioned user
+func (h *handler) patchUser(w http.ResponseWriter, r *http.Request, accountID string) {
+	user, err := h.accountManager.GetSCIMUser(r.Context(), accountID, mux.Vars(r)["userId"])
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	var patched scim.UserResource
+	if err = patchResource(r, scim.NewUserResource(user, baseURL(r)), &patched); err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	h.updateUser(w, r, accountID, &patched)
+}
+
+func (h *handler) updateUser(w http.ResponseWriter, r *http.Request, accountID string, req *scim.UserResource) {
+	user, err := req.ToUser()
+	if err != nil {
+		writeError(r.Context(), w, status.Errorf(status.InvalidArgument, "%v", err))
+		return
+	}
+	user.ID = mux.Vars(r)["userId"]
+
+	user, err = h.accountManager.UpdateSCIMUser(r.Context(), accountID, user)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusOK, scim.NewUserResource(user, baseURL(r)))
+}
+
+// deleteUser deletes a provisioned user and its peers
+func (h *handler) deleteUser(w http.ResponseWriter, r *http.Request, accountID string) {
+	if err := h.accountManager.DeleteSCIMUser(r.Context(), accountID, mux.Vars(r)["userId"]); err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	writeResponse(r.Context(), w, http.StatusNoContent, nil)
+}
@@ -84,8 +84,9 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userRole := types.StrRoleToUserRole(req.Role)
-	if userRole == types.UserRoleUnknown {
+	// the role is either one of the built-in roles or a custom role ID, it is validated by the account manager
+	userRole := types.UserRole(req.Role)
+	if userRole == "" {
 		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid user role"), w)
 		return
 	}
@@ -157,8 +158,8 @@ func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if types.StrRoleToUserRole(req.Role) == types.UserRoleUnknown {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown user role %s", req.Role), w)
+	if req.Role == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "user role can't be empty"), w)
 		return
 	}
 
@@ -59,7 +59,8 @@ func (a *AccessControl) Handler(h http.Handler) http.Handler {
 			return
 		}
 
-		if !user.HasAdminPower() {
+		// permissions of users with a custom role are validated by the account manager per resource
+		if !user.HasAdminPower() && !user.HasCustomRole() {
 			switch r.Method {
 			case http.MethodDelete, http.MethodPost, http.MethodPatch, http.MethodPut:
 
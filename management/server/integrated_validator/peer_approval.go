@@ -0,0 +1,70 @@
+package integrated_validator
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// PeerApprovalValidator is the built-in validator that keeps newly registered peers pending until an administrator
+// approves them when peer approval is enabled in the account extra settings.
+// Everything else is delegated to the wrapped validator.
+type PeerApprovalValidator struct {
+	IntegratedValidator
+}
+
+// NewPeerApprovalValidator returns a PeerApprovalValidator wrapping the given validator
+func NewPeerApprovalValidator(next IntegratedValidator) *PeerApprovalValidator {
+	return &PeerApprovalValidator{IntegratedValidator: next}
+}
+
+// PreparePeer marks the new peer as pending approval if the account requires it
+func (v *PeerApprovalValidator) PreparePeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *account.ExtraSettings) *nbpeer.Peer {
+	peer = v.IntegratedValidator.PreparePeer(ctx, accountID, peer, peersGroup, extraSettings)
+	if isPeerApprovalEnabled(extraSettings) && peer.Status != nil {
+		peer.Status.RequiresApproval = true
+	}
+	return peer
+}
+
+// PrepareApprovalExemptPeer prepares the new peer with the wrapped validator only, for the peers that skip the built-in
+// approval. The wrapped validator may still require an approval.
+func (v *PeerApprovalValidator) PrepareApprovalExemptPeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *account.ExtraSettings) *nbpeer.Peer {
+	return v.IntegratedValidator.PreparePeer(ctx, accountID, peer, peersGroup, extraSettings)
+}
+
+// IsNotValidPeer reports the peer as not valid while it is pending approval
+func (v *PeerApprovalValidator) IsNotValidPeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *account.ExtraSettings) (bool, bool, error) {
+	notValid, statusChanged, err := v.IntegratedValidator.IsNotValidPeer(ctx, accountID, peer, peersGroup, extraSettings)
+	if err != nil {
+		return false, false, err
+	}
+
+	return notValid || isPendingApproval(peer, extraSettings), statusChanged, nil
+}
+
+// GetValidatedPeers excludes the peers pending approval from the peers validated by the wrapped validator
+func (v *PeerApprovalValidator) GetValidatedPeers(accountID string, groups map[string]*types.Group, peers map[string]*nbpeer.Peer, extraSettings *account.ExtraSettings) (map[string]struct{}, error) {
+	validatedPeers, err := v.IntegratedValidator.GetValidatedPeers(accountID, groups, peers, extraSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	for peerID := range validatedPeers {
+		if peer, ok := peers[peerID]; ok && isPendingApproval(peer, extraSettings) {
+			delete(validatedPeers, peerID)
+		}
+	}
+
+	return validatedPeers, nil
+}
+
+func isPeerApprovalEnabled(extraSettings *account.ExtraSettings) bool {
+	return extraSettings != nil && extraSettings.PeerApprovalEnabled
+}
+
+func isPendingApproval(peer *nbpeer.Peer, extraSettings *account.ExtraSettings) bool {
+	return isPeerApprovalEnabled(extraSettings) && peer.Status != nil && peer.Status.RequiresApproval
+}
@@ -0,0 +1,73 @@
+package integrated_validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+type allowAllValidator struct {
+	IntegratedValidator
+}
+
+func (allowAllValidator) PreparePeer(_ context.Context, _ string, peer *nbpeer.Peer, _ []string, _ *account.ExtraSettings) *nbpeer.Peer {
+	return peer.Copy()
+}
+
+func (allowAllValidator) IsNotValidPeer(_ context.Context, _ string, _ *nbpeer.Peer, _ []string, _ *account.ExtraSettings) (bool, bool, error) {
+	return false, false, nil
+}
+
+func (allowAllValidator) GetValidatedPeers(_ string, _ map[string]*types.Group, peers map[string]*nbpeer.Peer, _ *account.ExtraSettings) (map[string]struct{}, error) {
+	validatedPeers := make(map[string]struct{})
+	for peerID := range peers {
+		validatedPeers[peerID] = struct{}{}
+	}
+	return validatedPeers, nil
+}
+
+func TestPeerApprovalValidator(t *testing.T) {
+	validator := NewPeerApprovalValidator(allowAllValidator{})
+	enabled := &account.ExtraSettings{PeerApprovalEnabled: true}
+	disabled := &account.ExtraSettings{}
+
+	tt := []struct {
+		name             string
+		extraSettings    *account.ExtraSettings
+		expectedApproval bool
+	}{
+		{name: "approval enabled", extraSettings: enabled, expectedApproval: true},
+		{name: "approval disabled", extraSettings: disabled, expectedApproval: false},
+		{name: "no extra settings", extraSettings: nil, expectedApproval: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			peer := validator.PreparePeer(context.Background(), "account", &nbpeer.Peer{ID: "peer", Status: &nbpeer.PeerStatus{}}, nil, tc.extraSettings)
+			assert.Equal(t, tc.expectedApproval, peer.Status.RequiresApproval)
+
+			notValid, _, err := validator.IsNotValidPeer(context.Background(), "account", peer, nil, tc.extraSettings)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedApproval, notValid)
+
+			validatedPeers, err := validator.GetValidatedPeers("account", nil, map[string]*nbpeer.Peer{peer.ID: peer}, tc.extraSettings)
+			require.NoError(t, err)
+			_, valid := validatedPeers[peer.ID]
+			assert.Equal(t, !tc.expectedApproval, valid)
+		})
+	}
+
+	t.Run("pending peer is valid once approval is disabled", func(t *testing.T) {
+		peer := &nbpeer.Peer{ID: "peer", Status: &nbpeer.PeerStatus{RequiresApproval: true}}
+
+		validatedPeers, err := validator.GetValidatedPeers("account", nil, map[string]*nbpeer.Peer{peer.ID: peer}, disabled)
+		require.NoError(t, err)
+		assert.Contains(t, validatedPeers, peer.ID)
+	})
+}
@@ -15,6 +15,9 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/types"
 )
 
 func GetColumnName(db *gorm.DB, column string) string {
@@ -352,3 +355,158 @@ func MigrateNewField[T any](ctx context.Context, db *gorm.DB, columnName string,
 	log.WithContext(ctx).Infof("Migration of empty %s to default value in table %s completed", columnName, tableName)
 	return nil
 }
+
+// MigrateIPv6Overlay allocates an IPv6 overlay network for the accounts created before the IPv6 support
+// and assigns an IPv6 address from it to every peer of these accounts.
+func MigrateIPv6Overlay(ctx context.Context, db *gorm.DB) error {
+	networkColumn := "network_net_v6"
+	ipColumn := "ipv6"
+
+	var account types.Account
+	var peer nbpeer.Peer
+
+	if !db.Migrator().HasTable(&account) || !db.Migrator().HasTable(&peer) {
+		log.WithContext(ctx).Debugf("Tables for %T or %T do not exist, no migration needed", account, peer)
+		return nil
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&account, networkColumn) {
+			log.WithContext(ctx).Infof("Column %s does not exist in table accounts, adding it", networkColumn)
+			if err := tx.Migrator().AddColumn(&account, networkColumn); err != nil {
+				return fmt.Errorf("add column %s: %w", networkColumn, err)
+			}
+		}
+
+		if !tx.Migrator().HasColumn(&peer, ipColumn) {
+			log.WithContext(ctx).Infof("Column %s does not exist in table peers, adding it", ipColumn)
+			if err := tx.Migrator().AddColumn(&peer, ipColumn); err != nil {
+				return fmt.Errorf("add column %s: %w", ipColumn, err)
+			}
+		}
+
+		var accountRows []map[string]any
+		if err := tx.Table("accounts").Select("id", networkColumn).Find(&accountRows).Error; err != nil {
+			return fmt.Errorf("find accounts: %w", err)
+		}
+
+		for _, row := range accountRows {
+			if _, err := getOrCreateNetworkV6(tx, row, networkColumn); err != nil {
+				return fmt.Errorf("create IPv6 network of account %v: %w", row["id"], err)
+			}
+		}
+
+		var accountIDs []string
+		if err := tx.Table("peers").Distinct("account_id").
+			Where(ipColumn+" IS NULL OR "+ipColumn+" IN ?", []string{"", `""`, "null"}).
+			Pluck("account_id", &accountIDs).Error; err != nil {
+			return fmt.Errorf("find peers without IPv6 address: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			var row map[string]any
+			if err := tx.Table("accounts").Select("id", networkColumn).Where("id = ?", accountID).Take(&row).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				return fmt.Errorf("find account %s: %w", accountID, err)
+			}
+
+			netV6, err := getOrCreateNetworkV6(tx, row, networkColumn)
+			if err != nil {
+				return fmt.Errorf("get IPv6 network of account %s: %w", accountID, err)
+			}
+
+			if err := assignPeerIPv6s(tx, accountID, netV6, ipColumn); err != nil {
+				return fmt.Errorf("assign IPv6 addresses to peers of account %s: %w", accountID, err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	log.WithContext(ctx).Infof("Migration of IPv6 overlay addresses completed")
+	return nil
+}
+
+// getOrCreateNetworkV6 returns the IPv6 overlay network of the account row, allocating it if the row has none
+func getOrCreateNetworkV6(tx *gorm.DB, row map[string]any, networkColumn string) (net.IPNet, error) {
+	if value := columnString(row[networkColumn]); value != "" {
+		var netV6 net.IPNet
+		if err := json.Unmarshal([]byte(value), &netV6); err != nil {
+			return net.IPNet{}, fmt.Errorf("unmarshal network: %w", err)
+		}
+		if netV6.IP != nil {
+			return netV6, nil
+		}
+	}
+
+	netV6 := types.NewNetworkV6()
+	value, err := json.Marshal(netV6)
+	if err != nil {
+		return net.IPNet{}, fmt.Errorf("marshal network: %w", err)
+	}
+
+	if err := tx.Table("accounts").Where("id = ?", row["id"]).Update(networkColumn, string(value)).Error; err != nil {
+		return net.IPNet{}, fmt.Errorf("update network: %w", err)
+	}
+
+	return netV6, nil
+}
+
+// assignPeerIPv6s allocates an address from netV6 to every peer of the account that has no IPv6 address yet
+func assignPeerIPv6s(tx *gorm.DB, accountID string, netV6 net.IPNet, ipColumn string) error {
+	var peerRows []map[string]any
+	if err := tx.Table("peers").Select("id", ipColumn).Where("account_id = ?", accountID).Find(&peerRows).Error; err != nil {
+		return fmt.Errorf("find peers: %w", err)
+	}
+
+	var taken []net.IP
+	var missing []any
+	for _, row := range peerRows {
+		var ip net.IP
+		if value := columnString(row[ipColumn]); value != "" {
+			if err := json.Unmarshal([]byte(value), &ip); err != nil {
+				return fmt.Errorf("unmarshal ip of peer %v: %w", row["id"], err)
+			}
+		}
+
+		if ip == nil {
+			missing = append(missing, row["id"])
+			continue
+		}
+		taken = append(taken, ip)
+	}
+
+	for _, peerID := range missing {
+		ip, err := types.AllocatePeerIPv6(netV6, taken)
+		if err != nil {
+			return fmt.Errorf("allocate ip: %w", err)
+		}
+		taken = append(taken, ip)
+
+		value, err := json.Marshal(ip)
+		if err != nil {
+			return fmt.Errorf("marshal ip: %w", err)
+		}
+
+		if err := tx.Table("peers").Where("id = ?", peerID).Update(ipColumn, string(value)).Error; err != nil {
+			return fmt.Errorf("update peer %v: %w", peerID, err)
+		}
+	}
+
+	return nil
+}
+
+func columnString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
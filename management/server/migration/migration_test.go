@@ -229,3 +229,47 @@ func TestMigrateSetupKeyToHashedSetupKey_ForAlreadyMigratedKey_Case2(t *testing.
 
 	assert.Equal(t, "9+FQcmNd2GCxIK+SvHmtp6PPGV4MKEicDS+xuSQmvlE=", key.Key, "Key should be hashed")
 }
+
+func TestMigrateIPv6Overlay(t *testing.T) {
+	db := setupDatabase(t)
+
+	err := db.AutoMigrate(&types.Account{}, &nbpeer.Peer{})
+	require.NoError(t, err, "Failed to auto-migrate tables")
+
+	err = db.Save(&types.Account{Id: "ipv6-account", Network: &types.Network{Net: net.IPNet{IP: net.IP{100, 64, 0, 0}, Mask: net.CIDRMask(16, 32)}}}).Error
+	require.NoError(t, err, "Failed to insert account")
+	err = db.Model(&types.Account{}).Where("id = ?", "ipv6-account").Update("network_net_v6", nil).Error
+	require.NoError(t, err, "Failed to reset IPv6 network")
+
+	takenIP := net.ParseIP("fd00::1")
+	err = db.Save(&nbpeer.Peer{ID: "ipv6-peer-1", AccountID: "ipv6-account", IP: net.IP{100, 64, 0, 1}}).Error
+	require.NoError(t, err, "Failed to insert peer")
+	err = db.Save(&nbpeer.Peer{ID: "ipv6-peer-2", AccountID: "ipv6-account", IP: net.IP{100, 64, 0, 2}, IPv6: takenIP}).Error
+	require.NoError(t, err, "Failed to insert peer")
+
+	err = migration.MigrateIPv6Overlay(context.Background(), db)
+	require.NoError(t, err, "Migration should not fail")
+
+	var account types.Account
+	err = db.Model(&types.Account{}).Where("id = ?", "ipv6-account").First(&account).Error
+	require.NoError(t, err, "Failed to fetch account")
+	require.True(t, account.Network.HasNetV6(), "Account should have an IPv6 network")
+
+	var migratedPeer nbpeer.Peer
+	err = db.Model(&nbpeer.Peer{}).Where("id = ?", "ipv6-peer-1").First(&migratedPeer).Error
+	require.NoError(t, err, "Failed to fetch peer")
+	assert.True(t, account.Network.NetV6.Contains(migratedPeer.IPv6), "Peer IPv6 should be allocated from the account IPv6 network")
+
+	var existingPeer nbpeer.Peer
+	err = db.Model(&nbpeer.Peer{}).Where("id = ?", "ipv6-peer-2").First(&existingPeer).Error
+	require.NoError(t, err, "Failed to fetch peer")
+	assert.True(t, takenIP.Equal(existingPeer.IPv6), "Existing peer IPv6 should be unchanged")
+
+	netV6 := account.Network.NetV6
+	err = migration.MigrateIPv6Overlay(context.Background(), db)
+	require.NoError(t, err, "Second migration should not fail")
+
+	err = db.Model(&types.Account{}).Where("id = ?", "ipv6-account").First(&account).Error
+	require.NoError(t, err, "Failed to fetch account")
+	assert.Equal(t, netV6.String(), account.Network.NetV6.String(), "IPv6 network should be unchanged")
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/scim"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/route"
 )
@@ -35,6 +37,8 @@ type MockAccountManager struct {
 	MarkPeerConnectedFunc               func(ctx context.Context, peerKey string, connected bool, realIP net.IP) error
 	SyncAndMarkPeerFunc                 func(ctx context.Context, accountID string, peerPubKey string, meta nbpeer.PeerSystemMeta, realIP net.IP) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
 	DeletePeerFunc                      func(ctx context.Context, accountID, peerKey, userID string) error
+	ApprovePeerFunc                     func(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error)
+	RejectPeerFunc                      func(ctx context.Context, accountID, userID, peerID string) error
 	GetNetworkMapFunc                   func(ctx context.Context, peerKey string) (*types.NetworkMap, error)
 	GetPeerNetworkFunc                  func(ctx context.Context, peerKey string) (*types.Network, error)
 	AddPeerFunc                         func(ctx context.Context, setupKey string, userId string, peer *nbpeer.Peer) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error)
@@ -57,7 +61,7 @@ type MockAccountManager struct {
 	MarkPATUsedFunc                     func(ctx context.Context, pat string) error
 	UpdatePeerMetaFunc                  func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerFunc                      func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
-	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, exitNode route.ExitNode) (*route.Route, error)
 	GetRouteFunc                        func(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	SaveRouteFunc                       func(ctx context.Context, accountID string, userID string, route *route.Route) error
 	DeleteRouteFunc                     func(ctx context.Context, accountID string, routeID route.ID, userID string) error
@@ -74,17 +78,34 @@ type MockAccountManager struct {
 	GetPATFunc                          func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string, tokenID string) (*types.PersonalAccessToken, error)
 	GetAllPATsFunc                      func(ctx context.Context, accountID string, initiatorUserID string, targetUserId string) ([]*types.PersonalAccessToken, error)
 	GetNameServerGroupFunc              func(ctx context.Context, accountID, userID, nsGroupID string) (*nbdns.NameServerGroup, error)
-	CreateNameServerGroupFunc           func(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool) (*nbdns.NameServerGroup, error)
+	CreateNameServerGroupFunc           func(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool, dnssecValidation bool, dnssecTrustAnchors []string) (*nbdns.NameServerGroup, error)
 	SaveNameServerGroupFunc             func(ctx context.Context, accountID, userID string, nsGroupToSave *nbdns.NameServerGroup) error
 	DeleteNameServerGroupFunc           func(ctx context.Context, accountID, nsGroupID, userID string) error
 	ListNameServerGroupsFunc            func(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error)
+	GetDNSRecordFunc                    func(ctx context.Context, accountID, userID, recordID string) (*types.DNSRecord, error)
+	ListDNSRecordsFunc                  func(ctx context.Context, accountID, userID string) ([]*types.DNSRecord, error)
+	CreateDNSRecordFunc                 func(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error)
+	SaveDNSRecordFunc                   func(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error)
+	DeleteDNSRecordFunc                 func(ctx context.Context, accountID, userID, recordID string) error
+	GetDNSBlocklistFunc                 func(ctx context.Context, accountID, userID, blocklistID string) (*types.DNSBlocklist, error)
+	ListDNSBlocklistsFunc               func(ctx context.Context, accountID, userID string) ([]*types.DNSBlocklist, error)
+	CreateDNSBlocklistFunc              func(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error)
+	SaveDNSBlocklistFunc                func(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error)
+	DeleteDNSBlocklistFunc              func(ctx context.Context, accountID, userID, blocklistID string) error
+	GetDNSServiceNameFunc               func(ctx context.Context, accountID, userID, serviceNameID string) (*types.DNSServiceName, error)
+	ListDNSServiceNamesFunc             func(ctx context.Context, accountID, userID string) ([]*types.DNSServiceName, error)
+	GetDNSServiceNamesHealthyPeersFunc  func(ctx context.Context, accountID, userID string) (map[string][]string, error)
+	CreateDNSServiceNameFunc            func(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error)
+	SaveDNSServiceNameFunc              func(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error)
+	DeleteDNSServiceNameFunc            func(ctx context.Context, accountID, userID, serviceNameID string) error
 	CreateUserFunc                      func(ctx context.Context, accountID, userID string, key *types.UserInfo) (*types.UserInfo, error)
 	GetAccountIDFromTokenFunc           func(ctx context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error)
 	CheckUserAccessByJWTGroupsFunc      func(ctx context.Context, claims jwtclaims.AuthorizationClaims) error
 	DeleteAccountFunc                   func(ctx context.Context, accountID, userID string) error
 	GetDNSDomainFunc                    func() string
 	StoreEventFunc                      func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
-	GetEventsFunc                       func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	GetEventsFunc                       func(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, error)
+	ExportEventsFunc                    func(ctx context.Context, accountID, userID string, filter *activity.Filter, export func([]*activity.Event) error) error
 	GetDNSSettingsFunc                  func(ctx context.Context, accountID, userID string) (*types.DNSSettings, error)
 	SaveDNSSettingsFunc                 func(ctx context.Context, accountID, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeerFunc                         func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -109,6 +130,24 @@ type MockAccountManager struct {
 	GetUserByIDFunc                     func(ctx context.Context, id string) (*types.User, error)
 	GetAccountSettingsFunc              func(ctx context.Context, accountID string, userID string) (*types.Settings, error)
 	DeleteSetupKeyFunc                  func(ctx context.Context, accountID, userID, keyID string) error
+	GetCustomRolesFunc                  func(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error)
+	GetCustomRoleFunc                   func(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error)
+	SaveCustomRoleFunc                  func(ctx context.Context, accountID, userID string, role *roles.CustomRole) (*roles.CustomRole, error)
+	DeleteCustomRoleFunc                func(ctx context.Context, accountID, userID, roleID string) error
+	CreateSCIMTokenFunc                 func(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error)
+	GetSCIMTokenFunc                    func(ctx context.Context, accountID, userID string) (*scim.Token, error)
+	DeleteSCIMTokenFunc                 func(ctx context.Context, accountID, userID string) error
+	GetAccountIDFromSCIMTokenFunc       func(ctx context.Context, token string) (string, error)
+	GetSCIMUsersFunc                    func(ctx context.Context, accountID string) ([]*scim.User, error)
+	GetSCIMUserFunc                     func(ctx context.Context, accountID, userID string) (*scim.User, error)
+	CreateSCIMUserFunc                  func(ctx context.Context, accountID string, user *scim.User) (*scim.User, error)
+	UpdateSCIMUserFunc                  func(ctx context.Context, accountID string, user *scim.User) (*scim.User, error)
+	DeleteSCIMUserFunc                  func(ctx context.Context, accountID, userID string) error
+	GetSCIMGroupsFunc                   func(ctx context.Context, accountID string) ([]*scim.Group, error)
+	GetSCIMGroupFunc                    func(ctx context.Context, accountID, groupID string) (*scim.Group, error)
+	CreateSCIMGroupFunc                 func(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error)
+	UpdateSCIMGroupFunc                 func(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error)
+	DeleteSCIMGroupFunc                 func(ctx context.Context, accountID, groupID string) error
 }
 
 func (am *MockAccountManager) UpdateAccountPeers(ctx context.Context, accountID string) {
@@ -174,6 +213,22 @@ func (am *MockAccountManager) DeletePeer(ctx context.Context, accountID, peerID,
 	return status.Errorf(codes.Unimplemented, "method DeletePeer is not implemented")
 }
 
+// ApprovePeer mock implementation of ApprovePeer from server.AccountManager interface
+func (am *MockAccountManager) ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error) {
+	if am.ApprovePeerFunc != nil {
+		return am.ApprovePeerFunc(ctx, accountID, userID, peerID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePeer is not implemented")
+}
+
+// RejectPeer mock implementation of RejectPeer from server.AccountManager interface
+func (am *MockAccountManager) RejectPeer(ctx context.Context, accountID, userID, peerID string) error {
+	if am.RejectPeerFunc != nil {
+		return am.RejectPeerFunc(ctx, accountID, userID, peerID)
+	}
+	return status.Errorf(codes.Unimplemented, "method RejectPeer is not implemented")
+}
+
 // GetOrCreateAccountByUser mock implementation of GetOrCreateAccountByUser from server.AccountManager interface
 func (am *MockAccountManager) GetOrCreateAccountByUser(
 	ctx context.Context, userId, domain string,
@@ -445,9 +500,9 @@ func (am *MockAccountManager) UpdatePeer(ctx context.Context, accountID, userID
 }
 
 // CreateRoute mock implementation of CreateRoute from server.AccountManager interface
-func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool, exitNode route.ExitNode) (*route.Route, error) {
 	if am.CreateRouteFunc != nil {
-		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute)
+		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute, exitNode)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute is not implemented")
 }
@@ -567,9 +622,9 @@ func (am *MockAccountManager) GetNameServerGroup(ctx context.Context, accountID,
 }
 
 // CreateNameServerGroup mocks CreateNameServerGroup of the AccountManager interface
-func (am *MockAccountManager) CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool) (*nbdns.NameServerGroup, error) {
+func (am *MockAccountManager) CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainsEnabled bool, dnssecValidation bool, dnssecTrustAnchors []string) (*nbdns.NameServerGroup, error) {
 	if am.CreateNameServerGroupFunc != nil {
-		return am.CreateNameServerGroupFunc(ctx, accountID, name, description, nameServerList, groups, primary, domains, enabled, userID, searchDomainsEnabled)
+		return am.CreateNameServerGroupFunc(ctx, accountID, name, description, nameServerList, groups, primary, domains, enabled, userID, searchDomainsEnabled, dnssecValidation, dnssecTrustAnchors)
 	}
 	return nil, nil
 }
@@ -598,6 +653,134 @@ func (am *MockAccountManager) ListNameServerGroups(ctx context.Context, accountI
 	return nil, nil
 }
 
+// GetDNSRecord mocks GetDNSRecord of the AccountManager interface
+func (am *MockAccountManager) GetDNSRecord(ctx context.Context, accountID, userID, recordID string) (*types.DNSRecord, error) {
+	if am.GetDNSRecordFunc != nil {
+		return am.GetDNSRecordFunc(ctx, accountID, userID, recordID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSRecord is not implemented")
+}
+
+// ListDNSRecords mocks ListDNSRecords of the AccountManager interface
+func (am *MockAccountManager) ListDNSRecords(ctx context.Context, accountID, userID string) ([]*types.DNSRecord, error) {
+	if am.ListDNSRecordsFunc != nil {
+		return am.ListDNSRecordsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSRecords is not implemented")
+}
+
+// CreateDNSRecord mocks CreateDNSRecord of the AccountManager interface
+func (am *MockAccountManager) CreateDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error) {
+	if am.CreateDNSRecordFunc != nil {
+		return am.CreateDNSRecordFunc(ctx, accountID, userID, record)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDNSRecord is not implemented")
+}
+
+// SaveDNSRecord mocks SaveDNSRecord of the AccountManager interface
+func (am *MockAccountManager) SaveDNSRecord(ctx context.Context, accountID, userID string, record *types.DNSRecord) (*types.DNSRecord, error) {
+	if am.SaveDNSRecordFunc != nil {
+		return am.SaveDNSRecordFunc(ctx, accountID, userID, record)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDNSRecord is not implemented")
+}
+
+// DeleteDNSRecord mocks DeleteDNSRecord of the AccountManager interface
+func (am *MockAccountManager) DeleteDNSRecord(ctx context.Context, accountID, userID, recordID string) error {
+	if am.DeleteDNSRecordFunc != nil {
+		return am.DeleteDNSRecordFunc(ctx, accountID, userID, recordID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteDNSRecord is not implemented")
+}
+
+// GetDNSBlocklist mocks GetDNSBlocklist of the AccountManager interface
+func (am *MockAccountManager) GetDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) (*types.DNSBlocklist, error) {
+	if am.GetDNSBlocklistFunc != nil {
+		return am.GetDNSBlocklistFunc(ctx, accountID, userID, blocklistID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSBlocklist is not implemented")
+}
+
+// ListDNSBlocklists mocks ListDNSBlocklists of the AccountManager interface
+func (am *MockAccountManager) ListDNSBlocklists(ctx context.Context, accountID, userID string) ([]*types.DNSBlocklist, error) {
+	if am.ListDNSBlocklistsFunc != nil {
+		return am.ListDNSBlocklistsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSBlocklists is not implemented")
+}
+
+// CreateDNSBlocklist mocks CreateDNSBlocklist of the AccountManager interface
+func (am *MockAccountManager) CreateDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error) {
+	if am.CreateDNSBlocklistFunc != nil {
+		return am.CreateDNSBlocklistFunc(ctx, accountID, userID, blocklist)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDNSBlocklist is not implemented")
+}
+
+// SaveDNSBlocklist mocks SaveDNSBlocklist of the AccountManager interface
+func (am *MockAccountManager) SaveDNSBlocklist(ctx context.Context, accountID, userID string, blocklist *types.DNSBlocklist) (*types.DNSBlocklist, error) {
+	if am.SaveDNSBlocklistFunc != nil {
+		return am.SaveDNSBlocklistFunc(ctx, accountID, userID, blocklist)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDNSBlocklist is not implemented")
+}
+
+// DeleteDNSBlocklist mocks DeleteDNSBlocklist of the AccountManager interface
+func (am *MockAccountManager) DeleteDNSBlocklist(ctx context.Context, accountID, userID, blocklistID string) error {
+	if am.DeleteDNSBlocklistFunc != nil {
+		return am.DeleteDNSBlocklistFunc(ctx, accountID, userID, blocklistID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteDNSBlocklist is not implemented")
+}
+
+// GetDNSServiceName mocks GetDNSServiceName of the AccountManager interface
+func (am *MockAccountManager) GetDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) (*types.DNSServiceName, error) {
+	if am.GetDNSServiceNameFunc != nil {
+		return am.GetDNSServiceNameFunc(ctx, accountID, userID, serviceNameID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSServiceName is not implemented")
+}
+
+// ListDNSServiceNames mocks ListDNSServiceNames of the AccountManager interface
+func (am *MockAccountManager) ListDNSServiceNames(ctx context.Context, accountID, userID string) ([]*types.DNSServiceName, error) {
+	if am.ListDNSServiceNamesFunc != nil {
+		return am.ListDNSServiceNamesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListDNSServiceNames is not implemented")
+}
+
+// GetDNSServiceNamesHealthyPeers mocks GetDNSServiceNamesHealthyPeers of the AccountManager interface
+func (am *MockAccountManager) GetDNSServiceNamesHealthyPeers(ctx context.Context, accountID, userID string) (map[string][]string, error) {
+	if am.GetDNSServiceNamesHealthyPeersFunc != nil {
+		return am.GetDNSServiceNamesHealthyPeersFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSServiceNamesHealthyPeers is not implemented")
+}
+
+// CreateDNSServiceName mocks CreateDNSServiceName of the AccountManager interface
+func (am *MockAccountManager) CreateDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error) {
+	if am.CreateDNSServiceNameFunc != nil {
+		return am.CreateDNSServiceNameFunc(ctx, accountID, userID, serviceName)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDNSServiceName is not implemented")
+}
+
+// SaveDNSServiceName mocks SaveDNSServiceName of the AccountManager interface
+func (am *MockAccountManager) SaveDNSServiceName(ctx context.Context, accountID, userID string, serviceName *types.DNSServiceName) (*types.DNSServiceName, error) {
+	if am.SaveDNSServiceNameFunc != nil {
+		return am.SaveDNSServiceNameFunc(ctx, accountID, userID, serviceName)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDNSServiceName is not implemented")
+}
+
+// DeleteDNSServiceName mocks DeleteDNSServiceName of the AccountManager interface
+func (am *MockAccountManager) DeleteDNSServiceName(ctx context.Context, accountID, userID, serviceNameID string) error {
+	if am.DeleteDNSServiceNameFunc != nil {
+		return am.DeleteDNSServiceNameFunc(ctx, accountID, userID, serviceNameID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteDNSServiceName is not implemented")
+}
+
 // CreateUser mocks CreateUser of the AccountManager interface
 func (am *MockAccountManager) CreateUser(ctx context.Context, accountID, userID string, invite *types.UserInfo) (*types.UserInfo, error) {
 	if am.CreateUserFunc != nil {
@@ -638,13 +821,21 @@ func (am *MockAccountManager) GetDNSDomain() string {
 }
 
 // GetEvents mocks GetEvents of the AccountManager interface
-func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter *activity.Filter) ([]*activity.Event, error) {
 	if am.GetEventsFunc != nil {
-		return am.GetEventsFunc(ctx, accountID, userID)
+		return am.GetEventsFunc(ctx, accountID, userID, filter)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
 
+// ExportEvents mocks ExportEvents of the AccountManager interface
+func (am *MockAccountManager) ExportEvents(ctx context.Context, accountID, userID string, filter *activity.Filter, export func([]*activity.Event) error) error {
+	if am.ExportEventsFunc != nil {
+		return am.ExportEventsFunc(ctx, accountID, userID, filter, export)
+	}
+	return status.Errorf(codes.Unimplemented, "method ExportEvents is not implemented")
+}
+
 // GetDNSSettings mocks GetDNSSettings of the AccountManager interface
 func (am *MockAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
 	if am.GetDNSSettingsFunc != nil {
@@ -835,3 +1026,147 @@ func (am *MockAccountManager) GetAccount(ctx context.Context, accountID string)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount is not implemented")
 }
+
+// GetCustomRoles mocks GetCustomRoles of the AccountManager interface
+func (am *MockAccountManager) GetCustomRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error) {
+	if am.GetCustomRolesFunc != nil {
+		return am.GetCustomRolesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomRoles is not implemented")
+}
+
+// GetCustomRole mocks GetCustomRole of the AccountManager interface
+func (am *MockAccountManager) GetCustomRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error) {
+	if am.GetCustomRoleFunc != nil {
+		return am.GetCustomRoleFunc(ctx, accountID, userID, roleID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomRole is not implemented")
+}
+
+// SaveCustomRole mocks SaveCustomRole of the AccountManager interface
+func (am *MockAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	if am.SaveCustomRoleFunc != nil {
+		return am.SaveCustomRoleFunc(ctx, accountID, userID, role)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCustomRole is not implemented")
+}
+
+// DeleteCustomRole mocks DeleteCustomRole of the AccountManager interface
+func (am *MockAccountManager) DeleteCustomRole(ctx context.Context, accountID, userID, roleID string) error {
+	if am.DeleteCustomRoleFunc != nil {
+		return am.DeleteCustomRoleFunc(ctx, accountID, userID, roleID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteCustomRole is not implemented")
+}
+
+// CreateSCIMToken mocks CreateSCIMToken of the AccountManager interface
+func (am *MockAccountManager) CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error) {
+	if am.CreateSCIMTokenFunc != nil {
+		return am.CreateSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMToken is not implemented")
+}
+
+// GetSCIMToken mocks GetSCIMToken of the AccountManager interface
+func (am *MockAccountManager) GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error) {
+	if am.GetSCIMTokenFunc != nil {
+		return am.GetSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMToken is not implemented")
+}
+
+// DeleteSCIMToken mocks DeleteSCIMToken of the AccountManager interface
+func (am *MockAccountManager) DeleteSCIMToken(ctx context.Context, accountID, userID string) error {
+	if am.DeleteSCIMTokenFunc != nil {
+		return am.DeleteSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMToken is not implemented")
+}
+
+// GetAccountIDFromSCIMToken mocks GetAccountIDFromSCIMToken of the AccountManager interface
+func (am *MockAccountManager) GetAccountIDFromSCIMToken(ctx context.Context, token string) (string, error) {
+	if am.GetAccountIDFromSCIMTokenFunc != nil {
+		return am.GetAccountIDFromSCIMTokenFunc(ctx, token)
+	}
+	return "", status.Errorf(codes.Unimplemented, "method GetAccountIDFromSCIMToken is not implemented")
+}
+
+// GetSCIMUsers mocks GetSCIMUsers of the AccountManager interface
+func (am *MockAccountManager) GetSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error) {
+	if am.GetSCIMUsersFunc != nil {
+		return am.GetSCIMUsersFunc(ctx, accountID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMUsers is not implemented")
+}
+
+// GetSCIMUser mocks GetSCIMUser of the AccountManager interface
+func (am *MockAccountManager) GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error) {
+	if am.GetSCIMUserFunc != nil {
+		return am.GetSCIMUserFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMUser is not implemented")
+}
+
+// CreateSCIMUser mocks CreateSCIMUser of the AccountManager interface
+func (am *MockAccountManager) CreateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error) {
+	if am.CreateSCIMUserFunc != nil {
+		return am.CreateSCIMUserFunc(ctx, accountID, user)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMUser is not implemented")
+}
+
+// UpdateSCIMUser mocks UpdateSCIMUser of the AccountManager interface
+func (am *MockAccountManager) UpdateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error) {
+	if am.UpdateSCIMUserFunc != nil {
+		return am.UpdateSCIMUserFunc(ctx, accountID, user)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSCIMUser is not implemented")
+}
+
+// DeleteSCIMUser mocks DeleteSCIMUser of the AccountManager interface
+func (am *MockAccountManager) DeleteSCIMUser(ctx context.Context, accountID, userID string) error {
+	if am.DeleteSCIMUserFunc != nil {
+		return am.DeleteSCIMUserFunc(ctx, accountID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMUser is not implemented")
+}
+
+// GetSCIMGroups mocks GetSCIMGroups of the AccountManager interface
+func (am *MockAccountManager) GetSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error) {
+	if am.GetSCIMGroupsFunc != nil {
+		return am.GetSCIMGroupsFunc(ctx, accountID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMGroups is not implemented")
+}
+
+// GetSCIMGroup mocks GetSCIMGroup of the AccountManager interface
+func (am *MockAccountManager) GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error) {
+	if am.GetSCIMGroupFunc != nil {
+		return am.GetSCIMGroupFunc(ctx, accountID, groupID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMGroup is not implemented")
+}
+
+// CreateSCIMGroup mocks CreateSCIMGroup of the AccountManager interface
+func (am *MockAccountManager) CreateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error) {
+	if am.CreateSCIMGroupFunc != nil {
+		return am.CreateSCIMGroupFunc(ctx, accountID, group)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMGroup is not implemented")
+}
+
+// UpdateSCIMGroup mocks UpdateSCIMGroup of the AccountManager interface
+func (am *MockAccountManager) UpdateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error) {
+	if am.UpdateSCIMGroupFunc != nil {
+		return am.UpdateSCIMGroupFunc(ctx, accountID, group)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSCIMGroup is not implemented")
+}
+
+// DeleteSCIMGroup mocks DeleteSCIMGroup of the AccountManager interface
+func (am *MockAccountManager) DeleteSCIMGroup(ctx context.Context, accountID, groupID string) error {
+	if am.DeleteSCIMGroupFunc != nil {
+		return am.DeleteSCIMGroupFunc(ctx, accountID, groupID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMGroup is not implemented")
+}
@@ -19,6 +19,7 @@ type ManagementServiceServerMock struct {
 	GetDeviceAuthorizationFlowFunc func(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error)
 	GetPKCEAuthorizationFlowFunc   func(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error)
 	SyncMetaFunc                   func(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error)
+	SendFlowEventsFunc             func(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error)
 }
 
 func (m ManagementServiceServerMock) Login(ctx context.Context, req *proto.EncryptedMessage) (*proto.EncryptedMessage, error) {
@@ -69,3 +70,10 @@ func (m ManagementServiceServerMock) SyncMeta(ctx context.Context, req *proto.En
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method SyncMeta not implemented")
 }
+
+func (m ManagementServiceServerMock) SendFlowEvents(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	if m.SendFlowEventsFunc != nil {
+		return m.SendFlowEventsFunc(ctx, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SendFlowEvents not implemented")
+}
@@ -11,6 +11,8 @@ import (
 
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -20,36 +22,24 @@ const domainPattern = `^(?i)[a-z0-9]+([\-\.]{1}[a-z0-9]+)*\.[a-z]{2,}$`
 
 // GetNameServerGroup gets a nameserver group object from account and nameserver group IDs
 func (am *DefaultAccountManager) GetNameServerGroup(ctx context.Context, accountID, userID, nsGroupID string) (*nbdns.NameServerGroup, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetNameServerGroupByID(ctx, store.LockingStrengthShare, accountID, nsGroupID)
 }
 
 // CreateNameServerGroup creates and saves a new nameserver group
-func (am *DefaultAccountManager) CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainEnabled bool) (*nbdns.NameServerGroup, error) {
+func (am *DefaultAccountManager) CreateNameServerGroup(ctx context.Context, accountID string, name, description string, nameServerList []nbdns.NameServer, groups []string, primary bool, domains []string, enabled bool, userID string, searchDomainEnabled bool, dnssecValidation bool, dnssecTrustAnchors []string) (*nbdns.NameServerGroup, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Create)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
 	newNSGroup := &nbdns.NameServerGroup{
 		ID:                   xid.New().String(),
 		AccountID:            accountID,
@@ -61,6 +51,8 @@ func (am *DefaultAccountManager) CreateNameServerGroup(ctx context.Context, acco
 		Primary:              primary,
 		Domains:              domains,
 		SearchDomainsEnabled: searchDomainEnabled,
+		DNSSECValidation:     dnssecValidation,
+		DNSSECTrustAnchors:   dnssecTrustAnchors,
 	}
 
 	var updateAccountPeers bool
@@ -103,15 +95,11 @@ func (am *DefaultAccountManager) SaveNameServerGroup(ctx context.Context, accoun
 		return status.Errorf(status.InvalidArgument, "nameserver group provided is nil")
 	}
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
 	var updateAccountPeers bool
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
@@ -154,15 +142,11 @@ func (am *DefaultAccountManager) DeleteNameServerGroup(ctx context.Context, acco
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Delete)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
 	var nsGroup *nbdns.NameServerGroup
 	var updateAccountPeers bool
 
@@ -198,19 +182,11 @@ func (am *DefaultAccountManager) DeleteNameServerGroup(ctx context.Context, acco
 
 // ListNameServerGroups returns a list of nameserver groups from account
 func (am *DefaultAccountManager) ListNameServerGroups(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetAccountNameServerGroups(ctx, store.LockingStrengthShare, accountID)
 }
 
@@ -225,6 +201,11 @@ func validateNameServerGroup(ctx context.Context, transaction store.Store, accou
 		return err
 	}
 
+	err = validateDNSSECTrustAnchors(nameserverGroup.DNSSECValidation, nameserverGroup.DNSSECTrustAnchors)
+	if err != nil {
+		return err
+	}
+
 	nsServerGroups, err := transaction.GetAccountNameServerGroups(ctx, store.LockingStrengthShare, accountID)
 	if err != nil {
 		return err
@@ -298,11 +279,56 @@ func validateNSGroupName(name, nsGroupID string, groups []*nbdns.NameServerGroup
 	return nil
 }
 
+func validateDNSSECTrustAnchors(validation bool, anchors []string) error {
+	if !validation && len(anchors) != 0 {
+		return status.Errorf(status.InvalidArgument, "DNSSEC trust anchors are only supported with DNSSEC validation enabled")
+	}
+
+	for _, anchor := range anchors {
+		rr, err := dns.NewRR(anchor)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid DNSSEC trust anchor %q: %v", anchor, err)
+		}
+		if _, ok := rr.(*dns.DS); !ok {
+			return status.Errorf(status.InvalidArgument, "invalid DNSSEC trust anchor %q: expected a DS record", anchor)
+		}
+	}
+	return nil
+}
+
 func validateNSList(list []nbdns.NameServer) error {
 	nsListLength := len(list)
 	if nsListLength == 0 || nsListLength > 3 {
 		return status.Errorf(status.InvalidArgument, "the list of nameservers should be 1 or 3, got %d", len(list))
 	}
+
+	for _, ns := range list {
+		if err := validateNameServer(ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNameServer(ns nbdns.NameServer) error {
+	if ns.NSType == nbdns.InvalidNameServerType || ns.NSType.String() == nbdns.InvalidNameServerTypeString {
+		return status.Errorf(status.InvalidArgument, "invalid nameserver type for nameserver %s", ns.IP)
+	}
+
+	if ns.Hostname != "" {
+		if !ns.IsEncrypted() {
+			return status.Errorf(status.InvalidArgument, "hostname is only supported for %s and %s nameservers",
+				nbdns.TLSNameServerTypeString, nbdns.HTTPSNameServerTypeString)
+		}
+		if err := validateDomain(ns.Hostname); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid nameserver hostname %s: %v", ns.Hostname, err)
+		}
+	}
+
+	if ns.Path != "" && ns.NSType != nbdns.HTTPSNameServerType {
+		return status.Errorf(status.InvalidArgument, "path is only supported for %s nameservers", nbdns.HTTPSNameServerTypeString)
+	}
+
 	return nil
 }
 
@@ -12,9 +12,11 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
 )
 
 const (
@@ -31,14 +33,16 @@ const (
 
 func TestCreateNameServerGroup(t *testing.T) {
 	type input struct {
-		name          string
-		description   string
-		enabled       bool
-		groups        []string
-		nameServers   []nbdns.NameServer
-		primary       bool
-		domains       []string
-		searchDomains bool
+		name               string
+		description        string
+		enabled            bool
+		groups             []string
+		nameServers        []nbdns.NameServer
+		primary            bool
+		domains            []string
+		searchDomains      bool
+		dnssecValidation   bool
+		dnssecTrustAnchors []string
 	}
 
 	testCases := []struct {
@@ -374,6 +378,171 @@ func TestCreateNameServerGroup(t *testing.T) {
 			errFunc:      require.Error,
 			shouldCreate: false,
 		},
+		{
+			name: "Create A NS Group With Encrypted Nameservers",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:       netip.MustParseAddr("1.1.1.1"),
+						NSType:   nbdns.TLSNameServerType,
+						Port:     853,
+						Hostname: "one.one.one.one",
+					},
+					{
+						IP:     netip.MustParseAddr("1.1.2.2"),
+						NSType: nbdns.HTTPSNameServerType,
+						Port:   443,
+						Path:   "/custom-query",
+					},
+				},
+				enabled: true,
+			},
+			errFunc:      require.NoError,
+			shouldCreate: true,
+			expectedNSGroup: &nbdns.NameServerGroup{
+				Name:        "super",
+				Description: "super",
+				Primary:     true,
+				Groups:      []string{group1ID},
+				NameServers: []nbdns.NameServer{
+					{
+						IP:       netip.MustParseAddr("1.1.1.1"),
+						NSType:   nbdns.TLSNameServerType,
+						Port:     853,
+						Hostname: "one.one.one.one",
+					},
+					{
+						IP:     netip.MustParseAddr("1.1.2.2"),
+						NSType: nbdns.HTTPSNameServerType,
+						Port:   443,
+						Path:   "/custom-query",
+					},
+				},
+				Enabled: true,
+			},
+		},
+		{
+			name: "Should Not Create If Hostname Is Set For UDP Nameserver",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:       netip.MustParseAddr("1.1.1.1"),
+						NSType:   nbdns.UDPNameServerType,
+						Port:     nbdns.DefaultDNSPort,
+						Hostname: "one.one.one.one",
+					},
+				},
+				enabled: true,
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
+		{
+			name: "Should Not Create If Path Is Set For TLS Nameserver",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:     netip.MustParseAddr("1.1.1.1"),
+						NSType: nbdns.TLSNameServerType,
+						Port:   853,
+						Path:   "/dns-query",
+					},
+				},
+				enabled: true,
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
+		{
+			name: "Create A NS Group With DNSSEC Validation",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:     netip.MustParseAddr("1.1.1.1"),
+						NSType: nbdns.UDPNameServerType,
+						Port:   nbdns.DefaultDNSPort,
+					},
+				},
+				enabled:            true,
+				dnssecValidation:   true,
+				dnssecTrustAnchors: []string{". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"},
+			},
+			errFunc:      require.NoError,
+			shouldCreate: true,
+			expectedNSGroup: &nbdns.NameServerGroup{
+				Name:        "super",
+				Description: "super",
+				Primary:     true,
+				Groups:      []string{group1ID},
+				NameServers: []nbdns.NameServer{
+					{
+						IP:     netip.MustParseAddr("1.1.1.1"),
+						NSType: nbdns.UDPNameServerType,
+						Port:   nbdns.DefaultDNSPort,
+					},
+				},
+				Enabled:            true,
+				DNSSECValidation:   true,
+				DNSSECTrustAnchors: []string{". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"},
+			},
+		},
+		{
+			name: "Should Not Create If DNSSEC Trust Anchor Is Invalid",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:     netip.MustParseAddr("1.1.1.1"),
+						NSType: nbdns.UDPNameServerType,
+						Port:   nbdns.DefaultDNSPort,
+					},
+				},
+				enabled:            true,
+				dnssecValidation:   true,
+				dnssecTrustAnchors: []string{"example.com. IN A 192.0.2.1"},
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
+		{
+			name: "Should Not Create If DNSSEC Trust Anchors Are Set Without Validation",
+			inputArgs: input{
+				name:        "super",
+				description: "super",
+				groups:      []string{group1ID},
+				primary:     true,
+				nameServers: []nbdns.NameServer{
+					{
+						IP:     netip.MustParseAddr("1.1.1.1"),
+						NSType: nbdns.UDPNameServerType,
+						Port:   nbdns.DefaultDNSPort,
+					},
+				},
+				enabled:            true,
+				dnssecTrustAnchors: []string{". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"},
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -399,6 +568,8 @@ func TestCreateNameServerGroup(t *testing.T) {
 				testCase.inputArgs.enabled,
 				userID,
 				testCase.inputArgs.searchDomains,
+				testCase.inputArgs.dnssecValidation,
+				testCase.inputArgs.dnssecTrustAnchors,
 			)
 
 			testCase.errFunc(t, err)
@@ -770,7 +941,7 @@ func createNSManager(t *testing.T) (*DefaultAccountManager, error) {
 	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
 	require.NoError(t, err)
 
-	return BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.selfhosted", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	return BuildManager(context.Background(), store, NewPeersUpdateManager(nil), nil, "", "netbird.selfhosted", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(store, users.NewManager(store)))
 }
 
 func createNSStore(t *testing.T) (store.Store, error) {
@@ -980,7 +1151,7 @@ func TestNameServerAccountPeersUpdate(t *testing.T) {
 				Port:   nbdns.DefaultDNSPort,
 			}},
 			[]string{"groupA"},
-			true, []string{}, true, userID, false,
+			true, []string{}, true, userID, false, false, nil,
 		)
 		assert.NoError(t, err)
 
@@ -1025,7 +1196,7 @@ func TestNameServerAccountPeersUpdate(t *testing.T) {
 				Port:   nbdns.DefaultDNSPort,
 			}},
 			[]string{"groupB"},
-			true, []string{}, true, userID, false,
+			true, []string{}, true, userID, false, false, nil,
 		)
 		assert.NoError(t, err)
 
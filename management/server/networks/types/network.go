@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"net/netip"
+	"slices"
+
 	"github.com/rs/xid"
 
 	"github.com/netbirdio/netbird/management/server/http/api"
@@ -11,6 +15,8 @@ type Network struct {
 	AccountID   string `gorm:"index"`
 	Name        string
 	Description string
+	// DNSUpstreams are the resolvers the routing peers relay the queries of the domain resources to
+	DNSUpstreams []string `gorm:"serializer:json"`
 }
 
 func NewNetwork(accountId, name, description string) *Network {
@@ -23,6 +29,11 @@ func NewNetwork(accountId, name, description string) *Network {
 }
 
 func (n *Network) ToAPIResponse(routerIDs []string, resourceIDs []string, routingPeersCount int, policyIDs []string) *api.Network {
+	dnsUpstreams := slices.Clone(n.DNSUpstreams)
+	if dnsUpstreams == nil {
+		dnsUpstreams = []string{}
+	}
+
 	return &api.Network{
 		Id:                n.ID,
 		Name:              n.Name,
@@ -31,6 +42,7 @@ func (n *Network) ToAPIResponse(routerIDs []string, resourceIDs []string, routin
 		Resources:         resourceIDs,
 		RoutingPeersCount: routingPeersCount,
 		Policies:          policyIDs,
+		DnsUpstreams:      &dnsUpstreams,
 	}
 }
 
@@ -39,15 +51,32 @@ func (n *Network) FromAPIRequest(req *api.NetworkRequest) {
 	if req.Description != nil {
 		n.Description = *req.Description
 	}
+	if req.DnsUpstreams != nil {
+		n.DNSUpstreams = *req.DnsUpstreams
+	}
+}
+
+// Validate checks that the DNS upstreams of the network are IP addresses with an optional port
+func (n *Network) Validate() error {
+	for _, upstream := range n.DNSUpstreams {
+		if _, err := netip.ParseAddrPort(upstream); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(upstream); err != nil {
+			return fmt.Errorf("invalid DNS upstream %s, expected IP or IP:port", upstream)
+		}
+	}
+	return nil
 }
 
 // Copy returns a copy of a posture checks.
 func (n *Network) Copy() *Network {
 	return &Network{
-		ID:          n.ID,
-		AccountID:   n.AccountID,
-		Name:        n.Name,
-		Description: n.Description,
+		ID:           n.ID,
+		AccountID:    n.AccountID,
+		Name:         n.Name,
+		Description:  n.Description,
+		DNSUpstreams: slices.Clone(n.DNSUpstreams),
 	}
 }
 
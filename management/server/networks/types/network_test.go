@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetwork_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		dnsUpstreams []string
+		expectErr    bool
+	}{
+		{name: "no upstreams"},
+		{name: "IPv4 and IPv6 upstreams", dnsUpstreams: []string{"10.0.0.53", "fd00::53"}},
+		{name: "upstreams with port", dnsUpstreams: []string{"10.0.0.53:5353", "[fd00::53]:5353"}},
+		{name: "hostname upstream", dnsUpstreams: []string{"dns.example.com"}, expectErr: true},
+		{name: "invalid port", dnsUpstreams: []string{"10.0.0.53:dns"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network := &Network{Name: "net", DNSUpstreams: tt.dnsUpstreams}
+			err := network.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
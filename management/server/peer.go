@@ -24,6 +24,8 @@ import (
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -67,6 +69,11 @@ func (am *DefaultAccountManager) GetPeers(ctx context.Context, accountID, userID
 		return nil, err
 	}
 
+	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+
 	approvedPeersMap, err := am.GetValidatedPeers(account)
 	if err != nil {
 		return nil, err
@@ -74,7 +81,8 @@ func (am *DefaultAccountManager) GetPeers(ctx context.Context, accountID, userID
 	peers := make([]*nbpeer.Peer, 0)
 	peersMap := make(map[string]*nbpeer.Peer)
 
-	regularUser := !user.HasAdminPower() && !user.IsServiceUser
+	// users without the permission to view all peers only see their own peers and the peers these have access to
+	regularUser := !allowed
 
 	if regularUser && account.Settings.RegularUsersViewBlocked {
 		return peers, nil
@@ -122,6 +130,9 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 		return fmt.Errorf("failed to find peer by pub key: %w", err)
 	}
 
+	serviceNameMember := account.IsDNSServiceNameMember(peer.ID)
+	wasPublished := serviceNameMember && types.IsDNSServiceNameMemberHealthy(peer, time.Now().UTC())
+
 	expired, err := am.updatePeerStatusAndLocation(ctx, peer, connected, realIP, account)
 	if err != nil {
 		return fmt.Errorf("failed to update peer status and location: %w", err)
@@ -139,10 +150,21 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 		}
 	}
 
+	if serviceNameMember {
+		// a disconnected member stays published during the grace period, the update job removes it afterwards
+		am.scheduleDNSServiceNameUpdates(ctx, account)
+	}
+
 	if expired {
 		// we need to update other peers because when peer login expires all other peers are notified to disconnect from
 		// the expired one. Here we notify them that connection is now allowed again.
 		am.UpdateAccountPeers(ctx, account.Id)
+	} else if serviceNameMember && connected && !wasPublished {
+		// the member is healthy again and must be added back to the service name records of the account zone
+		if err = am.Store.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, account.Id); err != nil {
+			return fmt.Errorf("failed to increment network serial: %w", err)
+		}
+		am.UpdateAccountPeers(ctx, account.Id)
 	}
 
 	return nil
@@ -189,6 +211,10 @@ func (am *DefaultAccountManager) updatePeerStatusAndLocation(ctx context.Context
 
 // UpdatePeer updates peer. Only Peer.Name, Peer.SSHEnabled, Peer.LoginExpirationEnabled and Peer.InactivityExpirationEnabled can be updated.
 func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, userID string, update *nbpeer.Peer) (*nbpeer.Peer, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Update); err != nil {
+		return nil, err
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -290,7 +316,7 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 }
 
 // deletePeers will delete all specified peers and send updates to the remote peers. Don't call without acquiring account lock
-func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *types.Account, peerIDs []string, userID string) error {
+func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *types.Account, peerIDs []string, userID string, event activity.Activity) error {
 
 	// the first loop is needed to ensure all peers present under the account before modifying, otherwise
 	// we might have some inconsistencies
@@ -331,7 +357,7 @@ func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *types
 				NetworkMap: &types.NetworkMap{},
 			})
 		am.peersUpdateManager.CloseChannel(ctx, peer.ID)
-		am.StoreEvent(ctx, userID, peer.ID, account.Id, activity.PeerRemovedByUser, peer.EventMeta(am.GetDNSDomain()))
+		am.StoreEvent(ctx, userID, peer.ID, account.Id, event, peer.EventMeta(am.GetDNSDomain()))
 	}
 
 	return nil
@@ -339,6 +365,13 @@ func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *types
 
 // DeletePeer removes peer from the account by its IP
 func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peerID, userID string) error {
+	// ephemeral peers are removed by the system
+	if userID != activity.SystemInitiator {
+		if err := am.validateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Delete); err != nil {
+			return err
+		}
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -352,7 +385,7 @@ func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peer
 		return err
 	}
 
-	err = am.deletePeers(ctx, account, []string{peerID}, userID)
+	err = am.deletePeers(ctx, account, []string{peerID}, userID, activity.PeerRemovedByUser)
 	if err != nil {
 		return err
 	}
@@ -390,8 +423,8 @@ func (am *DefaultAccountManager) GetNetworkMap(ctx context.Context, peerID strin
 	if err != nil {
 		return nil, err
 	}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	return account.GetPeerNetworkMap(ctx, peer.ID, customZone, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil), nil
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	return account.GetPeerNetworkMap(ctx, peer.ID, customZones, validatedPeers, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), nil), nil
 }
 
 // GetPeerNetwork returns the Network for a given peer
@@ -508,12 +541,18 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			return fmt.Errorf("failed to get free IP: %w", err)
 		}
 
+		freeIPv6, err := am.getFreeIPv6(ctx, transaction, accountID)
+		if err != nil {
+			return fmt.Errorf("failed to get free IPv6: %w", err)
+		}
+
 		registrationTime := time.Now().UTC()
 		newPeer = &nbpeer.Peer{
 			ID:                          xid.New().String(),
 			AccountID:                   accountID,
 			Key:                         peer.Key,
 			IP:                          freeIP,
+			IPv6:                        freeIPv6,
 			Meta:                        peer.Meta,
 			Name:                        peer.Meta.Hostname,
 			DNSLabel:                    freeLabel,
@@ -549,7 +588,12 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		if err != nil {
 			return fmt.Errorf("failed to get account settings: %w", err)
 		}
-		newPeer = am.integratedPeerValidator.PreparePeer(ctx, accountID, newPeer, groupsToAdd, settings.Extra)
+		exemptValidator, ok := am.integratedPeerValidator.(peerApprovalExemptValidator)
+		if ok && isPeerApprovalExempt(settings.Extra, setupKeyID) {
+			newPeer = exemptValidator.PrepareApprovalExemptPeer(ctx, accountID, newPeer, groupsToAdd, settings.Extra)
+		} else {
+			newPeer = am.integratedPeerValidator.PreparePeer(ctx, accountID, newPeer, groupsToAdd, settings.Extra)
+		}
 
 		err = transaction.AddPeerToAllGroup(ctx, accountID, newPeer.ID)
 		if err != nil {
@@ -600,6 +644,9 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 	}
 
 	am.StoreEvent(ctx, opEvent.InitiatorID, opEvent.TargetID, opEvent.AccountID, opEvent.Activity, opEvent.Meta)
+	if newPeer.Status.RequiresApproval {
+		am.StoreEvent(ctx, opEvent.InitiatorID, opEvent.TargetID, opEvent.AccountID, activity.PeerApprovalRequested, opEvent.Meta)
+	}
 
 	unlock()
 	unlock = nil
@@ -634,8 +681,8 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		return nil, nil, nil, err
 	}
 
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	networkMap := account.GetPeerNetworkMap(ctx, newPeer.ID, customZone, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics())
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	networkMap := account.GetPeerNetworkMap(ctx, newPeer.ID, customZones, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics())
 	return newPeer, networkMap, postureChecks, nil
 }
 
@@ -658,6 +705,31 @@ func (am *DefaultAccountManager) getFreeIP(ctx context.Context, s store.Store, a
 	return nextIp, nil
 }
 
+// getFreeIPv6 allocates an IPv6 address from the IPv6 overlay network of the account.
+// It returns nil if the account has no IPv6 overlay network.
+func (am *DefaultAccountManager) getFreeIPv6(ctx context.Context, s store.Store, accountID string) (net.IP, error) {
+	network, err := s.GetAccountNetwork(ctx, store.LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting network: %w", err)
+	}
+
+	if !network.HasNetV6() {
+		return nil, nil
+	}
+
+	takenIps, err := s.GetTakenIPv6s(ctx, store.LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get taken IPv6s: %w", err)
+	}
+
+	nextIp, err := types.AllocatePeerIPv6(network.NetV6, takenIps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate new peer ipv6: %w", err)
+	}
+
+	return nextIp, nil
+}
+
 // SyncPeer checks whether peer is eligible for receiving NetworkMap (authenticated) and returns its NetworkMap if eligible
 func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, account *types.Account) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error) {
 	start := time.Now()
@@ -723,8 +795,8 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 		return nil, nil, nil, fmt.Errorf("failed to get validated peers: %w", err)
 	}
 
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZone, validPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZones, validPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
 }
 
 func (am *DefaultAccountManager) handlePeerLoginNotFound(ctx context.Context, login PeerLogin, err error) (*nbpeer.Peer, *types.NetworkMap, []*posture.Checks, error) {
@@ -909,8 +981,8 @@ func (am *DefaultAccountManager) getValidatedPeerWithMap(ctx context.Context, is
 		return nil, nil, nil, err
 	}
 
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
-	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZone, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
+	return peer, account.GetPeerNetworkMap(ctx, peer.ID, customZones, approvedPeersMap, account.GetResourcePoliciesMap(), account.GetResourceRoutersMap(), am.metrics.AccountManagerMetrics()), postureChecks, nil
 }
 
 func (am *DefaultAccountManager) handleExpiredPeer(ctx context.Context, user *types.User, peer *nbpeer.Peer) error {
@@ -981,7 +1053,12 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 		return nil, err
 	}
 
-	if !user.HasAdminPower() && !user.IsServiceUser && account.Settings.RegularUsersViewBlocked {
+	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+
+	if !allowed && account.Settings.RegularUsersViewBlocked {
 		return nil, status.Errorf(status.Internal, "user %s has no access to his own peer %s under account %s", userID, peerID, accountID)
 	}
 
@@ -990,8 +1067,8 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 		return nil, status.Errorf(status.NotFound, "peer with %s not found under account %s", peerID, accountID)
 	}
 
-	// if admin or user owns this peer, return peer
-	if user.HasAdminPower() || user.IsServiceUser || peer.UserID == userID {
+	// if the user is allowed to view all peers or owns this peer, return peer
+	if allowed || peer.UserID == user.Id {
 		return peer, nil
 	}
 
@@ -1047,7 +1124,7 @@ func (am *DefaultAccountManager) UpdateAccountPeers(ctx context.Context, account
 	semaphore := make(chan struct{}, 10)
 
 	dnsCache := &DNSConfigCache{}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
 	resourcePolicies := account.GetResourcePoliciesMap()
 	routers := account.GetResourceRoutersMap()
 
@@ -1069,8 +1146,8 @@ func (am *DefaultAccountManager) UpdateAccountPeers(ctx context.Context, account
 				return
 			}
 
-			remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZone, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
-			update := toSyncResponse(ctx, nil, p, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings.RoutingPeerDNSResolutionEnabled)
+			remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZones, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
+			update := toSyncResponse(ctx, nil, p, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings)
 			am.peersUpdateManager.SendUpdate(ctx, p.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
 		}(peer)
 	}
@@ -1093,7 +1170,7 @@ func (am *DefaultAccountManager) UpdateAccountPeer(ctx context.Context, account
 	}
 
 	dnsCache := &DNSConfigCache{}
-	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
+	customZones := account.GetPeersCustomZones(ctx, am.dnsDomain)
 	resourcePolicies := account.GetResourcePoliciesMap()
 	routers := account.GetResourceRoutersMap()
 
@@ -1103,8 +1180,8 @@ func (am *DefaultAccountManager) UpdateAccountPeer(ctx context.Context, account
 		return
 	}
 
-	remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, peer.ID, customZone, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
-	update := toSyncResponse(ctx, nil, peer, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings.RoutingPeerDNSResolutionEnabled)
+	remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, peer.ID, customZones, approvedPeersMap, resourcePolicies, routers, am.metrics.AccountManagerMetrics())
+	update := toSyncResponse(ctx, nil, peer, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache, account.Settings)
 	am.peersUpdateManager.SendUpdate(ctx, peer.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
 }
 
@@ -21,6 +21,8 @@ type Peer struct {
 	Key string `gorm:"index"`
 	// IP address of the Peer
 	IP net.IP `gorm:"serializer:json"`
+	// IPv6 address of the Peer within the IPv6 overlay network of the account
+	IPv6 net.IP `gorm:"column:ipv6;serializer:json"`
 	// Meta is a Peer system meta data
 	Meta PeerSystemMeta `gorm:"embedded;embeddedPrefix:meta_"`
 	// Name is peer's name (machine name)
@@ -107,6 +109,8 @@ type PeerSystemMeta struct { //nolint:revive
 	SystemManufacturer string
 	Environment        Environment `gorm:"serializer:json"`
 	Files              []File      `gorm:"serializer:json"`
+	// IPv6Supported indicates whether the client is able to configure an IPv6 overlay address
+	IPv6Supported bool `gorm:"column:ipv6_supported"`
 }
 
 func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
@@ -150,7 +154,8 @@ func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
 		p.SystemProductName == other.SystemProductName &&
 		p.SystemManufacturer == other.SystemManufacturer &&
 		p.Environment.Cloud == other.Environment.Cloud &&
-		p.Environment.Platform == other.Environment.Platform
+		p.Environment.Platform == other.Environment.Platform &&
+		p.IPv6Supported == other.IPv6Supported
 }
 
 func (p PeerSystemMeta) isEmpty() bool {
@@ -173,6 +178,11 @@ func (p PeerSystemMeta) isEmpty() bool {
 		len(p.Files) == 0
 }
 
+// SupportsIPv6 indicates whether the peer has an IPv6 overlay address and a client able to configure it.
+func (p *Peer) SupportsIPv6() bool {
+	return p.IPv6 != nil && p.Meta.IPv6Supported
+}
+
 // AddedWithSSOLogin indicates whether this peer has been added with an SSO login by a user.
 func (p *Peer) AddedWithSSOLogin() bool {
 	return p.UserID != ""
@@ -189,6 +199,7 @@ func (p *Peer) Copy() *Peer {
 		AccountID:                   p.AccountID,
 		Key:                         p.Key,
 		IP:                          p.IP,
+		IPv6:                        p.IPv6,
 		Meta:                        p.Meta,
 		Name:                        p.Name,
 		DNSLabel:                    p.DNSLabel,
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// ApprovePeer approves a peer pending approval, allowing it to join the network maps of the account
+func (am *DefaultAccountManager) ApprovePeer(ctx context.Context, accountID, userID, peerID string) (*nbpeer.Peer, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Update); err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var peer *nbpeer.Peer
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthUpdate, accountID, peerID)
+		if err != nil {
+			return err
+		}
+
+		if !peer.Status.RequiresApproval {
+			return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+		}
+		peer.Status.RequiresApproval = false
+
+		if err = transaction.SavePeerStatus(accountID, peerID, *peer.Status); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerApproved, peer.EventMeta(am.GetDNSDomain()))
+
+	am.UpdateAccountPeers(ctx, accountID)
+
+	return peer, nil
+}
+
+// RejectPeer rejects a peer pending approval and removes it from the account
+func (am *DefaultAccountManager) RejectPeer(ctx context.Context, accountID, userID, peerID string) error {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Delete); err != nil {
+		return err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	peer := account.GetPeer(peerID)
+	if peer == nil {
+		return status.NewPeerNotFoundError(peerID)
+	}
+
+	if !peer.Status.RequiresApproval {
+		return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+	}
+
+	// a pending peer is not part of any network map, so the other peers don't need an update
+	if err = am.deletePeers(ctx, account, []string{peerID}, userID, activity.PeerRejected); err != nil {
+		return err
+	}
+
+	return am.Store.SaveAccount(ctx, account)
+}
+
+// validatePeerApprovalSettings checks that the setup keys exempt from the peer approval exist
+func (am *DefaultAccountManager) validatePeerApprovalSettings(ctx context.Context, accountID string, newSettings *types.Settings) error {
+	if newSettings.Extra == nil {
+		return nil
+	}
+
+	for _, setupKeyID := range newSettings.Extra.PeerApprovalExemptSetupKeys {
+		_, err := am.Store.GetSetupKeyByID(ctx, store.LockingStrengthShare, accountID, setupKeyID)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "setup key %s doesn't exist", setupKeyID)
+		}
+	}
+
+	return nil
+}
+
+// handlePeerApprovalSettings stores the events of the saved peer approval settings and approves every pending peer
+// once the peer approval is disabled. It returns true if pending peers have been approved.
+func (am *DefaultAccountManager) handlePeerApprovalSettings(ctx context.Context, account *types.Account, oldSettings, newSettings *types.Settings, userID string) bool {
+	oldEnabled := oldSettings.Extra != nil && oldSettings.Extra.PeerApprovalEnabled
+	newEnabled := newSettings.Extra != nil && newSettings.Extra.PeerApprovalEnabled
+
+	if oldEnabled == newEnabled {
+		return false
+	}
+
+	if newEnabled {
+		am.StoreEvent(ctx, userID, account.Id, account.Id, activity.AccountPeerApprovalEnabled, nil)
+		return false
+	}
+
+	am.StoreEvent(ctx, userID, account.Id, account.Id, activity.AccountPeerApprovalDisabled, nil)
+
+	var pendingPeerIDs []string
+	for _, peer := range account.Peers {
+		if peer.Status != nil && peer.Status.RequiresApproval {
+			pendingPeerIDs = append(pendingPeerIDs, peer.ID)
+		}
+	}
+	if len(pendingPeerIDs) == 0 {
+		return false
+	}
+
+	var approvedPeers []*nbpeer.Peer
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		peers, err := transaction.GetPeersByIDs(ctx, store.LockingStrengthUpdate, account.Id, pendingPeerIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, peer := range peers {
+			if peer.Status == nil || !peer.Status.RequiresApproval {
+				continue
+			}
+			peer.Status.RequiresApproval = false
+			if err = transaction.SavePeerStatus(account.Id, peer.ID, *peer.Status); err != nil {
+				return err
+			}
+			approvedPeers = append(approvedPeers, peer)
+		}
+
+		if len(approvedPeers) == 0 {
+			return nil
+		}
+		return transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, account.Id)
+	})
+	if err != nil {
+		// the settings are saved already, the peers marked as pending aren't excluded while the approval is disabled
+		log.WithContext(ctx).Errorf("failed to approve the pending peers of account %s: %v", account.Id, err)
+		return false
+	}
+
+	for _, peer := range approvedPeers {
+		account.UpdatePeer(peer)
+		am.StoreEvent(ctx, userID, peer.ID, account.Id, activity.PeerApproved, peer.EventMeta(am.GetDNSDomain()))
+	}
+
+	return len(approvedPeers) > 0
+}
+
+// peerApprovalExemptValidator is implemented by the validators that keep new peers pending approval and can prepare
+// a peer without it, leaving the approval decision of the validators they wrap untouched
+type peerApprovalExemptValidator interface {
+	PrepareApprovalExemptPeer(ctx context.Context, accountID string, peer *nbpeer.Peer, peersGroup []string, extraSettings *account.ExtraSettings) *nbpeer.Peer
+}
+
+// isPeerApprovalExempt returns true if peers registered with the given setup key skip the approval
+func isPeerApprovalExempt(extraSettings *account.ExtraSettings, setupKeyID string) bool {
+	return setupKeyID != "" && extraSettings != nil && slices.Contains(extraSettings.PeerApprovalExemptSetupKeys, setupKeyID)
+}
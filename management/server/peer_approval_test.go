@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/integrated_validator"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDefaultAccountManager_PeerApproval(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	am.integratedPeerValidator = integrated_validator.NewPeerApprovalValidator(MocIntegratedValidator{})
+
+	ctx := context.Background()
+	adminID := "adminUser"
+
+	acc, err := createAccount(am, "testAccountId", adminID, "")
+	require.NoError(t, err)
+	accountID := acc.Id
+
+	setupKey, err := am.CreateSetupKey(ctx, accountID, "pending", types.SetupKeyReusable, time.Hour, nil, 999, adminID, false)
+	require.NoError(t, err)
+	exemptKey, err := am.CreateSetupKey(ctx, accountID, "exempt", types.SetupKeyReusable, time.Hour, nil, 999, adminID, false)
+	require.NoError(t, err)
+
+	updateApprovalSettings := func(extra *account.ExtraSettings) error {
+		settings := acc.Settings.Copy()
+		settings.Extra = extra
+		_, err := am.UpdateAccountSettings(ctx, accountID, adminID, settings)
+		return err
+	}
+
+	addPeer := func(setupKey string) *nbpeer.Peer {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, _, _, err := am.AddPeer(ctx, setupKey, "", &nbpeer.Peer{
+			Key:  key.PublicKey().String(),
+			Meta: nbpeer.PeerSystemMeta{Hostname: key.PublicKey().String()},
+		})
+		require.NoError(t, err)
+		return peer
+	}
+
+	err = updateApprovalSettings(&account.ExtraSettings{PeerApprovalEnabled: true, PeerApprovalExemptSetupKeys: []string{"unknown"}})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	err = updateApprovalSettings(&account.ExtraSettings{PeerApprovalEnabled: true, PeerApprovalExemptSetupKeys: []string{exemptKey.Id}})
+	require.NoError(t, err)
+
+	pendingPeer := addPeer(setupKey.Key)
+	assert.True(t, pendingPeer.Status.RequiresApproval, "peer should be pending approval")
+
+	exemptPeer := addPeer(exemptKey.Key)
+	assert.False(t, exemptPeer.Status.RequiresApproval, "peer added with exempt setup key should be approved")
+
+	networkMap, err := am.GetNetworkMap(ctx, pendingPeer.ID)
+	require.NoError(t, err)
+	assert.Empty(t, networkMap.Peers, "pending peer should get an empty network map")
+
+	networkMap, err = am.GetNetworkMap(ctx, exemptPeer.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, peerIDs(networkMap.Peers), pendingPeer.ID, "pending peer should be excluded from network maps")
+
+	approved, err := am.ApprovePeer(ctx, accountID, adminID, pendingPeer.ID)
+	require.NoError(t, err)
+	assert.False(t, approved.Status.RequiresApproval)
+
+	networkMap, err = am.GetNetworkMap(ctx, exemptPeer.ID)
+	require.NoError(t, err)
+	assert.Contains(t, peerIDs(networkMap.Peers), pendingPeer.ID, "approved peer should be part of network maps")
+
+	_, err = am.ApprovePeer(ctx, accountID, adminID, pendingPeer.ID)
+	assertStatusType(t, err, status.PreconditionFailed)
+
+	err = am.RejectPeer(ctx, accountID, adminID, exemptPeer.ID)
+	assertStatusType(t, err, status.PreconditionFailed)
+
+	rejectedPeer := addPeer(setupKey.Key)
+	require.NoError(t, am.RejectPeer(ctx, accountID, adminID, rejectedPeer.ID))
+
+	_, err = am.Store.GetPeerByID(ctx, store.LockingStrengthShare, accountID, rejectedPeer.ID)
+	assertStatusType(t, err, status.NotFound)
+
+	// disabling the peer approval approves the pending peers
+	pendingPeer = addPeer(setupKey.Key)
+	require.NoError(t, updateApprovalSettings(&account.ExtraSettings{PeerApprovalEnabled: false}))
+
+	peer, err := am.Store.GetPeerByID(ctx, store.LockingStrengthShare, accountID, pendingPeer.ID)
+	require.NoError(t, err)
+	assert.False(t, peer.Status.RequiresApproval)
+}
+
+// approvingValidator is an integrated validator that requires the approval of every new peer itself
+type approvingValidator struct {
+	MocIntegratedValidator
+}
+
+func (approvingValidator) PreparePeer(_ context.Context, _ string, peer *nbpeer.Peer, _ []string, _ *account.ExtraSettings) *nbpeer.Peer {
+	peer.Status.RequiresApproval = true
+	return peer
+}
+
+func TestDefaultAccountManager_PeerApprovalExemptKeepsIntegratedApproval(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	am.integratedPeerValidator = integrated_validator.NewPeerApprovalValidator(approvingValidator{})
+
+	ctx := context.Background()
+	adminID := "adminUser"
+
+	acc, err := createAccount(am, "testAccountId", adminID, "")
+	require.NoError(t, err)
+
+	exemptKey, err := am.CreateSetupKey(ctx, acc.Id, "exempt", types.SetupKeyReusable, time.Hour, nil, 999, adminID, false)
+	require.NoError(t, err)
+
+	settings := acc.Settings.Copy()
+	settings.Extra = &account.ExtraSettings{PeerApprovalEnabled: true, PeerApprovalExemptSetupKeys: []string{exemptKey.Id}}
+	_, err = am.UpdateAccountSettings(ctx, acc.Id, adminID, settings)
+	require.NoError(t, err)
+
+	key, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	peer, _, _, err := am.AddPeer(ctx, exemptKey.Key, "", &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "exempt-peer"},
+	})
+	require.NoError(t, err)
+	assert.True(t, peer.Status.RequiresApproval, "the exemption must not override the approval of the integrated validator")
+}
+
+// failingSaveAccountStore fails saving accounts
+type failingSaveAccountStore struct {
+	store.Store
+}
+
+func (s *failingSaveAccountStore) SaveAccount(context.Context, *types.Account) error {
+	return status.Errorf(status.Internal, "failed to save account")
+}
+
+func TestDefaultAccountManager_PeerApprovalSettingsNotSaved(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+	am.integratedPeerValidator = integrated_validator.NewPeerApprovalValidator(MocIntegratedValidator{})
+
+	ctx := context.Background()
+	adminID := "adminUser"
+
+	acc, err := createAccount(am, "testAccountId", adminID, "")
+	require.NoError(t, err)
+
+	setupKey, err := am.CreateSetupKey(ctx, acc.Id, "pending", types.SetupKeyReusable, time.Hour, nil, 999, adminID, false)
+	require.NoError(t, err)
+
+	settings := acc.Settings.Copy()
+	settings.Extra = &account.ExtraSettings{PeerApprovalEnabled: true}
+	_, err = am.UpdateAccountSettings(ctx, acc.Id, adminID, settings)
+	require.NoError(t, err)
+
+	key, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	pendingPeer, _, _, err := am.AddPeer(ctx, setupKey.Key, "", &nbpeer.Peer{
+		Key:  key.PublicKey().String(),
+		Meta: nbpeer.PeerSystemMeta{Hostname: "pending-peer"},
+	})
+	require.NoError(t, err)
+	require.True(t, pendingPeer.Status.RequiresApproval)
+
+	am.Store = &failingSaveAccountStore{Store: am.Store}
+
+	settings = settings.Copy()
+	settings.Extra = &account.ExtraSettings{PeerApprovalEnabled: false}
+	_, err = am.UpdateAccountSettings(ctx, acc.Id, adminID, settings)
+	require.Error(t, err)
+
+	peer, err := am.Store.GetPeerByID(ctx, store.LockingStrengthShare, acc.Id, pendingPeer.ID)
+	require.NoError(t, err)
+	assert.True(t, peer.Status.RequiresApproval, "the peer must stay pending when the settings are not saved")
+
+	// the events are stored asynchronously
+	assert.Never(t, func() bool {
+		events, err := am.eventStore.Get(ctx, acc.Id, 0, 100, true, nil)
+		require.NoError(t, err)
+		for _, event := range events {
+			if event.Activity == activity.PeerApproved || event.Activity == activity.AccountPeerApprovalDisabled {
+				return true
+			}
+		}
+		return false
+	}, 500*time.Millisecond, 50*time.Millisecond, "the approval must not be reported when the settings are not saved")
+}
+
+func peerIDs(peers []*nbpeer.Peer) []string {
+	ids := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		ids = append(ids, peer.ID)
+	}
+	return ids
+}
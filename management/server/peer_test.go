@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/miekg/dns"
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -31,10 +32,12 @@ import (
 	nbAccount "github.com/netbirdio/netbird/management/server/account"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
 	nbroute "github.com/netbirdio/netbird/route"
 )
 
@@ -224,6 +227,86 @@ func TestAccountManager_GetNetworkMap(t *testing.T) {
 	}
 }
 
+func TestAccountManager_GetNetworkMapIPv6(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	userID := "account_creator"
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	setupKey, err := manager.CreateSetupKey(context.Background(), account.Id, "test-key", types.SetupKeyReusable, time.Hour, nil, 999, userID, false)
+	require.NoError(t, err)
+
+	addPeer := func(hostname string, ipv6Supported bool) *nbpeer.Peer {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, _, _, err := manager.AddPeer(context.Background(), setupKey.Key, "", &nbpeer.Peer{
+			Key:  key.PublicKey().String(),
+			Meta: nbpeer.PeerSystemMeta{Hostname: hostname, IPv6Supported: ipv6Supported},
+		})
+		require.NoError(t, err)
+		return peer
+	}
+
+	peer1 := addPeer("test-peer-1", true)
+	peer2 := addPeer("test-peer-2", true)
+	legacyPeer := addPeer("test-peer-3", false)
+
+	network, err := manager.Store.GetAccountNetwork(context.Background(), store.LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	for _, peer := range []*nbpeer.Peer{peer1, peer2, legacyPeer} {
+		assert.True(t, network.NetV6.Contains(peer.IPv6), "peer IPv6 %s should be allocated from %s", peer.IPv6, network.NetV6.String())
+	}
+
+	networkMap, err := manager.GetNetworkMap(context.Background(), peer1.ID)
+	require.NoError(t, err)
+
+	var peerIPs []string
+	for _, rule := range networkMap.FirewallRules {
+		peerIPs = append(peerIPs, rule.PeerIP)
+	}
+	assert.Contains(t, peerIPs, "::", "IPv6 firewall rules should be generated for IPv6 capable peers")
+
+	response := toSyncResponse(context.Background(), &Config{Signal: &Host{Proto: UDP}}, peer1, nil, nil, networkMap, "netbird.cloud", nil, nil, nil)
+	assert.Equal(t, peer1.IPv6.String()+"/64", response.PeerConfig.AddressV6)
+	for _, remotePeer := range response.RemotePeers {
+		switch remotePeer.WgPubKey {
+		case peer2.Key:
+			assert.Equal(t, []string{peer2.IP.String() + "/32", peer2.IPv6.String() + "/128"}, remotePeer.AllowedIps)
+		case legacyPeer.Key:
+			assert.Equal(t, []string{legacyPeer.IP.String() + "/32"}, remotePeer.AllowedIps)
+		}
+	}
+	assert.Contains(t, response.NetworkMap.DNSConfig.CustomZones[0].Records, &proto.SimpleRecord{
+		Name:  peer2.DNSLabel + ".netbird.cloud",
+		Type:  int64(dns.TypeAAAA),
+		Class: nbdns.DefaultClass,
+		TTL:   300,
+		RData: peer2.IPv6.String(),
+	})
+
+	networkMap, err = manager.GetNetworkMap(context.Background(), legacyPeer.ID)
+	require.NoError(t, err)
+	for _, rule := range networkMap.FirewallRules {
+		assert.NotContains(t, rule.PeerIP, ":", "IPv6 firewall rules should not be sent to peers without IPv6 support")
+	}
+
+	networkMap.DNSConfig.Blocklists = []nbdns.Blocklist{{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com"}}}
+	response = toSyncResponse(context.Background(), &Config{Signal: &Host{Proto: UDP}}, legacyPeer, nil, nil, networkMap, "netbird.cloud", nil, nil, nil)
+	assert.Empty(t, response.PeerConfig.AddressV6)
+	require.Len(t, response.NetworkMap.DNSConfig.Blocklists, 1, "peers without IPv6 support should receive the blocklists")
+	assert.Equal(t, []string{"ads.example.com"}, response.NetworkMap.DNSConfig.Blocklists[0].Domains)
+	for _, remotePeer := range response.RemotePeers {
+		assert.Len(t, remotePeer.AllowedIps, 1, "peers without IPv6 support expect a single allowed IP")
+	}
+	for _, zone := range response.NetworkMap.DNSConfig.CustomZones {
+		for _, record := range zone.Records {
+			assert.NotEqual(t, int64(dns.TypeAAAA), record.Type, "AAAA records should not be sent to peers without IPv6 support")
+		}
+	}
+}
+
 func TestAccountManager_GetNetworkMapWithPolicy(t *testing.T) {
 	// TODO: disable until we start use policy again
 	t.Skip()
@@ -1092,13 +1175,15 @@ func TestToSyncResponse(t *testing.T) {
 	}
 	dnsCache := &DNSConfigCache{}
 
-	response := toSyncResponse(context.Background(), config, peer, turnRelayToken, turnRelayToken, networkMap, dnsName, checks, dnsCache, true)
+	response := toSyncResponse(context.Background(), config, peer, turnRelayToken, turnRelayToken, networkMap, dnsName, checks, dnsCache, &types.Settings{RoutingPeerDNSResolutionEnabled: true, NetworkTrafficLogsEnabled: true})
 
 	assert.NotNil(t, response)
 	// assert peer config
 	assert.Equal(t, "192.168.1.1/24", response.PeerConfig.Address)
 	assert.Equal(t, "peer1.example.com", response.PeerConfig.Fqdn)
 	assert.Equal(t, true, response.PeerConfig.SshConfig.SshEnabled)
+	assert.Equal(t, true, response.PeerConfig.RoutingPeerDnsResolutionEnabled)
+	assert.Equal(t, true, response.PeerConfig.FlowConfig.Enabled)
 	// assert wiretrustee config
 	assert.Equal(t, "signal.uri", response.WiretrusteeConfig.Signal.Uri)
 	assert.Equal(t, proto.HostConfig_HTTPS, response.WiretrusteeConfig.Signal.GetProtocol())
@@ -1188,7 +1273,7 @@ func Test_RegisterPeerByUser(t *testing.T) {
 	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
 	assert.NoError(t, err)
 
-	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(s, users.NewManager(s)))
 	assert.NoError(t, err)
 
 	existingAccountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
@@ -1252,7 +1337,7 @@ func Test_RegisterPeerBySetupKey(t *testing.T) {
 	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
 	assert.NoError(t, err)
 
-	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(s, users.NewManager(s)))
 	assert.NoError(t, err)
 
 	existingAccountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
@@ -1319,7 +1404,7 @@ func Test_RegisterPeerRollbackOnFailure(t *testing.T) {
 	metrics, err := telemetry.NewDefaultAppMetrics(context.Background())
 	assert.NoError(t, err)
 
-	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics)
+	am, err := BuildManager(context.Background(), s, NewPeersUpdateManager(nil), nil, "", "netbird.cloud", eventStore, nil, false, MocIntegratedValidator{}, metrics, permissions.NewManager(s, users.NewManager(s)))
 	assert.NoError(t, err)
 
 	existingAccountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
@@ -1627,7 +1712,7 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 		_, err := manager.CreateRoute(
 			context.Background(), account.Id, route.Network, route.NetworkType, route.Domains, route.Peer,
 			route.PeerGroups, route.Description, route.NetID, route.Masquerade, route.Metric,
-			route.Groups, []string{}, true, userID, route.KeepRoute,
+			route.Groups, []string{}, true, userID, route.KeepRoute, route.ExitNode,
 		)
 		require.NoError(t, err)
 
@@ -1682,7 +1767,7 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 				Port:   nbdns.DefaultDNSPort,
 			}},
 			[]string{"groupC"},
-			true, []string{}, true, userID, false,
+			true, []string{}, true, userID, false, false, nil,
 		)
 		require.NoError(t, err)
 
@@ -1728,3 +1813,29 @@ func TestPeerAccountPeersUpdate(t *testing.T) {
 		}
 	})
 }
+
+func TestWithoutIPv6Records(t *testing.T) {
+	aRecord := &proto.SimpleRecord{Name: "peer.netbird.cloud", Type: int64(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.1"}
+	aaaaRecord := &proto.SimpleRecord{Name: "peer.netbird.cloud", Type: int64(dns.TypeAAAA), Class: nbdns.DefaultClass, TTL: 300, RData: "fd00::1"}
+	config := &proto.DNSConfig{
+		ServiceEnable:    true,
+		NameServerGroups: []*proto.NameServerGroup{{Primary: true}},
+		CustomZones: []*proto.CustomZone{{
+			Domain:  "netbird.cloud",
+			Records: []*proto.SimpleRecord{aRecord, aaaaRecord},
+		}},
+		Blocklists: []*proto.DNSBlocklist{{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com"}}},
+	}
+
+	filtered := withoutIPv6Records(config)
+
+	require.Len(t, filtered.CustomZones, 1)
+	require.Len(t, filtered.CustomZones[0].Records, 1)
+	assert.Equal(t, aRecord.RData, filtered.CustomZones[0].Records[0].RData)
+	assert.True(t, filtered.ServiceEnable)
+	assert.Len(t, filtered.NameServerGroups, 1)
+	require.Len(t, filtered.Blocklists, 1, "the blocklists should be kept")
+	assert.Equal(t, []string{"ads.example.com"}, filtered.Blocklists[0].Domains)
+
+	assert.Len(t, config.CustomZones[0].Records, 2, "the shared config must not be modified")
+}
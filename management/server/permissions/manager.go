@@ -4,47 +4,73 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
-	"github.com/netbirdio/netbird/management/server/settings"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/users"
 )
 
-type Module string
-
-const (
-	Networks Module = "networks"
-	Peers    Module = "peers"
-	Groups   Module = "groups"
-)
-
-type Operation string
-
-const (
-	Read  Operation = "read"
-	Write Operation = "write"
-)
-
 type Manager interface {
-	ValidateUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error)
+	ValidateUserPermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) (bool, error)
 }
 
 type managerImpl struct {
-	userManager     users.Manager
-	settingsManager settings.Manager
+	store       store.Store
+	userManager users.Manager
 }
 
 type managerMock struct {
 }
 
-func NewManager(userManager users.Manager, settingsManager settings.Manager) Manager {
+// allPermissions grants every operation on every module
+var allPermissions = func() roles.Permissions {
+	permissions := make(roles.Permissions, len(modules.All))
+	for _, module := range modules.All {
+		permissions[module] = operations.All
+	}
+	return permissions
+}()
+
+// readPermissions grants read access to every module
+var readPermissions = func() roles.Permissions {
+	permissions := make(roles.Permissions, len(modules.All))
+	for _, module := range modules.All {
+		permissions[module] = []operations.Operation{operations.Read}
+	}
+	return permissions
+}()
+
+// serviceUserPermissions are the permissions of service users with the user role. They act on behalf of integrations,
+// can view all resources and manage the groups, policies and setup keys, as they could before custom roles existed.
+var serviceUserPermissions = func() roles.Permissions {
+	permissions := maps.Clone(readPermissions)
+	for _, module := range []modules.Module{modules.Groups, modules.Policies, modules.SetupKeys} {
+		permissions[module] = operations.All
+	}
+	return permissions
+}()
+
+// builtinRoles defines the permissions of the predefined user roles. Regular users have no module wide permissions,
+// the access to their own peers, user and tokens is handled by the account manager.
+var builtinRoles = map[types.UserRole]roles.Permissions{
+	types.UserRoleOwner:        allPermissions,
+	types.UserRoleAdmin:        allPermissions,
+	types.UserRoleUser:         {},
+	types.UserRoleBillingAdmin: {},
+}
+
+func NewManager(store store.Store, userManager users.Manager) Manager {
 	return &managerImpl{
-		userManager:     userManager,
-		settingsManager: settingsManager,
+		store:       store,
+		userManager: userManager,
 	}
 }
 
-func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error) {
+func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) (bool, error) {
 	user, err := m.userManager.GetUser(ctx, userID)
 	if err != nil {
 		return false, err
@@ -58,43 +84,45 @@ func (m *managerImpl) ValidateUserPermissions(ctx context.Context, accountID, us
 		return false, errors.New("user does not belong to account")
 	}
 
-	switch user.Role {
-	case types.UserRoleAdmin, types.UserRoleOwner:
-		return true, nil
-	case types.UserRoleUser:
-		return m.validateRegularUserPermissions(ctx, accountID, userID, module, operation)
-	case types.UserRoleBillingAdmin:
+	if user.IsBlocked() {
 		return false, nil
-	default:
-		return false, errors.New("invalid role")
 	}
-}
 
-func (m *managerImpl) validateRegularUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error) {
-	settings, err := m.settingsManager.GetSettings(ctx, accountID, userID)
+	permissions, err := m.getUserPermissions(ctx, accountID, user)
 	if err != nil {
-		return false, fmt.Errorf("failed to get settings: %w", err)
-	}
-	if settings.RegularUsersViewBlocked {
-		return false, nil
+		return false, err
 	}
 
-	if operation == Write {
-		return false, nil
+	return permissions.Allows(module, operation), nil
+}
+
+func (m *managerImpl) getUserPermissions(ctx context.Context, accountID string, user *types.User) (roles.Permissions, error) {
+	if !user.HasCustomRole() {
+		permissions, ok := builtinRoles[user.Role]
+		if !ok {
+			return nil, errors.New("invalid role")
+		}
+
+		if user.IsServiceUser && user.Role == types.UserRoleUser {
+			return serviceUserPermissions, nil
+		}
+
+		return permissions, nil
 	}
 
-	if module == Peers {
-		return true, nil
+	role, err := m.store.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, string(user.Role))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom role: %w", err)
 	}
 
-	return false, nil
+	return role.Permissions, nil
 }
 
 func NewManagerMock() Manager {
 	return &managerMock{}
 }
 
-func (m *managerMock) ValidateUserPermissions(ctx context.Context, accountID, userID string, module Module, operation Operation) (bool, error) {
+func (m *managerMock) ValidateUserPermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) (bool, error) {
 	if userID == "allowedUser" {
 		return true, nil
 	}
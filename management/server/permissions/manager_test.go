@@ -0,0 +1,88 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/users"
+)
+
+const (
+	testAccountID = "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	testAdminID   = "edafee4e-63fb-11ec-90d6-0242ac120003"
+	testUserID    = "f4f6d672-63fb-11ec-90d6-0242ac120003"
+)
+
+func Test_ValidateUserPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	s, cleanUp, err := store.NewTestStoreFromSQL(ctx, "../testdata/store.sql", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	networkOperator := roles.NewCustomRole(testAccountID, "network operator", "", roles.Permissions{
+		modules.Routes: {operations.Create, operations.Read, operations.Update, operations.Delete},
+		modules.Groups: {operations.Read},
+	})
+	require.NoError(t, s.SaveCustomRole(ctx, store.LockingStrengthUpdate, networkOperator))
+
+	testUsers := map[string]*types.User{
+		"serviceUser":  {Id: "serviceUser", AccountID: testAccountID, Role: types.UserRoleUser, IsServiceUser: true},
+		"blockedAdmin": {Id: "blockedAdmin", AccountID: testAccountID, Role: types.UserRoleAdmin, Blocked: true},
+		"operator":     {Id: "operator", AccountID: testAccountID, Role: types.UserRole(networkOperator.ID)},
+		"unknownRole":  {Id: "unknownRole", AccountID: testAccountID, Role: "unknown"},
+	}
+	for _, user := range testUsers {
+		require.NoError(t, s.SaveUser(ctx, store.LockingStrengthUpdate, user))
+	}
+
+	manager := NewManager(s, users.NewManager(s))
+
+	tests := []struct {
+		name      string
+		accountID string
+		userID    string
+		module    modules.Module
+		operation operations.Operation
+		allowed   bool
+		wantErr   bool
+	}{
+		{"admin can delete users", testAccountID, testAdminID, modules.Users, operations.Delete, true, false},
+		{"regular user can't read routes", testAccountID, testUserID, modules.Routes, operations.Read, false, false},
+		{"service user can read routes", testAccountID, "serviceUser", modules.Routes, operations.Read, true, false},
+		{"service user can't update routes", testAccountID, "serviceUser", modules.Routes, operations.Update, false, false},
+		{"service user can create policies", testAccountID, "serviceUser", modules.Policies, operations.Create, true, false},
+		{"service user can update groups", testAccountID, "serviceUser", modules.Groups, operations.Update, true, false},
+		{"service user can delete setup keys", testAccountID, "serviceUser", modules.SetupKeys, operations.Delete, true, false},
+		{"service user can't update posture checks", testAccountID, "serviceUser", modules.PostureChecks, operations.Update, false, false},
+		{"service user can't update settings", testAccountID, "serviceUser", modules.Settings, operations.Update, false, false},
+		{"regular user can't create policies", testAccountID, testUserID, modules.Policies, operations.Create, false, false},
+		{"blocked admin can't read routes", testAccountID, "blockedAdmin", modules.Routes, operations.Read, false, false},
+		{"custom role can update routes", testAccountID, "operator", modules.Routes, operations.Update, true, false},
+		{"custom role can read groups", testAccountID, "operator", modules.Groups, operations.Read, true, false},
+		{"custom role can't update groups", testAccountID, "operator", modules.Groups, operations.Update, false, false},
+		{"custom role can't manage users", testAccountID, "operator", modules.Users, operations.Create, false, false},
+		{"unknown custom role", testAccountID, "unknownRole", modules.Routes, operations.Read, false, true},
+		{"user of another account", "otherAccount", testAdminID, modules.Routes, operations.Read, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := manager.ValidateUserPermissions(ctx, tt.accountID, tt.userID, tt.module, tt.operation)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package modules
+
+type Module string
+
+const (
+	Networks      Module = "networks"
+	Peers         Module = "peers"
+	Groups        Module = "groups"
+	Events        Module = "events"
+	Policies      Module = "policies"
+	Routes        Module = "routes"
+	Dns           Module = "dns"
+	SetupKeys     Module = "setup_keys"
+	Pats          Module = "pats"
+	PostureChecks Module = "posture_checks"
+	Users         Module = "users"
+	Settings      Module = "settings"
+)
+
+// All contains every module a permission can be granted for
+var All = []Module{
+	Networks,
+	Peers,
+	Groups,
+	Events,
+	Policies,
+	Routes,
+	Dns,
+	SetupKeys,
+	Pats,
+	PostureChecks,
+	Users,
+	Settings,
+}
+
+// IsValid returns true if the module is known
+func (m Module) IsValid() bool {
+	for _, module := range All {
+		if module == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package operations
+
+type Operation string
+
+const (
+	Create Operation = "create"
+	Read   Operation = "read"
+	Update Operation = "update"
+	Delete Operation = "delete"
+)
+
+// All contains every operation a permission can be granted for
+var All = []Operation{Create, Read, Update, Delete}
+
+// IsValid returns true if the operation is known
+func (o Operation) IsValid() bool {
+	for _, operation := range All {
+		if operation == o {
+			return true
+		}
+	}
+	return false
+}
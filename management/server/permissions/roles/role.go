@@ -0,0 +1,127 @@
+package roles
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+)
+
+// Permissions maps a module to the operations allowed on it
+type Permissions map[modules.Module][]operations.Operation
+
+// Allows returns true if the operation is allowed on the module
+func (p Permissions) Allows(module modules.Module, operation operations.Operation) bool {
+	return slices.Contains(p[module], operation)
+}
+
+// Copy returns a copy of the permissions
+func (p Permissions) Copy() Permissions {
+	permissions := make(Permissions, len(p))
+	for module, ops := range p {
+		permissions[module] = slices.Clone(ops)
+	}
+	return permissions
+}
+
+// CustomRole is an account defined role that grants a set of permissions to the users it is assigned to.
+// Users reference a custom role by setting their role to the custom role ID.
+type CustomRole struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"index"`
+	Name        string
+	Description string
+	Permissions Permissions `gorm:"serializer:json"`
+}
+
+func NewCustomRole(accountID, name, description string, permissions Permissions) *CustomRole {
+	return &CustomRole{
+		ID:          xid.New().String(),
+		AccountID:   accountID,
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+	}
+}
+
+// Validate checks that the role has a name and only refers to known modules and operations
+func (r *CustomRole) Validate() error {
+	if r.Name == "" {
+		return errors.New("role name can't be empty")
+	}
+
+	for module, ops := range r.Permissions {
+		if !module.IsValid() {
+			return fmt.Errorf("unknown module %s", module)
+		}
+		for _, op := range ops {
+			if !op.IsValid() {
+				return fmt.Errorf("unknown operation %s for module %s", op, module)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *CustomRole) ToAPIResponse() *api.Role {
+	permissions := make([]api.RolePermission, 0, len(r.Permissions))
+	for _, module := range modules.All {
+		ops, ok := r.Permissions[module]
+		if !ok {
+			continue
+		}
+		apiOps := make([]api.RolePermissionOperations, 0, len(ops))
+		for _, op := range ops {
+			apiOps = append(apiOps, api.RolePermissionOperations(op))
+		}
+		permissions = append(permissions, api.RolePermission{
+			Module:     api.RolePermissionModule(module),
+			Operations: apiOps,
+		})
+	}
+
+	return &api.Role{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: &r.Description,
+		Permissions: permissions,
+	}
+}
+
+func (r *CustomRole) FromAPIRequest(req *api.RoleRequest) {
+	r.Name = req.Name
+	if req.Description != nil {
+		r.Description = *req.Description
+	}
+
+	r.Permissions = make(Permissions, len(req.Permissions))
+	for _, permission := range req.Permissions {
+		module := modules.Module(permission.Module)
+		for _, op := range permission.Operations {
+			if !slices.Contains(r.Permissions[module], operations.Operation(op)) {
+				r.Permissions[module] = append(r.Permissions[module], operations.Operation(op))
+			}
+		}
+	}
+}
+
+// Copy returns a copy of the custom role
+func (r *CustomRole) Copy() *CustomRole {
+	return &CustomRole{
+		ID:          r.ID,
+		AccountID:   r.AccountID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions.Copy(),
+	}
+}
+
+func (r *CustomRole) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name}
+}
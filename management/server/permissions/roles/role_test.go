@@ -0,0 +1,76 @@
+package roles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+)
+
+func TestCustomRole_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    *CustomRole
+		wantErr bool
+	}{
+		{
+			name: "valid role",
+			role: &CustomRole{Name: "operator", Permissions: Permissions{modules.Routes: {operations.Read, operations.Update}}},
+		},
+		{
+			name: "role without permissions",
+			role: &CustomRole{Name: "nobody"},
+		},
+		{
+			name:    "empty name",
+			role:    &CustomRole{Permissions: Permissions{modules.Routes: {operations.Read}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown module",
+			role:    &CustomRole{Name: "operator", Permissions: Permissions{"billing": {operations.Read}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			role:    &CustomRole{Name: "operator", Permissions: Permissions{modules.Routes: {"execute"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomRole_FromAPIRequest(t *testing.T) {
+	description := "manages routes"
+	req := &api.RoleRequest{
+		Name:        "operator",
+		Description: &description,
+		Permissions: []api.RolePermission{
+			{Module: api.RolePermissionModuleRoutes, Operations: []api.RolePermissionOperations{"read", "update", "read"}},
+			{Module: api.RolePermissionModuleGroups, Operations: []api.RolePermissionOperations{"read"}},
+		},
+	}
+
+	role := &CustomRole{}
+	role.FromAPIRequest(req)
+
+	assert.Equal(t, "operator", role.Name)
+	assert.Equal(t, description, role.Description)
+	assert.Equal(t, []operations.Operation{operations.Read, operations.Update}, role.Permissions[modules.Routes])
+	assert.True(t, role.Permissions.Allows(modules.Groups, operations.Read))
+	assert.False(t, role.Permissions.Allows(modules.Groups, operations.Update))
+	assert.False(t, role.Permissions.Allows(modules.Users, operations.Read))
+}
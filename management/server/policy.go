@@ -5,31 +5,26 @@ import (
 	_ "embed"
 
 	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
 // GetPolicy from the store
 func (am *DefaultAccountManager) GetPolicy(ctx context.Context, accountID, policyID, userID string) (*types.Policy, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Read)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
-	}
-
 	return am.Store.GetPolicyByID(ctx, store.LockingStrengthShare, accountID, policyID)
 }
 
@@ -38,23 +33,20 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
-		return nil, err
-	}
+	var isUpdate = policy.ID != ""
+	var updateAccountPeers bool
+	var action = activity.PolicyAdded
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
+	operation := operations.Create
+	if isUpdate {
+		operation = operations.Update
 	}
 
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Policies, operation)
+	if err != nil {
+		return nil, err
 	}
 
-	var isUpdate = policy.ID != ""
-	var updateAccountPeers bool
-	var action = activity.PolicyAdded
-
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
 		if err = validatePolicy(ctx, transaction, accountID, policy); err != nil {
 			return err
@@ -83,6 +75,8 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 
 	am.StoreEvent(ctx, userID, policy.ID, accountID, action, policy.EventMeta())
 
+	am.reschedulePolicyScheduleUpdates(ctx, accountID)
+
 	if updateAccountPeers {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -95,19 +89,11 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Delete)
 	if err != nil {
 		return err
 	}
 
-	if user.AccountID != accountID {
-		return status.NewUserNotPartOfAccountError()
-	}
-
-	if user.IsRegularUser() {
-		return status.NewAdminPermissionError()
-	}
-
 	var policy *types.Policy
 	var updateAccountPeers bool
 
@@ -134,6 +120,8 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 
 	am.StoreEvent(ctx, userID, policyID, accountID, activity.PolicyRemoved, policy.EventMeta())
 
+	am.reschedulePolicyScheduleUpdates(ctx, accountID)
+
 	if updateAccountPeers {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -143,20 +131,23 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 
 // ListPolicies from the store.
 func (am *DefaultAccountManager) ListPolicies(ctx context.Context, accountID, userID string) ([]*types.Policy, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	err := am.validateUserPermissions(ctx, accountID, userID, modules.Policies, operations.Read)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.AccountID != accountID {
-		return nil, status.NewUserNotPartOfAccountError()
-	}
+	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+}
 
-	if user.IsRegularUser() {
-		return nil, status.NewAdminPermissionError()
+// reschedulePolicyScheduleUpdates recalculates the next policy schedule transition of the account after its policies changed
+func (am *DefaultAccountManager) reschedulePolicyScheduleUpdates(ctx context.Context, accountID string) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get account %s to reschedule policy updates: %v", accountID, err)
+		return
 	}
 
-	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthShare, accountID)
+	am.checkAndSchedulePolicyScheduleUpdates(ctx, account)
 }
 
 // arePolicyChangesAffectPeers checks if changes to a policy will affect any associated peers.
@@ -207,6 +198,10 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 	}
 
 	for i, rule := range policy.Rules {
+		if err = rule.Schedule.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid schedule of rule %s: %v", rule.Name, err)
+		}
+
 		ruleCopy := rule.Copy()
 		if ruleCopy.ID == "" {
 			ruleCopy.ID = policy.ID // TODO: when policy can contain multiple rules, need refactor
@@ -256,6 +251,7 @@ func toProtocolFirewallRules(rules []*types.FirewallRule) []*proto.FirewallRule
 		rule := rules[i]
 
 		result[i] = &proto.FirewallRule{
+			PolicyID:  []byte(rule.PolicyID),
 			PeerIP:    rule.PeerIP,
 			Direction: getProtoDirection(rule.Direction),
 			Action:    getProtoAction(rule.Action),
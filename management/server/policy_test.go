@@ -494,6 +494,92 @@ func TestAccount_getPeersByPolicyDirect(t *testing.T) {
 	})
 }
 
+func TestAccount_getPeersByPolicySchedule(t *testing.T) {
+	account := &types.Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {
+				ID:     "peerA",
+				IP:     net.ParseIP("100.65.14.88"),
+				Status: &nbpeer.PeerStatus{},
+			},
+			"peerB": {
+				ID:     "peerB",
+				IP:     net.ParseIP("100.65.80.39"),
+				Status: &nbpeer.PeerStatus{},
+			},
+		},
+		Groups: map[string]*types.Group{
+			"GroupContractors": {
+				ID:    "GroupContractors",
+				Name:  "contractors",
+				Peers: []string{"peerA"},
+			},
+			"GroupProduction": {
+				ID:    "GroupProduction",
+				Name:  "production",
+				Peers: []string{"peerB"},
+			},
+		},
+		Policies: []*types.Policy{
+			{
+				ID:      "RuleContractors",
+				Name:    "Contractors",
+				Enabled: true,
+				Rules: []*types.PolicyRule{
+					{
+						ID:            "RuleContractors",
+						Name:          "Contractors",
+						Bidirectional: true,
+						Enabled:       true,
+						Protocol:      types.PolicyRuleProtocolALL,
+						Action:        types.PolicyTrafficActionAccept,
+						Sources:       []string{"GroupContractors"},
+						Destinations:  []string{"GroupProduction"},
+					},
+				},
+			},
+		},
+	}
+
+	approvedPeers := make(map[string]struct{})
+	for p := range account.Peers {
+		approvedPeers[p] = struct{}{}
+	}
+
+	rule := account.Policies[0].Rules[0]
+
+	t.Run("rule within schedule is applied", func(t *testing.T) {
+		validFrom := time.Now().Add(-time.Hour)
+		validUntil := time.Now().Add(time.Hour)
+		rule.Schedule = &types.RuleSchedule{ValidFrom: &validFrom, ValidUntil: &validUntil}
+
+		peers, firewallRules := account.GetPeerConnectionResources(context.Background(), "peerA", approvedPeers)
+		assert.Len(t, peers, 1)
+		assert.Len(t, firewallRules, 2)
+
+		next, ok := account.GetNextPolicyScheduleTransition()
+		assert.True(t, ok)
+		assert.LessOrEqual(t, next, time.Hour)
+	})
+
+	t.Run("rule outside schedule is not applied", func(t *testing.T) {
+		validFrom := time.Now().Add(time.Hour)
+		rule.Schedule = &types.RuleSchedule{ValidFrom: &validFrom}
+
+		peers, firewallRules := account.GetPeerConnectionResources(context.Background(), "peerA", approvedPeers)
+		assert.Len(t, peers, 0)
+		assert.Len(t, firewallRules, 0)
+	})
+
+	t.Run("expired schedule has no transitions", func(t *testing.T) {
+		validUntil := time.Now().Add(-time.Hour)
+		rule.Schedule = &types.RuleSchedule{ValidUntil: &validUntil}
+
+		_, ok := account.GetNextPolicyScheduleTransition()
+		assert.False(t, ok)
+	})
+}
+
 func TestAccount_getPeersByPolicyPostureChecks(t *testing.T) {
 	account := &types.Account{
 		Peers: map[string]*nbpeer.Peer{
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// GetCustomRoles returns all custom roles of the account
+func (am *DefaultAccountManager) GetCustomRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error) {
+	if err := am.validateRoleManagementPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountCustomRoles(ctx, store.LockingStrengthShare, accountID)
+}
+
+// GetCustomRole returns a custom role of the account by its ID
+func (am *DefaultAccountManager) GetCustomRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error) {
+	if err := am.validateRoleManagementPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, roleID)
+}
+
+// SaveCustomRole creates a new custom role when the ID is empty, otherwise updates the existing one
+func (am *DefaultAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	if role == nil {
+		return nil, status.Errorf(status.InvalidArgument, "role provided is nil")
+	}
+
+	if err := am.validateRoleManagementPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := role.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	isUpdate := role.ID != ""
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if isUpdate {
+			if _, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, accountID, role.ID); err != nil {
+				return err
+			}
+		} else {
+			role = roles.NewCustomRole(accountID, role.Name, role.Description, role.Permissions)
+		}
+		role.AccountID = accountID
+
+		return transaction.SaveCustomRole(ctx, store.LockingStrengthUpdate, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := activity.CustomRoleCreated
+	if isUpdate {
+		action = activity.CustomRoleUpdated
+	}
+	am.StoreEvent(ctx, userID, role.ID, accountID, action, role.EventMeta())
+
+	return role.Copy(), nil
+}
+
+// DeleteCustomRole deletes a custom role of the account. Roles that are still assigned to users can't be deleted.
+func (am *DefaultAccountManager) DeleteCustomRole(ctx context.Context, accountID, userID, roleID string) error {
+	if err := am.validateRoleManagementPermissions(ctx, accountID, userID); err != nil {
+		return err
+	}
+
+	var role *roles.CustomRole
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		role, err = transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, accountID, roleID)
+		if err != nil {
+			return err
+		}
+
+		users, err := transaction.GetAccountUsers(ctx, store.LockingStrengthShare, accountID)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if string(user.Role) == roleID {
+				return status.Errorf(status.PreconditionFailed, "role %s is assigned to user %s", role.Name, user.Id)
+			}
+		}
+
+		return transaction.DeleteCustomRole(ctx, store.LockingStrengthUpdate, accountID, roleID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, roleID, accountID, activity.CustomRoleDeleted, role.EventMeta())
+
+	return nil
+}
+
+// validateRoleManagementPermissions checks that the user is allowed to manage custom roles.
+// Only users with admin power can manage roles to prevent users from granting themselves additional permissions.
+func (am *DefaultAccountManager) validateRoleManagementPermissions(ctx context.Context, accountID, userID string) error {
+	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// resolveUserRole returns the role if it is either a built-in role or an existing custom role of the account
+func (am *DefaultAccountManager) resolveUserRole(ctx context.Context, accountID, role string) (types.UserRole, error) {
+	if userRole := types.StrRoleToUserRole(role); userRole != types.UserRoleUnknown {
+		return userRole, nil
+	}
+
+	_, err := am.Store.GetCustomRoleByID(ctx, store.LockingStrengthShare, accountID, role)
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return "", status.Errorf(status.InvalidArgument, "unknown user role %s", role)
+		}
+		return "", err
+	}
+
+	return types.UserRole(role), nil
+}
+
+// validateRoleAssignment checks that the initiator is allowed to assign the role.
+// Users without admin power can only assign the regular user role.
+func validateRoleAssignment(initiator *types.User, role types.UserRole) error {
+	if !initiator.HasAdminPower() && role != types.UserRoleUser {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can assign the %s role", role)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDefaultAccountManager_CustomRoles(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "adminUser"
+	userID := "regularUser"
+
+	account := newAccountWithId(ctx, accountID, adminID, "")
+	account.Users[userID] = types.NewRegularUser(userID)
+	require.NoError(t, am.Store.SaveAccount(ctx, account))
+
+	role := &roles.CustomRole{
+		Name: "network operator",
+		Permissions: roles.Permissions{
+			modules.Routes: {operations.Create, operations.Read, operations.Update, operations.Delete},
+		},
+	}
+
+	_, err = am.SaveCustomRole(ctx, accountID, userID, role)
+	assertStatusType(t, err, status.PermissionDenied)
+
+	_, err = am.SaveCustomRole(ctx, accountID, adminID, &roles.CustomRole{})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	role, err = am.SaveCustomRole(ctx, accountID, adminID, role)
+	require.NoError(t, err)
+	require.NotEmpty(t, role.ID)
+
+	accountRoles, err := am.GetCustomRoles(ctx, accountID, adminID)
+	require.NoError(t, err)
+	require.Len(t, accountRoles, 1)
+
+	role.Description = "manages routes"
+	_, err = am.SaveCustomRole(ctx, accountID, adminID, role)
+	require.NoError(t, err)
+
+	storedRole, err := am.GetCustomRole(ctx, accountID, adminID, role.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "manages routes", storedRole.Description)
+
+	_, err = am.SaveCustomRole(ctx, accountID, adminID, &roles.CustomRole{ID: "unknown", Name: "unknown"})
+	assertStatusType(t, err, status.NotFound)
+
+	_, err = am.SaveUser(ctx, accountID, adminID, &types.User{Id: userID, Role: "unknown", AutoGroups: []string{}})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	_, err = am.SaveUser(ctx, accountID, adminID, &types.User{Id: userID, Role: types.UserRole(role.ID), AutoGroups: []string{}})
+	require.NoError(t, err)
+
+	_, err = am.ListRoutes(ctx, accountID, userID)
+	assert.NoError(t, err, "network operator should be able to list routes")
+
+	_, err = am.ListSetupKeys(ctx, accountID, userID)
+	assertStatusType(t, err, status.PermissionDenied)
+
+	_, err = am.SaveUser(ctx, accountID, userID, &types.User{Id: adminID, Role: types.UserRoleUser, AutoGroups: []string{}})
+	assert.Error(t, err, "network operator should not be able to manage users")
+
+	err = am.DeleteCustomRole(ctx, accountID, adminID, role.ID)
+	assertStatusType(t, err, status.PreconditionFailed)
+
+	_, err = am.SaveUser(ctx, accountID, adminID, &types.User{Id: userID, Role: types.UserRoleUser, AutoGroups: []string{}})
+	require.NoError(t, err)
+
+	err = am.DeleteCustomRole(ctx, accountID, adminID, role.ID)
+	require.NoError(t, err)
+
+	_, err = am.GetCustomRole(ctx, accountID, adminID, role.ID)
+	assertStatusType(t, err, status.NotFound)
+}
+
+func assertStatusType(t *testing.T, err error, errType status.Type) {
+	t.Helper()
+	require.Error(t, err)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok, "expected status error, got %v", err)
+	assert.Equal(t, errType, sErr.Type())
+}
@@ -14,21 +14,18 @@ import (
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/route"
 )
 
 // GetRoute gets a route object from account and route IDs
 func (am *DefaultAccountManager) GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Read); err != nil {
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
-	}
-
 	return am.Store.GetRouteByID(ctx, store.LockingStrengthShare, string(routeID), accountID)
 }
 
@@ -107,6 +104,28 @@ func (am *DefaultAccountManager) checkRoutePrefixOrDomainsExistForPeers(account
 	return nil
 }
 
+// validateExitNode checks that only default routes are marked as exit nodes and that the user groups exist
+func validateExitNode(exitNode route.ExitNode, prefix netip.Prefix, domains domain.List, groups map[string]*types.Group) error {
+	if !exitNode.Enabled {
+		if len(exitNode.UserGroups) > 0 || exitNode.AllowLANAccess {
+			return status.Errorf(status.InvalidArgument, "exit node settings require the route to be an exit node")
+		}
+		return nil
+	}
+
+	if len(domains) > 0 || prefix.Bits() != 0 {
+		return status.Errorf(status.InvalidArgument, "only default routes (0.0.0.0/0 or ::/0) can be exit nodes")
+	}
+
+	if len(exitNode.UserGroups) > 0 {
+		if err := validateGroups(exitNode.UserGroups, groups); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getRouteDescriptor(prefix netip.Prefix, domains domain.List) string {
 	if len(domains) > 0 {
 		return fmt.Sprintf("domains [%s]", domains.SafeString())
@@ -115,7 +134,11 @@ func getRouteDescriptor(prefix netip.Prefix, domains domain.List) string {
 }
 
 // CreateRoute creates and saves a new route
-func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, exitNode route.ExitNode) (*route.Route, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Create); err != nil {
+		return nil, err
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -185,6 +208,10 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 		return nil, err
 	}
 
+	if err = validateExitNode(exitNode, prefix, domains, account.Groups); err != nil {
+		return nil, err
+	}
+
 	newRoute.Peer = peerID
 	newRoute.PeerGroups = peerGroupIDs
 	newRoute.Network = prefix
@@ -198,6 +225,7 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 	newRoute.Groups = groups
 	newRoute.KeepRoute = keepRoute
 	newRoute.AccessControlGroups = accessControlGroupIDs
+	newRoute.ExitNode = exitNode
 
 	if account.Routes == nil {
 		account.Routes = make(map[route.ID]*route.Route)
@@ -221,6 +249,10 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 
 // SaveRoute saves route
 func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userID string, routeToSave *route.Route) error {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Update); err != nil {
+		return err
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -288,6 +320,10 @@ func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userI
 		return err
 	}
 
+	if err = validateExitNode(routeToSave.ExitNode, routeToSave.Network, routeToSave.Domains, account.Groups); err != nil {
+		return err
+	}
+
 	oldRoute := account.Routes[routeToSave.ID]
 	account.Routes[routeToSave.ID] = routeToSave
 
@@ -307,6 +343,10 @@ func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userI
 
 // DeleteRoute deletes route with routeID
 func (am *DefaultAccountManager) DeleteRoute(ctx context.Context, accountID string, routeID route.ID, userID string) error {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Delete); err != nil {
+		return err
+	}
+
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -337,15 +377,10 @@ func (am *DefaultAccountManager) DeleteRoute(ctx context.Context, accountID stri
 
 // ListRoutes returns a list of routes from account
 func (am *DefaultAccountManager) ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error) {
-	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, userID)
-	if err != nil {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Routes, operations.Read); err != nil {
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
-	}
-
 	return am.Store.GetAccountRoutes(ctx, store.LockingStrengthShare, accountID)
 }
 
@@ -360,6 +395,10 @@ func toProtocolRoute(route *route.Route) *proto.Route {
 		Metric:      int64(route.Metric),
 		Masquerade:  route.Masquerade,
 		KeepRoute:   route.KeepRoute,
+
+		IsExitNode:             route.ExitNode.Enabled,
+		ExitNodeAllowLanAccess: route.ExitNode.AllowLANAccess,
+		DnsUpstreams:           route.DNSUpstreams,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -118,3 +119,34 @@ func TestScheduler_Schedule(t *testing.T) {
 	scheduler.cancel(context.Background(), jobID)
 
 }
+
+func TestScheduler_JobReplacedWhileRunning(t *testing.T) {
+	jobID := "test-scheduler-job-1"
+	scheduler := NewDefaultScheduler()
+
+	running := make(chan struct{})
+	release := make(chan struct{})
+	scheduler.Schedule(context.Background(), time.Millisecond, jobID, func() (nextRunIn time.Duration, reschedule bool) {
+		close(running)
+		<-release
+		return 0, false
+	})
+	<-running
+
+	// the running job is replaced before it finishes
+	scheduler.Cancel(context.Background(), []string{jobID})
+	var replacementRuns int32
+	scheduler.Schedule(context.Background(), 50*time.Millisecond, jobID, func() (nextRunIn time.Duration, reschedule bool) {
+		atomic.AddInt32(&replacementRuns, 1)
+		return 0, false
+	})
+	close(release)
+
+	// the finished job must not remove the replacement, so it can still be cancelled
+	time.Sleep(10 * time.Millisecond)
+	scheduler.Cancel(context.Background(), []string{jobID})
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(&replacementRuns), "the cancelled replacement job should not run")
+	assert.Len(t, scheduler.jobs, 0)
+}
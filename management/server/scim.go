@@ -0,0 +1,847 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/util"
+)
+
+// CreateSCIMToken creates the SCIM provisioning token of the account. An existing token is replaced.
+func (am *DefaultAccountManager) CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Settings, operations.Create); err != nil {
+		return nil, err
+	}
+
+	token, err := scim.NewToken(accountID, userID)
+	if err != nil {
+		return nil, status.Errorf(status.Internal, "failed to create SCIM token: %v", err)
+	}
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		err := transaction.DeleteSCIMToken(ctx, store.LockingStrengthUpdate, accountID)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		return transaction.SaveSCIMToken(ctx, store.LockingStrengthUpdate, &token.Token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenCreated, nil)
+
+	return token, nil
+}
+
+// GetSCIMToken returns the SCIM provisioning token of the account
+func (am *DefaultAccountManager) GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error) {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Settings, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetSCIMToken(ctx, store.LockingStrengthShare, accountID)
+}
+
+// DeleteSCIMToken deletes the SCIM provisioning token of the account which disables provisioning
+func (am *DefaultAccountManager) DeleteSCIMToken(ctx context.Context, accountID, userID string) error {
+	if err := am.validateUserPermissions(ctx, accountID, userID, modules.Settings, operations.Delete); err != nil {
+		return err
+	}
+
+	token, err := am.Store.GetSCIMToken(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err = am.Store.DeleteSCIMToken(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenDeleted, nil)
+
+	return nil
+}
+
+// GetAccountIDFromSCIMToken returns the ID of the account the SCIM token belongs to and marks the token as used
+func (am *DefaultAccountManager) GetAccountIDFromSCIMToken(ctx context.Context, plainToken string) (string, error) {
+	if err := scim.ValidateToken(plainToken); err != nil {
+		return "", status.Errorf(status.Unauthorized, "invalid SCIM token: %v", err)
+	}
+
+	token, err := am.Store.GetSCIMTokenByHashedToken(ctx, store.LockingStrengthShare, scim.HashToken(plainToken))
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", status.Errorf(status.Unauthorized, "invalid SCIM token")
+		}
+		return "", err
+	}
+
+	token.LastUsed = util.ToPtr(time.Now().UTC())
+	if err = am.Store.SaveSCIMToken(ctx, store.LockingStrengthUpdate, token); err != nil {
+		log.WithContext(ctx).Errorf("failed to mark SCIM token of account %s as used: %v", token.AccountID, err)
+	}
+
+	return token.AccountID, nil
+}
+
+// GetSCIMUsers returns the users of the account provisioned via SCIM
+func (am *DefaultAccountManager) GetSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := am.Store.GetAccountSCIMUsers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := am.getSCIMGroupIDs(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*scim.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, fillSCIMUser(account, user, groupIDs))
+	}
+
+	return result, nil
+}
+
+// GetSCIMUser returns a user of the account provisioned via SCIM
+func (am *DefaultAccountManager) GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := am.Store.GetSCIMUserByID(ctx, store.LockingStrengthShare, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := am.getSCIMGroupIDs(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMUser(account, user, groupIDs), nil
+}
+
+// CreateSCIMUser provisions a user. The external ID is used as user ID to match the subject of the identity provider tokens.
+// An existing user with the same ID that wasn't provisioned yet is taken over.
+func (am *DefaultAccountManager) CreateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error) {
+	if user == nil {
+		return nil, status.Errorf(status.InvalidArgument, "provided user is nil")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user = user.Copy()
+	user.ID = user.ExternalID
+	if user.ID == "" {
+		user.ID = xid.New().String()
+	}
+
+	if err = am.validateSCIMUserName(ctx, accountID, user); err != nil {
+		return nil, err
+	}
+
+	_, err = am.Store.GetSCIMUserByID(ctx, store.LockingStrengthShare, accountID, user.ID)
+	if err == nil {
+		return nil, status.Errorf(status.AlreadyExists, "user %s already exists", user.ID)
+	}
+	if !isNotFoundError(err) {
+		return nil, err
+	}
+
+	eventsToStore, blockedPeers, err := am.provisionSCIMUser(ctx, account, user)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	user.AccountID = accountID
+	user.CreatedAt = now
+	user.UpdatedAt = now
+	if err = am.saveSCIMUser(ctx, account, user, blockedPeers); err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	groupIDs, err := am.getSCIMGroupIDs(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMUser(account, user, groupIDs), nil
+}
+
+// UpdateSCIMUser replaces the attributes of a provisioned user. Deactivating the user blocks it and expires its peers.
+func (am *DefaultAccountManager) UpdateSCIMUser(ctx context.Context, accountID string, user *scim.User) (*scim.User, error) {
+	if user == nil {
+		return nil, status.Errorf(status.InvalidArgument, "provided user is nil")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := am.Store.GetSCIMUserByID(ctx, store.LockingStrengthUpdate, accountID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = am.validateSCIMUserName(ctx, accountID, user); err != nil {
+		return nil, err
+	}
+
+	eventsToStore, blockedPeers, err := am.provisionSCIMUser(ctx, account, user)
+	if err != nil {
+		return nil, err
+	}
+
+	user = user.Copy()
+	user.AccountID = accountID
+	user.CreatedAt = existing.CreatedAt
+	user.UpdatedAt = time.Now().UTC()
+	if err = am.saveSCIMUser(ctx, account, user, blockedPeers); err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	groupIDs, err := am.getSCIMGroupIDs(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMUser(account, user, groupIDs), nil
+}
+
+// DeleteSCIMUser deletes a provisioned user and its peers. The user is not deleted from the identity provider.
+func (am *DefaultAccountManager) DeleteSCIMUser(ctx context.Context, accountID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	user, err := am.Store.GetSCIMUserByID(ctx, store.LockingStrengthUpdate, accountID, userID)
+	if err != nil {
+		return err
+	}
+
+	targetUser := account.Users[userID]
+	var hadPeers bool
+	if targetUser != nil {
+		if targetUser.Role == types.UserRoleOwner {
+			return status.Errorf(status.PermissionDenied, "unable to delete a user with owner role")
+		}
+
+		hadPeers, err = am.deleteUserPeers(ctx, activity.SystemInitiator, userID, account)
+		if err != nil {
+			return err
+		}
+
+		delete(account.Users, userID)
+	}
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if targetUser != nil {
+			if err := transaction.SaveAccount(ctx, account); err != nil {
+				return err
+			}
+		}
+		return transaction.DeleteSCIMUser(ctx, store.LockingStrengthUpdate, accountID, userID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if targetUser != nil {
+		meta := map[string]any{"name": user.DisplayName, "email": user.Email, "created_at": targetUser.CreatedAt}
+		am.StoreEvent(ctx, activity.SystemInitiator, userID, accountID, activity.UserDeleted, meta)
+	}
+
+	if hadPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// provisionSCIMUser creates or updates the NetBird user of a provisioned user in the account.
+// It returns the events to store and the peers of a deactivated user to expire once the changes are persisted.
+func (am *DefaultAccountManager) provisionSCIMUser(ctx context.Context, account *types.Account, user *scim.User) ([]func(), []*nbpeer.Peer, error) {
+	var (
+		eventsToStore []func()
+		blockedPeers  []*nbpeer.Peer
+	)
+
+	oldUser := account.Users[user.ID]
+	if oldUser == nil {
+		newUser := types.NewUser(user.ID, types.UserRoleUser, false, false, "", []string{}, types.UserIssuedSCIM)
+		newUser.AccountID = account.Id
+		newUser.Blocked = !user.Active
+		account.Users[newUser.Id] = newUser
+
+		eventsToStore = append(eventsToStore, func() {
+			meta := map[string]any{"name": user.DisplayName, "email": user.Email, "user_name": user.UserName}
+			am.StoreEvent(ctx, activity.SystemInitiator, user.ID, account.Id, activity.UserProvisioned, meta)
+		})
+	} else {
+		if oldUser.IsServiceUser {
+			return nil, nil, status.Errorf(status.AlreadyExists, "service user %s already exists", user.ID)
+		}
+		if oldUser.Role == types.UserRoleOwner && !user.Active {
+			return nil, nil, status.Errorf(status.PermissionDenied, "unable to block owner user")
+		}
+
+		newUser := oldUser.Copy()
+		newUser.Issued = types.UserIssuedSCIM
+		newUser.Blocked = !user.Active
+		account.Users[newUser.Id] = newUser
+
+		if oldUser.Issued != types.UserIssuedSCIM {
+			eventsToStore = append(eventsToStore, func() {
+				meta := map[string]any{"name": user.DisplayName, "email": user.Email, "user_name": user.UserName}
+				am.StoreEvent(ctx, activity.SystemInitiator, user.ID, account.Id, activity.UserProvisioned, meta)
+			})
+		}
+		eventsToStore = append(eventsToStore, am.prepareUserUpdateEvents(ctx, activity.SystemInitiator, oldUser, newUser, account, false)...)
+
+		if !oldUser.IsBlocked() && newUser.IsBlocked() {
+			var err error
+			blockedPeers, err = account.FindUserPeers(newUser.Id)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	account.Network.IncSerial()
+
+	return eventsToStore, blockedPeers, nil
+}
+
+// saveSCIMUser saves the account and the provisioned user in one transaction and expires the peers of a deactivated
+// user afterward to disconnect them
+func (am *DefaultAccountManager) saveSCIMUser(ctx context.Context, account *types.Account, user *scim.User, blockedPeers []*nbpeer.Peer) error {
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := transaction.SaveAccount(ctx, account); err != nil {
+			return err
+		}
+		return transaction.SaveSCIMUser(ctx, store.LockingStrengthUpdate, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = am.expireAndUpdatePeers(ctx, account, blockedPeers); err != nil {
+		log.WithContext(ctx).Errorf("failed update expired peers: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// validateSCIMUserName checks that no other provisioned user of the account has the same user name
+func (am *DefaultAccountManager) validateSCIMUserName(ctx context.Context, accountID string, user *scim.User) error {
+	users, err := am.Store.GetAccountSCIMUsers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range users {
+		if existing.ID != user.ID && strings.EqualFold(existing.UserName, user.UserName) {
+			return status.Errorf(status.AlreadyExists, "user with user name %s already exists", user.UserName)
+		}
+	}
+
+	return nil
+}
+
+// GetSCIMGroups returns the groups of the account provisioned via SCIM
+func (am *DefaultAccountManager) GetSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := am.Store.GetAccountSCIMGroups(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := am.getSCIMUsersByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*scim.Group, 0, len(groups))
+	for _, group := range groups {
+		if account.Groups[group.ID] == nil {
+			continue
+		}
+		result = append(result, fillSCIMGroup(account, group, users))
+	}
+
+	return result, nil
+}
+
+// GetSCIMGroup returns a group of the account provisioned via SCIM
+func (am *DefaultAccountManager) GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := am.Store.GetSCIMGroupByID(ctx, store.LockingStrengthShare, accountID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Groups[groupID] == nil {
+		return nil, status.NewGroupNotFoundError(groupID)
+	}
+
+	users, err := am.getSCIMUsersByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMGroup(account, group, users), nil
+}
+
+// CreateSCIMGroup provisions a group and adds it to the auto groups of its members.
+// An existing group with the same name that was created from JWT claims is taken over.
+func (am *DefaultAccountManager) CreateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error) {
+	if group == nil {
+		return nil, status.Errorf(status.InvalidArgument, "provided group is nil")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateSCIMGroupMembers(account, group); err != nil {
+		return nil, err
+	}
+
+	var eventsToStore []func()
+
+	newGroup := findGroupByName(account, group.DisplayName)
+	switch {
+	case newGroup == nil:
+		newGroup = &types.Group{
+			ID:        xid.New().String(),
+			AccountID: accountID,
+			Name:      group.DisplayName,
+			Issued:    types.GroupIssuedSCIM,
+			Peers:     []string{},
+		}
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, activity.SystemInitiator, newGroup.ID, accountID, activity.GroupCreated, newGroup.EventMeta())
+		})
+	case newGroup.Issued == types.GroupIssuedJWT:
+		newGroup = newGroup.Copy()
+		newGroup.Issued = types.GroupIssuedSCIM
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, activity.SystemInitiator, newGroup.ID, accountID, activity.GroupUpdated, newGroup.EventMeta())
+		})
+	default:
+		return nil, status.Errorf(status.AlreadyExists, "group with name %s already exists", group.DisplayName)
+	}
+	account.Groups[newGroup.ID] = newGroup
+
+	membersEvents, updateAccountPeers := am.setSCIMGroupMembers(ctx, account, newGroup.ID, group.MemberIDs())
+	eventsToStore = append(eventsToStore, membersEvents...)
+
+	account.Network.IncSerial()
+
+	now := time.Now().UTC()
+	scimGroup := &scim.Group{
+		ID:         newGroup.ID,
+		AccountID:  accountID,
+		ExternalID: group.ExternalID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err = am.saveSCIMGroup(ctx, account, scimGroup); err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	users, err := am.getSCIMUsersByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMGroup(account, scimGroup, users), nil
+}
+
+// UpdateSCIMGroup replaces the name and the members of a provisioned group
+func (am *DefaultAccountManager) UpdateSCIMGroup(ctx context.Context, accountID string, group *scim.Group) (*scim.Group, error) {
+	if group == nil {
+		return nil, status.Errorf(status.InvalidArgument, "provided group is nil")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	scimGroup, err := am.Store.GetSCIMGroupByID(ctx, store.LockingStrengthUpdate, accountID, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldGroup := account.Groups[group.ID]
+	if oldGroup == nil {
+		return nil, status.NewGroupNotFoundError(group.ID)
+	}
+
+	if err = validateSCIMGroupMembers(account, group); err != nil {
+		return nil, err
+	}
+
+	var eventsToStore []func()
+
+	if oldGroup.Name != group.DisplayName {
+		if existing := findGroupByName(account, group.DisplayName); existing != nil && existing.ID != group.ID {
+			return nil, status.Errorf(status.AlreadyExists, "group with name %s already exists", group.DisplayName)
+		}
+
+		newGroup := oldGroup.Copy()
+		newGroup.Name = group.DisplayName
+		account.Groups[newGroup.ID] = newGroup
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, activity.SystemInitiator, newGroup.ID, accountID, activity.GroupUpdated, newGroup.EventMeta())
+		})
+	}
+
+	membersEvents, updateAccountPeers := am.setSCIMGroupMembers(ctx, account, group.ID, group.MemberIDs())
+	eventsToStore = append(eventsToStore, membersEvents...)
+
+	account.Network.IncSerial()
+
+	scimGroup.ExternalID = group.ExternalID
+	scimGroup.UpdatedAt = time.Now().UTC()
+	if err = am.saveSCIMGroup(ctx, account, scimGroup); err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	if updateAccountPeers {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	users, err := am.getSCIMUsersByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillSCIMGroup(account, scimGroup, users), nil
+}
+
+// saveSCIMGroup saves the account and the provisioned group in one transaction
+func (am *DefaultAccountManager) saveSCIMGroup(ctx context.Context, account *types.Account, group *scim.Group) error {
+	return am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := transaction.SaveAccount(ctx, account); err != nil {
+			return err
+		}
+		return transaction.SaveSCIMGroup(ctx, store.LockingStrengthUpdate, group)
+	})
+}
+
+// DeleteSCIMGroup removes a provisioned group from the auto groups of its members and deletes it.
+// Groups that are still used by policies, routes or other resources can't be deleted.
+func (am *DefaultAccountManager) DeleteSCIMGroup(ctx context.Context, accountID, groupID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var (
+		group        *types.Group
+		updatedUsers []*types.User
+	)
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if _, err := transaction.GetSCIMGroupByID(ctx, store.LockingStrengthUpdate, accountID, groupID); err != nil {
+			return err
+		}
+
+		var err error
+		group, err = transaction.GetGroupByID(ctx, store.LockingStrengthUpdate, accountID, groupID)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+
+		if group != nil {
+			users, err := transaction.GetAccountUsers(ctx, store.LockingStrengthUpdate, accountID)
+			if err != nil {
+				return err
+			}
+
+			for _, user := range users {
+				if !slices.Contains(user.AutoGroups, groupID) {
+					continue
+				}
+				user.AutoGroups = slices.DeleteFunc(user.AutoGroups, func(id string) bool { return id == groupID })
+				if err = transaction.SaveUser(ctx, store.LockingStrengthUpdate, user); err != nil {
+					return err
+				}
+				updatedUsers = append(updatedUsers, user)
+			}
+
+			if err = validateDeleteGroup(ctx, transaction, group, activity.SystemInitiator); err != nil {
+				var linkErr *GroupLinkError
+				if errors.As(err, &linkErr) {
+					return status.Errorf(status.PreconditionFailed, "%s", linkErr.Error())
+				}
+				return err
+			}
+
+			if err = transaction.DeleteGroup(ctx, store.LockingStrengthUpdate, accountID, groupID); err != nil {
+				return err
+			}
+
+			if err = transaction.IncrementNetworkSerial(ctx, store.LockingStrengthUpdate, accountID); err != nil {
+				return err
+			}
+		}
+
+		return transaction.DeleteSCIMGroup(ctx, store.LockingStrengthUpdate, accountID, groupID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if group == nil {
+		return nil
+	}
+
+	for _, user := range updatedUsers {
+		meta := map[string]any{"group": group.Name, "group_id": group.ID, "is_service_user": user.IsServiceUser, "user_name": user.ServiceUserName}
+		am.StoreEvent(ctx, activity.SystemInitiator, user.Id, accountID, activity.GroupRemovedFromUser, meta)
+	}
+	am.StoreEvent(ctx, activity.SystemInitiator, group.ID, accountID, activity.GroupDeleted, group.EventMeta())
+
+	if len(group.Peers) > 0 {
+		am.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// setSCIMGroupMembers adds the group to the auto groups of the members and removes it from the other users.
+// It returns the events to store and whether the peers of the account have to be updated.
+func (am *DefaultAccountManager) setSCIMGroupMembers(ctx context.Context, account *types.Account, groupID string, memberIDs []string) ([]func(), bool) {
+	var (
+		eventsToStore      []func()
+		updateAccountPeers bool
+	)
+
+	for _, oldUser := range account.Users {
+		if oldUser.IsServiceUser {
+			continue
+		}
+
+		isMember := slices.Contains(memberIDs, oldUser.Id)
+		hasGroup := slices.Contains(oldUser.AutoGroups, groupID)
+		if isMember == hasGroup {
+			continue
+		}
+
+		newUser := oldUser.Copy()
+		peerGroups := make(map[string][]string)
+
+		if isMember {
+			newUser.AutoGroups = append(newUser.AutoGroups, groupID)
+			if account.Settings.GroupsPropagationEnabled {
+				peerGroups = account.UserGroupsAddToPeers(newUser.Id, groupID)
+			}
+			account.Users[newUser.Id] = newUser
+			eventsToStore = append(eventsToStore, am.handleGroupAddedToUser(ctx, activity.SystemInitiator, oldUser, newUser, account, []string{groupID}, peerGroups)...)
+		} else {
+			newUser.AutoGroups = slices.DeleteFunc(newUser.AutoGroups, func(id string) bool { return id == groupID })
+			if account.Settings.GroupsPropagationEnabled {
+				peerGroups = account.UserGroupsRemoveFromPeers(newUser.Id, groupID)
+			}
+			account.Users[newUser.Id] = newUser
+			eventsToStore = append(eventsToStore, am.handleGroupRemovedFromUser(ctx, activity.SystemInitiator, oldUser, newUser, account, []string{groupID}, peerGroups)...)
+		}
+
+		if len(peerGroups) > 0 {
+			updateAccountPeers = true
+		}
+	}
+
+	return eventsToStore, updateAccountPeers
+}
+
+// getSCIMGroupIDs returns the IDs of the groups of the account provisioned via SCIM
+func (am *DefaultAccountManager) getSCIMGroupIDs(ctx context.Context, accountID string) (map[string]struct{}, error) {
+	groups, err := am.Store.GetAccountSCIMGroups(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		groupIDs[group.ID] = struct{}{}
+	}
+	return groupIDs, nil
+}
+
+// getSCIMUsersByID returns the users of the account provisioned via SCIM by their ID
+func (am *DefaultAccountManager) getSCIMUsersByID(ctx context.Context, accountID string) (map[string]*scim.User, error) {
+	users, err := am.Store.GetAccountSCIMUsers(ctx, store.LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[string]*scim.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+	return usersByID, nil
+}
+
+// fillSCIMUser sets the active state and the provisioned groups of the user from the NetBird user
+func fillSCIMUser(account *types.Account, user *scim.User, scimGroupIDs map[string]struct{}) *scim.User {
+	user = user.Copy()
+	user.Groups = []scim.Reference{}
+
+	accountUser := account.Users[user.ID]
+	user.Active = accountUser != nil && !accountUser.IsBlocked()
+	if accountUser == nil {
+		return user
+	}
+
+	for _, groupID := range accountUser.AutoGroups {
+		if _, ok := scimGroupIDs[groupID]; !ok {
+			continue
+		}
+		group := account.GetGroup(groupID)
+		if group == nil {
+			continue
+		}
+		user.Groups = append(user.Groups, scim.Reference{ID: group.ID, Display: group.Name})
+	}
+
+	return user
+}
+
+// fillSCIMGroup sets the name and the members of the group from the NetBird group and users
+func fillSCIMGroup(account *types.Account, group *scim.Group, scimUsers map[string]*scim.User) *scim.Group {
+	group = group.Copy()
+	group.Members = []scim.Reference{}
+
+	if accountGroup := account.GetGroup(group.ID); accountGroup != nil {
+		group.DisplayName = accountGroup.Name
+	}
+
+	for _, user := range account.Users {
+		if user.IsServiceUser || !slices.Contains(user.AutoGroups, group.ID) {
+			continue
+		}
+
+		member := scim.Reference{ID: user.Id}
+		if scimUser, ok := scimUsers[user.Id]; ok {
+			member.Display = scimUser.DisplayName
+			if member.Display == "" {
+				member.Display = scimUser.UserName
+			}
+		}
+		group.Members = append(group.Members, member)
+	}
+
+	sort.Slice(group.Members, func(i, j int) bool {
+		return group.Members[i].ID < group.Members[j].ID
+	})
+
+	return group
+}
+
+// validateSCIMGroupMembers checks that all members of the group are existing users of the account
+func validateSCIMGroupMembers(account *types.Account, group *scim.Group) error {
+	if group.DisplayName == "" {
+		return status.Errorf(status.InvalidArgument, "group name can't be empty")
+	}
+
+	for _, memberID := range group.MemberIDs() {
+		user := account.Users[memberID]
+		if user == nil || user.IsServiceUser {
+			return status.Errorf(status.InvalidArgument, "member %s doesn't exist", memberID)
+		}
+	}
+
+	return nil
+}
+
+// findGroupByName returns the group of the account with the given name or nil if there is none
+func findGroupByName(account *types.Account, name string) *types.Group {
+	for _, group := range account.Groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func isNotFoundError(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s != nil && s.Type() == status.NotFound
+}
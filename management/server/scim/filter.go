@@ -0,0 +1,510 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filter is a parsed SCIM filter expression (RFC 7644 section 3.4.2.2)
+type Filter interface {
+	// Matches returns true if the resource attributes match the filter
+	Matches(resource map[string]any) bool
+}
+
+// ParseFilter parses a SCIM filter expression
+func ParseFilter(expression string) (Filter, error) {
+	p := &filterParser{tokens: nil}
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+	p.tokens = tokens
+
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected token %q in filter", p.peek().value)
+	}
+	return filter, nil
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right Filter
+}
+
+func (f *logicalFilter) Matches(resource map[string]any) bool {
+	if f.and {
+		return f.left.Matches(resource) && f.right.Matches(resource)
+	}
+	return f.left.Matches(resource) || f.right.Matches(resource)
+}
+
+type notFilter struct {
+	filter Filter
+}
+
+func (f *notFilter) Matches(resource map[string]any) bool {
+	return !f.filter.Matches(resource)
+}
+
+// valuePathFilter matches when an element of a multi-valued attribute matches the inner filter, e.g. emails[type eq "work"]
+type valuePathFilter struct {
+	attribute string
+	filter    Filter
+}
+
+func (f *valuePathFilter) Matches(resource map[string]any) bool {
+	for _, element := range asSlice(lookupAttribute(resource, f.attribute)) {
+		if object, ok := element.(map[string]any); ok && f.filter.Matches(object) {
+			return true
+		}
+	}
+	return false
+}
+
+type attributeFilter struct {
+	path     []string
+	operator string
+	value    any
+}
+
+func (f *attributeFilter) Matches(resource map[string]any) bool {
+	for _, value := range resolvePath(resource, f.path) {
+		if f.compare(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *attributeFilter) compare(value any) bool {
+	if f.operator == "pr" {
+		return !isEmptyValue(value)
+	}
+
+	if value == nil {
+		return f.operator == "ne" && f.value != nil
+	}
+
+	switch expected := f.value.(type) {
+	case string:
+		actual, ok := value.(string)
+		if !ok {
+			return f.operator == "ne"
+		}
+		return compareStrings(f.operator, actual, expected)
+	case bool:
+		actual, ok := value.(bool)
+		switch f.operator {
+		case "eq":
+			return ok && actual == expected
+		case "ne":
+			return !ok || actual != expected
+		}
+	case float64:
+		actual, ok := toFloat(value)
+		if !ok {
+			return f.operator == "ne"
+		}
+		return compareFloats(f.operator, actual, expected)
+	case nil:
+		return f.operator == "ne"
+	}
+	return false
+}
+
+func compareStrings(operator, actual, expected string) bool {
+	actualTime, actualErr := time.Parse(time.RFC3339, actual)
+	expectedTime, expectedErr := time.Parse(time.RFC3339, expected)
+	if actualErr == nil && expectedErr == nil {
+		return compareFloats(operator, float64(actualTime.UnixNano()), float64(expectedTime.UnixNano()))
+	}
+
+	actual = strings.ToLower(actual)
+	expected = strings.ToLower(expected)
+
+	switch operator {
+	case "eq":
+		return actual == expected
+	case "ne":
+		return actual != expected
+	case "co":
+		return strings.Contains(actual, expected)
+	case "sw":
+		return strings.HasPrefix(actual, expected)
+	case "ew":
+		return strings.HasSuffix(actual, expected)
+	case "gt":
+		return actual > expected
+	case "ge":
+		return actual >= expected
+	case "lt":
+		return actual < expected
+	case "le":
+		return actual <= expected
+	}
+	return false
+}
+
+func compareFloats(operator string, actual, expected float64) bool {
+	switch operator {
+	case "eq":
+		return actual == expected
+	case "ne":
+		return actual != expected
+	case "gt":
+		return actual > expected
+	case "ge":
+		return actual >= expected
+	case "lt":
+		return actual < expected
+	case "le":
+		return actual <= expected
+	}
+	return false
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// resolvePath returns the values of the attribute path. Multi-valued attributes are flattened.
+func resolvePath(resource map[string]any, path []string) []any {
+	values := []any{resource}
+	for _, name := range path {
+		var next []any
+		for _, value := range values {
+			object, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			attribute := lookupAttribute(object, name)
+			if elements, ok := attribute.([]any); ok {
+				next = append(next, elements...)
+				continue
+			}
+			next = append(next, attribute)
+		}
+		values = next
+	}
+
+	// a filter on a multi-valued attribute without sub attribute applies to the value of the elements
+	resolved := make([]any, 0, len(values))
+	for _, value := range values {
+		if object, ok := value.(map[string]any); ok {
+			if v, exists := object["value"]; exists {
+				resolved = append(resolved, v)
+				continue
+			}
+		}
+		resolved = append(resolved, value)
+	}
+	return resolved
+}
+
+// lookupAttribute returns the attribute by name ignoring the case as attribute names are case-insensitive
+func lookupAttribute(resource map[string]any, name string) any {
+	if value, ok := resource[name]; ok {
+		return value
+	}
+	for key, value := range resource {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return nil
+}
+
+func asSlice(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	}
+	return []any{value}
+}
+
+// stripSchema removes the schema URN prefix of a fully qualified attribute name
+func stripSchema(attribute string) string {
+	if !strings.HasPrefix(strings.ToLower(attribute), "urn:") {
+		return attribute
+	}
+	index := strings.LastIndex(attribute, ":")
+	return attribute[index+1:]
+}
+
+func splitAttributePath(attribute string) []string {
+	return strings.Split(stripSchema(attribute), ".")
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type filterToken struct {
+	kind  tokenKind
+	value string
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: tokenOpenParen, value: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: tokenCloseParen, value: ")"})
+			i++
+		case r == '[':
+			tokens = append(tokens, filterToken{kind: tokenOpenBracket, value: "["})
+			i++
+		case r == ']':
+			tokens = append(tokens, filterToken{kind: tokenCloseBracket, value: "]"})
+			i++
+		case r == '"':
+			var builder strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				builder.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, value: builder.String()})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()[]\"", runes[j]); j++ {
+			}
+			tokens = append(tokens, filterToken{kind: tokenWord, value: string(runes[i:j])})
+			i = j
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens   []filterToken
+	position int
+}
+
+func (p *filterParser) done() bool {
+	return p.position >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{}
+	}
+	return p.tokens[p.position]
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.done() {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	token := p.tokens[p.position]
+	p.position++
+	return token, nil
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return !p.done() && token.kind == tokenWord && strings.EqualFold(token.value, keyword)
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.position++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.position++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (Filter, error) {
+	if p.isKeyword("not") {
+		p.position++
+		if p.peek().kind != tokenOpenParen || p.done() {
+			return nil, fmt.Errorf("expected ( after not")
+		}
+		filter, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return &notFilter{filter: filter}, nil
+	}
+
+	if !p.done() && p.peek().kind == tokenOpenParen {
+		return p.parseGroup()
+	}
+
+	return p.parseAttributeExpression()
+}
+
+func (p *filterParser) parseGroup() (Filter, error) {
+	p.position++
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.next()
+	if err != nil || token.kind != tokenCloseParen {
+		return nil, fmt.Errorf("expected ) in filter")
+	}
+	return filter, nil
+}
+
+func (p *filterParser) parseAttributeExpression() (Filter, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if token.kind != tokenWord {
+		return nil, fmt.Errorf("expected attribute name, got %q", token.value)
+	}
+	attribute := token.value
+
+	if !p.done() && p.peek().kind == tokenOpenBracket {
+		p.position++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, err := p.next()
+		if err != nil || closing.kind != tokenCloseBracket {
+			return nil, fmt.Errorf("expected ] in filter")
+		}
+		return &valuePathFilter{attribute: stripSchema(attribute), filter: inner}, nil
+	}
+
+	operatorToken, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	operator := strings.ToLower(operatorToken.value)
+
+	filter := &attributeFilter{path: splitAttributePath(attribute), operator: operator}
+
+	switch operator {
+	case "pr":
+		return filter, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported filter operator %q", operatorToken.value)
+	}
+
+	valueToken, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	filter.value, err = parseFilterValue(valueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isString := filter.value.(string); !isString {
+		switch operator {
+		case "co", "sw", "ew":
+			return nil, fmt.Errorf("operator %q requires a string value", operator)
+		}
+	}
+
+	return filter, nil
+}
+
+func parseFilterValue(token filterToken) (any, error) {
+	if token.kind == tokenString {
+		return token.value, nil
+	}
+	if token.kind != tokenWord {
+		return nil, fmt.Errorf("expected comparison value, got %q", token.value)
+	}
+
+	switch strings.ToLower(token.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(token.value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comparison value %q", token.value)
+	}
+	return number, nil
+}
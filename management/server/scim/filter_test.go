@@ -0,0 +1,85 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	resource := map[string]any{
+		"userName":    "Alice@Example.com",
+		"displayName": "Alice Smith",
+		"active":      true,
+		"name": map[string]any{
+			"givenName":  "Alice",
+			"familyName": "Smith",
+		},
+		"emails": []any{
+			map[string]any{"value": "alice@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "alice@home.example", "type": "home"},
+		},
+		"meta": map[string]any{
+			"lastModified": "2024-03-01T10:00:00Z",
+		},
+	}
+
+	tt := []struct {
+		name     string
+		filter   string
+		expected bool
+	}{
+		{name: "eq is case insensitive", filter: `userName eq "alice@example.com"`, expected: true},
+		{name: "eq no match", filter: `userName eq "bob@example.com"`, expected: false},
+		{name: "ne", filter: `userName ne "bob@example.com"`, expected: true},
+		{name: "co", filter: `displayName co "smi"`, expected: true},
+		{name: "sw", filter: `displayName sw "Ali"`, expected: true},
+		{name: "ew", filter: `displayName ew "Ali"`, expected: false},
+		{name: "sub attribute", filter: `name.familyName eq "Smith"`, expected: true},
+		{name: "schema prefix", filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`, expected: true},
+		{name: "boolean", filter: `active eq true`, expected: true},
+		{name: "boolean no match", filter: `active eq false`, expected: false},
+		{name: "present", filter: `displayName pr`, expected: true},
+		{name: "not present", filter: `externalId pr`, expected: false},
+		{name: "multi valued", filter: `emails eq "alice@home.example"`, expected: true},
+		{name: "multi valued sub attribute", filter: `emails.type eq "home"`, expected: true},
+		{name: "value path", filter: `emails[type eq "work" and value co "example.com"]`, expected: true},
+		{name: "value path no match", filter: `emails[type eq "home" and primary eq true]`, expected: false},
+		{name: "and", filter: `userName sw "alice" and active eq true`, expected: true},
+		{name: "or", filter: `userName eq "bob" or displayName co "alice"`, expected: true},
+		{name: "not", filter: `not (userName eq "bob")`, expected: true},
+		{name: "precedence", filter: `userName eq "bob" and active eq true or displayName pr`, expected: true},
+		{name: "parentheses", filter: `userName eq "bob" and (active eq true or displayName pr)`, expected: false},
+		{name: "date gt", filter: `meta.lastModified gt "2024-01-01T00:00:00Z"`, expected: true},
+		{name: "date lt", filter: `meta.lastModified lt "2024-01-01T00:00:00Z"`, expected: false},
+		{name: "case insensitive operators and attributes", filter: `USERNAME EQ "alice@example.com"`, expected: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := ParseFilter(tc.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filter.Matches(resource))
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	for _, expression := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName xx "alice"`,
+		`userName eq "alice`,
+		`(userName eq "alice"`,
+		`emails[type eq "work"`,
+		`active co true`,
+		`userName eq "alice" extra`,
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParseFilter(expression)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package scim
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PatchRequest is the body of a PATCH request (RFC 7644 section 3.5.2)
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single modification of a PATCH request
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// patchPath is a parsed PATCH path of the form attribute[filter].subAttribute
+type patchPath struct {
+	attribute    string
+	filter       Filter
+	eqFilter     *attributeFilter
+	subAttribute string
+}
+
+// ApplyPatch applies the operations to the JSON representation of a resource
+func ApplyPatch(resource map[string]any, operations []PatchOperation) error {
+	for _, operation := range operations {
+		var err error
+		switch strings.ToLower(operation.Op) {
+		case "add":
+			err = applyAdd(resource, operation, false)
+		case "replace":
+			err = applyAdd(resource, operation, true)
+		case "remove":
+			err = applyRemove(resource, operation)
+		default:
+			err = fmt.Errorf("unsupported patch operation %q", operation.Op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyAdd(resource map[string]any, operation PatchOperation, replace bool) error {
+	if operation.Path == "" {
+		values, ok := operation.Value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("patch operation without path requires an object value")
+		}
+		for key, value := range values {
+			if err := setAttribute(resource, []string{stripSchema(key)}, value, replace); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	path, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	if path.filter == nil {
+		attributePath := []string{path.attribute}
+		if path.subAttribute != "" {
+			attributePath = append(attributePath, path.subAttribute)
+		}
+		return setAttribute(resource, attributePath, operation.Value, replace)
+	}
+
+	key := attributeKey(resource, path.attribute)
+	elements := asSlice(resource[key])
+	matched := false
+	for i, element := range elements {
+		object, ok := element.(map[string]any)
+		if !ok || !path.filter.Matches(object) {
+			continue
+		}
+		matched = true
+		if path.subAttribute == "" {
+			values, ok := operation.Value.(map[string]any)
+			if !ok {
+				elements[i] = operation.Value
+				continue
+			}
+			for k, v := range values {
+				object[attributeKey(object, k)] = v
+			}
+			continue
+		}
+		object[attributeKey(object, path.subAttribute)] = operation.Value
+	}
+
+	if !matched {
+		// replacing a sub attribute of a missing element creates it, e.g. emails[type eq "work"].value
+		if path.eqFilter == nil || path.subAttribute == "" {
+			return fmt.Errorf("no values match the patch path %q", operation.Path)
+		}
+		element := map[string]any{
+			path.eqFilter.path[0]: path.eqFilter.value,
+			path.subAttribute:     operation.Value,
+		}
+		elements = append(elements, element)
+	}
+
+	resource[key] = elements
+	return nil
+}
+
+func applyRemove(resource map[string]any, operation PatchOperation) error {
+	if operation.Path == "" {
+		return fmt.Errorf("remove operation requires a path")
+	}
+
+	path, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	key := attributeKey(resource, path.attribute)
+
+	if path.filter == nil {
+		if path.subAttribute != "" {
+			if object, ok := resource[key].(map[string]any); ok {
+				delete(object, attributeKey(object, path.subAttribute))
+			}
+			return nil
+		}
+
+		// some identity providers send the members to remove as value instead of a filter
+		if values := asSlice(operation.Value); len(values) > 0 {
+			resource[key] = removeByValue(asSlice(resource[key]), values)
+			return nil
+		}
+
+		delete(resource, key)
+		return nil
+	}
+
+	elements := asSlice(resource[key])
+	kept := make([]any, 0, len(elements))
+	for _, element := range elements {
+		object, ok := element.(map[string]any)
+		if !ok || !path.filter.Matches(object) {
+			kept = append(kept, element)
+			continue
+		}
+		if path.subAttribute != "" {
+			delete(object, attributeKey(object, path.subAttribute))
+			kept = append(kept, object)
+		}
+	}
+	resource[key] = kept
+	return nil
+}
+
+// removeByValue returns the elements whose value is not in the values to remove
+func removeByValue(elements []any, values []any) []any {
+	remove := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if object, ok := value.(map[string]any); ok {
+			value = lookupAttribute(object, "value")
+		}
+		remove[fmt.Sprint(value)] = struct{}{}
+	}
+
+	kept := make([]any, 0, len(elements))
+	for _, element := range elements {
+		value := element
+		if object, ok := element.(map[string]any); ok {
+			value = lookupAttribute(object, "value")
+		}
+		if _, ok := remove[fmt.Sprint(value)]; !ok {
+			kept = append(kept, element)
+		}
+	}
+	return kept
+}
+
+// setAttribute sets the value of the attribute path. Adding to a multi-valued attribute appends the values.
+func setAttribute(resource map[string]any, path []string, value any, replace bool) error {
+	object := resource
+	for _, name := range path[:len(path)-1] {
+		key := attributeKey(object, name)
+		child, ok := object[key].(map[string]any)
+		if !ok {
+			if object[key] != nil {
+				return fmt.Errorf("attribute %q is not complex", name)
+			}
+			child = map[string]any{}
+			object[key] = child
+		}
+		object = child
+	}
+
+	key := attributeKey(object, path[len(path)-1])
+	existing, isSlice := object[key].([]any)
+	newValues, valueIsSlice := value.([]any)
+
+	switch {
+	case !replace && isSlice && valueIsSlice:
+		object[key] = append(existing, newValues...)
+	case !replace && isSlice:
+		object[key] = append(existing, value)
+	case isComplex(object[key]) && isComplex(value):
+		current := object[key].(map[string]any)
+		for k, v := range value.(map[string]any) {
+			current[attributeKey(current, k)] = v
+		}
+	default:
+		object[key] = value
+	}
+	return nil
+}
+
+func isComplex(value any) bool {
+	_, ok := value.(map[string]any)
+	return ok
+}
+
+// attributeKey returns the existing key matching the name ignoring the case or the name itself
+func attributeKey(resource map[string]any, name string) string {
+	if _, ok := resource[name]; ok {
+		return name
+	}
+	for key := range resource {
+		if strings.EqualFold(key, name) {
+			return key
+		}
+	}
+	return name
+}
+
+func parsePatchPath(raw string) (*patchPath, error) {
+	raw = strings.TrimSpace(raw)
+	path := &patchPath{}
+
+	open := strings.Index(raw, "[")
+	if open < 0 {
+		parts := splitAttributePath(raw)
+		path.attribute = parts[0]
+		if len(parts) > 1 {
+			path.subAttribute = strings.Join(parts[1:], ".")
+		}
+		return path, nil
+	}
+
+	closing := strings.LastIndex(raw, "]")
+	if closing < open {
+		return nil, fmt.Errorf("invalid patch path %q", raw)
+	}
+
+	path.attribute = stripSchema(raw[:open])
+	filter, err := ParseFilter(raw[open+1 : closing])
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch path %q: %w", raw, err)
+	}
+	path.filter = filter
+	if eq, ok := filter.(*attributeFilter); ok && eq.operator == "eq" && len(eq.path) == 1 {
+		path.eqFilter = eq
+	}
+
+	rest := raw[closing+1:]
+	if rest != "" {
+		if !strings.HasPrefix(rest, ".") {
+			return nil, fmt.Errorf("invalid patch path %q", raw)
+		}
+		path.subAttribute = rest[1:]
+	}
+
+	return path, nil
+}
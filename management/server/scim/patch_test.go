@@ -0,0 +1,177 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	newUser := func() map[string]any {
+		return map[string]any{
+			"userName": "alice@example.com",
+			"active":   true,
+			"name": map[string]any{
+				"givenName": "Alice",
+			},
+			"emails": []any{
+				map[string]any{"value": "alice@example.com", "type": "work"},
+			},
+		}
+	}
+
+	newGroup := func() map[string]any {
+		return map[string]any{
+			"displayName": "Engineering",
+			"members": []any{
+				map[string]any{"value": "user1"},
+				map[string]any{"value": "user2"},
+			},
+		}
+	}
+
+	tt := []struct {
+		name       string
+		resource   map[string]any
+		operations string
+		expected   map[string]any
+		expectErr  bool
+	}{
+		{
+			name:       "replace simple attribute",
+			resource:   newUser(),
+			operations: `[{"op":"replace","path":"active","value":false}]`,
+			expected: map[string]any{
+				"active": false,
+			},
+		},
+		{
+			name:       "replace without path uses value object",
+			resource:   newUser(),
+			operations: `[{"op":"Replace","value":{"active":false,"displayName":"Alice"}}]`,
+			expected: map[string]any{
+				"active":      false,
+				"displayName": "Alice",
+			},
+		},
+		{
+			name:       "replace sub attribute with case insensitive path",
+			resource:   newUser(),
+			operations: `[{"op":"replace","path":"Name.GivenName","value":"Alicia"}]`,
+			expected: map[string]any{
+				"name": map[string]any{"givenName": "Alicia"},
+			},
+		},
+		{
+			name:       "replace filtered sub attribute",
+			resource:   newUser(),
+			operations: `[{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice@corp.example"}]`,
+			expected: map[string]any{
+				"emails": []any{map[string]any{"value": "alice@corp.example", "type": "work"}},
+			},
+		},
+		{
+			name:       "replace filtered sub attribute creates missing element",
+			resource:   newUser(),
+			operations: `[{"op":"replace","path":"emails[type eq \"home\"].value","value":"alice@home.example"}]`,
+			expected: map[string]any{
+				"emails": []any{
+					map[string]any{"value": "alice@example.com", "type": "work"},
+					map[string]any{"value": "alice@home.example", "type": "home"},
+				},
+			},
+		},
+		{
+			name:       "add members",
+			resource:   newGroup(),
+			operations: `[{"op":"add","path":"members","value":[{"value":"user3"}]}]`,
+			expected: map[string]any{
+				"members": []any{
+					map[string]any{"value": "user1"},
+					map[string]any{"value": "user2"},
+					map[string]any{"value": "user3"},
+				},
+			},
+		},
+		{
+			name:       "replace members",
+			resource:   newGroup(),
+			operations: `[{"op":"replace","path":"members","value":[{"value":"user3"}]}]`,
+			expected: map[string]any{
+				"members": []any{map[string]any{"value": "user3"}},
+			},
+		},
+		{
+			name:       "remove member by filter",
+			resource:   newGroup(),
+			operations: `[{"op":"remove","path":"members[value eq \"user1\"]"}]`,
+			expected: map[string]any{
+				"members": []any{map[string]any{"value": "user2"}},
+			},
+		},
+		{
+			name:       "remove member by value",
+			resource:   newGroup(),
+			operations: `[{"op":"remove","path":"members","value":[{"value":"user2"}]}]`,
+			expected: map[string]any{
+				"members": []any{map[string]any{"value": "user1"}},
+			},
+		},
+		{
+			name:       "remove all members",
+			resource:   newGroup(),
+			operations: `[{"op":"remove","path":"members"}]`,
+			expected: map[string]any{
+				"members": nil,
+			},
+		},
+		{
+			name:       "unsupported operation",
+			resource:   newUser(),
+			operations: `[{"op":"move","path":"active"}]`,
+			expectErr:  true,
+		},
+		{
+			name:       "remove without path",
+			resource:   newUser(),
+			operations: `[{"op":"remove"}]`,
+			expectErr:  true,
+		},
+		{
+			name:       "replace filtered attribute without match",
+			resource:   newUser(),
+			operations: `[{"op":"replace","path":"emails[type co \"home\"]","value":{"value":"x"}}]`,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var operations []PatchOperation
+			require.NoError(t, json.Unmarshal([]byte(tc.operations), &operations))
+
+			err := ApplyPatch(tc.resource, operations)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for key, value := range tc.expected {
+				assert.Equal(t, value, tc.resource[key], key)
+			}
+		})
+	}
+}
+
+func TestBool_UnmarshalJSON(t *testing.T) {
+	var resource UserResource
+	require.NoError(t, json.Unmarshal([]byte(`{"userName":"alice","active":"False","emails":[{"value":"a@b.c","primary":"True"}]}`), &resource))
+	require.NotNil(t, resource.Active)
+	assert.False(t, bool(*resource.Active))
+	assert.True(t, bool(resource.Emails[0].Primary))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"active":"maybe"}`), &resource))
+}
@@ -0,0 +1,254 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Meta holds the resource metadata
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Name is the name of a user
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// MultiValuedAttribute is an entry of a multi-valued attribute like emails, groups or members
+type MultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary Bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Bool is a boolean that also accepts the "True" and "False" strings some identity providers send
+type Bool bool
+
+// UnmarshalJSON accepts JSON booleans and strings
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = Bool(v)
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			*b = true
+		case "false":
+			*b = false
+		default:
+			return fmt.Errorf("invalid boolean value %q", v)
+		}
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean value %v", v)
+	}
+	return nil
+}
+
+// UserResource is the SCIM representation of a user
+type UserResource struct {
+	Schemas     []string               `json:"schemas"`
+	ID          string                 `json:"id,omitempty"`
+	ExternalID  string                 `json:"externalId,omitempty"`
+	UserName    string                 `json:"userName"`
+	Name        *Name                  `json:"name,omitempty"`
+	DisplayName string                 `json:"displayName,omitempty"`
+	Emails      []MultiValuedAttribute `json:"emails,omitempty"`
+	Active      *Bool                  `json:"active,omitempty"`
+	Groups      []MultiValuedAttribute `json:"groups,omitempty"`
+	Meta        *Meta                  `json:"meta,omitempty"`
+}
+
+// NewUserResource returns the SCIM representation of the user located at the given base URL
+func NewUserResource(user *User, baseURL string) *UserResource {
+	active := Bool(user.Active)
+	resource := &UserResource{
+		Schemas:     []string{UserSchema},
+		ID:          user.ID,
+		ExternalID:  user.ExternalID,
+		UserName:    user.UserName,
+		DisplayName: user.DisplayName,
+		Active:      &active,
+		Meta:        newMeta("User", user.CreatedAt, user.UpdatedAt, baseURL+"/Users/"+user.ID),
+	}
+
+	if user.GivenName != "" || user.FamilyName != "" {
+		resource.Name = &Name{
+			Formatted:  strings.TrimSpace(user.GivenName + " " + user.FamilyName),
+			GivenName:  user.GivenName,
+			FamilyName: user.FamilyName,
+		}
+	}
+
+	if user.Email != "" {
+		resource.Emails = []MultiValuedAttribute{{Value: user.Email, Type: "work", Primary: true}}
+	}
+
+	for _, group := range user.Groups {
+		resource.Groups = append(resource.Groups, MultiValuedAttribute{
+			Value:   group.ID,
+			Display: group.Display,
+			Ref:     baseURL + "/Groups/" + group.ID,
+		})
+	}
+
+	return resource
+}
+
+// ToUser returns the user attributes of the resource. Read-only attributes are ignored.
+func (r *UserResource) ToUser() (*User, error) {
+	if r.UserName == "" {
+		return nil, fmt.Errorf("userName is required")
+	}
+
+	user := &User{
+		ID:          r.ID,
+		ExternalID:  r.ExternalID,
+		UserName:    r.UserName,
+		DisplayName: r.DisplayName,
+		Active:      r.Active == nil || bool(*r.Active),
+		Email:       primaryValue(r.Emails),
+	}
+
+	if r.Name != nil {
+		user.GivenName = r.Name.GivenName
+		user.FamilyName = r.Name.FamilyName
+	}
+
+	return user, nil
+}
+
+// GroupResource is the SCIM representation of a group
+type GroupResource struct {
+	Schemas     []string               `json:"schemas"`
+	ID          string                 `json:"id,omitempty"`
+	ExternalID  string                 `json:"externalId,omitempty"`
+	DisplayName string                 `json:"displayName"`
+	Members     []MultiValuedAttribute `json:"members,omitempty"`
+	Meta        *Meta                  `json:"meta,omitempty"`
+}
+
+// NewGroupResource returns the SCIM representation of the group located at the given base URL
+func NewGroupResource(group *Group, baseURL string) *GroupResource {
+	resource := &GroupResource{
+		Schemas:     []string{GroupSchema},
+		ID:          group.ID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Meta:        newMeta("Group", group.CreatedAt, group.UpdatedAt, baseURL+"/Groups/"+group.ID),
+	}
+
+	for _, member := range group.Members {
+		resource.Members = append(resource.Members, MultiValuedAttribute{
+			Value:   member.ID,
+			Display: member.Display,
+			Ref:     baseURL + "/Users/" + member.ID,
+		})
+	}
+
+	return resource
+}
+
+// ToGroup returns the group attributes of the resource. Read-only attributes are ignored.
+func (r *GroupResource) ToGroup() (*Group, error) {
+	if r.DisplayName == "" {
+		return nil, fmt.Errorf("displayName is required")
+	}
+
+	group := &Group{
+		ID:          r.ID,
+		ExternalID:  r.ExternalID,
+		DisplayName: r.DisplayName,
+		Members:     make([]Reference, 0, len(r.Members)),
+	}
+
+	for _, member := range r.Members {
+		if member.Value == "" {
+			return nil, fmt.Errorf("member value is required")
+		}
+		group.Members = append(group.Members, Reference{ID: member.Value, Display: member.Display})
+	}
+
+	return group, nil
+}
+
+// ListResponse is a page of resources
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// NewListResponse returns a page of resources starting at the 1-based start index
+func NewListResponse(resources []any, totalResults, startIndex int) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// ErrorResponse is the SCIM error message
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewErrorResponse returns a SCIM error message for the HTTP status code
+func NewErrorResponse(status int, scimType, detail string) *ErrorResponse {
+	return &ErrorResponse{
+		Schemas:  []string{ErrorSchema},
+		Status:   fmt.Sprint(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+func newMeta(resourceType string, created, lastModified time.Time, location string) *Meta {
+	meta := &Meta{
+		ResourceType: resourceType,
+		Location:     location,
+	}
+	if !created.IsZero() {
+		meta.Created = &created
+	}
+	if !lastModified.IsZero() {
+		meta.LastModified = &lastModified
+	}
+	return meta
+}
+
+// primaryValue returns the value of the primary entry or the first one if none is marked as primary
+func primaryValue(values []MultiValuedAttribute) string {
+	for _, value := range values {
+		if value.Primary {
+			return value.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
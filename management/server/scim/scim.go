@@ -0,0 +1,95 @@
+package scim
+
+import (
+	"time"
+)
+
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ResourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// User holds the attributes of a user provisioned by the identity provider.
+// The NetBird user with the same ID holds the role, groups and blocked state of the user.
+type User struct {
+	// ID is the ID of the NetBird user
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID   string `gorm:"index"`
+	ExternalID  string
+	UserName    string
+	DisplayName string
+	GivenName   string
+	FamilyName  string
+	Email       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Active is the inverse of the blocked state of the NetBird user
+	Active bool `gorm:"-"`
+	// Groups are the groups the NetBird user is a member of
+	Groups []Reference `gorm:"-"`
+}
+
+// TableName returns the name of the SCIM users table
+func (User) TableName() string {
+	return "scim_users"
+}
+
+// Copy returns a copy of the user
+func (u *User) Copy() *User {
+	user := *u
+	user.Groups = make([]Reference, len(u.Groups))
+	copy(user.Groups, u.Groups)
+	return &user
+}
+
+// Group holds the attributes of a group provisioned by the identity provider.
+// The NetBird group with the same ID holds the name and the members are the users having it in their auto groups.
+type Group struct {
+	// ID is the ID of the NetBird group
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID  string `gorm:"index"`
+	ExternalID string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	// DisplayName is the name of the NetBird group
+	DisplayName string `gorm:"-"`
+	// Members are the users that are members of the group
+	Members []Reference `gorm:"-"`
+}
+
+// TableName returns the name of the SCIM groups table
+func (Group) TableName() string {
+	return "scim_groups"
+}
+
+// Copy returns a copy of the group
+func (g *Group) Copy() *Group {
+	group := *g
+	group.Members = make([]Reference, len(g.Members))
+	copy(group.Members, g.Members)
+	return &group
+}
+
+// MemberIDs returns the user IDs of the group members
+func (g *Group) MemberIDs() []string {
+	ids := make([]string, 0, len(g.Members))
+	for _, member := range g.Members {
+		ids = append(ids, member.ID)
+	}
+	return ids
+}
+
+// Reference points to a related resource, e.g. a group of a user or a member of a group
+type Reference struct {
+	ID      string
+	Display string
+}
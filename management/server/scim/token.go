@@ -0,0 +1,110 @@
+package scim
+
+import (
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	b "github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/base62"
+)
+
+const (
+	// TokenPrefix is the prefix of SCIM provisioning tokens
+	TokenPrefix = "nbs_"
+	// TokenSecretLength number of characters used for the secret inside the token
+	TokenSecretLength = 30
+	// TokenChecksumLength number of characters used for the encoded checksum of the secret inside the token
+	TokenChecksumLength = 6
+	// TokenLength total number of characters used for the token
+	TokenLength = 40
+)
+
+// Token is the bearer token the identity provider uses to authenticate against the SCIM API of an account.
+// Only a hashed version of the token is stored.
+type Token struct {
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID   string `gorm:"uniqueIndex"`
+	HashedToken string `gorm:"index"`
+	CreatedBy   string
+	CreatedAt   time.Time
+	LastUsed    *time.Time
+}
+
+// TableName returns the name of the SCIM tokens table
+func (Token) TableName() string {
+	return "scim_tokens"
+}
+
+// GetLastUsed returns the last time the token was used.
+func (t *Token) GetLastUsed() time.Time {
+	if t.LastUsed != nil {
+		return *t.LastUsed
+	}
+	return time.Time{}
+}
+
+// TokenGenerated holds the new Token and the plain text version of it
+type TokenGenerated struct {
+	PlainToken string
+	Token
+}
+
+// NewToken generates a new SCIM token for the account. The plain text token is returned once and only the hash is kept.
+func NewToken(accountID, createdBy string) (*TokenGenerated, error) {
+	secret, err := b.Random(TokenSecretLength)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := crc32.ChecksumIEEE([]byte(secret))
+	plainToken := TokenPrefix + secret + fmt.Sprintf("%06s", base62.Encode(checksum))
+
+	return &TokenGenerated{
+		Token: Token{
+			ID:          xid.New().String(),
+			AccountID:   accountID,
+			HashedToken: HashToken(plainToken),
+			CreatedBy:   createdBy,
+			CreatedAt:   time.Now().UTC(),
+		},
+		PlainToken: plainToken,
+	}, nil
+}
+
+// HashToken returns the encoded hash of the plain text token as it is stored
+func HashToken(plainToken string) string {
+	hashedToken := sha256.Sum256([]byte(plainToken))
+	return b64.StdEncoding.EncodeToString(hashedToken[:])
+}
+
+// ValidateToken checks the format and checksum of a plain text token
+func ValidateToken(plainToken string) error {
+	if len(plainToken) != TokenLength {
+		return fmt.Errorf("token has an invalid length")
+	}
+
+	if !strings.HasPrefix(plainToken, TokenPrefix) {
+		return fmt.Errorf("token has an invalid prefix")
+	}
+
+	secret := plainToken[len(TokenPrefix) : len(plainToken)-TokenChecksumLength]
+	encodedChecksum := plainToken[len(plainToken)-TokenChecksumLength:]
+
+	verificationChecksum, err := base62.Decode(encodedChecksum)
+	if err != nil {
+		return fmt.Errorf("token checksum decoding failed: %w", err)
+	}
+
+	if crc32.ChecksumIEEE([]byte(secret)) != verificationChecksum {
+		return fmt.Errorf("token checksum does not match")
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"net"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDefaultAccountManager_SCIMToken(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "adminUser"
+	userID := "regularUser"
+
+	account := newAccountWithId(ctx, accountID, adminID, "")
+	account.Users[userID] = types.NewRegularUser(userID)
+	require.NoError(t, am.Store.SaveAccount(ctx, account))
+
+	_, err = am.CreateSCIMToken(ctx, accountID, userID)
+	assertStatusType(t, err, status.PermissionDenied)
+
+	_, err = am.GetSCIMToken(ctx, accountID, adminID)
+	assertStatusType(t, err, status.NotFound)
+
+	first, err := am.CreateSCIMToken(ctx, accountID, adminID)
+	require.NoError(t, err)
+	require.NoError(t, scim.ValidateToken(first.PlainToken))
+
+	resolvedAccountID, err := am.GetAccountIDFromSCIMToken(ctx, first.PlainToken)
+	require.NoError(t, err)
+	assert.Equal(t, accountID, resolvedAccountID)
+
+	token, err := am.GetSCIMToken(ctx, accountID, adminID)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, token.ID)
+	assert.False(t, token.GetLastUsed().IsZero(), "token should be marked as used")
+
+	second, err := am.CreateSCIMToken(ctx, accountID, adminID)
+	require.NoError(t, err)
+
+	_, err = am.GetAccountIDFromSCIMToken(ctx, first.PlainToken)
+	assertStatusType(t, err, status.Unauthorized)
+
+	_, err = am.GetAccountIDFromSCIMToken(ctx, "nbs_invalid")
+	assertStatusType(t, err, status.Unauthorized)
+
+	require.NoError(t, am.DeleteSCIMToken(ctx, accountID, adminID))
+
+	_, err = am.GetAccountIDFromSCIMToken(ctx, second.PlainToken)
+	assertStatusType(t, err, status.Unauthorized)
+}
+
+func TestDefaultAccountManager_SCIMUsers(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "adminUser"
+
+	account := newAccountWithId(ctx, accountID, adminID, "")
+	account.Users["existingUser"] = types.NewRegularUser("existingUser")
+	account.Peers["peer1"] = &nbpeer.Peer{
+		ID:     "peer1",
+		Key:    "peer1Key",
+		UserID: "existingUser",
+		IP:     net.IP{100, 64, 0, 1},
+		Status: &nbpeer.PeerStatus{Connected: true},
+		Meta:   nbpeer.PeerSystemMeta{Hostname: "peer1"},
+	}
+	require.NoError(t, am.Store.SaveAccount(ctx, account))
+
+	created, err := am.CreateSCIMUser(ctx, accountID, &scim.User{UserName: "alice@example.com", DisplayName: "Alice", Active: true})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, created.Active)
+
+	user, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.UserIssuedSCIM, user.Issued)
+	assert.Equal(t, types.UserRoleUser, user.Role)
+	assert.False(t, user.Blocked)
+
+	_, err = am.CreateSCIMUser(ctx, accountID, &scim.User{UserName: "ALICE@example.com", Active: true})
+	assertStatusType(t, err, status.AlreadyExists)
+
+	// an existing user is taken over when the external ID matches
+	adopted, err := am.CreateSCIMUser(ctx, accountID, &scim.User{ExternalID: "existingUser", UserName: "bob@example.com", Active: true})
+	require.NoError(t, err)
+	assert.Equal(t, "existingUser", adopted.ID)
+
+	users, err := am.GetSCIMUsers(ctx, accountID)
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	adopted.Active = false
+	adopted, err = am.UpdateSCIMUser(ctx, accountID, adopted)
+	require.NoError(t, err)
+	assert.False(t, adopted.Active)
+
+	user, err = am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, "existingUser")
+	require.NoError(t, err)
+	assert.True(t, user.Blocked, "deactivated user should be blocked")
+
+	peer, err := am.Store.GetPeerByID(ctx, store.LockingStrengthShare, accountID, "peer1")
+	require.NoError(t, err)
+	assert.True(t, peer.Status.LoginExpired, "peers of deactivated user should be expired")
+
+	_, err = am.UpdateSCIMUser(ctx, accountID, &scim.User{ID: "unknown", UserName: "unknown"})
+	assertStatusType(t, err, status.NotFound)
+
+	_, err = am.CreateSCIMUser(ctx, accountID, &scim.User{ExternalID: adminID, UserName: "owner@example.com", Active: false})
+	assertStatusType(t, err, status.PermissionDenied)
+
+	require.NoError(t, am.DeleteSCIMUser(ctx, accountID, "existingUser"))
+
+	_, err = am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, "existingUser")
+	assertStatusType(t, err, status.NotFound)
+
+	_, err = am.Store.GetPeerByID(ctx, store.LockingStrengthShare, accountID, "peer1")
+	assert.Error(t, err, "peers of deleted user should be deleted")
+
+	_, err = am.GetSCIMUser(ctx, accountID, "existingUser")
+	assertStatusType(t, err, status.NotFound)
+}
+
+func TestDefaultAccountManager_SCIMGroups(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "adminUser"
+
+	account := newAccountWithId(ctx, accountID, adminID, "")
+	account.Groups["jwtGroup"] = &types.Group{ID: "jwtGroup", AccountID: accountID, Name: "devops", Issued: types.GroupIssuedJWT, Peers: []string{}}
+	account.Groups["apiGroup"] = &types.Group{ID: "apiGroup", AccountID: accountID, Name: "admins", Issued: types.GroupIssuedAPI, Peers: []string{}}
+	require.NoError(t, am.Store.SaveAccount(ctx, account))
+
+	alice, err := am.CreateSCIMUser(ctx, accountID, &scim.User{ExternalID: "alice", UserName: "alice@example.com", Active: true})
+	require.NoError(t, err)
+	bob, err := am.CreateSCIMUser(ctx, accountID, &scim.User{ExternalID: "bob", UserName: "bob@example.com", Active: true})
+	require.NoError(t, err)
+
+	group, err := am.CreateSCIMGroup(ctx, accountID, &scim.Group{
+		DisplayName: "engineering",
+		Members:     []scim.Reference{{ID: alice.ID}, {ID: bob.ID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "engineering", group.DisplayName)
+	assert.ElementsMatch(t, []string{alice.ID, bob.ID}, group.MemberIDs())
+
+	accountGroup, err := am.Store.GetGroupByID(ctx, store.LockingStrengthShare, accountID, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.GroupIssuedSCIM, accountGroup.Issued)
+
+	user, err := am.GetSCIMUser(ctx, accountID, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, user.Groups, 1)
+	assert.Equal(t, group.ID, user.Groups[0].ID)
+
+	_, err = am.CreateSCIMGroup(ctx, accountID, &scim.Group{DisplayName: "admins"})
+	assertStatusType(t, err, status.AlreadyExists)
+
+	_, err = am.CreateSCIMGroup(ctx, accountID, &scim.Group{DisplayName: "unknown members", Members: []scim.Reference{{ID: "unknown"}}})
+	assertStatusType(t, err, status.InvalidArgument)
+
+	adopted, err := am.CreateSCIMGroup(ctx, accountID, &scim.Group{DisplayName: "devops", Members: []scim.Reference{{ID: bob.ID}}})
+	require.NoError(t, err)
+	assert.Equal(t, "jwtGroup", adopted.ID)
+
+	group.DisplayName = "platform"
+	group.Members = []scim.Reference{{ID: bob.ID}}
+	group, err = am.UpdateSCIMGroup(ctx, accountID, group)
+	require.NoError(t, err)
+	assert.Equal(t, "platform", group.DisplayName)
+	assert.Equal(t, []string{bob.ID}, group.MemberIDs())
+
+	aliceUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, alice.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, aliceUser.AutoGroups, group.ID)
+
+	groups, err := am.GetSCIMGroups(ctx, accountID)
+	require.NoError(t, err)
+	assert.Len(t, groups, 2)
+
+	require.NoError(t, am.DeleteSCIMGroup(ctx, accountID, group.ID))
+
+	_, err = am.Store.GetGroupByID(ctx, store.LockingStrengthShare, accountID, group.ID)
+	assertStatusType(t, err, status.NotFound)
+
+	bobUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, bob.ID)
+	require.NoError(t, err)
+	assert.False(t, slices.Contains(bobUser.AutoGroups, group.ID), "deleted group should be removed from the users")
+	assert.Contains(t, bobUser.AutoGroups, "jwtGroup")
+
+	err = am.DeleteSCIMGroup(ctx, accountID, "apiGroup")
+	assertStatusType(t, err, status.NotFound)
+}
+
+// failingSCIMStore fails saving the provisioned users and groups in transactions
+type failingSCIMStore struct {
+	store.Store
+}
+
+func (s *failingSCIMStore) ExecuteInTransaction(ctx context.Context, operation func(store.Store) error) error {
+	return s.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		return operation(&failingSCIMStore{Store: transaction})
+	})
+}
+
+func (s *failingSCIMStore) SaveSCIMUser(context.Context, store.LockingStrength, *scim.User) error {
+	return status.Errorf(status.Internal, "failed to save SCIM user")
+}
+
+func (s *failingSCIMStore) SaveSCIMGroup(context.Context, store.LockingStrength, *scim.Group) error {
+	return status.Errorf(status.Internal, "failed to save SCIM group")
+}
+
+func TestDefaultAccountManager_SCIMRollback(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "adminUser"
+
+	account := newAccountWithId(ctx, accountID, adminID, "")
+	require.NoError(t, am.Store.SaveAccount(ctx, account))
+
+	am.Store = &failingSCIMStore{Store: am.Store}
+
+	_, err = am.CreateSCIMUser(ctx, accountID, &scim.User{ExternalID: "alice", UserName: "alice@example.com", Active: true})
+	require.Error(t, err)
+
+	_, err = am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, "alice")
+	assert.Error(t, err, "the user must not be created without the provisioned user")
+
+	_, err = am.CreateSCIMGroup(ctx, accountID, &scim.Group{DisplayName: "engineering"})
+	require.Error(t, err)
+
+	groups, err := am.Store.GetAccountGroups(ctx, store.LockingStrengthShare, accountID)
+	require.NoError(t, err)
+	for _, group := range groups {
+		assert.NotEqual(t, "engineering", group.Name, "the group must not be created without the provisioned group")
+	}
+}
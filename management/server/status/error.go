@@ -155,6 +155,21 @@ func NewNameServerGroupNotFoundError(nsGroupID string) error {
 	return Errorf(NotFound, "nameserver group: %s not found", nsGroupID)
 }
 
+// NewDNSRecordNotFoundError creates a new Error with NotFound type for a missing DNS record
+func NewDNSRecordNotFoundError(recordID string) error {
+	return Errorf(NotFound, "DNS record: %s not found", recordID)
+}
+
+// NewDNSBlocklistNotFoundError creates a new Error with NotFound type for a missing DNS blocklist
+func NewDNSBlocklistNotFoundError(blocklistID string) error {
+	return Errorf(NotFound, "DNS blocklist: %s not found", blocklistID)
+}
+
+// NewDNSServiceNameNotFoundError creates a new Error with NotFound type for a missing DNS service name
+func NewDNSServiceNameNotFoundError(serviceNameID string) error {
+	return Errorf(NotFound, "DNS service name: %s not found", serviceNameID)
+}
+
 // NewNetworkNotFoundError creates a new Error with NotFound type for a missing network.
 func NewNetworkNotFoundError(networkID string) error {
 	return Errorf(NotFound, "network: %s not found", networkID)
@@ -170,6 +185,11 @@ func NewNetworkResourceNotFoundError(resourceID string) error {
 	return Errorf(NotFound, "network resource: %s not found", resourceID)
 }
 
+// NewCustomRoleNotFoundError creates a new Error with NotFound type for a missing custom role.
+func NewCustomRoleNotFoundError(roleID string) error {
+	return Errorf(NotFound, "role: %s not found", roleID)
+}
+
 // NewPermissionDeniedError creates a new Error with PermissionDenied type for a permission denied error.
 func NewPermissionDeniedError() error {
 	return Errorf(PermissionDenied, "permission denied")
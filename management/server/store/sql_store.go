@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,11 +26,14 @@ import (
 
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/account"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/scim"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -93,8 +97,10 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine Engine, metrics t
 	err = db.AutoMigrate(
 		&types.SetupKey{}, &nbpeer.Peer{}, &types.User{}, &types.PersonalAccessToken{}, &types.Group{},
 		&types.Account{}, &types.Policy{}, &types.PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
-		&installation{}, &account.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
+		&installation{}, &account.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{}, &types.DNSRecord{},
+		&types.DNSBlocklist{}, &types.DNSServiceName{},
 		&networkTypes.Network{}, &routerTypes.NetworkRouter{}, &resourceTypes.NetworkResource{},
+		&flowTypes.Event{}, &roles.CustomRole{}, &scim.Token{}, &scim.User{}, &scim.Group{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("auto migrate: %w", err)
@@ -279,6 +285,18 @@ func (s *SqlStore) DeleteAccount(ctx context.Context, account *types.Account) er
 			return result.Error
 		}
 
+		result = tx.Delete(&roles.CustomRole{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for _, model := range []any{&scim.Token{}, &scim.User{}, &scim.Group{}} {
+			result = tx.Delete(model, accountIDCondition, account.Id)
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
 		result = tx.Select(clause.Associations).Delete(account)
 		if result.Error != nil {
 			return result.Error
@@ -374,7 +392,7 @@ func (s *SqlStore) SavePeerStatus(accountID, peerID string, peerStatus nbpeer.Pe
 
 	fieldsToUpdate := []string{
 		"peer_status_last_seen", "peer_status_connected",
-		"peer_status_login_expired", "peer_status_required_approval",
+		"peer_status_login_expired", "peer_status_requires_approval",
 	}
 	result := s.db.Model(&nbpeer.Peer{}).
 		Select(fieldsToUpdate).
@@ -831,6 +849,35 @@ func (s *SqlStore) GetTakenIPs(ctx context.Context, lockStrength LockingStrength
 	return ips, nil
 }
 
+// GetTakenIPv6s returns the IPv6 overlay addresses of the account peers. Peers without an IPv6 address are skipped.
+func (s *SqlStore) GetTakenIPv6s(ctx context.Context, lockStrength LockingStrength, accountID string) ([]net.IP, error) {
+	var ipJSONStrings []sql.NullString
+
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&nbpeer.Peer{}).
+		Where("account_id = ?", accountID).
+		Pluck("ipv6", &ipJSONStrings)
+	if result.Error != nil {
+		return nil, status.Errorf(status.Internal, "issue getting IPv6s from store: %s", result.Error)
+	}
+
+	ips := make([]net.IP, 0, len(ipJSONStrings))
+	for _, ipJSON := range ipJSONStrings {
+		if !ipJSON.Valid || ipJSON.String == "" {
+			continue
+		}
+
+		var ip net.IP
+		if err := json.Unmarshal([]byte(ipJSON.String), &ip); err != nil {
+			return nil, status.Errorf(status.Internal, "issue parsing IPv6 JSON from store")
+		}
+		if ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips, nil
+}
+
 func (s *SqlStore) GetPeerLabelsInAccount(ctx context.Context, lockStrength LockingStrength, accountID string) ([]string, error) {
 	var labels []string
 	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&nbpeer.Peer{}).
@@ -1688,6 +1735,171 @@ func (s *SqlStore) DeleteNameServerGroup(ctx context.Context, lockStrength Locki
 	return nil
 }
 
+// GetAccountDNSRecords retrieves the custom DNS records of an account.
+func (s *SqlStore) GetAccountDNSRecords(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSRecord, error) {
+	var records []*types.DNSRecord
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&records, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS records from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS records from store")
+	}
+
+	return records, nil
+}
+
+// GetDNSRecordByID retrieves a custom DNS record by its ID and account ID.
+func (s *SqlStore) GetDNSRecordByID(ctx context.Context, lockStrength LockingStrength, accountID, recordID string) (*types.DNSRecord, error) {
+	var record *types.DNSRecord
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&record, accountAndIDQueryCondition, accountID, recordID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSRecordNotFoundError(recordID)
+		}
+		log.WithContext(ctx).Errorf("failed to get DNS record from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS record from store")
+	}
+
+	return record, nil
+}
+
+// SaveDNSRecord saves a custom DNS record to the database.
+func (s *SqlStore) SaveDNSRecord(ctx context.Context, lockStrength LockingStrength, record *types.DNSRecord) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(record)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save DNS record to the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save DNS record to store")
+	}
+
+	return nil
+}
+
+// DeleteDNSRecord deletes a custom DNS record from the database.
+func (s *SqlStore) DeleteDNSRecord(ctx context.Context, lockStrength LockingStrength, accountID, recordID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&types.DNSRecord{}, accountAndIDQueryCondition, accountID, recordID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete DNS record from the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete DNS record from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSRecordNotFoundError(recordID)
+	}
+
+	return nil
+}
+
+// GetAccountDNSBlocklists retrieves the DNS blocklists of an account.
+func (s *SqlStore) GetAccountDNSBlocklists(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSBlocklist, error) {
+	var blocklists []*types.DNSBlocklist
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&blocklists, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS blocklists from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS blocklists from store")
+	}
+
+	return blocklists, nil
+}
+
+// GetDNSBlocklistByID retrieves a DNS blocklist by its ID and account ID.
+func (s *SqlStore) GetDNSBlocklistByID(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) (*types.DNSBlocklist, error) {
+	var blocklist *types.DNSBlocklist
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&blocklist, accountAndIDQueryCondition, accountID, blocklistID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSBlocklistNotFoundError(blocklistID)
+		}
+		log.WithContext(ctx).Errorf("failed to get DNS blocklist from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS blocklist from store")
+	}
+
+	return blocklist, nil
+}
+
+// SaveDNSBlocklist saves a DNS blocklist to the database.
+func (s *SqlStore) SaveDNSBlocklist(ctx context.Context, lockStrength LockingStrength, blocklist *types.DNSBlocklist) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(blocklist)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save DNS blocklist to the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save DNS blocklist to store")
+	}
+
+	return nil
+}
+
+// DeleteDNSBlocklist deletes a DNS blocklist from the database.
+func (s *SqlStore) DeleteDNSBlocklist(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&types.DNSBlocklist{}, accountAndIDQueryCondition, accountID, blocklistID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete DNS blocklist from the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete DNS blocklist from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSBlocklistNotFoundError(blocklistID)
+	}
+
+	return nil
+}
+
+// GetAccountDNSServiceNames retrieves the DNS service names of an account.
+func (s *SqlStore) GetAccountDNSServiceNames(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSServiceName, error) {
+	var serviceNames []*types.DNSServiceName
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&serviceNames, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS service names from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS service names from store")
+	}
+
+	return serviceNames, nil
+}
+
+// GetDNSServiceNameByID retrieves a DNS service name by its ID and account ID.
+func (s *SqlStore) GetDNSServiceNameByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceNameID string) (*types.DNSServiceName, error) {
+	var serviceName *types.DNSServiceName
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&serviceName, accountAndIDQueryCondition, accountID, serviceNameID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSServiceNameNotFoundError(serviceNameID)
+		}
+		log.WithContext(ctx).Errorf("failed to get DNS service name from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS service name from store")
+	}
+
+	return serviceName, nil
+}
+
+// SaveDNSServiceName saves a DNS service name to the database.
+func (s *SqlStore) SaveDNSServiceName(ctx context.Context, lockStrength LockingStrength, serviceName *types.DNSServiceName) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(serviceName)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save DNS service name to the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save DNS service name to store")
+	}
+
+	return nil
+}
+
+// DeleteDNSServiceName deletes a DNS service name from the database.
+func (s *SqlStore) DeleteDNSServiceName(ctx context.Context, lockStrength LockingStrength, accountID, serviceNameID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&types.DNSServiceName{}, accountAndIDQueryCondition, accountID, serviceNameID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete DNS service name from the store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete DNS service name from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSServiceNameNotFoundError(serviceNameID)
+	}
+
+	return nil
+}
+
 // getRecords retrieves records from the database based on the account ID.
 func getRecords[T any](db *gorm.DB, lockStrength LockingStrength, accountID string) ([]T, error) {
 	var record []T
@@ -1931,3 +2143,288 @@ func (s *SqlStore) DeleteNetworkResource(ctx context.Context, lockStrength Locki
 
 	return nil
 }
+
+// SaveNetworkTrafficEvents stores the network traffic events. Events that are already stored are skipped.
+func (s *SqlStore) SaveNetworkTrafficEvents(ctx context.Context, events []*flowTypes.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(events, 500)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save network traffic events to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save network traffic events to store")
+	}
+
+	return nil
+}
+
+// GetNetworkTrafficEvents returns a page of the account network traffic events matching the filter,
+// ordered from the newest to the oldest, and the total number of matching events.
+func (s *SqlStore) GetNetworkTrafficEvents(ctx context.Context, lockStrength LockingStrength, accountID string, filter *flowTypes.Filter) ([]*flowTypes.Event, int64, error) {
+	query := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Model(&flowTypes.Event{}).Where(accountIDCondition, accountID)
+
+	if filter.PeerID != "" {
+		query = query.Where("peer_id = ?", filter.PeerID)
+	}
+	if filter.PolicyID != "" {
+		query = query.Where("policy_id = ?", filter.PolicyID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Direction != "" {
+		query = query.Where("direction = ?", filter.Direction)
+	}
+	if filter.Protocol != 0 {
+		query = query.Where("protocol = ?", filter.Protocol)
+	}
+	if !filter.StartDate.IsZero() {
+		query = query.Where("timestamp >= ?", filter.StartDate)
+	}
+	if !filter.EndDate.IsZero() {
+		query = query.Where("timestamp <= ?", filter.EndDate)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to count network traffic events in store: %v", err)
+		return nil, 0, status.Errorf(status.Internal, "failed to get network traffic events from store")
+	}
+
+	var events []*flowTypes.Event
+	result := query.Order("timestamp desc").Offset(filter.Offset()).Limit(filter.PageSize).Find(&events)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get network traffic events from store: %v", result.Error)
+		return nil, 0, status.Errorf(status.Internal, "failed to get network traffic events from store")
+	}
+
+	return events, total, nil
+}
+
+// DeleteNetworkTrafficEventsOlderThan deletes the account network traffic events that happened before the given time
+// and returns the number of deleted events.
+func (s *SqlStore) DeleteNetworkTrafficEventsOlderThan(ctx context.Context, accountID string, before time.Time) (int64, error) {
+	result := s.db.Where(accountIDCondition, accountID).Where("timestamp < ?", before).Delete(&flowTypes.Event{})
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete network traffic events from store: %v", result.Error)
+		return 0, status.Errorf(status.Internal, "failed to delete network traffic events from store")
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (s *SqlStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*roles.CustomRole, error) {
+	var customRoles []*roles.CustomRole
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&customRoles, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get custom roles from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom roles from store")
+	}
+
+	return customRoles, nil
+}
+
+func (s *SqlStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*roles.CustomRole, error) {
+	var role *roles.CustomRole
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&role, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewCustomRoleNotFoundError(roleID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get custom role from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom role from store")
+	}
+
+	return role, nil
+}
+
+func (s *SqlStore) SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *roles.CustomRole) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save custom role to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save custom role to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&roles.CustomRole{}, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete custom role from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete custom role from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewCustomRoleNotFoundError(roleID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) (*scim.Token, error) {
+	var token *scim.Token
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&token, accountIDCondition, accountID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "SCIM token not found")
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM token from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token from store")
+	}
+
+	return token, nil
+}
+
+func (s *SqlStore) GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*scim.Token, error) {
+	var token *scim.Token
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&token, "hashed_token = ?", hashedToken)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "SCIM token not found")
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM token from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token from store")
+	}
+
+	return token, nil
+}
+
+func (s *SqlStore) SaveSCIMToken(ctx context.Context, lockStrength LockingStrength, token *scim.Token) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(token)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM token to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save SCIM token to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&scim.Token{}, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM token from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM token from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.Errorf(status.NotFound, "SCIM token not found")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountSCIMUsers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.User, error) {
+	var users []*scim.User
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&users, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM users from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM users from store")
+	}
+
+	return users, nil
+}
+
+func (s *SqlStore) GetSCIMUserByID(ctx context.Context, lockStrength LockingStrength, accountID, userID string) (*scim.User, error) {
+	var user *scim.User
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&user, accountAndIDQueryCondition, accountID, userID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewUserNotFoundError(userID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM user from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM user from store")
+	}
+
+	return user, nil
+}
+
+func (s *SqlStore) SaveSCIMUser(ctx context.Context, lockStrength LockingStrength, user *scim.User) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(user)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM user to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save SCIM user to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteSCIMUser(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&scim.User{}, accountAndIDQueryCondition, accountID, userID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM user from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM user from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewUserNotFoundError(userID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountSCIMGroups(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Group, error) {
+	var groups []*scim.Group
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&groups, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM groups from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM groups from store")
+	}
+
+	return groups, nil
+}
+
+func (s *SqlStore) GetSCIMGroupByID(ctx context.Context, lockStrength LockingStrength, accountID, groupID string) (*scim.Group, error) {
+	var group *scim.Group
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&group, accountAndIDQueryCondition, accountID, groupID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewGroupNotFoundError(groupID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM group from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM group from store")
+	}
+
+	return group, nil
+}
+
+func (s *SqlStore) SaveSCIMGroup(ctx context.Context, lockStrength LockingStrength, group *scim.Group) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(group)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM group to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save SCIM group to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteSCIMGroup(ctx context.Context, lockStrength LockingStrength, accountID, groupID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&scim.Group{}, accountAndIDQueryCondition, accountID, groupID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM group from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM group from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewGroupNotFoundError(groupID)
+	}
+
+	return nil
+}
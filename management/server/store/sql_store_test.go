@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
@@ -2561,3 +2562,86 @@ func TestSqlStore_AddAndRemoveResourceFromGroup(t *testing.T) {
 	require.NoError(t, err)
 	require.NotContains(t, group.Resources, *res)
 }
+
+func TestSqlStore_NetworkTrafficEvents(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	now := time.Now().UTC()
+	events := []*flowTypes.Event{
+		{ID: "event-1", AccountID: accountID, PeerID: "peer-1", Timestamp: now.Add(-2 * time.Minute), Type: flowTypes.EventTypeStart, Direction: flowTypes.DirectionIngress, Protocol: 6},
+		{ID: "event-2", AccountID: accountID, PeerID: "peer-1", Timestamp: now.Add(-time.Minute), Type: flowTypes.EventTypeEnd, Direction: flowTypes.DirectionIngress, Protocol: 6},
+		{ID: "event-3", AccountID: accountID, PeerID: "peer-2", Timestamp: now, Type: flowTypes.EventTypeDrop, Direction: flowTypes.DirectionEgress, Protocol: 17},
+		{ID: "event-4", AccountID: "other-account", PeerID: "peer-3", Timestamp: now, Type: flowTypes.EventTypeStart, Direction: flowTypes.DirectionEgress, Protocol: 1},
+	}
+	require.NoError(t, store.SaveNetworkTrafficEvents(context.Background(), events))
+
+	// saving already stored events must not fail
+	require.NoError(t, store.SaveNetworkTrafficEvents(context.Background(), events[:1]))
+
+	tests := []struct {
+		name          string
+		filter        *flowTypes.Filter
+		expectedIDs   []string
+		expectedTotal int64
+	}{
+		{
+			name:          "all account events ordered by timestamp",
+			filter:        &flowTypes.Filter{Page: 1, PageSize: 10},
+			expectedIDs:   []string{"event-3", "event-2", "event-1"},
+			expectedTotal: 3,
+		},
+		{
+			name:          "second page",
+			filter:        &flowTypes.Filter{Page: 2, PageSize: 2},
+			expectedIDs:   []string{"event-1"},
+			expectedTotal: 3,
+		},
+		{
+			name:          "filter by peer and type",
+			filter:        &flowTypes.Filter{PeerID: "peer-1", Type: flowTypes.EventTypeEnd, Page: 1, PageSize: 10},
+			expectedIDs:   []string{"event-2"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "filter by protocol and direction",
+			filter:        &flowTypes.Filter{Protocol: 17, Direction: flowTypes.DirectionEgress, Page: 1, PageSize: 10},
+			expectedIDs:   []string{"event-3"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "filter by time range",
+			filter:        &flowTypes.Filter{StartDate: now.Add(-90 * time.Second), EndDate: now.Add(-30 * time.Second), Page: 1, PageSize: 10},
+			expectedIDs:   []string{"event-2"},
+			expectedTotal: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, total, err := store.GetNetworkTrafficEvents(context.Background(), LockingStrengthShare, accountID, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTotal, total)
+
+			ids := make([]string, 0, len(result))
+			for _, event := range result {
+				ids = append(ids, event.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+
+	deleted, err := store.DeleteNetworkTrafficEventsOlderThan(context.Background(), accountID, now.Add(-90*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, total, err := store.GetNetworkTrafficEvents(context.Background(), LockingStrengthShare, accountID, &flowTypes.Filter{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+
+	_, total, err = store.GetNetworkTrafficEvents(context.Background(), LockingStrengthShare, "other-account", &flowTypes.Filter{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total, "the events of other accounts must be kept")
+}
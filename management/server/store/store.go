@@ -24,12 +24,15 @@ import (
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/util"
 
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
 	"github.com/netbirdio/netbird/management/server/migration"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/scim"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -125,7 +128,23 @@ type Store interface {
 	SaveNameServerGroup(ctx context.Context, lockStrength LockingStrength, nameServerGroup *dns.NameServerGroup) error
 	DeleteNameServerGroup(ctx context.Context, lockStrength LockingStrength, accountID, nameServerGroupID string) error
 
+	GetAccountDNSRecords(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSRecord, error)
+	GetDNSRecordByID(ctx context.Context, lockStrength LockingStrength, accountID, recordID string) (*types.DNSRecord, error)
+	SaveDNSRecord(ctx context.Context, lockStrength LockingStrength, record *types.DNSRecord) error
+	DeleteDNSRecord(ctx context.Context, lockStrength LockingStrength, accountID, recordID string) error
+
+	GetAccountDNSBlocklists(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSBlocklist, error)
+	GetDNSBlocklistByID(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) (*types.DNSBlocklist, error)
+	SaveDNSBlocklist(ctx context.Context, lockStrength LockingStrength, blocklist *types.DNSBlocklist) error
+	DeleteDNSBlocklist(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) error
+
+	GetAccountDNSServiceNames(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.DNSServiceName, error)
+	GetDNSServiceNameByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceNameID string) (*types.DNSServiceName, error)
+	SaveDNSServiceName(ctx context.Context, lockStrength LockingStrength, serviceName *types.DNSServiceName) error
+	DeleteDNSServiceName(ctx context.Context, lockStrength LockingStrength, accountID, serviceNameID string) error
+
 	GetTakenIPs(ctx context.Context, lockStrength LockingStrength, accountId string) ([]net.IP, error)
+	GetTakenIPv6s(ctx context.Context, lockStrength LockingStrength, accountId string) ([]net.IP, error)
 	IncrementNetworkSerial(ctx context.Context, lockStrength LockingStrength, accountId string) error
 	GetAccountNetwork(ctx context.Context, lockStrength LockingStrength, accountId string) (*types.Network, error)
 
@@ -163,6 +182,28 @@ type Store interface {
 	GetNetworkResourceByName(ctx context.Context, lockStrength LockingStrength, accountID, resourceName string) (*resourceTypes.NetworkResource, error)
 	SaveNetworkResource(ctx context.Context, lockStrength LockingStrength, resource *resourceTypes.NetworkResource) error
 	DeleteNetworkResource(ctx context.Context, lockStrength LockingStrength, accountID, resourceID string) error
+
+	SaveNetworkTrafficEvents(ctx context.Context, events []*flowTypes.Event) error
+	GetNetworkTrafficEvents(ctx context.Context, lockStrength LockingStrength, accountID string, filter *flowTypes.Filter) ([]*flowTypes.Event, int64, error)
+	DeleteNetworkTrafficEventsOlderThan(ctx context.Context, accountID string, before time.Time) (int64, error)
+
+	GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*roles.CustomRole, error)
+	GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*roles.CustomRole, error)
+	SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *roles.CustomRole) error
+	DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error
+
+	GetSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) (*scim.Token, error)
+	GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*scim.Token, error)
+	SaveSCIMToken(ctx context.Context, lockStrength LockingStrength, token *scim.Token) error
+	DeleteSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) error
+	GetAccountSCIMUsers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.User, error)
+	GetSCIMUserByID(ctx context.Context, lockStrength LockingStrength, accountID, userID string) (*scim.User, error)
+	SaveSCIMUser(ctx context.Context, lockStrength LockingStrength, user *scim.User) error
+	DeleteSCIMUser(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error
+	GetAccountSCIMGroups(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Group, error)
+	GetSCIMGroupByID(ctx context.Context, lockStrength LockingStrength, accountID, groupID string) (*scim.Group, error)
+	SaveSCIMGroup(ctx context.Context, lockStrength LockingStrength, group *scim.Group) error
+	DeleteSCIMGroup(ctx context.Context, lockStrength LockingStrength, accountID, groupID string) error
 }
 
 type Engine string
@@ -294,6 +335,9 @@ func getMigrations(ctx context.Context) []migrationFunc {
 		func(db *gorm.DB) error {
 			return migration.MigrateNewField[routerTypes.NetworkRouter](ctx, db, "enabled", true)
 		},
+		func(db *gorm.DB) error {
+			return migration.MigrateIPv6Overlay(ctx, db)
+		},
 	}
 }
 
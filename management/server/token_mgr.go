@@ -212,6 +212,7 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Cont
 				Urls:           m.relayCfg.Addresses,
 				TokenPayload:   token.Payload,
 				TokenSignature: token.Signature,
+				Regions:        m.relayCfg.Regions,
 			}
 		}
 	}
@@ -233,6 +234,7 @@ func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, pe
 				Urls:           m.relayCfg.Addresses,
 				TokenPayload:   string(relayToken.Payload),
 				TokenSignature: base64.StdEncoding.EncodeToString(relayToken.Signature),
+				Regions:        m.relayCfg.Regions,
 			},
 			// omit Turns to avoid updates there
 		},
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/netip"
 	"slices"
@@ -66,6 +67,9 @@ type Account struct {
 	NameServerGroupsG      []nbdns.NameServerGroup           `json:"-" gorm:"foreignKey:AccountID;references:id"`
 	DNSSettings            DNSSettings                       `gorm:"embedded;embeddedPrefix:dns_settings_"`
 	PostureChecks          []*posture.Checks                 `gorm:"foreignKey:AccountID;references:id"`
+	DNSRecords             []*DNSRecord                      `gorm:"foreignKey:AccountID;references:id"`
+	DNSBlocklists          []*DNSBlocklist                   `gorm:"foreignKey:AccountID;references:id"`
+	DNSServiceNames        []*DNSServiceName                 `gorm:"foreignKey:AccountID;references:id"`
 	// Settings is a dictionary of Account settings
 	Settings *Settings `gorm:"embedded;embeddedPrefix:settings_"`
 
@@ -103,6 +107,7 @@ func (a *Account) GetRoutesToSync(ctx context.Context, peerID string, aclPeers [
 	for _, peer := range aclPeers {
 		activeRoutes, _ := a.getRoutingPeerRoutes(ctx, peer.ID)
 		groupFilteredRoutes := a.filterRoutesByGroups(activeRoutes, groupListMap)
+		groupFilteredRoutes = a.filterExitNodesByUserGroups(groupFilteredRoutes, peerID)
 		filteredRoutes := a.filterRoutesFromPeersOfSameHAGroup(groupFilteredRoutes, peerRoutesMembership)
 		routes = append(routes, filteredRoutes...)
 	}
@@ -137,6 +142,35 @@ func (a *Account) filterRoutesByGroups(routes []*route.Route, groupListMap Looku
 	return filteredRoutes
 }
 
+// filterExitNodesByUserGroups removes the exit node routes that the user of the peer is not allowed to use.
+// Exit nodes without user groups are available to all users, peers without a user can use only those.
+func (a *Account) filterExitNodesByUserGroups(routes []*route.Route, peerID string) []*route.Route {
+	var userGroups LookupMap
+	if peer := a.GetPeer(peerID); peer != nil && peer.UserID != "" {
+		if user, ok := a.Users[peer.UserID]; ok {
+			userGroups = make(LookupMap, len(user.AutoGroups))
+			for _, groupID := range user.AutoGroups {
+				userGroups[groupID] = struct{}{}
+			}
+		}
+	}
+
+	var filteredRoutes []*route.Route
+	for _, r := range routes {
+		if !r.IsExitNode() || len(r.ExitNode.UserGroups) == 0 {
+			filteredRoutes = append(filteredRoutes, r)
+			continue
+		}
+		for _, groupID := range r.ExitNode.UserGroups {
+			if _, found := userGroups[groupID]; found {
+				filteredRoutes = append(filteredRoutes, r)
+				break
+			}
+		}
+	}
+	return filteredRoutes
+}
+
 // getRoutingPeerRoutes returns the enabled and disabled lists of routes that the given routing peer serves
 // Please mind, that the returned route.Route objects will contain Peer.Key instead of Peer.ID.
 // If the given is not a routing peer, then the lists are empty.
@@ -220,7 +254,7 @@ func (a *Account) GetGroup(groupID string) *Group {
 func (a *Account) GetPeerNetworkMap(
 	ctx context.Context,
 	peerID string,
-	peersCustomZone nbdns.CustomZone,
+	peersCustomZones []nbdns.CustomZone,
 	validatedPeersMap map[string]struct{},
 	resourcePolicies map[string][]*Policy,
 	routers map[string]map[string]*routerTypes.NetworkRouter,
@@ -257,6 +291,7 @@ func (a *Account) GetPeerNetworkMap(
 	routesUpdate := a.GetRoutesToSync(ctx, peerID, peersToConnect)
 	routesFirewallRules := a.GetPeerRoutesFirewallRules(ctx, peerID, validatedPeersMap)
 	isRouter, networkResourcesRoutes, sourcePeers := a.GetNetworkResourcesRoutesToSync(ctx, peerID, resourcePolicies, routers)
+	networkResourcesRoutes, sourcePeers = filterNotValidatedRoutingPeers(networkResourcesRoutes, sourcePeers, validatedPeersMap)
 	var networkResourcesFirewallRules []*RouteFirewallRule
 	if isRouter {
 		networkResourcesFirewallRules = a.GetPeerNetworkResourceFirewallRules(ctx, peer, validatedPeersMap, networkResourcesRoutes, resourcePolicies)
@@ -269,13 +304,9 @@ func (a *Account) GetPeerNetworkMap(
 	}
 
 	if dnsManagementStatus {
-		var zones []nbdns.CustomZone
-
-		if peersCustomZone.Domain != "" {
-			zones = append(zones, peersCustomZone)
-		}
-		dnsUpdate.CustomZones = zones
+		dnsUpdate.CustomZones = peersCustomZones
 		dnsUpdate.NameServerGroups = getPeerNSGroups(a, peerID)
+		dnsUpdate.Blocklists = a.getPeerDNSBlocklists(peerID)
 	}
 
 	nm := &NetworkMap{
@@ -303,6 +334,25 @@ func (a *Account) GetPeerNetworkMap(
 	return nm
 }
 
+// filterNotValidatedRoutingPeers removes the network resource routes served by peers that are not validated
+// and the not validated peers from the source peers
+func filterNotValidatedRoutingPeers(routes []*route.Route, sourcePeers map[string]struct{}, validatedPeersMap map[string]struct{}) ([]*route.Route, map[string]struct{}) {
+	validatedRoutes := make([]*route.Route, 0, len(routes))
+	for _, r := range routes {
+		if _, ok := validatedPeersMap[r.PeerID]; ok {
+			validatedRoutes = append(validatedRoutes, r)
+		}
+	}
+
+	for peerID := range sourcePeers {
+		if _, ok := validatedPeersMap[peerID]; !ok {
+			delete(sourcePeers, peerID)
+		}
+	}
+
+	return validatedRoutes, sourcePeers
+}
+
 func (a *Account) addNetworksRoutingPeers(
 	networkResourcesRoutes []*route.Route,
 	peer *nbpeer.Peer,
@@ -348,6 +398,46 @@ func (a *Account) addNetworksRoutingPeers(
 	return peersToConnect
 }
 
+// getPeerDNSBlocklists returns the enabled blocklists that apply to the peer, skipping the ones it is exempt from
+// The blocklists exceeding MaxDNSBlocklistsSize are skipped, so an oversized network map never breaks the sync of the
+// peer.
+func (a *Account) getPeerDNSBlocklists(peerID string) []nbdns.Blocklist {
+	var peerGroups LookupMap
+	var blocklists []nbdns.Blocklist
+	var size int
+
+	for _, blocklist := range a.DNSBlocklists {
+		if !blocklist.Enabled || len(blocklist.CompiledDomains) == 0 {
+			continue
+		}
+
+		if len(blocklist.ExemptGroups) > 0 {
+			if peerGroups == nil {
+				peerGroups = a.GetPeerGroups(peerID)
+			}
+			exempt := slices.ContainsFunc(blocklist.ExemptGroups, func(groupID string) bool {
+				_, found := peerGroups[groupID]
+				return found
+			})
+			if exempt {
+				continue
+			}
+		}
+
+		blocklistSize := blocklist.CompiledSize()
+		if size+blocklistSize > MaxDNSBlocklistsSize {
+			log.Warnf("skipping DNS blocklist %s of account %s, the blocklists of the account exceed %d bytes",
+				blocklist.ID, a.Id, MaxDNSBlocklistsSize)
+			continue
+		}
+		size += blocklistSize
+
+		blocklists = append(blocklists, blocklist.ToBlocklist())
+	}
+
+	return blocklists
+}
+
 func getPeerNSGroups(account *Account, peerID string) []*nbdns.NameServerGroup {
 	groupList := account.GetPeerGroups(peerID)
 
@@ -426,17 +516,36 @@ func getUniqueHostLabel(name string, peerLabels LookupMap) string {
 	return ""
 }
 
-func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdns.CustomZone {
+// GetPeersCustomZones returns the account DNS zone with the peer, custom and service name records,
+// and the reverse zones of the account network with the PTR records of the peers.
+func (a *Account) GetPeersCustomZones(ctx context.Context, dnsDomain string) []nbdns.CustomZone {
 	var merr *multierror.Error
 
 	if dnsDomain == "" {
 		log.WithContext(ctx).Error("no dns domain is set, returning empty zone")
-		return nbdns.CustomZone{}
+		return nil
 	}
 
 	customZone := nbdns.CustomZone{
 		Domain:  dns.Fqdn(dnsDomain),
-		Records: make([]nbdns.SimpleRecord, 0, len(a.Peers)),
+		Records: make([]nbdns.SimpleRecord, 0, len(a.Peers)+len(a.DNSRecords)),
+	}
+
+	reverseZones := make(map[string]*nbdns.CustomZone)
+	addPTRRecord := func(ip net.IP, record nbdns.SimpleRecord) {
+		if a.Network == nil || !a.Network.Net.Contains(ip) {
+			return
+		}
+		zoneName := reverseZoneName(ip, a.Network.Net)
+		if zoneName == "" {
+			return
+		}
+		zone, ok := reverseZones[zoneName]
+		if !ok {
+			zone = &nbdns.CustomZone{Domain: zoneName, SearchDomainDisabled: true}
+			reverseZones[zoneName] = zone
+		}
+		zone.Records = append(zone.Records, record)
 	}
 
 	domainSuffix := "." + dnsDomain
@@ -460,16 +569,57 @@ func (a *Account) GetPeersCustomZone(ctx context.Context, dnsDomain string) nbdn
 			RData: peer.IP.String(),
 		})
 
+		if peer.SupportsIPv6() {
+			customZone.Records = append(customZone.Records, nbdns.SimpleRecord{
+				Name:  sb.String(),
+				Type:  int(dns.TypeAAAA),
+				Class: nbdns.DefaultClass,
+				TTL:   defaultTTL,
+				RData: peer.IPv6.String(),
+			})
+		}
+
+		if ptrName, err := dns.ReverseAddr(peer.IP.String()); err == nil {
+			addPTRRecord(peer.IP, nbdns.SimpleRecord{
+				Name:  ptrName,
+				Type:  int(dns.TypePTR),
+				Class: nbdns.DefaultClass,
+				TTL:   defaultTTL,
+				RData: dns.Fqdn(sb.String()),
+			})
+		}
+
 		sb.Reset()
 	}
 
+	for _, record := range a.DNSRecords {
+		simpleRecord, err := record.ToSimpleRecord(dnsDomain)
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("DNS record %s: %w", record.ID, err))
+			continue
+		}
+
+		if record.Type == "PTR" {
+			addPTRRecord(net.ParseIP(record.Name), simpleRecord)
+			continue
+		}
+		customZone.Records = append(customZone.Records, simpleRecord)
+	}
+
+	customZone.Records = append(customZone.Records, a.getDNSServiceNameRecords(dnsDomain, time.Now().UTC())...)
+
 	go func() {
 		if merr != nil {
 			log.WithContext(ctx).Errorf("error generating custom zone for account %s: %v", a.Id, merr)
 		}
 	}()
 
-	return customZone
+	zones := []nbdns.CustomZone{customZone}
+	for _, zoneName := range slices.Sorted(maps.Keys(reverseZones)) {
+		zones = append(zones, *reverseZones[zoneName])
+	}
+
+	return zones
 }
 
 // GetExpiredPeers returns peers that have been expired
@@ -517,6 +667,41 @@ func (a *Account) GetNextPeerExpiration() (time.Duration, bool) {
 	return *nextExpiry, true
 }
 
+// GetNextPolicyScheduleTransition returns the minimum duration in which a scheduled policy rule of the account
+// becomes active or inactive. If no rule changes its state this function returns false and a duration of 0.
+func (a *Account) GetNextPolicyScheduleTransition() (time.Duration, bool) {
+	now := time.Now()
+	var next *time.Time
+	for _, policy := range a.Policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.Enabled {
+				continue
+			}
+
+			transition, ok := rule.Schedule.NextTransition(now)
+			if ok && (next == nil || transition.Before(*next)) {
+				next = &transition
+			}
+		}
+	}
+
+	if next == nil {
+		return 0, false
+	}
+
+	// avoid issues with ticker that can't be set to < 0
+	duration := next.Sub(now)
+	if duration < time.Second {
+		return time.Second, true
+	}
+
+	return duration, true
+}
+
 // GetPeersWithExpiration returns a list of peers that have Peer.LoginExpirationEnabled set to true and that were added by a user
 func (a *Account) GetPeersWithExpiration() []*nbpeer.Peer {
 	peers := make([]*nbpeer.Peer, 0)
@@ -805,6 +990,21 @@ func (a *Account) Copy() *Account {
 		postureChecks = append(postureChecks, postureCheck.Copy())
 	}
 
+	dnsRecords := []*DNSRecord{}
+	for _, record := range a.DNSRecords {
+		dnsRecords = append(dnsRecords, record.Copy())
+	}
+
+	dnsBlocklists := []*DNSBlocklist{}
+	for _, blocklist := range a.DNSBlocklists {
+		dnsBlocklists = append(dnsBlocklists, blocklist.Copy())
+	}
+
+	dnsServiceNames := []*DNSServiceName{}
+	for _, serviceName := range a.DNSServiceNames {
+		dnsServiceNames = append(dnsServiceNames, serviceName.Copy())
+	}
+
 	nets := []*networkTypes.Network{}
 	for _, network := range a.Networks {
 		nets = append(nets, network.Copy())
@@ -837,6 +1037,9 @@ func (a *Account) Copy() *Account {
 		NameServerGroups:       nsGroups,
 		DNSSettings:            dnsSettings,
 		PostureChecks:          postureChecks,
+		DNSRecords:             dnsRecords,
+		DNSBlocklists:          dnsBlocklists,
+		DNSServiceNames:        dnsServiceNames,
 		Settings:               settings,
 		Networks:               nets,
 		NetworkRouters:         networkRouters,
@@ -930,14 +1133,18 @@ func (a *Account) UserGroupsRemoveFromPeers(userID string, groups ...string) map
 //
 // This function returns the list of peers and firewall rules that are applicable to a given peer.
 func (a *Account) GetPeerConnectionResources(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) ([]*nbpeer.Peer, []*FirewallRule) {
-	generateResources, getAccumulatedResources := a.connResourcesGenerator(ctx)
+	peer := a.GetPeer(peerID)
+	ipv6Enabled := peer != nil && peer.SupportsIPv6()
+
+	generateResources, getAccumulatedResources := a.connResourcesGenerator(ctx, ipv6Enabled)
+	now := time.Now()
 	for _, policy := range a.Policies {
 		if !policy.Enabled {
 			continue
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.Enabled || !rule.Schedule.IsActive(now) {
 				continue
 			}
 
@@ -971,7 +1178,8 @@ func (a *Account) GetPeerConnectionResources(ctx context.Context, peerID string,
 // The generator function is used to generate the list of peers and firewall rules that are applicable to a given peer.
 // It safe to call the generator function multiple times for same peer and different rules no duplicates will be
 // generated. The accumulator function returns the result of all the generator calls.
-func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule, []*nbpeer.Peer, int), func() ([]*nbpeer.Peer, []*FirewallRule)) {
+// IPv6 rules are generated only if ipv6Enabled is true and the remote peer supports IPv6 as well.
+func (a *Account) connResourcesGenerator(ctx context.Context, ipv6Enabled bool) (func(*PolicyRule, []*nbpeer.Peer, int), func() ([]*nbpeer.Peer, []*FirewallRule)) {
 	rulesExists := make(map[string]struct{})
 	peersExists := make(map[string]struct{})
 	rules := make([]*FirewallRule, 0)
@@ -995,33 +1203,44 @@ func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule,
 					peersExists[peer.ID] = struct{}{}
 				}
 
-				fr := FirewallRule{
-					PeerIP:    peer.IP.String(),
-					Direction: direction,
-					Action:    string(rule.Action),
-					Protocol:  string(rule.Protocol),
-				}
-
+				peerIPs := []string{peer.IP.String()}
 				if isAll {
-					fr.PeerIP = "0.0.0.0"
-				}
-
-				ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
-					fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
-				if _, ok := rulesExists[ruleID]; ok {
-					continue
+					peerIPs = []string{"0.0.0.0"}
 				}
-				rulesExists[ruleID] = struct{}{}
-
-				if len(rule.Ports) == 0 {
-					rules = append(rules, &fr)
-					continue
+				if ipv6Enabled && peer.SupportsIPv6() {
+					if isAll {
+						peerIPs = append(peerIPs, "::")
+					} else {
+						peerIPs = append(peerIPs, peer.IPv6.String())
+					}
 				}
 
-				for _, port := range rule.Ports {
-					pr := fr // clone rule and add set new port
-					pr.Port = port
-					rules = append(rules, &pr)
+				for _, peerIP := range peerIPs {
+					fr := FirewallRule{
+						PolicyID:  rule.PolicyID,
+						PeerIP:    peerIP,
+						Direction: direction,
+						Action:    string(rule.Action),
+						Protocol:  string(rule.Protocol),
+					}
+
+					ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
+						fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
+					if _, ok := rulesExists[ruleID]; ok {
+						continue
+					}
+					rulesExists[ruleID] = struct{}{}
+
+					if len(rule.Ports) == 0 {
+						rules = append(rules, &fr)
+						continue
+					}
+
+					for _, port := range rule.Ports {
+						pr := fr // clone rule and add set new port
+						pr.Port = port
+						rules = append(rules, &pr)
+					}
 				}
 			}
 		}, func() ([]*nbpeer.Peer, []*FirewallRule) {
@@ -1129,13 +1348,14 @@ func (a *Account) GetPeerRoutesFirewallRules(ctx context.Context, peerID string,
 
 func (a *Account) getRouteFirewallRules(ctx context.Context, peerID string, policies []*Policy, route *route.Route, validatedPeersMap map[string]struct{}, distributionPeers map[string]struct{}) []*RouteFirewallRule {
 	var fwRules []*RouteFirewallRule
+	now := time.Now()
 	for _, policy := range policies {
 		if !policy.Enabled {
 			continue
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.Enabled || !rule.Schedule.IsActive(now) {
 				continue
 			}
 
@@ -1396,6 +1616,7 @@ func (a *Account) GetPoliciesForNetworkResource(resourceId string) []*Policy {
 	var resourceAppliedPolicies []*Policy
 
 	networkResourceGroups := a.getNetworkResourceGroups(resourceId)
+	now := time.Now()
 
 	for _, policy := range a.Policies {
 		if !policy.Enabled {
@@ -1403,7 +1624,7 @@ func (a *Account) GetPoliciesForNetworkResource(resourceId string) []*Policy {
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.Enabled || !rule.Schedule.IsActive(now) {
 				continue
 			}
 
@@ -1452,13 +1673,27 @@ func (a *Account) getNetworkResourcesRoutes(resource *resourceTypes.NetworkResou
 	if len(resourceAppliedPolicies) > 0 {
 		peer := a.GetPeer(peerId)
 		if peer != nil {
-			routes = append(routes, resource.ToRoute(peer, router))
+			r := resource.ToRoute(peer, router)
+			if r != nil && r.IsDynamic() {
+				r.DNSUpstreams = a.getNetworkDNSUpstreams(resource.NetworkID)
+			}
+			routes = append(routes, r)
 		}
 	}
 
 	return routes
 }
 
+// getNetworkDNSUpstreams returns the DNS upstreams configured for the network
+func (a *Account) getNetworkDNSUpstreams(networkID string) []string {
+	for _, network := range a.Networks {
+		if network.ID == networkID {
+			return slices.Clone(network.DNSUpstreams)
+		}
+	}
+	return nil
+}
+
 func (a *Account) GetResourceRoutersMap() map[string]map[string]*routerTypes.NetworkRouter {
 	routers := make(map[string]map[string]*routerTypes.NetworkRouter)
 
@@ -0,0 +1,310 @@
+package types
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	// DNSBlocklistActionNXDomain answers blocked queries with NXDOMAIN
+	DNSBlocklistActionNXDomain = "nxdomain"
+	// DNSBlocklistActionSinkhole answers blocked queries with the sinkhole addresses of the blocklist
+	DNSBlocklistActionSinkhole = "sinkhole"
+
+	// DefaultDNSBlocklistSinkholeIPv4 is the address returned for blocked A queries when none is configured
+	DefaultDNSBlocklistSinkholeIPv4 = "0.0.0.0"
+	// DefaultDNSBlocklistSinkholeIPv6 is the address returned for blocked AAAA queries when none is configured
+	DefaultDNSBlocklistSinkholeIPv6 = "::"
+
+	// MaxDNSBlocklistDomains is the maximum number of domains a blocklist can compile to
+	MaxDNSBlocklistDomains = 250000
+	// MaxDNSBlocklistSourceSize is the maximum size in bytes of a blocklist downloaded from its source URL
+	MaxDNSBlocklistSourceSize = 32 << 20
+	// MaxDNSBlocklistsSize is the maximum total size in bytes of the compiled domains of the enabled blocklists of an
+	// account. The blocklists are part of the network map sent to every peer, the limit keeps the sync messages well
+	// under the 4 MiB default gRPC message size limit.
+	MaxDNSBlocklistsSize = 1 << 20
+
+	// dnsBlocklistDomainOverhead is the encoding overhead of a domain in the sync message, the field tag and length
+	dnsBlocklistDomainOverhead = 3
+)
+
+// hostsFileNames are entries of hosts files that map local names and must never be blocked
+var hostsFileNames = map[string]struct{}{
+	"localhost":             {},
+	"localhost.localdomain": {},
+	"local":                 {},
+	"broadcasthost":         {},
+	"ip6-localhost":         {},
+	"ip6-loopback":          {},
+	"ip6-localnet":          {},
+	"ip6-mcastprefix":       {},
+	"ip6-allnodes":          {},
+	"ip6-allrouters":        {},
+	"ip6-allhosts":          {},
+}
+
+// DNSBlocklist is an admin managed list of domains that peers refuse to resolve
+type DNSBlocklist struct {
+	// ID of the blocklist
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+	// Name of the blocklist
+	Name string
+	// Description of the blocklist
+	Description string
+	// Enabled indicates whether the blocklist is distributed to peers
+	Enabled bool
+	// Action taken for blocked queries, one of DNSBlocklistActionNXDomain or DNSBlocklistActionSinkhole
+	Action string
+	// SinkholeIPv4 is the address returned for blocked A queries with the sinkhole action
+	SinkholeIPv4 string
+	// SinkholeIPv6 is the address returned for blocked AAAA queries with the sinkhole action
+	SinkholeIPv6 string
+	// Domains are the inline domains of the blocklist, subdomains are blocked as well
+	Domains []string `gorm:"serializer:json"`
+	// SourceURL is an optional URL of a hosts file, domain list or adblock list fetched by the management server
+	SourceURL string
+	// SourceUpdatedAt is the last time the source was fetched successfully
+	SourceUpdatedAt time.Time
+	// SourceError is the error of the last source fetch, empty if it succeeded
+	SourceError string
+	// ExemptGroups are groups of peers the blocklist is not applied to
+	ExemptGroups []string `gorm:"serializer:json"`
+	// CompiledDomains are the normalized and deduplicated inline and source domains distributed to peers
+	CompiledDomains []string `gorm:"serializer:json"`
+}
+
+// Copy returns a copy of the blocklist. The compiled domains are shared as they are replaced, never modified
+func (b *DNSBlocklist) Copy() *DNSBlocklist {
+	blocklist := *b
+	blocklist.Domains = slices.Clone(b.Domains)
+	blocklist.ExemptGroups = slices.Clone(b.ExemptGroups)
+	return &blocklist
+}
+
+// EventMeta returns activity event meta related to the blocklist
+func (b *DNSBlocklist) EventMeta() map[string]any {
+	return map[string]any{"name": b.Name, "action": b.Action, "source_url": b.SourceURL}
+}
+
+// Normalize sets the defaults of the blocklist and normalizes its inline domains
+func (b *DNSBlocklist) Normalize() {
+	if b.Action == "" {
+		b.Action = DNSBlocklistActionNXDomain
+	}
+	if b.SinkholeIPv4 == "" {
+		b.SinkholeIPv4 = DefaultDNSBlocklistSinkholeIPv4
+	}
+	if b.SinkholeIPv6 == "" {
+		b.SinkholeIPv6 = DefaultDNSBlocklistSinkholeIPv6
+	}
+	b.SourceURL = strings.TrimSpace(b.SourceURL)
+
+	domains := make([]string, 0, len(b.Domains))
+	for _, domain := range b.Domains {
+		domains = append(domains, normalizeBlocklistDomain(domain))
+	}
+	b.Domains = domains
+}
+
+// Validate checks the settings and the inline domains of the blocklist
+func (b *DNSBlocklist) Validate() error {
+	if b.Name == "" || utf8.RuneCountInString(b.Name) > nbdns.MaxGroupNameChar {
+		return fmt.Errorf("blocklist name should be between 1 and %d characters", nbdns.MaxGroupNameChar)
+	}
+
+	switch b.Action {
+	case DNSBlocklistActionNXDomain, DNSBlocklistActionSinkhole:
+	default:
+		return fmt.Errorf("unsupported blocklist action %q, supported actions are %s and %s",
+			b.Action, DNSBlocklistActionNXDomain, DNSBlocklistActionSinkhole)
+	}
+
+	if ip, err := netip.ParseAddr(b.SinkholeIPv4); err != nil || !ip.Is4() {
+		return fmt.Errorf("invalid sinkhole IPv4 address %q", b.SinkholeIPv4)
+	}
+	if ip, err := netip.ParseAddr(b.SinkholeIPv6); err != nil || !ip.Is6() || ip.Is4In6() {
+		return fmt.Errorf("invalid sinkhole IPv6 address %q", b.SinkholeIPv6)
+	}
+
+	if len(b.Domains) == 0 && b.SourceURL == "" {
+		return errors.New("blocklist should have at least one domain or a source URL")
+	}
+
+	if len(b.Domains) > MaxDNSBlocklistDomains {
+		return fmt.Errorf("blocklist can't have more than %d domains", MaxDNSBlocklistDomains)
+	}
+
+	for _, domain := range b.Domains {
+		if !isValidBlocklistDomain(domain) {
+			return fmt.Errorf("invalid blocklist domain %q", domain)
+		}
+	}
+
+	if b.SourceURL != "" {
+		sourceURL, err := url.Parse(b.SourceURL)
+		if err != nil || (sourceURL.Scheme != "http" && sourceURL.Scheme != "https") || sourceURL.Host == "" {
+			return fmt.Errorf("invalid blocklist source URL %q, it should be an http or https URL", b.SourceURL)
+		}
+	}
+
+	return nil
+}
+
+// Compile merges the inline domains with the domains fetched from the source into the compiled domains
+func (b *DNSBlocklist) Compile(sourceDomains []string) error {
+	compiled := make([]string, 0, len(b.Domains)+len(sourceDomains))
+	compiled = append(compiled, b.Domains...)
+	compiled = append(compiled, sourceDomains...)
+
+	slices.Sort(compiled)
+	compiled = slices.Compact(compiled)
+
+	if len(compiled) > MaxDNSBlocklistDomains {
+		return fmt.Errorf("blocklist compiles to %d domains, the maximum is %d", len(compiled), MaxDNSBlocklistDomains)
+	}
+
+	if size := compiledSize(compiled); size > MaxDNSBlocklistsSize {
+		return fmt.Errorf("blocklist compiles to %d bytes, the maximum is %d", size, MaxDNSBlocklistsSize)
+	}
+
+	b.CompiledDomains = compiled
+	return nil
+}
+
+// CompiledSize returns the approximate size in bytes of the compiled domains in the sync message
+func (b *DNSBlocklist) CompiledSize() int {
+	return compiledSize(b.CompiledDomains)
+}
+
+// ValidateDNSBlocklistsSize checks that the compiled domains of the enabled blocklists of an account fit into
+// MaxDNSBlocklistsSize
+func ValidateDNSBlocklistsSize(blocklists []*DNSBlocklist) error {
+	var size int
+	for _, blocklist := range blocklists {
+		if blocklist.Enabled {
+			size += blocklist.CompiledSize()
+		}
+	}
+
+	if size > MaxDNSBlocklistsSize {
+		return fmt.Errorf("the enabled blocklists of the account compile to %d bytes, the maximum is %d", size, MaxDNSBlocklistsSize)
+	}
+	return nil
+}
+
+func compiledSize(domains []string) int {
+	var size int
+	for _, domain := range domains {
+		size += len(domain) + dnsBlocklistDomainOverhead
+	}
+	return size
+}
+
+// ToBlocklist converts the blocklist into the DNS configuration distributed to peers
+func (b *DNSBlocklist) ToBlocklist() nbdns.Blocklist {
+	blocklist := nbdns.Blocklist{
+		ID:       b.ID,
+		Name:     b.Name,
+		Domains:  b.CompiledDomains,
+		Sinkhole: b.Action == DNSBlocklistActionSinkhole,
+	}
+
+	if blocklist.Sinkhole {
+		blocklist.SinkholeIPv4, _ = netip.ParseAddr(b.SinkholeIPv4)
+		blocklist.SinkholeIPv6, _ = netip.ParseAddr(b.SinkholeIPv6)
+	}
+
+	return blocklist
+}
+
+// ParseDNSBlocklist reads the domains of a blocklist in hosts file, plain domain list or adblock format.
+// Comments, local hostnames and rules that don't block a whole domain are skipped.
+func ParseDNSBlocklist(reader io.Reader) ([]string, error) {
+	var domains []string
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		for _, domain := range parseDNSBlocklistLine(scanner.Text()) {
+			domain = normalizeBlocklistDomain(domain)
+			if _, ok := hostsFileNames[domain]; ok || !isValidBlocklistDomain(domain) {
+				continue
+			}
+
+			domains = append(domains, domain)
+			if len(domains) > MaxDNSBlocklistDomains {
+				return nil, fmt.Errorf("blocklist has more than %d domains", MaxDNSBlocklistDomains)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read blocklist: %w", err)
+	}
+
+	return domains, nil
+}
+
+func parseDNSBlocklistLine(line string) []string {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' || line[0] == '!' || line[0] == '[' {
+		return nil
+	}
+
+	// adblock rules, only plain domain rules like ||example.com^ block a whole domain
+	if rule, ok := strings.CutPrefix(line, "||"); ok {
+		domain, rest, found := strings.Cut(rule, "^")
+		if !found || rest != "" {
+			return nil
+		}
+		return []string{domain}
+	}
+
+	line, _, _ = strings.Cut(line, "#")
+	fields := strings.Fields(line)
+
+	switch {
+	case len(fields) == 1:
+		return fields
+	case len(fields) > 1:
+		// hosts file entries map an address to one or more names
+		if _, err := netip.ParseAddr(fields[0]); err == nil {
+			return fields[1:]
+		}
+	}
+
+	return nil
+}
+
+func normalizeBlocklistDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimSuffix(domain, ".")
+	return strings.TrimPrefix(domain, "*.")
+}
+
+func isValidBlocklistDomain(domain string) bool {
+	if domain == "" || strings.ContainsAny(domain, "*/:@ \t\\") {
+		return false
+	}
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return false
+	}
+	_, ok := dns.IsDomainName(domain)
+	return ok
+}
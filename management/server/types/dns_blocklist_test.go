@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDNSBlocklist(t *testing.T) {
+	list := `# hosts file
+127.0.0.1 localhost
+::1 ip6-localhost ip6-loopback
+0.0.0.0 ads.example.com  tracker.example.com # inline comment
+0.0.0.0 0.0.0.0
+
+! adblock rules
+[Adblock Plus 2.0]
+||AdBlock.example.net^
+||thirdparty.example.net^$third-party
+||example.org/path^
+@@||allowed.example.org^
+
+plain.example.io.
+*.wildcard.example.io
+not a domain line
+`
+
+	domains, err := ParseDNSBlocklist(strings.NewReader(list))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"ads.example.com",
+		"tracker.example.com",
+		"adblock.example.net",
+		"plain.example.io",
+		"wildcard.example.io",
+	}, domains)
+}
+
+func TestDNSBlocklist_Compile(t *testing.T) {
+	blocklist := &DNSBlocklist{Domains: []string{"b.example.com", "a.example.com"}}
+	require.NoError(t, blocklist.Compile([]string{"a.example.com", "c.example.com"}))
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "c.example.com"}, blocklist.CompiledDomains)
+
+	tooMany := make([]string, MaxDNSBlocklistDomains+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("a", i%50+1) + "." + strings.Repeat("b", i/50+1) + ".com"
+	}
+	assert.Error(t, blocklist.Compile(tooMany))
+}
+
+func TestAccount_getPeerDNSBlocklists(t *testing.T) {
+	account := &Account{
+		Groups: map[string]*Group{
+			"exempt": {ID: "exempt", Peers: []string{"peer2"}},
+		},
+		DNSBlocklists: []*DNSBlocklist{
+			{ID: "ads", Name: "Ads", Enabled: true, Action: DNSBlocklistActionNXDomain, CompiledDomains: []string{"ads.example.com"}},
+			{
+				ID: "malware", Name: "Malware", Enabled: true, Action: DNSBlocklistActionSinkhole,
+				SinkholeIPv4: "10.0.0.1", SinkholeIPv6: "::", CompiledDomains: []string{"malware.test"},
+				ExemptGroups: []string{"exempt"},
+			},
+			{ID: "disabled", Name: "Disabled", CompiledDomains: []string{"disabled.test"}},
+			{ID: "empty", Name: "Empty", Enabled: true},
+		},
+	}
+
+	blocklists := account.getPeerDNSBlocklists("peer1")
+	require.Len(t, blocklists, 2)
+	assert.Equal(t, "ads", blocklists[0].ID)
+	assert.False(t, blocklists[0].Sinkhole)
+	assert.Equal(t, "malware", blocklists[1].ID)
+	assert.True(t, blocklists[1].Sinkhole)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), blocklists[1].SinkholeIPv4)
+
+	blocklists = account.getPeerDNSBlocklists("peer2")
+	require.Len(t, blocklists, 1, "peers of exempt groups should not receive the blocklist")
+	assert.Equal(t, "ads", blocklists[0].ID)
+}
+
+func testBlocklistDomains(prefix string, n int) []string {
+	domains := make([]string, n)
+	for i := range domains {
+		domains[i] = fmt.Sprintf("%s%d.example.com", prefix, i)
+	}
+	return domains
+}
+
+func TestValidateDNSBlocklistsSize(t *testing.T) {
+	large := &DNSBlocklist{ID: "large", Enabled: true, CompiledDomains: testBlocklistDomains("large", 30000)}
+	other := &DNSBlocklist{ID: "other", Enabled: true, CompiledDomains: testBlocklistDomains("other", 30000)}
+	require.Less(t, large.CompiledSize(), MaxDNSBlocklistsSize)
+
+	assert.NoError(t, ValidateDNSBlocklistsSize([]*DNSBlocklist{large}))
+	assert.Error(t, ValidateDNSBlocklistsSize([]*DNSBlocklist{large, other}))
+
+	other.Enabled = false
+	assert.NoError(t, ValidateDNSBlocklistsSize([]*DNSBlocklist{large, other}), "disabled blocklists are not distributed")
+
+	tooLarge := &DNSBlocklist{}
+	assert.Error(t, tooLarge.Compile(testBlocklistDomains("too-large", 50000)), "a single blocklist must fit into the limit")
+}
+
+func TestAccount_getPeerDNSBlocklistsSizeLimit(t *testing.T) {
+	account := &Account{
+		DNSBlocklists: []*DNSBlocklist{
+			{ID: "first", Enabled: true, CompiledDomains: testBlocklistDomains("first", 30000)},
+			{ID: "second", Enabled: true, CompiledDomains: testBlocklistDomains("second", 30000)},
+			{ID: "small", Enabled: true, CompiledDomains: []string{"small.example.com"}},
+		},
+	}
+
+	blocklists := account.getPeerDNSBlocklists("peer1")
+	require.Len(t, blocklists, 2, "the blocklists exceeding the size limit should be skipped")
+	assert.Equal(t, "first", blocklists[0].ID)
+	assert.Equal(t, "small", blocklists[1].ID)
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	// DNSRecordApex is the record name used for records at the apex of the account zone
+	DNSRecordApex = "@"
+	// DefaultDNSRecordTTL is the TTL used for records created without one
+	DefaultDNSRecordTTL = defaultTTL
+	// MaxDNSRecordTTL is the highest TTL accepted for custom records
+	MaxDNSRecordTTL = 86400
+)
+
+// DNSRecordTypes lists the record types that can be published in the account zone
+var DNSRecordTypes = []string{"A", "AAAA", "CNAME", "TXT", "MX", "SRV", "PTR"}
+
+var dnsRecordLabelMatcher = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?$`)
+
+// DNSRecord is an admin managed record published in the account DNS zone
+type DNSRecord struct {
+	// ID of the record
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+	// Name of the record relative to the account zone, e.g. "_sip._tcp", "*.apps" or "@" for the zone apex.
+	// PTR records are named by the peer network address they resolve, e.g. "100.64.0.10".
+	Name string
+	// Type of the record, one of DNSRecordTypes
+	Type string
+	// TTL time-to-live of the record in seconds
+	TTL int
+	// Content is the record data in zone file presentation format, e.g. "10 5 5060 sip" for SRV records.
+	// Relative names are resolved against the account zone.
+	Content string
+}
+
+// Copy returns a copy of the DNS record
+func (r *DNSRecord) Copy() *DNSRecord {
+	record := *r
+	return &record
+}
+
+// EventMeta returns activity event meta related to the DNS record
+func (r *DNSRecord) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name, "type": r.Type, "content": r.Content}
+}
+
+// Validate checks that the record can be published in the zone of the given domain and network
+func (r *DNSRecord) Validate(dnsDomain string, network *Network) error {
+	if !slices.Contains(DNSRecordTypes, r.Type) {
+		return fmt.Errorf("unsupported record type %q, supported types are %s", r.Type, strings.Join(DNSRecordTypes, ", "))
+	}
+
+	if r.TTL < 1 || r.TTL > MaxDNSRecordTTL {
+		return fmt.Errorf("record TTL must be between 1 and %d", MaxDNSRecordTTL)
+	}
+
+	if r.Type == "PTR" {
+		if network == nil {
+			return errors.New("account network is not set")
+		}
+		ip := net.ParseIP(r.Name).To4()
+		if ip == nil || !network.Net.Contains(ip) {
+			return fmt.Errorf("PTR record name must be an IPv4 address of the account network %s", network.Net.String())
+		}
+	} else if err := validateDNSRecordName(r.Name); err != nil {
+		return err
+	}
+
+	if _, err := r.ToSimpleRecord(dnsDomain); err != nil {
+		return fmt.Errorf("invalid %s record content %q: %w", r.Type, r.Content, err)
+	}
+
+	return nil
+}
+
+func validateDNSRecordName(name string) error {
+	if name == DNSRecordApex {
+		return nil
+	}
+
+	if name == "" || len(name) > 200 {
+		return errors.New("record name must be between 1 and 200 characters long")
+	}
+
+	for i, label := range strings.Split(name, ".") {
+		if label == "*" && i == 0 {
+			continue
+		}
+		if !dnsRecordLabelMatcher.MatchString(label) {
+			return fmt.Errorf("invalid record name %q, names must be relative to the account zone, "+
+				"contain lowercase letters, digits, hyphens and underscores and may start with a wildcard label", name)
+		}
+	}
+
+	return nil
+}
+
+// FQDN returns the fully qualified name of the record within the zone of the given domain
+func (r *DNSRecord) FQDN(dnsDomain string) string {
+	if r.Type == "PTR" {
+		name, err := dns.ReverseAddr(r.Name)
+		if err != nil {
+			return ""
+		}
+		return name
+	}
+
+	if r.Name == DNSRecordApex {
+		return dns.Fqdn(dnsDomain)
+	}
+	return dns.Fqdn(r.Name + "." + dnsDomain)
+}
+
+// ToSimpleRecord converts the record into a zone record with the content normalized to absolute names
+func (r *DNSRecord) ToSimpleRecord(dnsDomain string) (nbdns.SimpleRecord, error) {
+	name := r.FQDN(dnsDomain)
+	if name == "" {
+		return nbdns.SimpleRecord{}, fmt.Errorf("invalid record name %q", r.Name)
+	}
+
+	content := strings.TrimSpace(r.Content)
+	if content == "" {
+		return nbdns.SimpleRecord{}, errors.New("record content is empty")
+	}
+	if r.Type == "TXT" && !strings.HasPrefix(content, `"`) {
+		content = quoteTXT(content)
+	}
+
+	parser := dns.NewZoneParser(strings.NewReader(fmt.Sprintf("%s %d IN %s %s", name, r.TTL, r.Type, content)), dns.Fqdn(dnsDomain), "")
+	rr, ok := parser.Next()
+	if err := parser.Err(); err != nil {
+		return nbdns.SimpleRecord{}, err
+	}
+	if !ok || rr == nil {
+		return nbdns.SimpleRecord{}, errors.New("unable to parse record")
+	}
+
+	return nbdns.SimpleRecord{
+		Name:  rr.Header().Name,
+		Type:  int(rr.Header().Rrtype),
+		Class: nbdns.DefaultClass,
+		TTL:   r.TTL,
+		RData: strings.TrimPrefix(rr.String(), rr.Header().String()),
+	}, nil
+}
+
+// quoteTXT splits text into quoted character strings of at most 255 bytes as required for TXT records
+func quoteTXT(text string) string {
+	var parts []string
+	for len(text) > 0 {
+		n := min(len(text), 255)
+		chunk := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text[:n])
+		parts = append(parts, `"`+chunk+`"`)
+		text = text[n:]
+	}
+	return strings.Join(parts, " ")
+}
+
+// reverseZoneName returns the in-addr.arpa zone containing the given address. The prefix length of the network is
+// rounded up to whole octets, so a network not aligned to an octet, e.g. 100.64.0.0/10, is split into several zones
+// and no addresses outside of it are covered.
+func reverseZoneName(ip net.IP, network net.IPNet) string {
+	ip = ip.To4()
+	if ip == nil || network.IP.To4() == nil {
+		return ""
+	}
+
+	ones, _ := network.Mask.Size()
+	octets := (ones + 7) / 8
+
+	labels := make([]string, 0, octets+1)
+	for i := octets - 1; i >= 0; i-- {
+		labels = append(labels, fmt.Sprintf("%d", ip[i]))
+	}
+	labels = append(labels, "in-addr.arpa.")
+
+	return strings.Join(labels, ".")
+}
@@ -0,0 +1,189 @@
+package types
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestDNSRecord_Validate(t *testing.T) {
+	network := &Network{Net: net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(16, 32)}}
+
+	testCases := []struct {
+		name          string
+		record        DNSRecord
+		expectedRData string
+		expectedName  string
+		expectErr     bool
+	}{
+		{
+			name:          "A record",
+			record:        DNSRecord{Name: "web", Type: "A", TTL: 300, Content: "10.0.0.1"},
+			expectedName:  "web.netbird.cloud.",
+			expectedRData: "10.0.0.1",
+		},
+		{
+			name:          "apex MX record with relative target",
+			record:        DNSRecord{Name: "@", Type: "MX", TTL: 300, Content: "10 mail"},
+			expectedName:  "netbird.cloud.",
+			expectedRData: "10 mail.netbird.cloud.",
+		},
+		{
+			name:          "SRV record",
+			record:        DNSRecord{Name: "_sip._tcp", Type: "SRV", TTL: 300, Content: "10 5 5060 sip.example.com."},
+			expectedName:  "_sip._tcp.netbird.cloud.",
+			expectedRData: "10 5 5060 sip.example.com.",
+		},
+		{
+			name:          "unquoted TXT record",
+			record:        DNSRecord{Name: "info", Type: "TXT", TTL: 300, Content: `v=spf1 include:"example.com" -all`},
+			expectedName:  "info.netbird.cloud.",
+			expectedRData: `"v=spf1 include:\"example.com\" -all"`,
+		},
+		{
+			name:          "wildcard CNAME record",
+			record:        DNSRecord{Name: "*.apps", Type: "CNAME", TTL: 300, Content: "ingress"},
+			expectedName:  "*.apps.netbird.cloud.",
+			expectedRData: "ingress.netbird.cloud.",
+		},
+		{
+			name:          "PTR record",
+			record:        DNSRecord{Name: "100.64.1.10", Type: "PTR", TTL: 300, Content: "printer"},
+			expectedName:  "10.1.64.100.in-addr.arpa.",
+			expectedRData: "printer.netbird.cloud.",
+		},
+		{
+			name:      "PTR record outside of the account network",
+			record:    DNSRecord{Name: "10.0.0.1", Type: "PTR", TTL: 300, Content: "printer"},
+			expectErr: true,
+		},
+		{
+			name:      "unsupported type",
+			record:    DNSRecord{Name: "web", Type: "NS", TTL: 300, Content: "ns1"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid TTL",
+			record:    DNSRecord{Name: "web", Type: "A", TTL: MaxDNSRecordTTL + 1, Content: "10.0.0.1"},
+			expectErr: true,
+		},
+		{
+			name:      "wildcard in the middle of the name",
+			record:    DNSRecord{Name: "apps.*", Type: "A", TTL: 300, Content: "10.0.0.1"},
+			expectErr: true,
+		},
+		{
+			name:      "absolute name",
+			record:    DNSRecord{Name: "web.example.com.", Type: "A", TTL: 300, Content: "10.0.0.1"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid A content",
+			record:    DNSRecord{Name: "web", Type: "A", TTL: 300, Content: "fd00::1"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid SRV content",
+			record:    DNSRecord{Name: "_sip._tcp", Type: "SRV", TTL: 300, Content: "sip"},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.record.Validate("netbird.cloud", network)
+			if testCase.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			record, err := testCase.record.ToSimpleRecord("netbird.cloud")
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedName, record.Name)
+			assert.Equal(t, testCase.expectedRData, record.RData)
+
+			_, err = dns.NewRR(record.String())
+			assert.NoError(t, err, "record should be parsable by the clients")
+		})
+	}
+}
+
+func TestAccount_GetPeersCustomZones(t *testing.T) {
+	account := &Account{
+		Id:      "accountID",
+		Network: &Network{Net: net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(16, 32)}},
+		Peers: map[string]*nbpeer.Peer{
+			"peer1": {ID: "peer1", DNSLabel: "peer1", IP: net.ParseIP("100.64.0.1")},
+		},
+		DNSRecords: []*DNSRecord{
+			{ID: "srv", Name: "_sip._tcp", Type: "SRV", TTL: 60, Content: "10 5 5060 peer1"},
+			{ID: "ptr", Name: "100.64.0.20", Type: "PTR", TTL: 60, Content: "printer"},
+		},
+	}
+
+	zones := account.GetPeersCustomZones(context.Background(), "netbird.cloud")
+	require.Len(t, zones, 2)
+
+	assert.Equal(t, "netbird.cloud.", zones[0].Domain)
+	assert.False(t, zones[0].SearchDomainDisabled)
+	assert.ElementsMatch(t, []nbdns.SimpleRecord{
+		{Name: "peer1.netbird.cloud", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "100.64.0.1"},
+		{Name: "_sip._tcp.netbird.cloud.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: 60, RData: "10 5 5060 peer1.netbird.cloud."},
+	}, zones[0].Records)
+
+	assert.Equal(t, "64.100.in-addr.arpa.", zones[1].Domain)
+	assert.True(t, zones[1].SearchDomainDisabled)
+	assert.ElementsMatch(t, []nbdns.SimpleRecord{
+		{Name: "1.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "peer1.netbird.cloud."},
+		{Name: "20.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 60, RData: "printer.netbird.cloud."},
+	}, zones[1].Records)
+
+	assert.Nil(t, account.GetPeersCustomZones(context.Background(), ""))
+}
+
+func TestAccount_GetPeersCustomZonesReverseZonesOfUnalignedNetwork(t *testing.T) {
+	account := &Account{
+		Id:      "accountID",
+		Network: &Network{Net: net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(10, 32)}},
+		Peers: map[string]*nbpeer.Peer{
+			"peer1": {ID: "peer1", DNSLabel: "peer1", IP: net.ParseIP("100.64.0.1")},
+			"peer2": {ID: "peer2", DNSLabel: "peer2", IP: net.ParseIP("100.64.0.2")},
+			"peer3": {ID: "peer3", DNSLabel: "peer3", IP: net.ParseIP("100.127.10.1")},
+		},
+		DNSRecords: []*DNSRecord{
+			{ID: "ptr", Name: "100.100.0.20", Type: "PTR", TTL: 60, Content: "printer"},
+		},
+	}
+
+	zones := account.GetPeersCustomZones(context.Background(), "netbird.cloud")
+	require.Len(t, zones, 4)
+
+	assert.Equal(t, "100.100.in-addr.arpa.", zones[1].Domain)
+	assert.True(t, zones[1].SearchDomainDisabled)
+	assert.ElementsMatch(t, []nbdns.SimpleRecord{
+		{Name: "20.0.100.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 60, RData: "printer.netbird.cloud."},
+	}, zones[1].Records)
+
+	assert.Equal(t, "127.100.in-addr.arpa.", zones[2].Domain)
+	assert.ElementsMatch(t, []nbdns.SimpleRecord{
+		{Name: "1.10.127.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "peer3.netbird.cloud."},
+	}, zones[2].Records)
+
+	assert.Equal(t, "64.100.in-addr.arpa.", zones[3].Domain)
+	assert.ElementsMatch(t, []nbdns.SimpleRecord{
+		{Name: "1.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "peer1.netbird.cloud."},
+		{Name: "2.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: defaultTTL, RData: "peer2.netbird.cloud."},
+	}, zones[3].Records)
+
+	for _, zone := range zones[1:] {
+		assert.NotEqual(t, "100.in-addr.arpa.", zone.Domain, "the zone must not cover addresses outside of the network")
+	}
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+const (
+	// DNSServiceNameTTL is the TTL of the service name records, it is short so clients follow the member health changes
+	DNSServiceNameTTL = 30
+	// DNSServiceNameGracePeriod is how long a disconnected member is still published, so short reconnects don't remove it
+	DNSServiceNameGracePeriod = time.Minute
+)
+
+// DNSServiceName is an admin defined name in the account zone resolving to the healthy peers of a set of peers and groups
+type DNSServiceName struct {
+	// ID of the service name
+	ID string `gorm:"primaryKey"`
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+	// Name of the service relative to the account zone, e.g. "db"
+	Name string
+	// Description of the service name
+	Description string
+	// Enabled status of the service name
+	Enabled bool
+	// Peers list of peer IDs serving the name
+	Peers []string `gorm:"serializer:json"`
+	// Groups list of group IDs whose peers serve the name
+	Groups []string `gorm:"serializer:json"`
+}
+
+// Copy returns a copy of the service name
+func (s *DNSServiceName) Copy() *DNSServiceName {
+	serviceName := *s
+	serviceName.Peers = slices.Clone(s.Peers)
+	serviceName.Groups = slices.Clone(s.Groups)
+	return &serviceName
+}
+
+// EventMeta returns activity event meta related to the service name
+func (s *DNSServiceName) EventMeta() map[string]any {
+	return map[string]any{"name": s.Name}
+}
+
+// Validate checks the name and that the service name has members
+func (s *DNSServiceName) Validate() error {
+	if s.Name == DNSRecordApex || strings.HasPrefix(s.Name, "*") {
+		return errors.New("service names can't be placed at the zone apex or be wildcards")
+	}
+
+	if err := validateDNSRecordName(s.Name); err != nil {
+		return err
+	}
+
+	if len(s.Peers) == 0 && len(s.Groups) == 0 {
+		return errors.New("service name must have at least one peer or group")
+	}
+
+	return nil
+}
+
+// FQDN returns the fully qualified service name within the zone of the given domain
+func (s *DNSServiceName) FQDN(dnsDomain string) string {
+	return dns.Fqdn(s.Name + "." + dnsDomain)
+}
+
+// IsDNSServiceNameMemberHealthy returns true if the peer is connected or disconnected within the grace period
+func IsDNSServiceNameMemberHealthy(peer *nbpeer.Peer, now time.Time) bool {
+	if peer.Status == nil || peer.Status.LoginExpired {
+		return false
+	}
+	return peer.Status.Connected || now.Sub(peer.Status.LastSeen) < DNSServiceNameGracePeriod
+}
+
+// getDNSServiceNameMembers returns the peers serving the service name sorted by ID
+func (a *Account) getDNSServiceNameMembers(serviceName *DNSServiceName) []*nbpeer.Peer {
+	memberIDs := slices.Clone(serviceName.Peers)
+	for _, groupID := range serviceName.Groups {
+		if group, ok := a.Groups[groupID]; ok {
+			memberIDs = append(memberIDs, group.Peers...)
+		}
+	}
+	slices.Sort(memberIDs)
+
+	members := make([]*nbpeer.Peer, 0, len(memberIDs))
+	for _, peerID := range slices.Compact(memberIDs) {
+		if peer, ok := a.Peers[peerID]; ok {
+			members = append(members, peer)
+		}
+	}
+	return members
+}
+
+// GetDNSServiceNameHealthyPeers returns the members of the service name that are healthy at the given time
+func (a *Account) GetDNSServiceNameHealthyPeers(serviceName *DNSServiceName, now time.Time) []*nbpeer.Peer {
+	return slices.DeleteFunc(a.getDNSServiceNameMembers(serviceName), func(peer *nbpeer.Peer) bool {
+		return !IsDNSServiceNameMemberHealthy(peer, now)
+	})
+}
+
+// getDNSServiceNameRecords returns the address records of the healthy members of the enabled service names
+func (a *Account) getDNSServiceNameRecords(dnsDomain string, now time.Time) []nbdns.SimpleRecord {
+	var records []nbdns.SimpleRecord
+	for _, serviceName := range a.DNSServiceNames {
+		if !serviceName.Enabled {
+			continue
+		}
+
+		name := serviceName.FQDN(dnsDomain)
+		for _, peer := range a.GetDNSServiceNameHealthyPeers(serviceName, now) {
+			records = append(records, nbdns.SimpleRecord{
+				Name:  name,
+				Type:  int(dns.TypeA),
+				Class: nbdns.DefaultClass,
+				TTL:   DNSServiceNameTTL,
+				RData: peer.IP.String(),
+			})
+
+			if peer.SupportsIPv6() {
+				records = append(records, nbdns.SimpleRecord{
+					Name:  name,
+					Type:  int(dns.TypeAAAA),
+					Class: nbdns.DefaultClass,
+					TTL:   DNSServiceNameTTL,
+					RData: peer.IPv6.String(),
+				})
+			}
+		}
+	}
+	return records
+}
+
+// IsDNSServiceNameMember returns true if the peer serves an enabled service name of the account
+func (a *Account) IsDNSServiceNameMember(peerID string) bool {
+	for _, serviceName := range a.DNSServiceNames {
+		if !serviceName.Enabled {
+			continue
+		}
+		if slices.ContainsFunc(a.getDNSServiceNameMembers(serviceName), func(peer *nbpeer.Peer) bool {
+			return peer.ID == peerID
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNextDNSServiceNameTransition returns the minimum duration in which a disconnected service name member
+// leaves its grace period and must be removed from the records. If there is none this function returns false and a duration of 0.
+func (a *Account) GetNextDNSServiceNameTransition() (time.Duration, bool) {
+	now := time.Now().UTC()
+	var next *time.Duration
+	for _, serviceName := range a.DNSServiceNames {
+		if !serviceName.Enabled {
+			continue
+		}
+
+		for _, peer := range a.GetDNSServiceNameHealthyPeers(serviceName, now) {
+			if peer.Status.Connected {
+				continue
+			}
+			remaining := DNSServiceNameGracePeriod - now.Sub(peer.Status.LastSeen)
+			if next == nil || remaining < *next {
+				next = &remaining
+			}
+		}
+	}
+
+	if next == nil {
+		return 0, false
+	}
+
+	// avoid issues with ticker that can't be set to < 0
+	if *next < time.Second {
+		return time.Second, true
+	}
+	return *next, true
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestDNSServiceName_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		serviceName DNSServiceName
+		expectErr   bool
+	}{
+		{name: "peers", serviceName: DNSServiceName{Name: "db", Peers: []string{"peer1"}}},
+		{name: "groups", serviceName: DNSServiceName{Name: "db.eu", Groups: []string{"group1"}}},
+		{name: "no members", serviceName: DNSServiceName{Name: "db"}, expectErr: true},
+		{name: "apex", serviceName: DNSServiceName{Name: "@", Peers: []string{"peer1"}}, expectErr: true},
+		{name: "wildcard", serviceName: DNSServiceName{Name: "*.db", Peers: []string{"peer1"}}, expectErr: true},
+		{name: "invalid name", serviceName: DNSServiceName{Name: "db..eu", Peers: []string{"peer1"}}, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.serviceName.Validate()
+			if testCase.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAccount_getDNSServiceNameRecords(t *testing.T) {
+	now := time.Now().UTC()
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"connected": {ID: "connected", IP: net.ParseIP("100.64.0.1"), Status: &nbpeer.PeerStatus{Connected: true, LastSeen: now.Add(-time.Hour)}},
+			"recent":    {ID: "recent", IP: net.ParseIP("100.64.0.2"), Status: &nbpeer.PeerStatus{LastSeen: now.Add(-10 * time.Second)}},
+			"gone":      {ID: "gone", IP: net.ParseIP("100.64.0.3"), Status: &nbpeer.PeerStatus{LastSeen: now.Add(-time.Hour)}},
+			"expired":   {ID: "expired", IP: net.ParseIP("100.64.0.4"), Status: &nbpeer.PeerStatus{Connected: true, LoginExpired: true, LastSeen: now}},
+		},
+		Groups: map[string]*Group{
+			"group1": {ID: "group1", Peers: []string{"recent", "gone", "connected"}},
+		},
+		DNSServiceNames: []*DNSServiceName{
+			{ID: "db", Name: "db", Enabled: true, Peers: []string{"connected", "expired"}, Groups: []string{"group1"}},
+			{ID: "disabled", Name: "disabled", Enabled: false, Peers: []string{"connected"}},
+		},
+	}
+
+	healthy := account.GetDNSServiceNameHealthyPeers(account.DNSServiceNames[0], now)
+	require.Len(t, healthy, 2)
+	assert.Equal(t, "connected", healthy[0].ID)
+	assert.Equal(t, "recent", healthy[1].ID)
+
+	assert.Equal(t, []nbdns.SimpleRecord{
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: DNSServiceNameTTL, RData: "100.64.0.1"},
+		{Name: "db.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: DNSServiceNameTTL, RData: "100.64.0.2"},
+	}, account.getDNSServiceNameRecords("netbird.cloud", now))
+
+	assert.True(t, account.IsDNSServiceNameMember("gone"))
+	assert.False(t, account.IsDNSServiceNameMember("unknown"))
+
+	next, ok := account.GetNextDNSServiceNameTransition()
+	require.True(t, ok, "the recently seen member should be removed at the end of its grace period")
+	assert.InDelta(t, (DNSServiceNameGracePeriod - 10*time.Second).Seconds(), next.Seconds(), 1)
+
+	account.Peers["recent"].Status.Connected = true
+	_, ok = account.GetNextDNSServiceNameTransition()
+	assert.False(t, ok)
+}
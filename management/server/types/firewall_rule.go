@@ -19,6 +19,9 @@ const (
 
 // FirewallRule is a rule of the firewall.
 type FirewallRule struct {
+	// PolicyID is the ID of the policy this rule is derived from
+	PolicyID string
+
 	// PeerIP of the peer
 	PeerIP string
 
@@ -49,11 +52,21 @@ func generateRouteFirewallRules(ctx context.Context, route *nbroute.Route, rule
 	rulesExists := make(map[string]struct{})
 	rules := make([]*RouteFirewallRule, 0)
 
+	// traffic to IPv6 networks originates from the IPv6 overlay address of the peers
+	isIPv6Route := !route.IsDynamic() && route.Network.Addr().Is6()
+
 	sourceRanges := make([]string, 0, len(groupPeers))
 	for _, peer := range groupPeers {
 		if peer == nil {
 			continue
 		}
+
+		if isIPv6Route {
+			if peer.SupportsIPv6() {
+				sourceRanges = append(sourceRanges, fmt.Sprintf(AllowedIPsV6Format, peer.IPv6))
+			}
+			continue
+		}
 		sourceRanges = append(sourceRanges, fmt.Sprintf(AllowedIPsFormat, peer.IP))
 	}
 
@@ -9,6 +9,7 @@ const (
 	GroupIssuedAPI         = "api"
 	GroupIssuedJWT         = "jwt"
 	GroupIssuedIntegration = "integration"
+	GroupIssuedSCIM        = "scim"
 )
 
 // Group of the peers for ACL
@@ -22,7 +23,7 @@ type Group struct {
 	// Name visible in the UI
 	Name string
 
-	// Issued defines how this group was created (enum of "api", "integration" or "jwt")
+	// Issued defines how this group was created (enum of "api", "integration", "jwt" or "scim")
 	Issued string
 
 	// Peers list of the group
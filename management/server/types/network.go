@@ -1,6 +1,7 @@
 package types
 
 import (
+	crand "crypto/rand"
 	"math/rand"
 	"net"
 	"sync"
@@ -21,8 +22,16 @@ const (
 	// NetSize is a global network size 100.64.0.0/10
 	NetSize = 10
 
+	// NetV6Size is the size of the IPv6 overlay network of an account, e.g. fd12:3456:789a::/64
+	NetV6Size = 64
+
 	// AllowedIPsFormat generates Wireguard AllowedIPs format (e.g. 100.64.30.1/32)
 	AllowedIPsFormat = "%s/32"
+	// AllowedIPsV6Format generates Wireguard AllowedIPs format for IPv6 addresses (e.g. fd12:3456:789a::1/128)
+	AllowedIPsV6Format = "%s/128"
+
+	// maxIPv6AllocationAttempts limits the number of random interface identifiers tried when allocating a peer IPv6
+	maxIPv6AllocationAttempts = 100
 )
 
 type NetworkMap struct {
@@ -38,7 +47,9 @@ type NetworkMap struct {
 type Network struct {
 	Identifier string    `json:"id"`
 	Net        net.IPNet `gorm:"serializer:json"`
-	Dns        string
+	// NetV6 is the unique local IPv6 (ULA) network of the account
+	NetV6 net.IPNet `gorm:"serializer:json"`
+	Dns   string
 	// Serial is an ID that increments by 1 when any change to the network happened (e.g. new peer has been added).
 	// Used to synchronize state to the client apps.
 	Serial uint64
@@ -60,10 +71,33 @@ func NewNetwork() *Network {
 	return &Network{
 		Identifier: xid.New().String(),
 		Net:        sub[intn].IPNet,
+		NetV6:      NewNetworkV6(),
 		Dns:        "",
 		Serial:     0}
 }
 
+// NewNetworkV6 generates a random unique local IPv6 /64 network as described in RFC 4193,
+// e.g. fd12:3456:789a::/64 where 12:3456:789a is a random 40-bit global ID
+func NewNetworkV6() net.IPNet {
+	ip := make(net.IP, net.IPv6len)
+	ip[0] = 0xfd
+	if _, err := crand.Read(ip[1:6]); err != nil {
+		// crypto/rand never fails on supported platforms, fallback to math/rand just in case
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		_, _ = r.Read(ip[1:6])
+	}
+
+	return net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(NetV6Size, 128),
+	}
+}
+
+// HasNetV6 returns true if the IPv6 overlay network has been allocated
+func (n *Network) HasNetV6() bool {
+	return n.NetV6.IP != nil && n.NetV6.Mask != nil
+}
+
 // IncSerial increments Serial by 1 reflecting that the network state has been changed
 func (n *Network) IncSerial() {
 	n.Mu.Lock()
@@ -82,6 +116,7 @@ func (n *Network) Copy() *Network {
 	return &Network{
 		Identifier: n.Identifier,
 		Net:        n.Net,
+		NetV6:      n.NetV6,
 		Dns:        n.Dns,
 		Serial:     n.Serial,
 	}
@@ -111,6 +146,42 @@ func AllocatePeerIP(ipNet net.IPNet, takenIps []net.IP) (net.IP, error) {
 	return ips[intn], nil
 }
 
+// AllocatePeerIPv6 picks a random available IPv6 address from the given /64 network.
+// The address space is too large to enumerate, hence random interface identifiers are tried until a free one is found.
+func AllocatePeerIPv6(ipNet net.IPNet, takenIps []net.IP) (net.IP, error) {
+	ones, bits := ipNet.Mask.Size()
+	if bits != 128 || ones > NetV6Size {
+		return nil, status.Errorf(status.PreconditionFailed, "failed allocating new IPv6 for the ipNet %s - invalid network", ipNet.String())
+	}
+
+	takenIPMap := make(map[string]struct{}, len(takenIps))
+	for _, ip := range takenIps {
+		takenIPMap[ip.String()] = struct{}{}
+	}
+
+	prefix := ipNet.IP.Mask(ipNet.Mask)
+	for i := 0; i < maxIPv6AllocationAttempts; i++ {
+		ip := make(net.IP, net.IPv6len)
+		copy(ip, prefix)
+		if _, err := crand.Read(ip[8:]); err != nil {
+			return nil, status.Errorf(status.Internal, "failed generating IPv6 interface identifier: %v", err)
+		}
+
+		// avoid the subnet-router anycast address
+		if ip.Equal(prefix) {
+			continue
+		}
+
+		if _, ok := takenIPMap[ip.String()]; ok {
+			continue
+		}
+
+		return ip, nil
+	}
+
+	return nil, status.Errorf(status.PreconditionFailed, "failed allocating new IPv6 for the ipNet %s", ipNet.String())
+}
+
 // generateIPs generates a list of all possible IPs of the given network excluding IPs specified in the exclusion list
 func generateIPs(ipNet *net.IPNet, exclusions map[string]struct{}) ([]net.IP, int) {
 
@@ -15,6 +15,44 @@ func TestNewNetwork(t *testing.T) {
 	assert.Equal(t, ipNet.Contains(network.Net.IP), true)
 }
 
+func TestNewNetworkV6(t *testing.T) {
+	network := NewNetwork()
+
+	// generated net should be a unique local /64 network
+	ulaNet := net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(8, 128)}
+	assert.True(t, ulaNet.Contains(network.NetV6.IP))
+
+	ones, bits := network.NetV6.Mask.Size()
+	assert.Equal(t, NetV6Size, ones)
+	assert.Equal(t, 128, bits)
+	assert.True(t, network.HasNetV6())
+	assert.Equal(t, network.NetV6.String(), network.Copy().NetV6.String())
+}
+
+func TestAllocatePeerIPv6(t *testing.T) {
+	ipNet := net.IPNet{IP: net.ParseIP("fd12:3456:789a::"), Mask: net.CIDRMask(64, 128)}
+	var ips []net.IP
+	for i := 0; i < 1000; i++ {
+		ip, err := AllocatePeerIPv6(ipNet, ips)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, ipNet.Contains(ip), "ip %s should be part of %s", ip, ipNet.String())
+		ips = append(ips, ip)
+	}
+
+	uniq := make(map[string]struct{})
+	for _, ip := range ips {
+		if _, ok := uniq[ip.String()]; ok {
+			t.Errorf("found duplicate IP %s", ip.String())
+		}
+		uniq[ip.String()] = struct{}{}
+	}
+
+	_, err := AllocatePeerIPv6(net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.CIDRMask(16, 32)}, nil)
+	assert.Error(t, err, "allocation should fail for an IPv4 network")
+}
+
 func TestAllocatePeerIP(t *testing.T) {
 	ipNet := net.IPNet{IP: net.ParseIP("100.64.0.0"), Mask: net.IPMask{255, 255, 255, 0}}
 	var ips []net.IP
@@ -0,0 +1,224 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// scheduleTimeLayout is the layout of the start and end time of a schedule window
+const scheduleTimeLayout = "15:04"
+
+// RuleSchedule limits the time when a policy rule is applied
+type RuleSchedule struct {
+	// Timezone is the IANA time zone name the windows are evaluated in. UTC is used if empty
+	Timezone string
+
+	// Windows are the recurring weekly periods when the rule is applied.
+	// If empty, the rule is applied at any time within the validity period.
+	Windows []ScheduleWindow
+
+	// ValidFrom is the optional time from which the rule is applied
+	ValidFrom *time.Time
+
+	// ValidUntil is the optional time until which the rule is applied
+	ValidUntil *time.Time
+}
+
+// ScheduleWindow is a recurring daily period of time on a set of week days
+type ScheduleWindow struct {
+	// Days are the days of the week the window applies to. If empty, the window applies to every day
+	Days []time.Weekday
+
+	// Start of the window in HH:MM format
+	Start string
+
+	// End of the window in HH:MM format. If End is not after Start, the window ends on the following day
+	End string
+}
+
+// Copy returns a copy of the schedule
+func (s *RuleSchedule) Copy() *RuleSchedule {
+	if s == nil {
+		return nil
+	}
+
+	schedule := &RuleSchedule{
+		Timezone: s.Timezone,
+		Windows:  make([]ScheduleWindow, len(s.Windows)),
+	}
+	for i, w := range s.Windows {
+		schedule.Windows[i] = ScheduleWindow{
+			Days:  make([]time.Weekday, len(w.Days)),
+			Start: w.Start,
+			End:   w.End,
+		}
+		copy(schedule.Windows[i].Days, w.Days)
+	}
+	if s.ValidFrom != nil {
+		validFrom := *s.ValidFrom
+		schedule.ValidFrom = &validFrom
+	}
+	if s.ValidUntil != nil {
+		validUntil := *s.ValidUntil
+		schedule.ValidUntil = &validUntil
+	}
+	return schedule
+}
+
+// Validate checks that the schedule is well-formed
+func (s *RuleSchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", s.Timezone)
+	}
+
+	if s.ValidFrom != nil && s.ValidUntil != nil && !s.ValidUntil.After(*s.ValidFrom) {
+		return fmt.Errorf("valid until must be after valid from")
+	}
+
+	for _, w := range s.Windows {
+		start, err := time.Parse(scheduleTimeLayout, w.Start)
+		if err != nil {
+			return fmt.Errorf("invalid window start %q, expected HH:MM", w.Start)
+		}
+		end, err := time.Parse(scheduleTimeLayout, w.End)
+		if err != nil {
+			return fmt.Errorf("invalid window end %q, expected HH:MM", w.End)
+		}
+		if start.Equal(end) {
+			return fmt.Errorf("window start and end can't be equal")
+		}
+		for _, day := range w.Days {
+			if day < time.Sunday || day > time.Saturday {
+				return fmt.Errorf("invalid week day %d", day)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsActive returns true if the rule is applied at the given time. A nil schedule is always active.
+func (s *RuleSchedule) IsActive(t time.Time) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.ValidFrom != nil && t.Before(*s.ValidFrom) {
+		return false
+	}
+	if s.ValidUntil != nil && !t.Before(*s.ValidUntil) {
+		return false
+	}
+
+	if len(s.Windows) == 0 {
+		return true
+	}
+
+	local := t.In(s.location())
+	// a window that spans midnight may have started on the previous day
+	for _, dayOffset := range []int{0, -1} {
+		for _, w := range s.Windows {
+			start, end, ok := w.period(local, dayOffset)
+			if ok && !local.Before(start) && local.Before(end) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// NextTransition returns the first time after t when the schedule becomes active or inactive.
+// It returns false if the schedule doesn't change its state anymore.
+func (s *RuleSchedule) NextTransition(t time.Time) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+
+	var candidates []time.Time
+	if s.ValidFrom != nil && s.ValidFrom.After(t) {
+		candidates = append(candidates, *s.ValidFrom)
+	}
+	if s.ValidUntil != nil && s.ValidUntil.After(t) {
+		candidates = append(candidates, *s.ValidUntil)
+	}
+
+	local := t.In(s.location())
+	// every window repeats at least once a week, so the boundaries of the following 8 days cover the next transition
+	for dayOffset := -1; dayOffset <= 8; dayOffset++ {
+		for _, w := range s.Windows {
+			start, end, ok := w.period(local, dayOffset)
+			if !ok {
+				continue
+			}
+			if start.After(t) {
+				candidates = append(candidates, start)
+			}
+			if end.After(t) {
+				candidates = append(candidates, end)
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	active := s.IsActive(t)
+	for _, candidate := range candidates {
+		if s.IsActive(candidate) != active {
+			return candidate, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (s *RuleSchedule) location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// period returns the start and end of the window on the day at the given offset from t.
+// It returns false if the window doesn't apply to that day.
+func (w ScheduleWindow) period(t time.Time, dayOffset int) (time.Time, time.Time, bool) {
+	start, err := time.Parse(scheduleTimeLayout, w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := time.Parse(scheduleTimeLayout, w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day := t.AddDate(0, 0, dayOffset)
+	if len(w.Days) > 0 && !containsWeekday(w.Days, day.Weekday()) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	year, month, date := day.Date()
+	startTime := time.Date(year, month, date, start.Hour(), start.Minute(), 0, 0, t.Location())
+	endTime := time.Date(year, month, date, end.Hour(), end.Minute(), 0, 0, t.Location())
+	if !endTime.After(startTime) {
+		endTime = endTime.AddDate(0, 0, 1)
+	}
+
+	return startTime, endTime, true
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleSchedule_Validate(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule *RuleSchedule
+		wantErr  bool
+	}{
+		{
+			name: "nil schedule",
+		},
+		{
+			name: "valid schedule",
+			schedule: &RuleSchedule{
+				Timezone: "Europe/Berlin",
+				Windows:  []ScheduleWindow{{Days: []time.Weekday{time.Monday}, Start: "09:00", End: "17:00"}},
+			},
+		},
+		{
+			name:     "invalid timezone",
+			schedule: &RuleSchedule{Timezone: "Mars/Olympus"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid window time",
+			schedule: &RuleSchedule{Windows: []ScheduleWindow{{Start: "9am", End: "17:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "equal window start and end",
+			schedule: &RuleSchedule{Windows: []ScheduleWindow{{Start: "09:00", End: "09:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid week day",
+			schedule: &RuleSchedule{Windows: []ScheduleWindow{{Days: []time.Weekday{7}, Start: "09:00", End: "17:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "valid until before valid from",
+			schedule: &RuleSchedule{ValidFrom: &from, ValidUntil: &until},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRuleSchedule_IsActive(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	businessHours := &RuleSchedule{
+		Timezone: "Europe/Berlin",
+		Windows: []ScheduleWindow{{
+			Days:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			Start: "09:00",
+			End:   "17:00",
+		}},
+	}
+	nightShift := &RuleSchedule{
+		Windows: []ScheduleWindow{{Days: []time.Weekday{time.Friday}, Start: "22:00", End: "06:00"}},
+	}
+	validFrom := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	validityOnly := &RuleSchedule{ValidFrom: &validFrom, ValidUntil: &validUntil}
+
+	tests := []struct {
+		name     string
+		schedule *RuleSchedule
+		time     time.Time
+		expected bool
+	}{
+		{"nil schedule is always active", nil, time.Now(), true},
+		{"monday within business hours", businessHours, time.Date(2025, 3, 3, 10, 0, 0, 0, berlin), true},
+		{"monday before business hours", businessHours, time.Date(2025, 3, 3, 8, 59, 0, 0, berlin), false},
+		{"monday at end of business hours", businessHours, time.Date(2025, 3, 3, 17, 0, 0, 0, berlin), false},
+		{"business hours evaluated in schedule timezone", businessHours, time.Date(2025, 3, 3, 8, 30, 0, 0, time.UTC), true},
+		{"saturday within business hours", businessHours, time.Date(2025, 3, 8, 10, 0, 0, 0, berlin), false},
+		{"friday night before midnight", nightShift, time.Date(2025, 3, 7, 23, 0, 0, 0, time.UTC), true},
+		{"saturday morning after midnight", nightShift, time.Date(2025, 3, 8, 5, 0, 0, 0, time.UTC), true},
+		{"saturday morning after window", nightShift, time.Date(2025, 3, 8, 6, 0, 0, 0, time.UTC), false},
+		{"thursday night", nightShift, time.Date(2025, 3, 6, 23, 0, 0, 0, time.UTC), false},
+		{"before validity window", validityOnly, validFrom.Add(-time.Second), false},
+		{"within validity window", validityOnly, validFrom, true},
+		{"after validity window", validityOnly, validUntil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.schedule.IsActive(tt.time))
+		})
+	}
+}
+
+func TestRuleSchedule_NextTransition(t *testing.T) {
+	schedule := &RuleSchedule{
+		Windows: []ScheduleWindow{{Days: []time.Weekday{time.Monday}, Start: "09:00", End: "17:00"}},
+	}
+
+	// sunday
+	next, ok := schedule.NextTransition(time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC), next.UTC())
+
+	// monday within the window
+	next, ok = schedule.NextTransition(time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 3, 17, 0, 0, 0, time.UTC), next.UTC())
+
+	// monday after the window, next opening a week later
+	next, ok = schedule.NextTransition(time.Date(2025, 3, 3, 18, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), next.UTC())
+
+	validUntil := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+	expiring := &RuleSchedule{ValidUntil: &validUntil}
+	next, ok = expiring.NextTransition(time.Date(2025, 3, 3, 11, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, validUntil, next)
+
+	_, ok = expiring.NextTransition(time.Date(2025, 3, 3, 13, 0, 0, 0, time.UTC))
+	assert.False(t, ok, "expired schedule should have no further transitions")
+
+	_, ok = (*RuleSchedule)(nil).NextTransition(time.Now())
+	assert.False(t, ok)
+}
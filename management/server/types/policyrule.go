@@ -61,6 +61,9 @@ type PolicyRule struct {
 
 	// PortRanges a list of port ranges.
 	PortRanges []RulePortRange `gorm:"serializer:json"`
+
+	// Schedule limits the time when the rule is applied. The rule is always applied if nil
+	Schedule *RuleSchedule `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of a policy rule
@@ -78,6 +81,7 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		Protocol:      pm.Protocol,
 		Ports:         make([]string, len(pm.Ports)),
 		PortRanges:    make([]RulePortRange, len(pm.PortRanges)),
+		Schedule:      pm.Schedule.Copy(),
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
@@ -41,6 +41,13 @@ type Settings struct {
 	// RoutingPeerDNSResolutionEnabled enabled the DNS resolution on the routing peers
 	RoutingPeerDNSResolutionEnabled bool
 
+	// NetworkTrafficLogsEnabled enables the collection of network traffic events from the peers
+	NetworkTrafficLogsEnabled bool
+
+	// EventRetentionDays is the number of days the activity and network traffic events of the account are kept, 0 keeps
+	// the activity events forever and the network traffic events for the default retention period
+	EventRetentionDays int
+
 	// Extra is a dictionary of Account settings
 	Extra *account.ExtraSettings `gorm:"embedded;embeddedPrefix:extra_"`
 }
@@ -60,6 +67,8 @@ func (s *Settings) Copy() *Settings {
 		PeerInactivityExpiration:        s.PeerInactivityExpiration,
 
 		RoutingPeerDNSResolutionEnabled: s.RoutingPeerDNSResolutionEnabled,
+		NetworkTrafficLogsEnabled:       s.NetworkTrafficLogsEnabled,
+		EventRetentionDays:              s.EventRetentionDays,
 	}
 	if s.Extra != nil {
 		settings.Extra = s.Extra.Copy()
@@ -22,6 +22,7 @@ const (
 
 	UserIssuedAPI         = "api"
 	UserIssuedIntegration = "integration"
+	UserIssuedSCIM        = "scim"
 )
 
 // StrRoleToUserRole returns UserRole for a given strRole or UserRoleUnknown if the specified role is unknown
@@ -126,6 +127,11 @@ func (u *User) IsRegularUser() bool {
 	return !u.HasAdminPower() && !u.IsServiceUser
 }
 
+// HasCustomRole checks if the user role refers to an account custom role instead of a predefined one.
+func (u *User) HasCustomRole() bool {
+	return StrRoleToUserRole(string(u.Role)) == UserRoleUnknown
+}
+
 // ToUserInfo converts a User object to a UserInfo object.
 func (u *User) ToUserInfo(userData *idp.UserData, settings *Settings) (*UserInfo, error) {
 	autoGroups := u.AutoGroups
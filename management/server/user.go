@@ -16,6 +16,8 @@ import (
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
@@ -36,14 +38,19 @@ func (am *DefaultAccountManager) createServiceUser(ctx context.Context, accountI
 	if executingUser == nil {
 		return nil, status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can create service users")
+
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Create); err != nil {
+		return nil, err
 	}
 
 	if role == types.UserRoleOwner {
 		return nil, status.Errorf(status.InvalidArgument, "can't create a service user with owner role")
 	}
 
+	if err = validateRoleAssignment(executingUser, role); err != nil {
+		return nil, err
+	}
+
 	newUserID := uuid.New().String()
 	newUser := types.NewUser(newUserID, role, true, nonDeletable, serviceUserName, autoGroups, types.UserIssuedAPI)
 	log.WithContext(ctx).Debugf("New User: %v", newUser)
@@ -73,7 +80,11 @@ func (am *DefaultAccountManager) createServiceUser(ctx context.Context, accountI
 // CreateUser creates a new user under the given account. Effectively this is a user invite.
 func (am *DefaultAccountManager) CreateUser(ctx context.Context, accountID, userID string, user *types.UserInfo) (*types.UserInfo, error) {
 	if user.IsServiceUser {
-		return am.createServiceUser(ctx, accountID, userID, types.StrRoleToUserRole(user.Role), user.Name, user.NonDeletable, user.AutoGroups)
+		role, err := am.resolveUserRole(ctx, accountID, user.Role)
+		if err != nil {
+			return nil, err
+		}
+		return am.createServiceUser(ctx, accountID, userID, role, user.Name, user.NonDeletable, user.AutoGroups)
 	}
 	return am.inviteNewUser(ctx, accountID, userID, user)
 }
@@ -91,7 +102,10 @@ func (am *DefaultAccountManager) inviteNewUser(ctx context.Context, accountID, u
 		return nil, fmt.Errorf("provided user update is nil")
 	}
 
-	invitedRole := types.StrRoleToUserRole(invite.Role)
+	invitedRole, err := am.resolveUserRole(ctx, accountID, invite.Role)
+	if err != nil {
+		return nil, err
+	}
 
 	switch {
 	case invite.Name == "":
@@ -113,6 +127,14 @@ func (am *DefaultAccountManager) inviteNewUser(ctx context.Context, accountID, u
 		return nil, status.Errorf(status.NotFound, "initiator user with ID %s doesn't exist", userID)
 	}
 
+	if err = am.validateUserPermissions(ctx, accountID, userID, modules.Users, operations.Create); err != nil {
+		return nil, err
+	}
+
+	if err = validateRoleAssignment(initiatorUser, invitedRole); err != nil {
+		return nil, err
+	}
+
 	inviterID := userID
 	if initiatorUser.IsServiceUser {
 		inviterID = account.CreatedBy
@@ -249,8 +271,8 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 	if executingUser == nil {
 		return status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
-		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users")
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Delete); err != nil {
+		return err
 	}
 
 	targetUser := account.Users[targetUserID]
@@ -262,6 +284,10 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 		return status.Errorf(status.PermissionDenied, "unable to delete a user with owner role")
 	}
 
+	if !executingUser.HasAdminPower() && targetUser.HasAdminPower() {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users with admin power")
+	}
+
 	// disable deleting integration user if the initiator is not admin service user
 	if targetUser.Issued == types.UserIssuedIntegration && !executingUser.IsServiceUser {
 		return status.Errorf(status.PermissionDenied, "only integration service user can delete this user")
@@ -316,7 +342,7 @@ func (am *DefaultAccountManager) deleteUserPeers(ctx context.Context, initiatorU
 		peerIDs = append(peerIDs, peer.ID)
 	}
 
-	return hadPeers, am.deletePeers(ctx, account, peerIDs, initiatorUserID)
+	return hadPeers, am.deletePeers(ctx, account, peerIDs, initiatorUserID, activity.PeerRemovedByUser)
 }
 
 // InviteUser resend invitations to users who haven't activated their accounts prior to the expiration period.
@@ -328,6 +354,10 @@ func (am *DefaultAccountManager) InviteUser(ctx context.Context, accountID strin
 		return status.Errorf(status.PreconditionFailed, "IdP manager must be enabled to send user invites")
 	}
 
+	if err := am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Create); err != nil {
+		return err
+	}
+
 	account, err := am.Store.GetAccount(ctx, accountID)
 	if err != nil {
 		return status.Errorf(status.NotFound, "account %s doesn't exist", accountID)
@@ -387,8 +417,8 @@ func (am *DefaultAccountManager) CreatePAT(ctx context.Context, accountID string
 		return nil, status.Errorf(status.NotFound, "user not found")
 	}
 
-	if !(initiatorUserID == targetUserID || (executingUser.HasAdminPower() && targetUser.IsServiceUser)) {
-		return nil, status.Errorf(status.PermissionDenied, "no permission to create PAT for this user")
+	if err = am.validatePATPermissions(ctx, accountID, initiatorUserID, targetUser, operations.Create); err != nil {
+		return nil, err
 	}
 
 	pat, err := types.CreateNewPAT(tokenName, expiresIn, executingUser.Id)
@@ -424,13 +454,12 @@ func (am *DefaultAccountManager) DeletePAT(ctx context.Context, accountID string
 		return status.Errorf(status.NotFound, "user not found")
 	}
 
-	executingUser, ok := account.Users[initiatorUserID]
-	if !ok {
+	if _, ok = account.Users[initiatorUserID]; !ok {
 		return status.Errorf(status.NotFound, "user not found")
 	}
 
-	if !(initiatorUserID == targetUserID || (executingUser.HasAdminPower() && targetUser.IsServiceUser)) {
-		return status.Errorf(status.PermissionDenied, "no permission to delete PAT for this user")
+	if err = am.validatePATPermissions(ctx, accountID, initiatorUserID, targetUser, operations.Delete); err != nil {
+		return err
 	}
 
 	pat := targetUser.PATs[tokenID]
@@ -471,8 +500,12 @@ func (am *DefaultAccountManager) GetPAT(ctx context.Context, accountID string, i
 		return nil, err
 	}
 
-	if (initiatorUserID != targetUserID && !initiatorUser.IsAdminOrServiceUser()) || initiatorUser.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "no permission to get PAT for this user")
+	if initiatorUser.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if err = am.validatePATPermissions(ctx, accountID, initiatorUserID, targetUser, operations.Read); err != nil {
+		return nil, err
 	}
 
 	for _, pat := range targetUser.PATsG {
@@ -496,8 +529,12 @@ func (am *DefaultAccountManager) GetAllPATs(ctx context.Context, accountID strin
 		return nil, err
 	}
 
-	if (initiatorUserID != targetUserID && !initiatorUser.IsAdminOrServiceUser()) || initiatorUser.AccountID != accountID {
-		return nil, status.Errorf(status.PermissionDenied, "no permission to get PAT for this user")
+	if initiatorUser.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if err = am.validatePATPermissions(ctx, accountID, initiatorUserID, targetUser, operations.Read); err != nil {
+		return nil, err
 	}
 
 	pats := make([]*types.PersonalAccessToken, 0, len(targetUser.PATsG))
@@ -508,6 +545,30 @@ func (am *DefaultAccountManager) GetAllPATs(ctx context.Context, accountID strin
 	return pats, nil
 }
 
+// validatePATPermissions checks whether the initiator can access the PATs of the target user. Users can always manage
+// their own tokens, the tokens of other users require the PATs permission and only tokens of service users can be modified.
+func (am *DefaultAccountManager) validatePATPermissions(ctx context.Context, accountID, initiatorUserID string, targetUser *types.User, operation operations.Operation) error {
+	if initiatorUserID == targetUser.Id {
+		return nil
+	}
+
+	if operation != operations.Read && !targetUser.IsServiceUser {
+		return status.Errorf(status.PermissionDenied, "no permission to manage PATs of this user")
+	}
+
+	if operation != operations.Read && targetUser.HasAdminPower() {
+		initiatorUser, err := am.Store.GetUserByUserID(ctx, store.LockingStrengthShare, initiatorUserID)
+		if err != nil {
+			return err
+		}
+		if !initiatorUser.HasAdminPower() {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can manage PATs of users with admin power")
+		}
+	}
+
+	return am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Pats, operation)
+}
+
 // SaveUser saves updates to the given user. If the user doesn't exist, it will throw status.NotFound error.
 func (am *DefaultAccountManager) SaveUser(ctx context.Context, accountID, initiatorUserID string, update *types.User) (*types.UserInfo, error) {
 	return am.SaveOrAddUser(ctx, accountID, initiatorUserID, update, false) // false means do not create user and throw status.NotFound
@@ -553,8 +614,8 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 		return nil, err
 	}
 
-	if !initiatorUser.HasAdminPower() || initiatorUser.IsBlocked() {
-		return nil, status.Errorf(status.PermissionDenied, "only users with admin power are authorized to perform user update operations")
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Update); err != nil {
+		return nil, err
 	}
 
 	updatedUsers := make([]*types.UserInfo, 0, len(updates))
@@ -584,6 +645,15 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 			return nil, err
 		}
 
+		if oldUser == update || oldUser.Role != update.Role {
+			if _, err := am.resolveUserRole(ctx, accountID, string(update.Role)); err != nil {
+				return nil, err
+			}
+			if err := validateRoleAssignment(initiatorUser, update.Role); err != nil {
+				return nil, err
+			}
+		}
+
 		// only auto groups, revoked status, and integration reference can be updated for now
 		newUser := oldUser.Copy()
 		newUser.Role = update.Role
@@ -778,6 +848,9 @@ func getUserInfo(ctx context.Context, am *DefaultAccountManager, user *types.Use
 
 // validateUserUpdate validates the update operation for a user.
 func validateUserUpdate(account *types.Account, initiatorUser, oldUser, update *types.User) error {
+	if !initiatorUser.HasAdminPower() && oldUser.HasAdminPower() {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can update users with admin power")
+	}
 	if initiatorUser.HasAdminPower() && initiatorUser.Id == update.Id && oldUser.Blocked != update.Blocked {
 		return status.Errorf(status.PermissionDenied, "admins can't block or unblock themselves")
 	}
@@ -863,6 +936,12 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 		return nil, err
 	}
 
+	// users without the permission to view users only see themselves
+	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Users, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+
 	queriedUsers := make([]*idp.UserData, 0)
 	if !isNil(am.idpManager) {
 		users := make(map[string]userLoggedInOnce, len(account.Users))
@@ -897,7 +976,7 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 	// in case of self-hosted, or IDP doesn't return anything, we will return the locally stored userInfo
 	if len(queriedUsers) == 0 {
 		for _, accountUser := range account.Users {
-			if !(user.HasAdminPower() || user.IsServiceUser || user.Id == accountUser.Id) {
+			if !allowed && user.Id != accountUser.Id {
 				// if user is not an admin then show only current user and do not show other users
 				continue
 			}
@@ -911,7 +990,7 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 	}
 
 	for _, localUser := range account.Users {
-		if !(user.HasAdminPower() || user.IsServiceUser) && user.Id != localUser.Id {
+		if !allowed && user.Id != localUser.Id {
 			// if user is not an admin then show only current user and do not show other users
 			continue
 		}
@@ -1038,8 +1117,8 @@ func (am *DefaultAccountManager) DeleteRegularUsers(ctx context.Context, account
 	if executingUser == nil {
 		return status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
-		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users")
+	if err = am.validateUserPermissions(ctx, accountID, initiatorUserID, modules.Users, operations.Delete); err != nil {
+		return err
 	}
 
 	var (
@@ -1065,6 +1144,11 @@ func (am *DefaultAccountManager) DeleteRegularUsers(ctx context.Context, account
 			continue
 		}
 
+		if !executingUser.HasAdminPower() && targetUser.HasAdminPower() {
+			allErrors = errors.Join(allErrors, fmt.Errorf("only users with admin power can delete a user: %s with admin power", targetUserID))
+			continue
+		}
+
 		// disable deleting integration user if the initiator is not admin service user
 		if targetUser.Issued == types.UserIssuedIntegration && !executingUser.IsServiceUser {
 			allErrors = errors.Join(allErrors, errors.New("only integration service user can delete this user"))
@@ -10,11 +10,11 @@ import (
 	"github.com/eko/gocache/v3/cache"
 	cacheStore "github.com/eko/gocache/v3/store"
 	"github.com/google/go-cmp/cmp"
-	"github.com/netbirdio/netbird/management/server/util"
 
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/management/server/util"
 
 	gocache "github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
@@ -25,6 +25,8 @@ import (
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/integration_reference"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/users"
 )
 
 const (
@@ -59,8 +61,9 @@ func TestUser_CreatePAT_ForSameUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockExpiresIn)
@@ -107,8 +110,9 @@ func TestUser_CreatePAT_ForDifferentUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
@@ -133,8 +137,9 @@ func TestUser_CreatePAT_ForServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	pat, err := am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockTargetUserId, mockTokenName, mockExpiresIn)
@@ -160,8 +165,9 @@ func TestUser_CreatePAT_WithWrongExpiration(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenName, mockWrongExpiresIn)
@@ -183,8 +189,9 @@ func TestUser_CreatePAT_WithEmptyName(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	_, err = am.CreatePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockEmptyTokenName, mockExpiresIn)
@@ -214,8 +221,9 @@ func TestUser_DeletePAT(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	err = am.DeletePAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenID1)
@@ -255,8 +263,9 @@ func TestUser_GetPAT(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	pat, err := am.GetPAT(context.Background(), mockAccountID, mockUserID, mockUserID, mockTokenID1)
@@ -296,8 +305,9 @@ func TestUser_GetAllPATs(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	pats, err := am.GetAllPATs(context.Background(), mockAccountID, mockUserID, mockUserID)
@@ -390,8 +400,9 @@ func TestUser_CreateServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	user, err := am.createServiceUser(context.Background(), mockAccountID, mockUserID, mockRole, mockServiceUserName, false, []string{"group1", "group2"})
@@ -435,8 +446,9 @@ func TestUser_CreateUser_ServiceUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	user, err := am.CreateUser(context.Background(), mockAccountID, mockUserID, &types.UserInfo{
@@ -481,8 +493,9 @@ func TestUser_CreateUser_RegularUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	_, err = am.CreateUser(context.Background(), mockAccountID, mockUserID, &types.UserInfo{
@@ -510,9 +523,10 @@ func TestUser_InviteNewUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:        store,
-		eventStore:   &activity.InMemoryEventStore{},
-		cacheLoading: map[string]chan struct{}{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		cacheLoading:       map[string]chan struct{}{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	goCacheClient := gocache.New(CacheExpirationMax, 30*time.Minute)
@@ -615,8 +629,9 @@ func TestUser_DeleteUser_ServiceUser(t *testing.T) {
 			}
 
 			am := DefaultAccountManager{
-				Store:      store,
-				eventStore: &activity.InMemoryEventStore{},
+				Store:              store,
+				eventStore:         &activity.InMemoryEventStore{},
+				permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 			}
 
 			err = am.DeleteUser(context.Background(), mockAccountID, mockUserID, mockServiceUserID)
@@ -651,8 +666,9 @@ func TestUser_DeleteUser_SelfDelete(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	err = am.DeleteUser(context.Background(), mockAccountID, mockUserID, mockUserID)
@@ -706,6 +722,7 @@ func TestUser_DeleteUser_regularUser(t *testing.T) {
 		Store:                   store,
 		eventStore:              &activity.InMemoryEventStore{},
 		integratedPeerValidator: MocIntegratedValidator{},
+		permissionsManager:      permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	testCases := []struct {
@@ -814,6 +831,7 @@ func TestUser_DeleteUser_RegularUsers(t *testing.T) {
 		Store:                   store,
 		eventStore:              &activity.InMemoryEventStore{},
 		integratedPeerValidator: MocIntegratedValidator{},
+		permissionsManager:      permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	testCases := []struct {
@@ -917,8 +935,9 @@ func TestDefaultAccountManager_GetUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	claims := jwtclaims.AuthorizationClaims{
@@ -952,8 +971,9 @@ func TestDefaultAccountManager_ListUsers(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	users, err := am.ListUsers(context.Background(), mockAccountID)
@@ -1039,8 +1059,9 @@ func TestDefaultAccountManager_ListUsers_DashboardPermissions(t *testing.T) {
 			}
 
 			am := DefaultAccountManager{
-				Store:      store,
-				eventStore: &activity.InMemoryEventStore{},
+				Store:              store,
+				eventStore:         &activity.InMemoryEventStore{},
+				permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 			}
 
 			users, err := am.ListUsers(context.Background(), mockAccountID)
@@ -1092,6 +1113,7 @@ func TestDefaultAccountManager_ExternalCache(t *testing.T) {
 		externalCacheManager: cache.New[*idp.UserData](
 			cacheStore.NewGoCache(gocache.New(CacheExpirationMax, 30*time.Minute)),
 		),
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	// pretend that we receive mockUserID from IDP
@@ -1145,8 +1167,9 @@ func TestUser_GetUsersFromAccount_ForAdmin(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	users, err := am.GetUsersFromAccount(context.Background(), mockAccountID, mockUserID)
@@ -1177,8 +1200,9 @@ func TestUser_GetUsersFromAccount_ForUser(t *testing.T) {
 	}
 
 	am := DefaultAccountManager{
-		Store:      store,
-		eventStore: &activity.InMemoryEventStore{},
+		Store:              store,
+		eventStore:         &activity.InMemoryEventStore{},
+		permissionsManager: permissions.NewManager(store, users.NewManager(store)),
 	}
 
 	users, err := am.GetUsersFromAccount(context.Background(), mockAccountID, mockServiceUserID)
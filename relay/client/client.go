@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -20,6 +22,7 @@ import (
 const (
 	bufferSize            = 8820
 	serverResponseTimeout = 8 * time.Second
+	probeTimeout          = 5 * time.Second
 )
 
 var (
@@ -138,11 +141,18 @@ type Client struct {
 	readLoopMutex    sync.Mutex
 	wgReadLoop       sync.WaitGroup
 	instanceURL      *RelayAddr
-	muInstanceURL    sync.Mutex
+	// clusterURLs are the instance URLs of the relay servers the server forwards transport messages to
+	clusterURLs   []string
+	muInstanceURL sync.Mutex
 
 	onDisconnectListener func(string)
 	onConnectedListener  func()
 	listenerMutex        sync.Mutex
+
+	// probeMu serializes the RTT measurements, the echo of the probe sent at probeSentAt is delivered on probeResult
+	probeMu     sync.Mutex
+	probeSentAt atomic.Int64
+	probeResult chan time.Duration
 }
 
 // NewClient creates a new client for the relay server. The client is not connected to the server until the Connect
@@ -160,7 +170,8 @@ func NewClient(ctx context.Context, serverURL string, authTokenStore *auth.Token
 				return &buf
 			},
 		},
-		conns: make(map[string]*connContainer),
+		conns:       make(map[string]*connContainer),
+		probeResult: make(chan time.Duration, 1),
 	}
 	c.log.Infof("create new relay connection: local peerID: %s, local peer hashedID: %s", peerID, hashedStringId)
 	return c
@@ -231,6 +242,51 @@ func (c *Client) ServerInstanceURL() (string, error) {
 	return c.instanceURL.String(), nil
 }
 
+// ForwardsToInstance returns true if the relay server forwards the transport messages to the peers connected to the
+// given relay instance. In this case there is no need to connect to that relay server.
+func (c *Client) ForwardsToInstance(instanceURL string) bool {
+	c.muInstanceURL.Lock()
+	defer c.muInstanceURL.Unlock()
+	return slices.Contains(c.clusterURLs, instanceURL)
+}
+
+// MeasureRTT measures the round trip time to the relay server with a health check probe. Relay servers not supporting
+// the probes do not answer it, in this case the measurement fails after a timeout.
+func (c *Client) MeasureRTT(ctx context.Context) (time.Duration, error) {
+	c.probeMu.Lock()
+	defer c.probeMu.Unlock()
+
+	c.mu.Lock()
+	if !c.serviceIsRunning {
+		c.mu.Unlock()
+		return 0, fmt.Errorf("relay connection is not established")
+	}
+	relayConn := c.relayConn
+	c.mu.Unlock()
+
+	// drop the echo of a previous probe that arrived after its timeout
+	select {
+	case <-c.probeResult:
+	default:
+	}
+
+	sentAt := time.Now()
+	c.probeSentAt.Store(sentAt.UnixNano())
+	if _, err := relayConn.Write(messages.MarshalHealthCheckProbe(sentAt)); err != nil {
+		return 0, fmt.Errorf("send health check probe: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	select {
+	case rtt := <-c.probeResult:
+		return rtt, nil
+	case <-ctx.Done():
+		return 0, fmt.Errorf("health check probe timed out")
+	}
+}
+
 // SetOnDisconnectListener sets a function that will be called when the connection to the relay server is closed.
 func (c *Client) SetOnDisconnectListener(fn func(string)) {
 	c.listenerMutex.Lock()
@@ -294,20 +350,8 @@ func (c *Client) handShake() error {
 		return err
 	}
 	buf := make([]byte, messages.MaxHandshakeRespSize)
-	n, err := c.readWithTimeout(buf)
+	n, msgType, err := c.readHandshakeResponse(buf)
 	if err != nil {
-		c.log.Errorf("failed to read auth response: %s", err)
-		return err
-	}
-
-	_, err = messages.ValidateVersion(buf[:n])
-	if err != nil {
-		return fmt.Errorf("validate version: %w", err)
-	}
-
-	msgType, err := messages.DetermineServerMessageType(buf[:n])
-	if err != nil {
-		c.log.Errorf("failed to determine message type: %s", err)
 		return err
 	}
 
@@ -327,6 +371,34 @@ func (c *Client) handShake() error {
 	return nil
 }
 
+// readHandshakeResponse reads the response of the server to the auth message. The cluster info message is sent right
+// after the response, but the datagrams of QUIC can be reordered, so it could arrive first.
+func (c *Client) readHandshakeResponse(buf []byte) (int, messages.MsgType, error) {
+	for {
+		n, err := c.readWithTimeout(buf)
+		if err != nil {
+			c.log.Errorf("failed to read auth response: %s", err)
+			return 0, messages.MsgTypeUnknown, err
+		}
+
+		_, err = messages.ValidateVersion(buf[:n])
+		if err != nil {
+			return 0, messages.MsgTypeUnknown, fmt.Errorf("validate version: %w", err)
+		}
+
+		msgType, err := messages.DetermineServerMessageType(buf[:n])
+		if err != nil {
+			c.log.Errorf("failed to determine message type: %s", err)
+			return 0, messages.MsgTypeUnknown, err
+		}
+
+		if msgType != messages.MsgTypeClusterInfo {
+			return n, msgType, nil
+		}
+		c.handleClusterInfo(buf[:n])
+	}
+}
+
 func (c *Client) readLoop(relayConn net.Conn) {
 	internallyStoppedFlag := newInternalStopFlag()
 	hc := healthcheck.NewReceiver(c.log)
@@ -376,6 +448,7 @@ func (c *Client) readLoop(relayConn net.Conn) {
 
 	c.muInstanceURL.Lock()
 	c.instanceURL = nil
+	c.clusterURLs = nil
 	c.muInstanceURL.Unlock()
 
 	c.wgReadLoop.Done()
@@ -386,10 +459,17 @@ func (c *Client) readLoop(relayConn net.Conn) {
 func (c *Client) handleMsg(msgType messages.MsgType, buf []byte, bufPtr *[]byte, hc *healthcheck.Receiver, internallyStoppedFlag *internalStopFlag) (continueLoop bool) {
 	switch msgType {
 	case messages.MsgTypeHealthCheck:
-		c.handleHealthCheck(hc, internallyStoppedFlag)
+		if sentAt, ok := messages.UnmarshalHealthCheckProbe(buf); ok {
+			c.handleHealthCheckProbe(sentAt)
+		} else {
+			c.handleHealthCheck(hc, internallyStoppedFlag)
+		}
 		c.bufPool.Put(bufPtr)
 	case messages.MsgTypeTransport:
 		return c.handleTransportMsg(buf, bufPtr, internallyStoppedFlag)
+	case messages.MsgTypeClusterInfo:
+		c.handleClusterInfo(buf)
+		c.bufPool.Put(bufPtr)
 	case messages.MsgTypeClose:
 		c.log.Debugf("relay connection close by server")
 		c.bufPool.Put(bufPtr)
@@ -410,6 +490,31 @@ func (c *Client) handleHealthCheck(hc *healthcheck.Receiver, internallyStoppedFl
 	hc.Heartbeat()
 }
 
+func (c *Client) handleHealthCheckProbe(sentAt time.Time) {
+	if sentAt.UnixNano() != c.probeSentAt.Load() {
+		c.log.Debugf("received an outdated health check probe")
+		return
+	}
+
+	select {
+	case c.probeResult <- time.Since(sentAt):
+	default:
+	}
+}
+
+func (c *Client) handleClusterInfo(buf []byte) {
+	clusterURLs, err := messages.UnmarshalClusterInfoMsg(buf)
+	if err != nil {
+		c.log.Errorf("failed to parse cluster info message: %v", err)
+		return
+	}
+
+	c.log.Debugf("relay server forwards to: %v", clusterURLs)
+	c.muInstanceURL.Lock()
+	c.clusterURLs = clusterURLs
+	c.muInstanceURL.Unlock()
+}
+
 func (c *Client) handleTransportMsg(buf []byte, bufPtr *[]byte, internallyStoppedFlag *internalStopFlag) bool {
 	peerID, payload, err := messages.UnmarshalTransportMsg(buf)
 	if err != nil {
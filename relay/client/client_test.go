@@ -710,3 +710,51 @@ func waitForServerToStart(errChan chan error) error {
 	}
 	return nil
 }
+
+func TestMeasureRTT(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := server.NewServer(otel.Meter(""), serverURL, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		listenCfg := server.ListenerConfig{Address: serverListenAddr}
+		err := srv.Listen(listenCfg)
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		err := srv.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	clientAlice := NewClient(ctx, serverURL, hmacTokenStore, "alice")
+	if _, err := clientAlice.MeasureRTT(ctx); err == nil {
+		t.Fatalf("expected error before connect")
+	}
+
+	if err := clientAlice.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientAlice.Close()
+
+	for i := 0; i < 3; i++ {
+		rtt, err := clientAlice.MeasureRTT(ctx)
+		if err != nil {
+			t.Fatalf("failed to measure rtt: %s", err)
+		}
+		if rtt <= 0 || rtt > probeTimeout {
+			t.Errorf("unexpected rtt: %s", rtt)
+		}
+	}
+}
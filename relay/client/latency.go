@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// rttSmoothingFactor is the weight of a new measurement in the smoothed round trip time
+	rttSmoothingFactor = 0.3
+	// probeSamples is the number of probes of a measurement, the fastest one is used to filter out the noise
+	probeSamples = 3
+	// probePeerIDSuffix makes the probe connections register with their own peer ID on the relay server, so they never
+	// replace a connection of the peer to the same relay server
+	probePeerIDSuffix = "/rtt-probe"
+
+	// a relay server must be faster than the home relay server by both thresholds to replace it
+	migrationMinImprovement = 20 * time.Millisecond
+	migrationMaxRatio       = 0.7
+)
+
+var (
+	homeProbeInterval   = 30 * time.Second
+	serverProbeInterval = 5 * time.Minute
+	// migrationRounds is the number of consecutive server probe rounds the same relay server must be clearly faster
+	// than the home relay server before the manager migrates to it
+	migrationRounds = 2
+)
+
+// latencyTracker holds the smoothed round trip times to the relay servers by server URL
+type latencyTracker struct {
+	mu   sync.Mutex
+	rtts map[string]time.Duration
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{
+		rtts: make(map[string]time.Duration),
+	}
+}
+
+func (t *latencyTracker) record(serverURL string, rtt time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, ok := t.rtts[serverURL]
+	if !ok {
+		t.rtts[serverURL] = rtt
+		return
+	}
+	t.rtts[serverURL] = time.Duration(rttSmoothingFactor*float64(rtt) + (1-rttSmoothingFactor)*float64(prev))
+}
+
+func (t *latencyTracker) rtt(serverURL string) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rtt, ok := t.rtts[serverURL]
+	return rtt, ok
+}
+
+// retain forgets the measurements of the relay servers not in the list
+func (t *latencyTracker) retain(serverURLs []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for serverURL := range t.rtts {
+		if !slices.Contains(serverURLs, serverURL) {
+			delete(t.rtts, serverURL)
+		}
+	}
+}
+
+// betterHomeServer returns the fastest relay server that is clearly faster than the home relay server. Relay servers
+// of the same region as the home relay server are not considered, the servers of a region are expected to be
+// equally fast. Servers without a region hint are a region on their own.
+func (t *latencyTracker) betterHomeServer(homeURL string, serverURLs []string, regions map[string]string) (string, bool) {
+	homeRTT, ok := t.rtt(homeURL)
+	if !ok {
+		return "", false
+	}
+
+	regionOf := func(serverURL string) string {
+		if region, ok := regions[serverURL]; ok && region != "" {
+			return region
+		}
+		return serverURL
+	}
+	homeRegion := regionOf(homeURL)
+
+	var (
+		bestURL string
+		bestRTT time.Duration
+	)
+	for _, serverURL := range serverURLs {
+		if regionOf(serverURL) == homeRegion {
+			continue
+		}
+		rtt, ok := t.rtt(serverURL)
+		if !ok {
+			continue
+		}
+		if bestURL == "" || rtt < bestRTT {
+			bestURL, bestRTT = serverURL, rtt
+		}
+	}
+
+	if bestURL == "" {
+		return "", false
+	}
+	if homeRTT-bestRTT < migrationMinImprovement || float64(bestRTT) > float64(homeRTT)*migrationMaxRatio {
+		return "", false
+	}
+	return bestURL, true
+}
+
+// startLatencyMonitor measures the round trip time to the relay servers and migrates the home relay server if another
+// relay server stays clearly faster for several rounds
+func (m *Manager) startLatencyMonitor() {
+	homeTicker := time.NewTicker(homeProbeInterval)
+	defer homeTicker.Stop()
+	serverTicker := time.NewTicker(serverProbeInterval)
+	defer serverTicker.Stop()
+
+	var (
+		candidate string
+		rounds    int
+	)
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-homeTicker.C:
+			m.probeHomeServer()
+		case <-serverTicker.C:
+			serverURLs := m.ServerURLs()
+			m.latency.retain(serverURLs)
+			m.probeServers(serverURLs)
+
+			homeURL, ok := m.homeServerURL()
+			if !ok {
+				candidate, rounds = "", 0
+				continue
+			}
+
+			serverURL, ok := m.latency.betterHomeServer(homeURL, serverURLs, m.serverRegions())
+			if !ok {
+				candidate, rounds = "", 0
+				continue
+			}
+			if serverURL != candidate {
+				candidate, rounds = serverURL, 0
+			}
+			rounds++
+
+			if rounds < migrationRounds {
+				log.Debugf("relay server %s is faster than the home relay server %s", serverURL, homeURL)
+				continue
+			}
+			candidate, rounds = "", 0
+			if err := m.migrateHomeServer(serverURL); err != nil {
+				log.Errorf("failed to migrate home relay server to %s: %s", serverURL, err)
+			}
+		}
+	}
+}
+
+// probeHomeServer measures the round trip time on the connection of the home relay server
+func (m *Manager) probeHomeServer() {
+	m.relayClientMu.Lock()
+	relayClient := m.relayClient
+	m.relayClientMu.Unlock()
+
+	if relayClient == nil || !relayClient.Ready() {
+		return
+	}
+
+	rtt, err := measureRTT(m.ctx, relayClient)
+	if err != nil {
+		log.Debugf("failed to measure the round trip time to the home relay server %s: %s", relayClient.connectionURL, err)
+		return
+	}
+	m.latency.record(relayClient.connectionURL, rtt)
+}
+
+// probeServers measures the round trip time to the relay servers, the home relay server included
+func (m *Manager) probeServers(serverURLs []string) {
+	m.probeHomeServer()
+
+	homeURL, _ := m.homeServerURL()
+
+	wg := sync.WaitGroup{}
+	concurrentLimiter := make(chan struct{}, maxConcurrentServers)
+	for _, serverURL := range serverURLs {
+		if serverURL == homeURL {
+			continue
+		}
+
+		concurrentLimiter <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-concurrentLimiter
+				wg.Done()
+			}()
+
+			rtt, err := m.probeServer(serverURL)
+			if err != nil {
+				log.Debugf("failed to measure the round trip time to the relay server %s: %s", serverURL, err)
+				return
+			}
+			m.latency.record(serverURL, rtt)
+		}()
+	}
+	wg.Wait()
+}
+
+// probeServer connects to the relay server to measure the round trip time
+func (m *Manager) probeServer(serverURL string) (time.Duration, error) {
+	probeClient := NewClient(m.ctx, serverURL, m.tokenStore, m.peerID+probePeerIDSuffix)
+	if err := probeClient.Connect(); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := probeClient.Close(); err != nil {
+			log.Debugf("failed to close the probe connection to %s: %s", serverURL, err)
+		}
+	}()
+
+	return measureRTT(m.ctx, probeClient)
+}
+
+// measureRTT returns the fastest of several round trip time measurements
+func measureRTT(ctx context.Context, relayClient *Client) (time.Duration, error) {
+	var best time.Duration
+	for i := range probeSamples {
+		rtt, err := relayClient.MeasureRTT(ctx)
+		if err != nil {
+			// most likely the server does not support the probes, do not wait for the timeout of the other samples
+			return 0, err
+		}
+		if i == 0 || rtt < best {
+			best = rtt
+		}
+	}
+	return best, nil
+}
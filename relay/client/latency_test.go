@@ -0,0 +1,93 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencyTracker_Record(t *testing.T) {
+	tracker := newLatencyTracker()
+
+	if _, ok := tracker.rtt("rels://a"); ok {
+		t.Fatalf("expected no measurement")
+	}
+
+	tracker.record("rels://a", 100*time.Millisecond)
+	if rtt, _ := tracker.rtt("rels://a"); rtt != 100*time.Millisecond {
+		t.Errorf("the first measurement should be used as is, got %s", rtt)
+	}
+
+	tracker.record("rels://a", 200*time.Millisecond)
+	if rtt, _ := tracker.rtt("rels://a"); rtt != 130*time.Millisecond {
+		t.Errorf("expected smoothed rtt of 130ms, got %s", rtt)
+	}
+
+	tracker.retain([]string{"rels://b"})
+	if _, ok := tracker.rtt("rels://a"); ok {
+		t.Errorf("the measurement of a removed server should be dropped")
+	}
+}
+
+func TestLatencyTracker_BetterHomeServer(t *testing.T) {
+	const (
+		home = "rels://eu-1"
+		eu2  = "rels://eu-2"
+		ap1  = "rels://ap-1"
+		ap2  = "rels://ap-2"
+	)
+	serverURLs := []string{home, eu2, ap1, ap2}
+	regions := map[string]string{home: "eu", eu2: "eu", ap1: "ap", ap2: "ap"}
+
+	tests := []struct {
+		name     string
+		rtts     map[string]time.Duration
+		regions  map[string]string
+		expected string
+	}{
+		{
+			name:     "faster region",
+			rtts:     map[string]time.Duration{home: 250 * time.Millisecond, ap1: 40 * time.Millisecond, ap2: 30 * time.Millisecond},
+			regions:  regions,
+			expected: ap2,
+		},
+		{
+			name:    "same region is ignored",
+			rtts:    map[string]time.Duration{home: 250 * time.Millisecond, eu2: 10 * time.Millisecond},
+			regions: regions,
+		},
+		{
+			name:     "servers without region hint",
+			rtts:     map[string]time.Duration{home: 250 * time.Millisecond, eu2: 10 * time.Millisecond},
+			expected: eu2,
+		},
+		{
+			name:    "improvement below the absolute threshold",
+			rtts:    map[string]time.Duration{home: 30 * time.Millisecond, ap1: 15 * time.Millisecond},
+			regions: regions,
+		},
+		{
+			name:    "improvement below the relative threshold",
+			rtts:    map[string]time.Duration{home: 250 * time.Millisecond, ap1: 200 * time.Millisecond},
+			regions: regions,
+		},
+		{
+			name:    "unknown home rtt",
+			rtts:    map[string]time.Duration{ap1: 10 * time.Millisecond},
+			regions: regions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := newLatencyTracker()
+			for serverURL, rtt := range tt.rtts {
+				tracker.record(serverURL, rtt)
+			}
+
+			serverURL, ok := tracker.betterHomeServer(home, serverURLs, tt.regions)
+			if ok != (tt.expected != "") || serverURL != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, serverURL)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"container/list"
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -68,12 +70,20 @@ type Manager struct {
 	relayClientMu  sync.Mutex
 	reconnectGuard *Guard
 
-	relayClients      map[string]*RelayTrack
+	relayClients map[string]*RelayTrack
+	// retiredClients holds the previous home relay clients with open connections that could not be kept as foreign
+	// relay clients, the cleanup loop closes them once their connections are closed
+	retiredClients    []*Client
 	relayClientsMutex sync.RWMutex
 
 	onDisconnectedListeners map[string]*list.List
 	onReconnectedListenerFn func()
 	listenerLock            sync.Mutex
+
+	latency *latencyTracker
+	// regions holds the region hints of the relay servers by server URL
+	regions   map[string]string
+	regionsMu sync.RWMutex
 }
 
 // NewManager creates a new manager instance.
@@ -91,6 +101,7 @@ func NewManager(ctx context.Context, serverURLs []string, peerID string) *Manage
 		},
 		relayClients:            make(map[string]*RelayTrack),
 		onDisconnectedListeners: make(map[string]*list.List),
+		latency:                 newLatencyTracker(),
 	}
 	m.serverPicker.ServerURLs.Store(serverURLs)
 	m.reconnectGuard = NewGuard(m.serverPicker)
@@ -117,12 +128,14 @@ func (m *Manager) Serve() error {
 
 	go m.listenGuardEvent(m.ctx)
 	go m.startCleanupLoop()
+	go m.startLatencyMonitor()
 	return err
 }
 
-// OpenConn opens a connection to the given peer key. If the peer is on the same relay server, the connection will be
-// established via the relay server. If the peer is on a different relay server, the manager will establish a new
-// connection to the relay server. It returns back with a net.Conn what represent the remote peer connection.
+// OpenConn opens a connection to the given peer key. If the peer is on the same relay server or on a relay server the
+// home relay server forwards to, the connection will be established via the home relay server. If the peer is on a
+// different relay server, the manager will establish a new connection to the relay server. It returns back with a
+// net.Conn what represent the remote peer connection.
 func (m *Manager) OpenConn(serverAddress, peerKey string) (net.Conn, error) {
 	m.relayClientMu.Lock()
 	defer m.relayClientMu.Unlock()
@@ -221,6 +234,20 @@ func (m *Manager) UpdateServerURLs(serverURLs []string) {
 	m.serverPicker.ServerURLs.Store(serverURLs)
 }
 
+// UpdateServerRegions updates the region hints of the relay servers. The manager migrates the home relay server only to
+// a relay server of another region.
+func (m *Manager) UpdateServerRegions(regions map[string]string) {
+	m.regionsMu.Lock()
+	defer m.regionsMu.Unlock()
+	m.regions = maps.Clone(regions)
+}
+
+func (m *Manager) serverRegions() map[string]string {
+	m.regionsMu.RLock()
+	defer m.regionsMu.RUnlock()
+	return m.regions
+}
+
 // UpdateToken updates the token in the token store.
 func (m *Manager) UpdateToken(token *relayAuth.Token) error {
 	return m.tokenStore.UpdateToken(token)
@@ -312,13 +339,72 @@ func (m *Manager) listenGuardEvent(ctx context.Context) {
 	}
 }
 
-func (m *Manager) storeClient(client *Client) {
+// storeClient sets the home relay client and returns the previous one
+func (m *Manager) storeClient(client *Client) *Client {
 	m.relayClientMu.Lock()
 	defer m.relayClientMu.Unlock()
 
+	prev := m.relayClient
 	m.relayClient = client
 	m.relayClient.SetOnConnectedListener(m.onServerConnected)
 	m.relayClient.SetOnDisconnectListener(m.onServerDisconnected)
+	return prev
+}
+
+// homeServerURL returns the server URL of the connected home relay server
+func (m *Manager) homeServerURL() (string, bool) {
+	m.relayClientMu.Lock()
+	defer m.relayClientMu.Unlock()
+
+	if m.relayClient == nil || !m.relayClient.Ready() {
+		return "", false
+	}
+	return m.relayClient.connectionURL, true
+}
+
+// migrateHomeServer connects to the relay server and makes it the home relay server. The connections of the previous
+// home relay server are kept open, see retireClient.
+func (m *Manager) migrateHomeServer(serverURL string) error {
+	if _, ok := m.homeServerURL(); !ok {
+		return ErrRelayClientNotConnected
+	}
+
+	log.Infof("migrate home relay server to faster relay server: %s", serverURL)
+	relayClient := NewClient(m.ctx, serverURL, m.tokenStore, m.peerID)
+	if err := relayClient.Connect(); err != nil {
+		return err
+	}
+
+	if prev := m.storeClient(relayClient); prev != nil {
+		m.retireClient(prev)
+	}
+	return nil
+}
+
+// retireClient keeps the previous home relay client as a foreign relay client while it has open connections, so the
+// migration does not break the relayed connections. The cleanup loop closes it once the connections are closed.
+func (m *Manager) retireClient(relayClient *Client) {
+	instanceURL, err := relayClient.ServerInstanceURL()
+	if err != nil || !relayClient.HasConns() {
+		relayClient.SetOnDisconnectListener(nil)
+		go func() {
+			_ = relayClient.Close()
+		}()
+		return
+	}
+
+	m.relayClientsMutex.Lock()
+	defer m.relayClientsMutex.Unlock()
+
+	if _, ok := m.relayClients[instanceURL]; ok {
+		log.Debugf("relay server %s already has a foreign relay client, keep the previous home relay client until its connections are closed", instanceURL)
+		m.retiredClients = append(m.retiredClients, relayClient)
+		return
+	}
+
+	rt := NewRelayTrack()
+	rt.relayClient = relayClient
+	m.relayClients[instanceURL] = rt
 }
 
 func (m *Manager) isForeignServer(address string) (bool, error) {
@@ -326,7 +412,11 @@ func (m *Manager) isForeignServer(address string) (bool, error) {
 	if err != nil {
 		return false, fmt.Errorf("relay client not connected")
 	}
-	return rAddr != address, nil
+	if rAddr == address {
+		return false, nil
+	}
+	// the home relay server forwards the messages to the peers of the other relay servers in its cluster
+	return !m.relayClient.ForwardsToInstance(address), nil
 }
 
 func (m *Manager) startCleanupLoop() {
@@ -372,6 +462,18 @@ func (m *Manager) cleanUpUnusedRelays() {
 		delete(m.relayClients, addr)
 		rt.Unlock()
 	}
+
+	m.retiredClients = slices.DeleteFunc(m.retiredClients, func(relayClient *Client) bool {
+		if relayClient.HasConns() {
+			return false
+		}
+		relayClient.SetOnDisconnectListener(nil)
+		go func() {
+			_ = relayClient.Close()
+		}()
+		log.Debugf("clean up retired home relay server connection: %s", relayClient.connectionURL)
+		return true
+	})
 }
 
 func (m *Manager) addListener(serverAddress string, onClosedListener OnServerCloseListener) {
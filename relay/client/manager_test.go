@@ -428,6 +428,412 @@ func TestNotifierDoubleAdd(t *testing.T) {
 
 }
 
+func TestFederatedConn(t *testing.T) {
+	ctx := context.Background()
+	federationSecret := "federation-secret"
+
+	srvCfg1 := server.ListenerConfig{
+		Address: "localhost:3234",
+	}
+	srvCfg2 := server.ListenerConfig{
+		Address: "localhost:4234",
+	}
+
+	srv1, err := server.NewServer(otel.Meter(""), srvCfg1.Address, false, av, server.WithFederation(server.FederationConfig{
+		Secret: federationSecret,
+	}))
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		err := srv1.Listen(srvCfg1)
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		err := srv1.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	// the second relay server learns the first one from its members and dials it
+	srv2, err := server.NewServer(otel.Meter(""), srvCfg2.Address, false, av, server.WithFederation(server.FederationConfig{
+		Secret:  federationSecret,
+		Members: toURL(srvCfg1),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan2 := make(chan error, 1)
+	go func() {
+		err := srv2.Listen(srvCfg2)
+		if err != nil {
+			errChan2 <- err
+		}
+	}()
+
+	defer func() {
+		err := srv2.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan2); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	mCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	idAlice := "alice"
+	clientAlice := NewManager(mCtx, toURL(srvCfg1), idAlice)
+	if err := clientAlice.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	idBob := "bob"
+	clientBob := NewManager(mCtx, toURL(srvCfg2), idBob)
+	if err := clientBob.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	alicesSrvAddr, err := clientAlice.RelayInstanceAddress()
+	if err != nil {
+		t.Fatalf("failed to get relay address: %s", err)
+	}
+	bobsSrvAddr, err := clientBob.RelayInstanceAddress()
+	if err != nil {
+		t.Fatalf("failed to get relay address: %s", err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !clientAlice.relayClient.ForwardsToInstance(bobsSrvAddr) || !clientBob.relayClient.ForwardsToInstance(alicesSrvAddr) {
+		if time.Now().After(deadline) {
+			t.Fatalf("relay servers did not link")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	connAliceToBob, err := clientAlice.OpenConn(bobsSrvAddr, idBob)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn(alicesSrvAddr, idAlice)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	if len(clientAlice.relayClients) != 0 || len(clientBob.relayClients) != 0 {
+		t.Fatalf("expected no foreign relay connections")
+	}
+
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 65535)
+		n, err := connBobToAlice.Read(buf)
+		if err != nil {
+			return
+		}
+		received <- string(buf[:n])
+	}()
+
+	// the presence of bob could reach the relay server of alice a bit later than the cluster info
+	payload := "hello bob, I am alice"
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		if _, err := connAliceToBob.Write([]byte(payload)); err != nil {
+			t.Fatalf("failed to write to channel: %s", err)
+		}
+		select {
+		case msg := <-received:
+			if msg != payload {
+				t.Fatalf("expected %s, got %s", payload, msg)
+			}
+			done = true
+		case <-ticker.C:
+		case <-timeout:
+			t.Fatalf("message was not forwarded between the relay servers")
+		}
+	}
+
+	if _, err := connBobToAlice.Write([]byte(payload)); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	buf := make([]byte, 65535)
+	n, err := connAliceToBob.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read from channel: %s", err)
+	}
+	if payload != string(buf[:n]) {
+		t.Fatalf("expected %s, got %s", payload, string(buf[:n]))
+	}
+}
+
+func TestMigrateHomeServer(t *testing.T) {
+	ctx := context.Background()
+
+	srvCfg1 := server.ListenerConfig{
+		Address: "localhost:5234",
+	}
+	srv1, err := server.NewServer(otel.Meter(""), srvCfg1.Address, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		err := srv1.Listen(srvCfg1)
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		err := srv1.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	srvCfg2 := server.ListenerConfig{
+		Address: "localhost:6234",
+	}
+	srv2, err := server.NewServer(otel.Meter(""), srvCfg2.Address, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan2 := make(chan error, 1)
+	go func() {
+		err := srv2.Listen(srvCfg2)
+		if err != nil {
+			errChan2 <- err
+		}
+	}()
+
+	defer func() {
+		err := srv2.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan2); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	mCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	idAlice := "alice"
+	clientAlice := NewManager(mCtx, toURL(srvCfg1), idAlice)
+	if err := clientAlice.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	idBob := "bob"
+	clientBob := NewManager(mCtx, toURL(srvCfg1), idBob)
+	if err := clientBob.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	oldSrvAddr, err := clientAlice.RelayInstanceAddress()
+	if err != nil {
+		t.Fatalf("failed to get relay address: %s", err)
+	}
+
+	connAliceToBob, err := clientAlice.OpenConn(oldSrvAddr, idBob)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn(oldSrvAddr, idAlice)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	if err := clientAlice.migrateHomeServer(toURL(srvCfg2)[0]); err != nil {
+		t.Fatalf("failed to migrate home relay server: %s", err)
+	}
+
+	newSrvAddr, err := clientAlice.RelayInstanceAddress()
+	if err != nil {
+		t.Fatalf("failed to get relay address: %s", err)
+	}
+	if newSrvAddr == oldSrvAddr {
+		t.Fatalf("expected new home relay server, got %s", newSrvAddr)
+	}
+	if _, ok := clientAlice.relayClients[oldSrvAddr]; !ok {
+		t.Fatalf("the previous home relay client should be kept for the open connection")
+	}
+
+	// the relayed connection survives the migration
+	payload := "hello bob, I am alice"
+	if _, err := connAliceToBob.Write([]byte(payload)); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	buf := make([]byte, 65535)
+	n, err := connBobToAlice.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read from channel: %s", err)
+	}
+	if payload != string(buf[:n]) {
+		t.Fatalf("expected %s, got %s", payload, string(buf[:n]))
+	}
+}
+
+func TestMigrateHomeServerWithForeignClient(t *testing.T) {
+	ctx := context.Background()
+
+	srvCfg1 := server.ListenerConfig{
+		Address: "localhost:5235",
+	}
+	srv1, err := server.NewServer(otel.Meter(""), srvCfg1.Address, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		err := srv1.Listen(srvCfg1)
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		err := srv1.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	srvCfg2 := server.ListenerConfig{
+		Address: "localhost:6235",
+	}
+	srv2, err := server.NewServer(otel.Meter(""), srvCfg2.Address, false, av)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan2 := make(chan error, 1)
+	go func() {
+		err := srv2.Listen(srvCfg2)
+		if err != nil {
+			errChan2 <- err
+		}
+	}()
+
+	defer func() {
+		err := srv2.Shutdown(ctx)
+		if err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan2); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	mCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	idAlice := "alice"
+	clientAlice := NewManager(mCtx, toURL(srvCfg1), idAlice)
+	if err := clientAlice.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	idBob := "bob"
+	clientBob := NewManager(mCtx, toURL(srvCfg1), idBob)
+	if err := clientBob.Serve(); err != nil {
+		t.Fatalf("failed to serve manager: %s", err)
+	}
+
+	oldSrvAddr, err := clientAlice.RelayInstanceAddress()
+	if err != nil {
+		t.Fatalf("failed to get relay address: %s", err)
+	}
+
+	connAliceToBob, err := clientAlice.OpenConn(oldSrvAddr, idBob)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+	connBobToAlice, err := clientBob.OpenConn(oldSrvAddr, idAlice)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	// a foreign relay client already tracked for the instance of the home relay server
+	foreignClient := NewClient(mCtx, toURL(srvCfg1)[0], clientAlice.tokenStore, "alice-foreign")
+	if err := foreignClient.Connect(); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer func() {
+		_ = foreignClient.Close()
+	}()
+	rt := NewRelayTrack()
+	rt.relayClient = foreignClient
+	clientAlice.relayClientsMutex.Lock()
+	clientAlice.relayClients[oldSrvAddr] = rt
+	clientAlice.relayClientsMutex.Unlock()
+
+	if err := clientAlice.migrateHomeServer(toURL(srvCfg2)[0]); err != nil {
+		t.Fatalf("failed to migrate home relay server: %s", err)
+	}
+
+	clientAlice.relayClientsMutex.RLock()
+	retired := len(clientAlice.retiredClients)
+	clientAlice.relayClientsMutex.RUnlock()
+	if retired != 1 {
+		t.Fatalf("the previous home relay client should be kept for the open connection, got %d retired clients", retired)
+	}
+
+	// the relayed connection survives the migration
+	payload := "hello bob, I am alice"
+	if _, err := connAliceToBob.Write([]byte(payload)); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	buf := make([]byte, 65535)
+	n, err := connBobToAlice.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read from channel: %s", err)
+	}
+	if payload != string(buf[:n]) {
+		t.Fatalf("expected %s, got %s", payload, string(buf[:n]))
+	}
+
+	// the retired client is closed once its connections are closed
+	if err := connAliceToBob.Close(); err != nil {
+		t.Fatalf("failed to close connection: %s", err)
+	}
+	clientAlice.cleanUpUnusedRelays()
+
+	clientAlice.relayClientsMutex.RLock()
+	retired = len(clientAlice.retiredClients)
+	clientAlice.relayClientsMutex.RUnlock()
+	if retired != 0 {
+		t.Fatalf("the retired home relay client should be closed, got %d retired clients", retired)
+	}
+}
+
 func toURL(address server.ListenerConfig) []string {
 	return []string{"rel://" + address.Address}
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/relay/auth"
 	"github.com/netbirdio/netbird/relay/server"
+	"github.com/netbirdio/netbird/signal/admin"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/util"
 )
@@ -40,6 +41,17 @@ type Config struct {
 	AuthSecret            string
 	LogLevel              string
 	LogFile               string
+	// FederationMembers are the instance URLs of other relays of the cluster. The relays forward the transport
+	// messages to the peers connected to each other.
+	FederationMembers []string
+	FederationSecret  string
+	// PeerBandwidthLimit and BandwidthLimit are the bandwidth limits in kbit/s of a single peer and of all peers,
+	// 0 means unlimited
+	PeerBandwidthLimit int
+	BandwidthLimit     int
+	// AdminAddress is the listen address of the admin API, the API is disabled if empty
+	AdminAddress string
+	AdminToken   string
 }
 
 func (c Config) Validate() error {
@@ -49,6 +61,15 @@ func (c Config) Validate() error {
 	if c.AuthSecret == "" {
 		return fmt.Errorf("auth secret is required")
 	}
+	if len(c.FederationMembers) > 0 && c.FederationSecret == "" {
+		return fmt.Errorf("federation secret is required with federation members")
+	}
+	if c.AdminAddress != "" && c.AdminToken == "" {
+		return fmt.Errorf("admin token is required with admin address")
+	}
+	if c.PeerBandwidthLimit < 0 || c.BandwidthLimit < 0 {
+		return fmt.Errorf("bandwidth limits must not be negative")
+	}
 	return nil
 }
 
@@ -87,6 +108,14 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&cobraConfig.AuthSecret, "auth-secret", "s", "", "auth secret")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogLevel, "log-level", "info", "log level")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogFile, "log-file", "console", "log file")
+	rootCmd.PersistentFlags().StringSliceVar(&cobraConfig.FederationMembers, "federation-members", nil, "instance URLs of other relays of the cluster to forward the messages to, e.g. rels://relay2.example.com:443")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.FederationSecret, "federation-secret", "", "secret shared by the relays of the cluster to authenticate each other. Enables the federation even without members, so other relays can link with this one")
+
+	rootCmd.PersistentFlags().IntVar(&cobraConfig.PeerBandwidthLimit, "peer-bandwidth-limit", 0, "bandwidth limit of a single peer in kbit/s, 0 means unlimited")
+	rootCmd.PersistentFlags().IntVar(&cobraConfig.BandwidthLimit, "bandwidth-limit", 0, "bandwidth limit of all peers in kbit/s, shared fairly between the peers, 0 means unlimited")
+
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.AdminAddress, "admin-address", "", "listen address of the admin API to list and disconnect the connected peers, e.g. 127.0.0.1:9091. Disabled if empty")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.AdminToken, "admin-token", "", "bearer token required by the admin API")
 
 	setFlagsFromEnvVars(rootCmd)
 }
@@ -141,7 +170,22 @@ func execute(cmd *cobra.Command, args []string) error {
 	hashedSecret := sha256.Sum256([]byte(cobraConfig.AuthSecret))
 	authenticator := auth.NewTimedHMACValidator(hashedSecret[:], 24*time.Hour)
 
-	srv, err := server.NewServer(metricsServer.Meter, cobraConfig.ExposedAddress, tlsSupport, authenticator)
+	var opts []server.Option
+	if cobraConfig.FederationSecret != "" {
+		opts = append(opts, server.WithFederation(server.FederationConfig{
+			Secret:  cobraConfig.FederationSecret,
+			Members: cobraConfig.FederationMembers,
+		}))
+	}
+
+	if cobraConfig.PeerBandwidthLimit > 0 || cobraConfig.BandwidthLimit > 0 {
+		opts = append(opts, server.WithRateLimit(server.RateLimitConfig{
+			PeerBytesPerSec:   kbitToBytes(cobraConfig.PeerBandwidthLimit),
+			GlobalBytesPerSec: kbitToBytes(cobraConfig.BandwidthLimit),
+		}))
+	}
+
+	srv, err := server.NewServer(metricsServer.Meter, cobraConfig.ExposedAddress, tlsSupport, authenticator, opts...)
 	if err != nil {
 		log.Debugf("failed to create relay server: %v", err)
 		return fmt.Errorf("failed to create relay server: %v", err)
@@ -153,6 +197,21 @@ func execute(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	var adminServer *admin.Server
+	if cobraConfig.AdminAddress != "" {
+		adminServer, err = admin.NewServer(cobraConfig.AdminAddress, cobraConfig.AdminToken, srv)
+		if err != nil {
+			log.Debugf("failed to create admin server: %v", err)
+			return fmt.Errorf("failed to create admin server: %v", err)
+		}
+		go func() {
+			log.Infof("running admin server: %s", adminServer.Addr)
+			if err := adminServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("failed to start admin server: %v", err)
+			}
+		}()
+	}
+
 	// it will block until exit signal
 	waitForExitSignal()
 
@@ -164,6 +223,13 @@ func execute(cmd *cobra.Command, args []string) error {
 		shutDownErrors = multierror.Append(shutDownErrors, fmt.Errorf("failed to close server: %s", err))
 	}
 
+	if adminServer != nil {
+		log.Infof("shutting down admin server")
+		if err := adminServer.Shutdown(ctx); err != nil {
+			shutDownErrors = multierror.Append(shutDownErrors, fmt.Errorf("failed to close admin server: %v", err))
+		}
+	}
+
 	log.Infof("shutting down metrics server")
 	if err := metricsServer.Shutdown(ctx); err != nil {
 		shutDownErrors = multierror.Append(shutDownErrors, fmt.Errorf("failed to close metrics server: %v", err))
@@ -171,6 +237,10 @@ func execute(cmd *cobra.Command, args []string) error {
 	return shutDownErrors
 }
 
+func kbitToBytes(kbit int) int {
+	return kbit * 1000 / 8
+}
+
 func handleTLSConfig(cfg *Config) (*tls.Config, bool, error) {
 	if cfg.LetsencryptAWSRoute53 {
 		log.Debugf("using Let's Encrypt DNS resolver with Route 53 support")
@@ -0,0 +1,252 @@
+package messages
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// FederationMACSize is the size of the HMAC-SHA256 signatures of the federation handshake
+	FederationMACSize = 32
+	// FederationNonceSize is the size of the random challenges of the federation handshake
+	FederationNonceSize = 32
+
+	// federation auth message
+	offsetFederationAuthNonce      = sizeOfProtoHeader + sizeOfMagicByte
+	headerTotalSizeFederationAuth  = offsetFederationAuthNonce + FederationNonceSize
+	offsetFederationRespMAC        = sizeOfProtoHeader + FederationNonceSize
+	headerTotalSizeFederationResp  = offsetFederationRespMAC + FederationMACSize
+	headerTotalSizeFederationProof = sizeOfProtoHeader + FederationMACSize
+	maxFederationAuthURLSize       = MaxHandshakeSize - headerTotalSizeFederationAuth
+
+	// federation peers message
+	offsetFederationPeersOnline    = sizeOfProtoHeader
+	headerTotalSizeFederationPeers = sizeOfProtoHeader + 1
+
+	// federation transport message
+	offsetFederationTransportDstID     = sizeOfProtoHeader
+	offsetFederationTransportSrcID     = offsetFederationTransportDstID + IDSize
+	headerTotalSizeFederationTransport = offsetFederationTransportSrcID + IDSize
+
+	listSeparator = "\n"
+)
+
+// DetermineFederationMessageType determines the message type of a message received on a link between two relays
+func DetermineFederationMessageType(msg []byte) (MsgType, error) {
+	if len(msg) < sizeOfProtoHeader {
+		return 0, ErrInvalidMessageLength
+	}
+
+	msgType := MsgType(msg[1])
+	switch msgType {
+	case
+		MsgTypeFederationPeers,
+		MsgTypeFederationTransport,
+		MsgTypeFederationMembers,
+		MsgTypeClose,
+		MsgTypeHealthCheck:
+		return msgType, nil
+	default:
+		return MsgTypeUnknown, fmt.Errorf("invalid msg type %d", msgType)
+	}
+}
+
+// MarshalFederationAuthMsg creates the first message of a link between two relays of a cluster.
+// The message carries the instance URL of the dialing relay and a random nonce the accepting relay has to sign with the
+// secret shared by the cluster.
+func MarshalFederationAuthMsg(instanceURL string, nonce []byte) ([]byte, error) {
+	if len(nonce) != FederationNonceSize {
+		return nil, fmt.Errorf("invalid nonce length: %d", len(nonce))
+	}
+	if len(instanceURL) == 0 || len(instanceURL) > maxFederationAuthURLSize {
+		return nil, fmt.Errorf("invalid instance URL length: %d", len(instanceURL))
+	}
+
+	msg := make([]byte, headerTotalSizeFederationAuth, headerTotalSizeFederationAuth+len(instanceURL))
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeFederationAuth)
+	copy(msg[sizeOfProtoHeader:], magicHeader)
+	copy(msg[offsetFederationAuthNonce:], nonce)
+
+	msg = append(msg, instanceURL...)
+	return msg, nil
+}
+
+// UnmarshalFederationAuthMsg extracts the instance URL and the nonce from the federation auth message
+func UnmarshalFederationAuthMsg(msg []byte) (string, []byte, error) {
+	if len(msg) <= headerTotalSizeFederationAuth {
+		return "", nil, ErrInvalidMessageLength
+	}
+	if !bytes.Equal(msg[offsetMagicByte:offsetMagicByte+sizeOfMagicByte], magicHeader) {
+		return "", nil, errors.New("invalid magic header")
+	}
+
+	return string(msg[headerTotalSizeFederationAuth:]), msg[offsetFederationAuthNonce:headerTotalSizeFederationAuth], nil
+}
+
+// MarshalFederationAuthResponse creates the response to the federation auth message. The accepting relay proves with the
+// signature of the nonce of the dialing relay that it knows the cluster secret too, and challenges the dialing relay
+// with its own nonce.
+func MarshalFederationAuthResponse(instanceURL string, nonce, mac []byte) ([]byte, error) {
+	if len(nonce) != FederationNonceSize {
+		return nil, fmt.Errorf("invalid nonce length: %d", len(nonce))
+	}
+	if len(mac) != FederationMACSize {
+		return nil, fmt.Errorf("invalid mac length: %d", len(mac))
+	}
+
+	msg := make([]byte, headerTotalSizeFederationResp, headerTotalSizeFederationResp+len(instanceURL))
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeFederationAuthResponse)
+	copy(msg[sizeOfProtoHeader:], nonce)
+	copy(msg[offsetFederationRespMAC:], mac)
+
+	msg = append(msg, instanceURL...)
+	if len(msg) > MaxHandshakeRespSize {
+		return nil, fmt.Errorf("invalid message length: %d", len(msg))
+	}
+	return msg, nil
+}
+
+// UnmarshalFederationAuthResponse extracts the instance URL, the nonce and the signature from the federation auth
+// response
+func UnmarshalFederationAuthResponse(msg []byte) (string, []byte, []byte, error) {
+	if len(msg) <= headerTotalSizeFederationResp {
+		return "", nil, nil, ErrInvalidMessageLength
+	}
+	return string(msg[headerTotalSizeFederationResp:]), msg[sizeOfProtoHeader:offsetFederationRespMAC], msg[offsetFederationRespMAC:headerTotalSizeFederationResp], nil
+}
+
+// MarshalFederationAuthProof creates the last message of the federation handshake. The dialing relay proves with the
+// signature of the nonce of the accepting relay that it knows the cluster secret.
+func MarshalFederationAuthProof(mac []byte) ([]byte, error) {
+	if len(mac) != FederationMACSize {
+		return nil, fmt.Errorf("invalid mac length: %d", len(mac))
+	}
+
+	msg := make([]byte, headerTotalSizeFederationProof)
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeFederationAuthProof)
+	copy(msg[sizeOfProtoHeader:], mac)
+	return msg, nil
+}
+
+// UnmarshalFederationAuthProof extracts the signature from the federation auth proof
+func UnmarshalFederationAuthProof(msg []byte) ([]byte, error) {
+	if len(msg) != headerTotalSizeFederationProof {
+		return nil, ErrInvalidMessageLength
+	}
+	return msg[sizeOfProtoHeader:], nil
+}
+
+// MarshalFederationPeersMsg creates a message to announce the peers that connected to or disconnected from a relay
+func MarshalFederationPeersMsg(online bool, peerIDs [][]byte) ([]byte, error) {
+	msg := make([]byte, headerTotalSizeFederationPeers, headerTotalSizeFederationPeers+len(peerIDs)*IDSize)
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeFederationPeers)
+	if online {
+		msg[offsetFederationPeersOnline] = 1
+	}
+
+	for _, peerID := range peerIDs {
+		if len(peerID) != IDSize {
+			return nil, fmt.Errorf("invalid peerID length: %d", len(peerID))
+		}
+		msg = append(msg, peerID...)
+	}
+	return msg, nil
+}
+
+// UnmarshalFederationPeersMsg extracts whether the peers are online and the list of the peer IDs
+func UnmarshalFederationPeersMsg(msg []byte) (bool, [][]byte, error) {
+	if len(msg) < headerTotalSizeFederationPeers || (len(msg)-headerTotalSizeFederationPeers)%IDSize != 0 {
+		return false, nil, ErrInvalidMessageLength
+	}
+
+	ids := msg[headerTotalSizeFederationPeers:]
+	peerIDs := make([][]byte, 0, len(ids)/IDSize)
+	for i := 0; i < len(ids); i += IDSize {
+		peerIDs = append(peerIDs, ids[i:i+IDSize])
+	}
+	return msg[offsetFederationPeersOnline] == 1, peerIDs, nil
+}
+
+// MarshalFederationTransportMsg wraps the payload of a transport message to forward it to the relay of the destination
+// peer. Unlike the transport message, it carries the destination and the source peer ID as well.
+func MarshalFederationTransportMsg(dstPeerID, srcPeerID, payload []byte) ([]byte, error) {
+	if len(dstPeerID) != IDSize || len(srcPeerID) != IDSize {
+		return nil, fmt.Errorf("invalid peerID length: %d, %d", len(dstPeerID), len(srcPeerID))
+	}
+
+	msg := make([]byte, headerTotalSizeFederationTransport, headerTotalSizeFederationTransport+len(payload))
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeFederationTransport)
+	copy(msg[offsetFederationTransportDstID:], dstPeerID)
+	copy(msg[offsetFederationTransportSrcID:], srcPeerID)
+	msg = append(msg, payload...)
+	return msg, nil
+}
+
+// UnmarshalFederationTransportID extracts the destination peer ID from the federation transport message
+func UnmarshalFederationTransportID(msg []byte) ([]byte, error) {
+	if len(msg) < headerTotalSizeFederationTransport {
+		return nil, ErrInvalidMessageLength
+	}
+	return msg[offsetFederationTransportDstID:offsetFederationTransportSrcID], nil
+}
+
+// FederationTransportToTransportMsg converts the federation transport message in place to a transport message for the
+// destination peer, with the source peer ID in the peer ID field. The destination peer ID is overwritten, so it must be
+// read before the conversion.
+func FederationTransportToTransportMsg(msg []byte) ([]byte, error) {
+	if len(msg) < headerTotalSizeFederationTransport {
+		return nil, ErrInvalidMessageLength
+	}
+
+	transportMsg := msg[offsetFederationTransportSrcID-sizeOfProtoHeader:]
+	transportMsg[0] = byte(CurrentProtocolVersion)
+	transportMsg[1] = byte(MsgTypeTransport)
+	return transportMsg, nil
+}
+
+// MarshalFederationMembersMsg creates a message with the instance URLs of the relays known by the sender. The relays
+// learn the other members of the cluster with this message.
+func MarshalFederationMembersMsg(instanceURLs []string) []byte {
+	return marshalURLList(MsgTypeFederationMembers, instanceURLs)
+}
+
+// UnmarshalFederationMembersMsg extracts the instance URLs from the federation members message
+func UnmarshalFederationMembersMsg(msg []byte) ([]string, error) {
+	return unmarshalURLList(msg)
+}
+
+// MarshalClusterInfoMsg creates a message with the instance URLs of the relays the server forwards transport messages
+// to. The client can reach the peers of these relays via its own relay server, without connecting to them.
+func MarshalClusterInfoMsg(instanceURLs []string) []byte {
+	return marshalURLList(MsgTypeClusterInfo, instanceURLs)
+}
+
+// UnmarshalClusterInfoMsg extracts the instance URLs from the cluster info message
+func UnmarshalClusterInfoMsg(msg []byte) ([]string, error) {
+	return unmarshalURLList(msg)
+}
+
+func marshalURLList(msgType MsgType, urls []string) []byte {
+	list := strings.Join(urls, listSeparator)
+	msg := make([]byte, sizeOfProtoHeader, sizeOfProtoHeader+len(list))
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(msgType)
+	return append(msg, list...)
+}
+
+func unmarshalURLList(msg []byte) ([]string, error) {
+	if len(msg) < sizeOfProtoHeader {
+		return nil, ErrInvalidMessageLength
+	}
+	if len(msg) == sizeOfProtoHeader {
+		return []string{}, nil
+	}
+	return strings.Split(string(msg[sizeOfProtoHeader:]), listSeparator), nil
+}
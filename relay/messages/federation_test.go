@@ -0,0 +1,195 @@
+package messages
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMarshalFederationAuthMsg(t *testing.T) {
+	nonce := bytes.Repeat([]byte{1}, FederationNonceSize)
+	msg, err := MarshalFederationAuthMsg("rels://relay.example.com:443", nonce)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	msgType, err := DetermineClientMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeFederationAuth {
+		t.Errorf("expected %d, got %d", MsgTypeFederationAuth, msgType)
+	}
+
+	instanceURL, receivedNonce, err := UnmarshalFederationAuthMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if instanceURL != "rels://relay.example.com:443" {
+		t.Errorf("unexpected instance URL: %s", instanceURL)
+	}
+	if !bytes.Equal(receivedNonce, nonce) {
+		t.Errorf("expected %v, got %v", nonce, receivedNonce)
+	}
+}
+
+func TestMarshalFederationAuthResponse(t *testing.T) {
+	nonce := bytes.Repeat([]byte{1}, FederationNonceSize)
+	mac := bytes.Repeat([]byte{2}, FederationMACSize)
+	msg, err := MarshalFederationAuthResponse("rels://relay.example.com:443", nonce, mac)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	msgType, err := DetermineServerMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeFederationAuthResponse {
+		t.Errorf("expected %d, got %d", MsgTypeFederationAuthResponse, msgType)
+	}
+
+	instanceURL, receivedNonce, receivedMAC, err := UnmarshalFederationAuthResponse(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if instanceURL != "rels://relay.example.com:443" {
+		t.Errorf("unexpected instance URL: %s", instanceURL)
+	}
+	if !bytes.Equal(receivedNonce, nonce) {
+		t.Errorf("expected %v, got %v", nonce, receivedNonce)
+	}
+	if !bytes.Equal(receivedMAC, mac) {
+		t.Errorf("expected %v, got %v", mac, receivedMAC)
+	}
+}
+
+func TestMarshalFederationAuthProof(t *testing.T) {
+	mac := bytes.Repeat([]byte{1}, FederationMACSize)
+	msg, err := MarshalFederationAuthProof(mac)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	msgType, err := DetermineClientMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeFederationAuthProof {
+		t.Errorf("expected %d, got %d", MsgTypeFederationAuthProof, msgType)
+	}
+
+	receivedMAC, err := UnmarshalFederationAuthProof(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if !bytes.Equal(receivedMAC, mac) {
+		t.Errorf("expected %v, got %v", mac, receivedMAC)
+	}
+
+	if _, err := UnmarshalFederationAuthProof(msg[:len(msg)-1]); err == nil {
+		t.Errorf("expected error for truncated message")
+	}
+}
+
+func TestMarshalFederationPeersMsg(t *testing.T) {
+	peerA, _ := HashID("alice")
+	peerB, _ := HashID("bob")
+	msg, err := MarshalFederationPeersMsg(true, [][]byte{peerA, peerB})
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	online, peerIDs, err := UnmarshalFederationPeersMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if !online {
+		t.Errorf("expected online peers")
+	}
+	if len(peerIDs) != 2 || !bytes.Equal(peerIDs[0], peerA) || !bytes.Equal(peerIDs[1], peerB) {
+		t.Errorf("unexpected peer IDs: %v", peerIDs)
+	}
+
+	if _, _, err := UnmarshalFederationPeersMsg(msg[:len(msg)-1]); err == nil {
+		t.Errorf("expected error for truncated message")
+	}
+}
+
+func TestFederationTransportToTransportMsg(t *testing.T) {
+	dstID, _ := HashID("alice")
+	srcID, _ := HashID("bob")
+	payload := []byte("payload")
+	msg, err := MarshalFederationTransportMsg(dstID, srcID, payload)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	msgType, err := DetermineFederationMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeFederationTransport {
+		t.Errorf("expected %d, got %d", MsgTypeFederationTransport, msgType)
+	}
+
+	receivedDstID, err := UnmarshalFederationTransportID(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if !bytes.Equal(receivedDstID, dstID) {
+		t.Errorf("expected %s, got %s", dstID, receivedDstID)
+	}
+
+	transportMsg, err := FederationTransportToTransportMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	msgType, err = DetermineServerMessageType(transportMsg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeTransport {
+		t.Errorf("expected %d, got %d", MsgTypeTransport, msgType)
+	}
+
+	peerID, receivedPayload, err := UnmarshalTransportMsg(transportMsg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if !bytes.Equal(peerID, srcID) {
+		t.Errorf("expected %s, got %s", srcID, peerID)
+	}
+	if !bytes.Equal(receivedPayload, payload) {
+		t.Errorf("expected %s, got %s", payload, receivedPayload)
+	}
+}
+
+func TestMarshalClusterInfoMsg(t *testing.T) {
+	urls := []string{"rels://a.example.com:443", "rels://b.example.com:443"}
+	msg := MarshalClusterInfoMsg(urls)
+
+	msgType, err := DetermineServerMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeClusterInfo {
+		t.Errorf("expected %d, got %d", MsgTypeClusterInfo, msgType)
+	}
+
+	receivedURLs, err := UnmarshalClusterInfoMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(receivedURLs) != 2 || receivedURLs[0] != urls[0] || receivedURLs[1] != urls[1] {
+		t.Errorf("expected %v, got %v", urls, receivedURLs)
+	}
+
+	receivedURLs, err = UnmarshalClusterInfoMsg(MarshalClusterInfoMsg(nil))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(receivedURLs) != 0 {
+		t.Errorf("expected no URLs, got %v", receivedURLs)
+	}
+}
@@ -2,8 +2,10 @@ package messages
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 )
 
 const (
@@ -22,6 +24,15 @@ const (
 	MsgTypeHealthCheck   MsgType = 5
 	MsgTypeAuth                  = 6
 	MsgTypeAuthResponse          = 7
+	// MsgTypeClusterInfo is sent by the server to tell the client which other relay instances it forwards to
+	MsgTypeClusterInfo MsgType = 8
+	// federation messages are exchanged between the relay instances of a cluster
+	MsgTypeFederationAuth         MsgType = 9
+	MsgTypeFederationAuthResponse MsgType = 10
+	MsgTypeFederationPeers        MsgType = 11
+	MsgTypeFederationTransport    MsgType = 12
+	MsgTypeFederationMembers      MsgType = 13
+	MsgTypeFederationAuthProof    MsgType = 14
 
 	// base size of the message
 	sizeOfVersionByte = 1
@@ -43,6 +54,10 @@ const (
 	headerSizeTransport      = IDSize
 	offsetTransportID        = sizeOfProtoHeader
 	headerTotalSizeTransport = sizeOfProtoHeader + headerSizeTransport
+
+	// health check probe
+	sizeOfProbeTimestamp            = 8
+	headerTotalSizeHealthCheckProbe = sizeOfProtoHeader + sizeOfProbeTimestamp
 )
 
 var (
@@ -72,6 +87,20 @@ func (m MsgType) String() string {
 		return "close"
 	case MsgTypeHealthCheck:
 		return "health check"
+	case MsgTypeClusterInfo:
+		return "cluster info"
+	case MsgTypeFederationAuth:
+		return "federation auth"
+	case MsgTypeFederationAuthResponse:
+		return "federation auth response"
+	case MsgTypeFederationPeers:
+		return "federation peers"
+	case MsgTypeFederationTransport:
+		return "federation transport"
+	case MsgTypeFederationMembers:
+		return "federation members"
+	case MsgTypeFederationAuthProof:
+		return "federation auth proof"
 	default:
 		return "unknown"
 	}
@@ -100,6 +129,8 @@ func DetermineClientMessageType(msg []byte) (MsgType, error) {
 	case
 		MsgTypeHello,
 		MsgTypeAuth,
+		MsgTypeFederationAuth,
+		MsgTypeFederationAuthProof,
 		MsgTypeTransport,
 		MsgTypeClose,
 		MsgTypeHealthCheck:
@@ -120,6 +151,8 @@ func DetermineServerMessageType(msg []byte) (MsgType, error) {
 	case
 		MsgTypeHelloResponse,
 		MsgTypeAuthResponse,
+		MsgTypeFederationAuthResponse,
+		MsgTypeClusterInfo,
 		MsgTypeTransport,
 		MsgTypeClose,
 		MsgTypeHealthCheck:
@@ -316,3 +349,23 @@ func UpdateTransportMsg(msg []byte, peerID []byte) error {
 func MarshalHealthcheck() []byte {
 	return healthCheckMsg
 }
+
+// MarshalHealthCheckProbe creates a health check message carrying the time it was sent. The client sends it to the
+// server and the server echoes it back unchanged, so the client can measure the round trip time to the server.
+// Servers not supporting the probes handle it as a regular health check response.
+func MarshalHealthCheckProbe(sentAt time.Time) []byte {
+	msg := make([]byte, headerTotalSizeHealthCheckProbe)
+	msg[0] = byte(CurrentProtocolVersion)
+	msg[1] = byte(MsgTypeHealthCheck)
+	binary.BigEndian.PutUint64(msg[sizeOfProtoHeader:], uint64(sentAt.UnixNano()))
+	return msg
+}
+
+// UnmarshalHealthCheckProbe extracts the send time from the health check probe. It returns false for a regular health
+// check message.
+func UnmarshalHealthCheckProbe(msg []byte) (time.Time, bool) {
+	if len(msg) < headerTotalSizeHealthCheckProbe {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(msg[sizeOfProtoHeader:headerTotalSizeHealthCheckProbe]))), true
+}
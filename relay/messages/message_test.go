@@ -2,6 +2,7 @@ package messages
 
 import (
 	"testing"
+	"time"
 )
 
 func TestMarshalHelloMsg(t *testing.T) {
@@ -136,3 +137,28 @@ func TestMarshalHealthcheck(t *testing.T) {
 		t.Errorf("expected %d, got %d", MsgTypeHealthCheck, msgType)
 	}
 }
+
+func TestMarshalHealthCheckProbe(t *testing.T) {
+	sentAt := time.Unix(1700000000, 123456789)
+	msg := MarshalHealthCheckProbe(sentAt)
+
+	msgType, err := DetermineServerMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeHealthCheck {
+		t.Errorf("expected %d, got %d", MsgTypeHealthCheck, msgType)
+	}
+
+	receivedSentAt, ok := UnmarshalHealthCheckProbe(msg)
+	if !ok {
+		t.Fatalf("expected a probe")
+	}
+	if !receivedSentAt.Equal(sentAt) {
+		t.Errorf("expected %s, got %s", sentAt, receivedSentAt)
+	}
+
+	if _, ok := UnmarshalHealthCheckProbe(MarshalHealthcheck()); ok {
+		t.Errorf("a plain health check should not be a probe")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -20,8 +21,17 @@ type Metrics struct {
 	TransferBytesRecv  metric.Int64Counter
 	AuthenticationTime metric.Float64Histogram
 	PeerStoreTime      metric.Float64Histogram
+	// FederationBytesSent and FederationBytesRecv count the transport bytes forwarded to and received from the other
+	// relays of the cluster
+	FederationBytesSent metric.Int64Counter
+	FederationBytesRecv metric.Int64Counter
+
+	throttledMessages metric.Int64Counter
+	droppedMessages   metric.Int64Counter
 
 	peers            metric.Int64UpDownCounter
+	federationLinks  metric.Int64UpDownCounter
+	remotePeers      metric.Int64UpDownCounter
 	peerActivityChan chan string
 	peerLastActive   map[string]time.Time
 	mutexActivity    sync.Mutex
@@ -64,13 +74,49 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	federationBytesSent, err := meter.Int64Counter("relay_federation_sent_bytes_total")
+	if err != nil {
+		return nil, err
+	}
+
+	federationBytesRecv, err := meter.Int64Counter("relay_federation_received_bytes_total")
+	if err != nil {
+		return nil, err
+	}
+
+	federationLinks, err := meter.Int64UpDownCounter("relay_federation_links")
+	if err != nil {
+		return nil, err
+	}
+
+	remotePeers, err := meter.Int64UpDownCounter("relay_federation_remote_peers")
+	if err != nil {
+		return nil, err
+	}
+
+	throttledMessages, err := meter.Int64Counter("relay_throttled_messages_total")
+	if err != nil {
+		return nil, err
+	}
+
+	droppedMessages, err := meter.Int64Counter("relay_dropped_messages_total")
+	if err != nil {
+		return nil, err
+	}
+
 	m := &Metrics{
-		Meter:              meter,
-		TransferBytesSent:  bytesSent,
-		TransferBytesRecv:  bytesRecv,
-		AuthenticationTime: authTime,
-		PeerStoreTime:      peerStoreTime,
-		peers:              peers,
+		Meter:               meter,
+		TransferBytesSent:   bytesSent,
+		TransferBytesRecv:   bytesRecv,
+		AuthenticationTime:  authTime,
+		PeerStoreTime:       peerStoreTime,
+		FederationBytesSent: federationBytesSent,
+		FederationBytesRecv: federationBytesRecv,
+		peers:               peers,
+		federationLinks:     federationLinks,
+		remotePeers:         remotePeers,
+		throttledMessages:   throttledMessages,
+		droppedMessages:     droppedMessages,
 
 		ctx:              ctx,
 		peerActivityChan: make(chan string, 10),
@@ -122,6 +168,31 @@ func (m *Metrics) PeerDisconnected(id string) {
 	delete(m.peerLastActive, id)
 }
 
+// FederationLinkUp increments the number of links to other relays of the cluster
+func (m *Metrics) FederationLinkUp() {
+	m.federationLinks.Add(m.ctx, 1)
+}
+
+// FederationLinkDown decrements the number of links to other relays of the cluster
+func (m *Metrics) FederationLinkDown() {
+	m.federationLinks.Add(m.ctx, -1)
+}
+
+// RemotePeersChanged adjusts the number of peers reachable via the other relays of the cluster
+func (m *Metrics) RemotePeersChanged(delta int64) {
+	m.remotePeers.Add(m.ctx, delta)
+}
+
+// MessageThrottled counts the transport messages delayed by the rate limit, the limit is "peer" or "global"
+func (m *Metrics) MessageThrottled(limit string) {
+	m.throttledMessages.Add(m.ctx, 1, metric.WithAttributes(attribute.String("limit", limit)))
+}
+
+// MessageDropped counts the transport messages dropped by the rate limit, the limit is "peer" or "global"
+func (m *Metrics) MessageDropped(limit string) {
+	m.droppedMessages.Add(m.ctx, 1, metric.WithAttributes(attribute.String("limit", limit)))
+}
+
 // PeerActivity increases the active connections
 func (m *Metrics) PeerActivity(peerID string) {
 	select {
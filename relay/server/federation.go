@@ -0,0 +1,711 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/relay/client/dialer/ws"
+	"github.com/netbirdio/netbird/relay/messages"
+	"github.com/netbirdio/netbird/relay/metrics"
+)
+
+const (
+	// the signatures of the accepting and the dialing relay use distinct contexts, so one can't be reflected as the other
+	federationResponseContext = "netbird-relay-federation-response"
+	federationProofContext    = "netbird-relay-federation-proof"
+
+	federationDialTimeout       = 10 * time.Second
+	federationRetryInterval     = 5 * time.Second
+	federationMaxRetryInterval  = time.Minute
+	federationKeepAliveInterval = 10 * time.Second
+	federationIdleTimeout       = 3 * federationKeepAliveInterval
+
+	// a forwarded transport message carries the source peer ID on top of the transport message of the client
+	federationLinkBufferSize = bufferSize + messages.IDSize
+	// federationPeersBatchSize keeps the presence announcements below the link buffer size
+	federationPeersBatchSize = 200
+)
+
+// FederationConfig configures the links between the relay instances of a cluster. The relays forward the transport
+// messages of their peers to the relay the destination peer is connected to, so the clients do not have to connect to
+// the relay of every remote peer.
+type FederationConfig struct {
+	// Secret authenticates the links between the relays. Every relay of the cluster must use the same secret.
+	Secret string
+	// Members are the instance URLs of other relays of the cluster. The relays share the members they are linked with,
+	// so it is enough to configure a single running member.
+	Members []string
+}
+
+// federation manages the links to the other relays of the cluster and tracks which peers are reachable via them
+type federation struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	instanceURL string
+	secret      []byte
+	store       *Store
+	metrics     *metrics.Metrics
+
+	mu      sync.Mutex
+	members map[string]struct{}
+	links   map[string][]*federationLink
+	// remotePeers holds the instance URLs of the linked relays a peer is connected to
+	remotePeers map[string]map[string]struct{}
+	wg          sync.WaitGroup
+}
+
+type federationLink struct {
+	instanceURL string
+	conn        net.Conn
+	log         *log.Entry
+	writeMu     sync.Mutex
+	lastRead    atomic.Int64
+}
+
+func newFederation(instanceURL string, cfg FederationConfig, store *Store, metrics *metrics.Metrics) (*federation, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("federation secret is required")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &federation{
+		ctx:         ctx,
+		cancel:      cancel,
+		instanceURL: instanceURL,
+		secret:      []byte(cfg.Secret),
+		store:       store,
+		metrics:     metrics,
+		members:     make(map[string]struct{}),
+		links:       make(map[string][]*federationLink),
+		remotePeers: make(map[string]map[string]struct{}),
+	}, nil
+}
+
+func newFederationLink(instanceURL string, conn net.Conn) *federationLink {
+	l := &federationLink{
+		instanceURL: instanceURL,
+		conn:        conn,
+		log:         log.WithField("relay_link", instanceURL),
+	}
+	l.lastRead.Store(time.Now().UnixNano())
+	return l
+}
+
+// addMember starts to link with the relay of the instance URL. A member learned from another relay is dialed only by
+// the relay with the lower instance URL, so two relays do not link twice. Seed members are always dialed.
+func (f *federation) addMember(instanceURL string, seed bool) {
+	if instanceURL == f.instanceURL {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.members[instanceURL]; ok {
+		return
+	}
+	f.members[instanceURL] = struct{}{}
+
+	if !seed && f.instanceURL > instanceURL {
+		return
+	}
+
+	f.wg.Add(1)
+	go f.dialLoop(instanceURL)
+}
+
+func (f *federation) dialLoop(instanceURL string) {
+	defer f.wg.Done()
+
+	retry := federationRetryInterval
+	for {
+		link, err := f.dial(instanceURL)
+		if err != nil {
+			if f.ctx.Err() == nil {
+				log.Warnf("failed to link with relay %s: %s", instanceURL, err)
+			}
+		} else {
+			retry = federationRetryInterval
+			f.serveLink(link)
+		}
+
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+		retry = min(retry*2, federationMaxRetryInterval)
+	}
+}
+
+func (f *federation) dial(instanceURL string) (*federationLink, error) {
+	ctx, cancel := context.WithTimeout(f.ctx, federationDialTimeout)
+	defer cancel()
+
+	conn, err := ws.Dialer{}.Dial(ctx, instanceURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial: %w", err)
+	}
+
+	remoteURL, err := f.handshake(ctx, conn)
+	if err != nil {
+		if cErr := conn.Close(); cErr != nil {
+			log.Debugf("failed to close relay link: %s", cErr)
+		}
+		return nil, err
+	}
+	return newFederationLink(remoteURL, conn), nil
+}
+
+func (f *federation) handshake(ctx context.Context, conn net.Conn) (string, error) {
+	nonce, err := newFederationNonce()
+	if err != nil {
+		return "", err
+	}
+
+	msg, err := messages.MarshalFederationAuthMsg(f.instanceURL, nonce)
+	if err != nil {
+		return "", fmt.Errorf("marshal federation auth message: %w", err)
+	}
+
+	if _, err := conn.Write(msg); err != nil {
+		return "", fmt.Errorf("send federation auth message: %w", err)
+	}
+
+	buf := make([]byte, messages.MaxHandshakeRespSize)
+	n, err := readWithTimeout(ctx, conn, buf)
+	if err != nil {
+		return "", fmt.Errorf("read federation auth response: %w", err)
+	}
+	buf = buf[:n]
+
+	if _, err := messages.ValidateVersion(buf); err != nil {
+		return "", fmt.Errorf("validate version: %w", err)
+	}
+
+	msgType, err := messages.DetermineServerMessageType(buf)
+	if err != nil {
+		return "", fmt.Errorf("determine message type: %w", err)
+	}
+	if msgType != messages.MsgTypeFederationAuthResponse {
+		return "", fmt.Errorf("unexpected message type: %s", msgType)
+	}
+
+	remoteURL, remoteNonce, mac, err := messages.UnmarshalFederationAuthResponse(buf)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal federation auth response: %w", err)
+	}
+	if !hmac.Equal(mac, f.responseMAC(nonce, remoteNonce, remoteURL)) {
+		return "", errors.New("invalid federation auth response signature")
+	}
+	if remoteURL == f.instanceURL {
+		return "", errors.New("the member is this relay")
+	}
+
+	proof, err := messages.MarshalFederationAuthProof(f.proofMAC(remoteNonce, nonce, f.instanceURL))
+	if err != nil {
+		return "", fmt.Errorf("marshal federation auth proof: %w", err)
+	}
+	if _, err := conn.Write(proof); err != nil {
+		return "", fmt.Errorf("send federation auth proof: %w", err)
+	}
+	return remoteURL, nil
+}
+
+// federationChallenge holds the nonces of a relay link accepted by this relay until the dialing relay proves that it
+// knows the cluster secret
+type federationChallenge struct {
+	instanceURL string
+	localNonce  []byte
+	remoteNonce []byte
+}
+
+// authenticate handles the federation auth message of a dialing relay. It returns the challenge the dialing relay has
+// to answer with the proof and the response message.
+func (f *federation) authenticate(msg []byte) (*federationChallenge, []byte, error) {
+	remoteURL, remoteNonce, err := messages.UnmarshalFederationAuthMsg(msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal federation auth message: %w", err)
+	}
+
+	if remoteURL == f.instanceURL {
+		return nil, nil, errors.New("federation auth message of this relay")
+	}
+
+	nonce, err := newFederationNonce()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := messages.MarshalFederationAuthResponse(f.instanceURL, nonce, f.responseMAC(remoteNonce, nonce, f.instanceURL))
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal federation auth response: %w", err)
+	}
+
+	challenge := &federationChallenge{
+		instanceURL: remoteURL,
+		localNonce:  nonce,
+		remoteNonce: remoteNonce,
+	}
+	return challenge, response, nil
+}
+
+// verifyProof validates the federation auth proof of a dialing relay. The proof signs the nonce of this relay, so a
+// captured handshake can't be replayed.
+func (f *federation) verifyProof(challenge *federationChallenge, msg []byte) error {
+	if _, err := messages.ValidateVersion(msg); err != nil {
+		return fmt.Errorf("validate version: %w", err)
+	}
+
+	msgType, err := messages.DetermineClientMessageType(msg)
+	if err != nil {
+		return fmt.Errorf("determine message type: %w", err)
+	}
+	if msgType != messages.MsgTypeFederationAuthProof {
+		return fmt.Errorf("unexpected message type: %s", msgType)
+	}
+
+	mac, err := messages.UnmarshalFederationAuthProof(msg)
+	if err != nil {
+		return fmt.Errorf("unmarshal federation auth proof: %w", err)
+	}
+	if !hmac.Equal(mac, f.proofMAC(challenge.localNonce, challenge.remoteNonce, challenge.instanceURL)) {
+		return fmt.Errorf("invalid federation auth proof of %s", challenge.instanceURL)
+	}
+	return nil
+}
+
+// receiveProof reads and validates the federation auth proof of a dialing relay
+func (f *federation) receiveProof(challenge *federationChallenge, conn net.Conn) error {
+	ctx, cancel := context.WithTimeout(f.ctx, federationDialTimeout)
+	defer cancel()
+
+	buf := make([]byte, messages.MaxHandshakeSize)
+	n, err := readWithTimeout(ctx, conn, buf)
+	if err != nil {
+		return fmt.Errorf("read federation auth proof: %w", err)
+	}
+	return f.verifyProof(challenge, buf[:n])
+}
+
+// responseMAC is the signature of the accepting relay over the nonces and its instance URL
+func (f *federation) responseMAC(dialerNonce, acceptorNonce []byte, instanceURL string) []byte {
+	h := hmac.New(sha256.New, f.secret)
+	h.Write([]byte(federationResponseContext))
+	h.Write(dialerNonce)
+	h.Write(acceptorNonce)
+	h.Write([]byte(instanceURL))
+	return h.Sum(nil)
+}
+
+// proofMAC is the signature of the dialing relay over the nonces and its instance URL
+func (f *federation) proofMAC(acceptorNonce, dialerNonce []byte, instanceURL string) []byte {
+	h := hmac.New(sha256.New, f.secret)
+	h.Write([]byte(federationProofContext))
+	h.Write(acceptorNonce)
+	h.Write(dialerNonce)
+	h.Write([]byte(instanceURL))
+	return h.Sum(nil)
+}
+
+func newFederationNonce() ([]byte, error) {
+	nonce := make([]byte, messages.FederationNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate federation nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// acceptLink serves the link of a relay that dialed this relay
+func (f *federation) acceptLink(instanceURL string, conn net.Conn) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		f.serveLink(newFederationLink(instanceURL, conn))
+	}()
+}
+
+// serveLink handles the messages of the link until it is closed
+func (f *federation) serveLink(link *federationLink) {
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+
+	f.addLink(link)
+	defer f.removeLink(link)
+
+	go link.keepAlive(ctx)
+
+	f.sendLocalPeers(link)
+
+	buf := make([]byte, federationLinkBufferSize)
+	for {
+		n, err := link.conn.Read(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) && f.ctx.Err() == nil {
+				link.log.Warnf("failed to read from relay link: %s", err)
+			}
+			return
+		}
+		link.lastRead.Store(time.Now().UnixNano())
+
+		msg := buf[:n]
+		if _, err := messages.ValidateVersion(msg); err != nil {
+			link.log.Warnf("failed to validate protocol version: %s", err)
+			return
+		}
+
+		msgType, err := messages.DetermineFederationMessageType(msg)
+		if err != nil {
+			link.log.Errorf("failed to determine message type: %s", err)
+			continue
+		}
+
+		switch msgType {
+		case messages.MsgTypeHealthCheck:
+		case messages.MsgTypeFederationTransport:
+			f.handleForwardedMsg(link, msg)
+		case messages.MsgTypeFederationPeers:
+			f.handlePeersMsg(link, msg)
+		case messages.MsgTypeFederationMembers:
+			f.handleMembersMsg(link, msg)
+		case messages.MsgTypeClose:
+			link.log.Infof("relay link closed by the remote relay")
+			return
+		}
+	}
+}
+
+func (f *federation) addLink(link *federationLink) {
+	f.mu.Lock()
+	f.members[link.instanceURL] = struct{}{}
+	f.links[link.instanceURL] = append(f.links[link.instanceURL], link)
+	newInstance := len(f.links[link.instanceURL]) == 1
+	f.mu.Unlock()
+
+	link.log.Infof("relay link established")
+	f.metrics.FederationLinkUp()
+
+	// the relays learn each other through the members of their links
+	f.broadcast(messages.MarshalFederationMembersMsg(append(f.linkedInstances(), f.instanceURL)))
+	if newInstance {
+		f.sendClusterInfoToPeers()
+	}
+}
+
+func (f *federation) removeLink(link *federationLink) {
+	f.mu.Lock()
+	links := slices.DeleteFunc(f.links[link.instanceURL], func(l *federationLink) bool {
+		return l == link
+	})
+	lostInstance := len(links) == 0
+	if lostInstance {
+		delete(f.links, link.instanceURL)
+		for peerID := range f.remotePeers {
+			f.removeRemotePeer(peerID, link.instanceURL)
+		}
+	} else {
+		f.links[link.instanceURL] = links
+	}
+	f.mu.Unlock()
+
+	link.log.Infof("relay link closed")
+	f.metrics.FederationLinkDown()
+
+	if lostInstance {
+		f.sendClusterInfoToPeers()
+	}
+}
+
+func (f *federation) sendLocalPeers(link *federationLink) {
+	peers := f.store.Peers()
+	for batch := range slices.Chunk(peers, federationPeersBatchSize) {
+		peerIDs := make([][]byte, 0, len(batch))
+		for _, p := range batch {
+			peerIDs = append(peerIDs, p.idB)
+		}
+
+		msg, err := messages.MarshalFederationPeersMsg(true, peerIDs)
+		if err != nil {
+			link.log.Errorf("failed to marshal peers message: %s", err)
+			return
+		}
+		if err := link.write(msg); err != nil {
+			link.log.Errorf("failed to send local peers: %s", err)
+			return
+		}
+	}
+}
+
+func (f *federation) handlePeersMsg(link *federationLink, msg []byte) {
+	online, peerIDs, err := messages.UnmarshalFederationPeersMsg(msg)
+	if err != nil {
+		link.log.Errorf("failed to unmarshal peers message: %s", err)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, peerID := range peerIDs {
+		stringPeerID := messages.HashIDToString(peerID)
+		if online {
+			f.addRemotePeer(stringPeerID, link.instanceURL)
+		} else {
+			f.removeRemotePeer(stringPeerID, link.instanceURL)
+		}
+	}
+}
+
+func (f *federation) handleMembersMsg(link *federationLink, msg []byte) {
+	members, err := messages.UnmarshalFederationMembersMsg(msg)
+	if err != nil {
+		link.log.Errorf("failed to unmarshal members message: %s", err)
+		return
+	}
+
+	for _, member := range members {
+		f.addMember(member, false)
+	}
+}
+
+func (f *federation) handleForwardedMsg(link *federationLink, msg []byte) {
+	peerID, err := messages.UnmarshalFederationTransportID(msg)
+	if err != nil {
+		link.log.Errorf("failed to unmarshal federation transport message: %s", err)
+		return
+	}
+
+	stringPeerID := messages.HashIDToString(peerID)
+	dp, ok := f.store.Peer(stringPeerID)
+	if !ok {
+		link.log.Debugf("peer not found: %s", stringPeerID)
+		return
+	}
+
+	transportMsg, err := messages.FederationTransportToTransportMsg(msg)
+	if err != nil {
+		link.log.Errorf("failed to convert federation transport message: %s", err)
+		return
+	}
+
+	f.metrics.FederationBytesRecv.Add(f.ctx, int64(len(msg)))
+	n, err := dp.Write(transportMsg)
+	if err != nil {
+		link.log.Errorf("failed to write transport message to: %s", dp.String())
+		return
+	}
+	f.metrics.TransferBytesSent.Add(f.ctx, int64(n))
+}
+
+// forward sends the transport message of the source peer to the relay the destination peer is connected to. It
+// returns false if the destination peer is not connected to any linked relay.
+func (f *federation) forward(dstPeerID []byte, dstStringID string, srcPeerID []byte, msg []byte) bool {
+	link, ok := f.linkOfPeer(dstStringID)
+	if !ok {
+		return false
+	}
+
+	_, payload, err := messages.UnmarshalTransportMsg(msg)
+	if err != nil {
+		link.log.Errorf("failed to unmarshal transport message: %s", err)
+		return true
+	}
+
+	fwdMsg, err := messages.MarshalFederationTransportMsg(dstPeerID, srcPeerID, payload)
+	if err != nil {
+		link.log.Errorf("failed to marshal federation transport message: %s", err)
+		return true
+	}
+
+	if err := link.write(fwdMsg); err != nil {
+		link.log.Errorf("failed to forward transport message to: %s", dstStringID)
+		return true
+	}
+	f.metrics.FederationBytesSent.Add(f.ctx, int64(len(fwdMsg)))
+	return true
+}
+
+func (f *federation) linkOfPeer(peerID string) (*federationLink, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for instanceURL := range f.remotePeers[peerID] {
+		if links := f.links[instanceURL]; len(links) > 0 {
+			return links[0], true
+		}
+	}
+	return nil, false
+}
+
+// peerConnected announces the new local peer to the linked relays
+func (f *federation) peerConnected(peer *Peer) {
+	msg, err := messages.MarshalFederationPeersMsg(true, [][]byte{peer.idB})
+	if err != nil {
+		peer.log.Errorf("failed to marshal peers message: %s", err)
+		return
+	}
+	f.broadcast(msg)
+}
+
+// peerDisconnected announces the disconnected local peer to the linked relays unless it has reconnected meanwhile
+func (f *federation) peerDisconnected(peer *Peer) {
+	if _, ok := f.store.Peer(peer.String()); ok {
+		return
+	}
+
+	msg, err := messages.MarshalFederationPeersMsg(false, [][]byte{peer.idB})
+	if err != nil {
+		peer.log.Errorf("failed to marshal peers message: %s", err)
+		return
+	}
+	f.broadcast(msg)
+}
+
+// sendClusterInfo tells the peer which relays it can reach via this relay
+func (f *federation) sendClusterInfo(peer *Peer) {
+	if _, err := peer.Write(messages.MarshalClusterInfoMsg(f.linkedInstances())); err != nil {
+		peer.log.Errorf("failed to send cluster info: %s", err)
+	}
+}
+
+func (f *federation) sendClusterInfoToPeers() {
+	if f.ctx.Err() != nil {
+		return
+	}
+
+	msg := messages.MarshalClusterInfoMsg(f.linkedInstances())
+	for _, peer := range f.store.Peers() {
+		if _, err := peer.Write(msg); err != nil {
+			peer.log.Debugf("failed to send cluster info: %s", err)
+		}
+	}
+}
+
+func (f *federation) broadcast(msg []byte) {
+	f.mu.Lock()
+	links := make([]*federationLink, 0, len(f.links))
+	for _, instanceLinks := range f.links {
+		links = append(links, instanceLinks...)
+	}
+	f.mu.Unlock()
+
+	for _, link := range links {
+		if err := link.write(msg); err != nil {
+			link.log.Debugf("failed to send message to relay link: %s", err)
+		}
+	}
+}
+
+// linkedInstances returns the sorted instance URLs of the linked relays
+func (f *federation) linkedInstances() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	instances := make([]string, 0, len(f.links))
+	for instanceURL := range f.links {
+		instances = append(instances, instanceURL)
+	}
+	slices.Sort(instances)
+	return instances
+}
+
+// addRemotePeer records the peer on the linked relay. The caller must hold the lock.
+func (f *federation) addRemotePeer(peerID, instanceURL string) {
+	instances, ok := f.remotePeers[peerID]
+	if !ok {
+		instances = make(map[string]struct{})
+		f.remotePeers[peerID] = instances
+		f.metrics.RemotePeersChanged(1)
+	}
+	instances[instanceURL] = struct{}{}
+}
+
+// removeRemotePeer removes the peer of the linked relay. The caller must hold the lock.
+func (f *federation) removeRemotePeer(peerID, instanceURL string) {
+	instances, ok := f.remotePeers[peerID]
+	if !ok {
+		return
+	}
+
+	delete(instances, instanceURL)
+	if len(instances) == 0 {
+		delete(f.remotePeers, peerID)
+		f.metrics.RemotePeersChanged(-1)
+	}
+}
+
+// close closes the links to the other relays and stops linking with them
+func (f *federation) close() {
+	f.cancel()
+	f.wg.Wait()
+}
+
+func (l *federationLink) write(msg []byte) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	_, err := l.conn.Write(msg)
+	return err
+}
+
+// keepAlive sends health check messages to the remote relay and closes the link if the remote relay has not sent
+// anything for a while
+func (l *federationLink) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(federationKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = l.write(messages.MarshalCloseMsg())
+			l.close()
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, l.lastRead.Load())) > federationIdleTimeout {
+				l.log.Warnf("relay link timed out")
+				l.close()
+				return
+			}
+			if err := l.write(messages.MarshalHealthcheck()); err != nil {
+				l.log.Debugf("failed to send health check: %s", err)
+			}
+		}
+	}
+}
+
+func (l *federationLink) close() {
+	if err := l.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		l.log.Debugf("failed to close relay link: %s", err)
+	}
+}
+
+func readWithTimeout(ctx context.Context, conn net.Conn, buf []byte) (int, error) {
+	readDone := make(chan struct{})
+	var (
+		n   int
+		err error
+	)
+
+	go func() {
+		n, err = conn.Read(buf)
+		close(readDone)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("read operation timed out")
+	case <-readDone:
+		return n, err
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/netbirdio/netbird/relay/messages"
+	"github.com/netbirdio/netbird/relay/metrics"
+)
+
+func newTestFederation(t *testing.T, instanceURL, secret string) *federation {
+	t.Helper()
+
+	m, err := metrics.NewMetrics(context.Background(), otel.Meter(""))
+	if err != nil {
+		t.Fatalf("failed to create metrics: %s", err)
+	}
+
+	f, err := newFederation(instanceURL, FederationConfig{Secret: secret}, NewStore(), m)
+	if err != nil {
+		t.Fatalf("failed to create federation: %s", err)
+	}
+	t.Cleanup(f.close)
+	return f
+}
+
+func TestFederation_Authenticate(t *testing.T) {
+	relayA := newTestFederation(t, "rel://relay-a:80", "secret")
+	relayB := newTestFederation(t, "rel://relay-b:80", "secret")
+	intruder := newTestFederation(t, "rel://intruder:80", "other")
+
+	nonce := bytes.Repeat([]byte{1}, messages.FederationNonceSize)
+	msg, err := messages.MarshalFederationAuthMsg(relayA.instanceURL, nonce)
+	if err != nil {
+		t.Fatalf("failed to marshal auth message: %s", err)
+	}
+
+	challenge, response, err := relayB.authenticate(msg)
+	if err != nil {
+		t.Fatalf("failed to authenticate: %s", err)
+	}
+	if challenge.instanceURL != relayA.instanceURL {
+		t.Errorf("expected %s, got %s", relayA.instanceURL, challenge.instanceURL)
+	}
+
+	remoteURL, remoteNonce, mac, err := messages.UnmarshalFederationAuthResponse(response)
+	if err != nil {
+		t.Fatalf("failed to unmarshal auth response: %s", err)
+	}
+	if remoteURL != relayB.instanceURL {
+		t.Errorf("expected %s, got %s", relayB.instanceURL, remoteURL)
+	}
+	if !bytes.Equal(mac, relayA.responseMAC(nonce, remoteNonce, remoteURL)) {
+		t.Errorf("the response should be signed with the shared secret")
+	}
+	if bytes.Equal(mac, intruder.responseMAC(nonce, remoteNonce, remoteURL)) {
+		t.Errorf("the response should not match the signature of another secret")
+	}
+
+	proof, err := messages.MarshalFederationAuthProof(relayA.proofMAC(remoteNonce, nonce, relayA.instanceURL))
+	if err != nil {
+		t.Fatalf("failed to marshal auth proof: %s", err)
+	}
+	if err := relayB.verifyProof(challenge, proof); err != nil {
+		t.Errorf("the proof of a relay with the shared secret should be accepted: %s", err)
+	}
+
+	forged, err := messages.MarshalFederationAuthProof(intruder.proofMAC(remoteNonce, nonce, relayA.instanceURL))
+	if err != nil {
+		t.Fatalf("failed to marshal auth proof: %s", err)
+	}
+	if err := relayB.verifyProof(challenge, forged); err == nil {
+		t.Errorf("the proof of a relay with another secret should be rejected")
+	}
+
+	// a captured handshake is bound to the nonce of the accepting relay
+	replayedChallenge, _, err := relayB.authenticate(msg)
+	if err != nil {
+		t.Fatalf("failed to authenticate: %s", err)
+	}
+	if err := relayB.verifyProof(replayedChallenge, proof); err == nil {
+		t.Errorf("a replayed proof should be rejected")
+	}
+
+	// the response of the accepting relay can't be reflected as the proof of the dialing relay
+	reflected, err := messages.MarshalFederationAuthProof(mac)
+	if err != nil {
+		t.Fatalf("failed to marshal auth proof: %s", err)
+	}
+	if err := relayB.verifyProof(challenge, reflected); err == nil {
+		t.Errorf("a reflected signature should be rejected")
+	}
+}
+
+func TestFederation_RemotePeers(t *testing.T) {
+	f := newTestFederation(t, "rel://relay-a:80", "secret")
+	link := newFederationLink("rel://relay-b:80", mockConn{})
+	f.links[link.instanceURL] = []*federationLink{link}
+
+	peerID, stringPeerID := messages.HashID("alice")
+	msg, err := messages.MarshalFederationPeersMsg(true, [][]byte{peerID})
+	if err != nil {
+		t.Fatalf("failed to marshal peers message: %s", err)
+	}
+	f.handlePeersMsg(link, msg)
+
+	if l, ok := f.linkOfPeer(stringPeerID); !ok || l != link {
+		t.Fatalf("the peer should be reachable via the link")
+	}
+
+	f.removeLink(link)
+	if _, ok := f.linkOfPeer(stringPeerID); ok {
+		t.Errorf("the peers of a closed link should be removed")
+	}
+}
@@ -56,9 +56,14 @@ type handshake struct {
 	conn        net.Conn
 	validator   auth.Validator
 	preparedMsg *preparedMsg
+	federation  *federation
 
 	handshakeMethodAuth bool
 	peerID              string
+
+	// linkChallenge and linkResponse are set if another relay of the cluster dialed this relay
+	linkChallenge *federationChallenge
+	linkResponse  []byte
 }
 
 func (h *handshake) handshakeReceive() ([]byte, error) {
@@ -91,6 +96,8 @@ func (h *handshake) handshakeReceive() ([]byte, error) {
 	case messages.MsgTypeAuth:
 		h.handshakeMethodAuth = true
 		bytePeerID, peerID, err = h.handleAuthMsg(buf)
+	case messages.MsgTypeFederationAuth:
+		return nil, h.handleFederationAuthMsg(buf)
 	default:
 		return nil, fmt.Errorf("invalid message type %d from %s", msgType, h.conn.RemoteAddr())
 	}
@@ -101,9 +108,16 @@ func (h *handshake) handshakeReceive() ([]byte, error) {
 	return bytePeerID, nil
 }
 
+// isFederationLink returns true if the connection is a link from another relay of the cluster
+func (h *handshake) isFederationLink() bool {
+	return h.linkChallenge != nil
+}
+
 func (h *handshake) handshakeResponse() error {
 	var responseMsg []byte
-	if h.handshakeMethodAuth {
+	if h.isFederationLink() {
+		responseMsg = h.linkResponse
+	} else if h.handshakeMethodAuth {
 		responseMsg = h.preparedMsg.responseAuthMsg
 	} else {
 		responseMsg = h.preparedMsg.responseHelloMsg
@@ -153,3 +167,19 @@ func (h *handshake) handleAuthMsg(buf []byte) ([]byte, string, error) {
 
 	return rawPeerID, peerID, nil
 }
+
+func (h *handshake) handleFederationAuthMsg(buf []byte) error {
+	if h.federation == nil {
+		return fmt.Errorf("federation is not enabled, reject relay link from %s", h.conn.RemoteAddr())
+	}
+
+	challenge, response, err := h.federation.authenticate(buf)
+	if err != nil {
+		return fmt.Errorf("validate relay link from %s: %w", h.conn.RemoteAddr(), err)
+	}
+
+	h.linkChallenge = challenge
+	h.linkResponse = response
+	h.peerID = challenge.instanceURL
+	return nil
+}
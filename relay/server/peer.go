@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -12,6 +13,9 @@ import (
 	"github.com/netbirdio/netbird/relay/healthcheck"
 	"github.com/netbirdio/netbird/relay/messages"
 	"github.com/netbirdio/netbird/relay/metrics"
+	"github.com/netbirdio/netbird/relay/server/listener/quic"
+	"github.com/netbirdio/netbird/relay/server/listener/ws"
+	"github.com/netbirdio/netbird/signal/admin"
 )
 
 const (
@@ -29,6 +33,12 @@ type Peer struct {
 	conn    net.Conn
 	connMu  sync.RWMutex
 	store   *Store
+
+	federation *federation
+	limiter    *peerLimiter
+
+	connectedAt    time.Time
+	bytesForwarded atomic.Int64
 }
 
 // NewPeer creates a new Peer instance and prepare custom logging
@@ -41,6 +51,8 @@ func NewPeer(metrics *metrics.Metrics, id []byte, conn net.Conn, store *Store) *
 		idB:     id,
 		conn:    conn,
 		store:   store,
+
+		connectedAt: time.Now(),
 	}
 }
 
@@ -98,10 +110,13 @@ func (p *Peer) handleMsgType(ctx context.Context, msgType messages.MsgType, hc *
 	switch msgType {
 	case messages.MsgTypeHealthCheck:
 		hc.OnHCResponse()
+		if _, ok := messages.UnmarshalHealthCheckProbe(msg); ok {
+			p.handleHealthCheckProbe(msg)
+		}
 	case messages.MsgTypeTransport:
 		p.metrics.TransferBytesRecv.Add(ctx, int64(n))
 		p.metrics.PeerActivity(p.String())
-		p.handleTransportMsg(msg)
+		p.handleTransportMsg(ctx, msg)
 	case messages.MsgTypeClose:
 		p.log.Infof("peer exited gracefully")
 		if err := p.conn.Close(); err != nil {
@@ -148,6 +163,25 @@ func (p *Peer) String() string {
 	return p.idS
 }
 
+func (p *Peer) info() admin.PeerInfo {
+	var transport string
+	switch p.conn.(type) {
+	case *ws.Conn:
+		transport = admin.TransportWebSocket
+	case *quic.Conn:
+		transport = admin.TransportQUIC
+	}
+
+	return admin.PeerInfo{
+		ID:             p.idS,
+		ConnectedAt:    p.connectedAt,
+		ConnectionAge:  int64(time.Since(p.connectedAt).Seconds()),
+		BytesForwarded: p.bytesForwarded.Load(),
+		Transport:      transport,
+		RemoteAddress:  p.conn.RemoteAddr().String(),
+	}
+}
+
 func (p *Peer) writeWithTimeout(ctx context.Context, buf []byte) error {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
@@ -190,7 +224,18 @@ func (p *Peer) handleHealthcheckEvents(ctx context.Context, hc *healthcheck.Send
 	}
 }
 
-func (p *Peer) handleTransportMsg(msg []byte) {
+// handleHealthCheckProbe echoes the probe back, so the client can measure the round trip time
+func (p *Peer) handleHealthCheckProbe(msg []byte) {
+	if _, err := p.Write(msg); err != nil {
+		p.log.Errorf("failed to echo health check probe: %s", err)
+	}
+}
+
+func (p *Peer) handleTransportMsg(ctx context.Context, msg []byte) {
+	if p.limiter != nil && !p.limiter.wait(ctx, len(msg)) {
+		return
+	}
+
 	peerID, err := messages.UnmarshalTransportID(msg)
 	if err != nil {
 		p.log.Errorf("failed to unmarshal transport message: %s", err)
@@ -200,6 +245,10 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 	stringPeerID := messages.HashIDToString(peerID)
 	dp, ok := p.store.Peer(stringPeerID)
 	if !ok {
+		if p.federation != nil && p.federation.forward(peerID, stringPeerID, p.idB, msg) {
+			p.bytesForwarded.Add(int64(len(msg)))
+			return
+		}
 		p.log.Debugf("peer not found: %s", stringPeerID)
 		return
 	}
@@ -216,4 +265,5 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 		return
 	}
 	p.metrics.TransferBytesSent.Add(context.Background(), int64(n))
+	p.bytesForwarded.Add(int64(n))
 }
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/netbirdio/netbird/relay/metrics"
+)
+
+const (
+	// maxThrottleDelay is the longest time a transport message waits for the rate limits. Longer waiting messages are
+	// dropped, the tunneled protocols handle the loss better than the growing latency.
+	maxThrottleDelay = 250 * time.Millisecond
+	// fairQuantum is the number of bytes a waiting peer is credited with in each round of the fair scheduler
+	fairQuantum = 1500
+
+	limitPeer   = "peer"
+	limitGlobal = "global"
+)
+
+// RateLimitConfig is the bandwidth limit of the relayed transport messages in bytes per second. Zero means no limit.
+type RateLimitConfig struct {
+	// PeerBytesPerSec limits the transport messages sent by a single peer
+	PeerBytesPerSec int
+	// GlobalBytesPerSec limits the transport messages of all peers. The bandwidth is shared fairly between the peers.
+	GlobalBytesPerSec int
+}
+
+// rateLimiter creates the limiters of the peers according to the config
+type rateLimiter struct {
+	cfg       RateLimitConfig
+	metrics   *metrics.Metrics
+	scheduler *fairScheduler
+}
+
+func newRateLimiter(cfg RateLimitConfig, metrics *metrics.Metrics) *rateLimiter {
+	r := &rateLimiter{
+		cfg:     cfg,
+		metrics: metrics,
+	}
+	if cfg.GlobalBytesPerSec > 0 {
+		r.scheduler = newFairScheduler(rate.NewLimiter(rate.Limit(cfg.GlobalBytesPerSec), burstSize(cfg.GlobalBytesPerSec)), metrics)
+		go r.scheduler.run()
+	}
+	return r
+}
+
+func (r *rateLimiter) newPeerLimiter() *peerLimiter {
+	l := &peerLimiter{
+		metrics:   r.metrics,
+		scheduler: r.scheduler,
+	}
+	if r.cfg.PeerBytesPerSec > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(r.cfg.PeerBytesPerSec), burstSize(r.cfg.PeerBytesPerSec))
+	}
+	if r.scheduler != nil {
+		l.flow = &flow{}
+	}
+	return l
+}
+
+func (r *rateLimiter) close() {
+	if r.scheduler != nil {
+		r.scheduler.close()
+	}
+}
+
+// burstSize allows the traffic of 100ms in a burst, but at least one message of the largest size
+func burstSize(bytesPerSec int) int {
+	return max(bytesPerSec/10, bufferSize)
+}
+
+// peerLimiter applies the rate limits to the transport messages of a peer
+type peerLimiter struct {
+	metrics   *metrics.Metrics
+	limiter   *rate.Limiter
+	scheduler *fairScheduler
+	flow      *flow
+}
+
+// wait blocks until the message of n bytes fits into the rate limits. It returns false if the message must be dropped.
+func (l *peerLimiter) wait(ctx context.Context, n int) bool {
+	if l.limiter != nil {
+		r := l.limiter.ReserveN(time.Now(), n)
+		if !r.OK() {
+			l.metrics.MessageDropped(limitPeer)
+			return false
+		}
+
+		delay := r.Delay()
+		if delay > maxThrottleDelay {
+			r.Cancel()
+			l.metrics.MessageDropped(limitPeer)
+			return false
+		}
+
+		if delay > 0 {
+			l.metrics.MessageThrottled(limitPeer)
+			if !sleep(ctx, delay) {
+				r.Cancel()
+				return false
+			}
+		}
+	}
+
+	if l.scheduler != nil {
+		return l.scheduler.wait(ctx, l.flow, n)
+	}
+	return true
+}
+
+// flow is the pending message of a peer in the fair scheduler. A peer has at most one pending message, the reading of
+// its connection is blocked until the message is sent.
+type flow struct {
+	deficit  int
+	size     int
+	enqueued time.Time
+	// result receives whether the pending message is sent. Every message has its own channel, so the result of a
+	// message the peer stopped waiting for is not taken for the next one.
+	result chan bool
+}
+
+// flowMsg is the pending message of a flow taken by the scheduler
+type flowMsg struct {
+	flow     *flow
+	size     int
+	enqueued time.Time
+	result   chan<- bool
+}
+
+// fairScheduler shares the global bandwidth between the peers with deficit round robin. In each round every waiting
+// peer is credited with fairQuantum bytes and the message of the peer is sent once the credit covers its size, so the
+// peers get the same bandwidth regardless of the size of their messages.
+type fairScheduler struct {
+	limiter *rate.Limiter
+	metrics *metrics.Metrics
+
+	mu     sync.Mutex
+	active []*flow
+	wakeup chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newFairScheduler(limiter *rate.Limiter, metrics *metrics.Metrics) *fairScheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fairScheduler{
+		limiter: limiter,
+		metrics: metrics,
+		wakeup:  make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// wait queues the message of n bytes of the flow and blocks until the scheduler lets it through. It returns false if
+// the message must be dropped.
+func (s *fairScheduler) wait(ctx context.Context, f *flow, n int) bool {
+	result := make(chan bool, 1)
+
+	s.mu.Lock()
+	f.size = n
+	f.enqueued = time.Now()
+	f.result = result
+	s.active = append(s.active, f)
+	s.mu.Unlock()
+
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+
+	select {
+	case ok := <-result:
+		return ok
+	case <-ctx.Done():
+		s.remove(f)
+		return false
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+func (s *fairScheduler) run() {
+	for {
+		msg, ok := s.next()
+		if !ok {
+			select {
+			case <-s.wakeup:
+				continue
+			case <-s.ctx.Done():
+				return
+			}
+		}
+
+		if time.Since(msg.enqueued) > maxThrottleDelay {
+			s.metrics.MessageDropped(limitGlobal)
+			msg.result <- false
+			continue
+		}
+
+		r := s.limiter.ReserveN(time.Now(), msg.size)
+		if delay := r.Delay(); delay > 0 {
+			s.metrics.MessageThrottled(limitGlobal)
+			if !sleep(s.ctx, delay) {
+				msg.result <- false
+				return
+			}
+		}
+		msg.result <- true
+	}
+}
+
+// next returns the message of the next flow to send in deficit round robin order
+func (s *fairScheduler) next() (flowMsg, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.active) > 0 {
+		f := s.active[0]
+		s.active = s.active[1:]
+
+		f.deficit += fairQuantum
+		if f.deficit >= f.size {
+			// the flow has no more pending messages, so the remaining credit is not carried over
+			f.deficit = 0
+			return flowMsg{flow: f, size: f.size, enqueued: f.enqueued, result: f.result}, true
+		}
+		s.active = append(s.active, f)
+	}
+	return flowMsg{}, false
+}
+
+func (s *fairScheduler) remove(f *flow) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, active := range s.active {
+		if active == f {
+			s.active = append(s.active[:i], s.active[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *fairScheduler) close() {
+	s.cancel()
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"golang.org/x/time/rate"
+
+	"github.com/netbirdio/netbird/relay/metrics"
+)
+
+func newTestMetrics(t *testing.T) *metrics.Metrics {
+	t.Helper()
+
+	m, err := metrics.NewMetrics(context.Background(), otel.Meter(""))
+	if err != nil {
+		t.Fatalf("failed to create metrics: %s", err)
+	}
+	return m
+}
+
+func TestPeerLimiter_DropsOverLimit(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{PeerBytesPerSec: bufferSize}, newTestMetrics(t))
+	defer r.close()
+
+	l := r.newPeerLimiter()
+	ctx := context.Background()
+
+	// the burst allows one full message, the next one is throttled and the following ones exceed the max delay
+	if !l.wait(ctx, bufferSize) {
+		t.Fatalf("the first message should fit into the burst")
+	}
+
+	start := time.Now()
+	if !l.wait(ctx, bufferSize/10) {
+		t.Fatalf("the second message should be throttled, not dropped")
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Errorf("the second message should be delayed")
+	}
+
+	if l.wait(ctx, bufferSize) {
+		t.Errorf("the message exceeding the max throttle delay should be dropped")
+	}
+}
+
+func TestFairScheduler_Order(t *testing.T) {
+	s := newFairScheduler(rate.NewLimiter(rate.Inf, 0), newTestMetrics(t))
+	defer s.close()
+
+	large := &flow{size: 4 * fairQuantum}
+	small := &flow{size: fairQuantum / 2}
+	s.active = []*flow{large, small}
+
+	// the large message needs four rounds of credit, the small one is sent in each round
+	var order []*flow
+	for i := 0; i < 4; i++ {
+		msg, ok := s.next()
+		if !ok {
+			t.Fatalf("expected a flow to send")
+		}
+		order = append(order, msg.flow)
+		if msg.flow == small {
+			s.active = append(s.active, small)
+		}
+	}
+
+	expected := []*flow{small, small, small, large}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("unexpected order at %d, large message sent too early", i)
+		}
+	}
+}
+
+func TestFairScheduler_Wait(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{GlobalBytesPerSec: 10 * bufferSize}, newTestMetrics(t))
+	defer r.close()
+
+	l := r.newPeerLimiter()
+	if !l.wait(context.Background(), bufferSize) {
+		t.Fatalf("the message should be sent")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r.scheduler.close()
+	if l.wait(ctx, bufferSize) {
+		t.Errorf("the message should not be sent after the scheduler is closed")
+	}
+}
+
+func TestFairScheduler_CancelledWait(t *testing.T) {
+	// the scheduler is driven by the test instead of run
+	s := newFairScheduler(rate.NewLimiter(rate.Inf, 0), newTestMetrics(t))
+	defer s.close()
+
+	f := &flow{}
+	takeMsg := func() flowMsg {
+		t.Helper()
+		for i := 0; i < 100; i++ {
+			if msg, ok := s.next(); ok {
+				return msg
+			}
+			time.Sleep(time.Millisecond)
+		}
+		t.Fatalf("expected a flow to send")
+		return flowMsg{}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan bool, 1)
+	go func() {
+		cancelled <- s.wait(ctx, f, bufferSize)
+	}()
+
+	// the scheduler takes the message, the peer stops waiting before the result is sent
+	stale := takeMsg()
+	cancel()
+	if <-cancelled {
+		t.Fatalf("the cancelled message should not be sent")
+	}
+	stale.result <- true
+
+	sent := make(chan bool, 1)
+	go func() {
+		sent <- s.wait(context.Background(), f, bufferSize)
+	}()
+
+	msg := takeMsg()
+	select {
+	case <-sent:
+		t.Fatalf("the next message should not take the result of the cancelled one")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	msg.result <- false
+	if <-sent {
+		t.Errorf("the next message should get its own result")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/netbirdio/netbird/relay/auth"
 	//nolint:staticcheck
 	"github.com/netbirdio/netbird/relay/metrics"
+	"github.com/netbirdio/netbird/signal/admin"
 )
 
 // Relay represents the relay server
@@ -26,6 +27,8 @@ type Relay struct {
 	store       *Store
 	instanceURL string
 	preparedMsg *preparedMsg
+	federation  *federation
+	rateLimiter *rateLimiter
 
 	closed  bool
 	closeMu sync.RWMutex
@@ -42,11 +45,17 @@ type Relay struct {
 // instance URL depends on this value.
 // validator: An instance of auth.Validator from the auth package. It is used to validate the authentication of the
 // peers.
+// opts: Optional features of the relay, e.g. WithFederation or WithRateLimit.
 //
 // Returns:
 // A pointer to a Relay instance and an error. If the Relay instance is successfully created, the error is nil.
 // Otherwise, the error contains the details of what went wrong.
-func NewRelay(meter metric.Meter, exposedAddress string, tlsSupport bool, validator auth.Validator) (*Relay, error) {
+func NewRelay(meter metric.Meter, exposedAddress string, tlsSupport bool, validator auth.Validator, opts ...Option) (*Relay, error) {
+	var options relayOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	ctx, metricsCancel := context.WithCancel(context.Background())
 	m, err := metrics.NewMetrics(ctx, meter)
 	if err != nil {
@@ -73,6 +82,28 @@ func NewRelay(meter metric.Meter, exposedAddress string, tlsSupport bool, valida
 		return nil, fmt.Errorf("prepare message: %v", err)
 	}
 
+	if options.rateLimit != nil && (options.rateLimit.PeerBytesPerSec > 0 || options.rateLimit.GlobalBytesPerSec > 0) {
+		r.rateLimiter = newRateLimiter(*options.rateLimit, r.metrics)
+	}
+
+	if options.federation != nil {
+		r.federation, err = newFederation(r.instanceURL, *options.federation, r.store, r.metrics)
+		if err != nil {
+			metricsCancel()
+			return nil, fmt.Errorf("create federation: %v", err)
+		}
+
+		for _, member := range options.federation.Members {
+			memberURL, err := getInstanceURL(member, tlsSupport)
+			if err != nil {
+				r.federation.close()
+				metricsCancel()
+				return nil, fmt.Errorf("invalid federation member %s: %v", member, err)
+			}
+			r.federation.addMember(memberURL, true)
+		}
+	}
+
 	return r, nil
 }
 
@@ -115,6 +146,7 @@ func (r *Relay) Accept(conn net.Conn) {
 		conn:        conn,
 		validator:   r.validator,
 		preparedMsg: r.preparedMsg,
+		federation:  r.federation,
 	}
 	peerID, err := h.handshakeReceive()
 	if err != nil {
@@ -125,26 +157,62 @@ func (r *Relay) Accept(conn net.Conn) {
 		return
 	}
 
+	if h.isFederationLink() {
+		r.acceptFederationLink(&h, conn)
+		return
+	}
+
 	peer := NewPeer(r.metrics, peerID, conn, r.store)
+	peer.federation = r.federation
+	if r.rateLimiter != nil {
+		peer.limiter = r.rateLimiter.newPeerLimiter()
+	}
 	peer.log.Infof("peer connected from: %s", conn.RemoteAddr())
 	storeTime := time.Now()
 	r.store.AddPeer(peer)
 	r.metrics.RecordPeerStoreTime(time.Since(storeTime))
 	r.metrics.PeerConnected(peer.String())
+	if r.federation != nil {
+		r.federation.peerConnected(peer)
+	}
 	go func() {
 		peer.Work()
 		r.store.DeletePeer(peer)
 		peer.log.Debugf("relay connection closed")
 		r.metrics.PeerDisconnected(peer.String())
+		if r.federation != nil {
+			r.federation.peerDisconnected(peer)
+		}
 	}()
 
 	if err := h.handshakeResponse(); err != nil {
 		log.Errorf("failed to send handshake response, close peer: %s", err)
 		peer.Close()
+	} else if r.federation != nil {
+		r.federation.sendClusterInfo(peer)
 	}
 	r.metrics.RecordAuthenticationTime(time.Since(acceptTime))
 }
 
+func (r *Relay) acceptFederationLink(h *handshake, conn net.Conn) {
+	if err := h.handshakeResponse(); err != nil {
+		log.Errorf("failed to send federation handshake response: %s", err)
+		if cErr := conn.Close(); cErr != nil {
+			log.Errorf("failed to close connection, %s: %s", conn.RemoteAddr(), cErr)
+		}
+		return
+	}
+
+	if err := r.federation.receiveProof(h.linkChallenge, conn); err != nil {
+		log.Errorf("failed to authenticate relay link from %s: %s", conn.RemoteAddr(), err)
+		if cErr := conn.Close(); cErr != nil {
+			log.Errorf("failed to close connection, %s: %s", conn.RemoteAddr(), cErr)
+		}
+		return
+	}
+	r.federation.acceptLink(h.linkChallenge.instanceURL, conn)
+}
+
 // Shutdown closes the relay server
 // It closes the connection with all peers in gracefully and stops accepting new connections.
 func (r *Relay) Shutdown(ctx context.Context) {
@@ -152,6 +220,13 @@ func (r *Relay) Shutdown(ctx context.Context) {
 	r.closeMu.Lock()
 	defer r.closeMu.Unlock()
 
+	if r.federation != nil {
+		r.federation.close()
+	}
+	if r.rateLimiter != nil {
+		r.rateLimiter.close()
+	}
+
 	wg := sync.WaitGroup{}
 	peers := r.store.Peers()
 	for _, peer := range peers {
@@ -166,6 +241,27 @@ func (r *Relay) Shutdown(ctx context.Context) {
 	r.closed = true
 }
 
+// Peers returns the peers connected to the relay server
+func (r *Relay) Peers() []admin.PeerInfo {
+	peers := r.store.Peers()
+	infos := make([]admin.PeerInfo, 0, len(peers))
+	for _, p := range peers {
+		infos = append(infos, p.info())
+	}
+	return infos
+}
+
+// DisconnectPeer closes the connection of the peer with the hashed ID gracefully
+func (r *Relay) DisconnectPeer(id string) bool {
+	p, ok := r.store.Peer(id)
+	if !ok {
+		return false
+	}
+	p.log.Infof("disconnecting peer by admin request")
+	p.CloseGracefully(context.Background())
+	return true
+}
+
 // InstanceURL returns the instance URL of the relay server
 func (r *Relay) InstanceURL() string {
 	return r.instanceURL
@@ -15,6 +15,7 @@ import (
 	"github.com/netbirdio/netbird/relay/server/listener/quic"
 	"github.com/netbirdio/netbird/relay/server/listener/ws"
 	quictls "github.com/netbirdio/netbird/relay/tls"
+	"github.com/netbirdio/netbird/signal/admin"
 )
 
 // ListenerConfig is the configuration for the listener.
@@ -25,6 +26,29 @@ type ListenerConfig struct {
 	TLSConfig *tls.Config
 }
 
+// Option configures an optional feature of the relay server
+type Option func(*relayOptions)
+
+type relayOptions struct {
+	federation *FederationConfig
+	rateLimit  *RateLimitConfig
+}
+
+// WithFederation links the relay server with the other relay servers of the cluster. Transport messages to peers
+// connected to a linked relay server are forwarded there.
+func WithFederation(cfg FederationConfig) Option {
+	return func(o *relayOptions) {
+		o.federation = &cfg
+	}
+}
+
+// WithRateLimit limits the bandwidth of the relayed transport messages per peer and in total
+func WithRateLimit(cfg RateLimitConfig) Option {
+	return func(o *relayOptions) {
+		o.rateLimit = &cfg
+	}
+}
+
 // Server is the main entry point for the relay server.
 // It is the gate between the WebSocket listener and the Relay server logic.
 // In a new HTTP connection, the server will accept the connection and pass it to the Relay server via the Accept method.
@@ -38,8 +62,9 @@ type Server struct {
 // exposedAddress: this address will be used as the instance URL. It should be a domain:port format.
 // tlsSupport: if true, the server will support TLS
 // authValidator: the auth validator to use for the server
-func NewServer(meter metric.Meter, exposedAddress string, tlsSupport bool, authValidator auth.Validator) (*Server, error) {
-	relay, err := NewRelay(meter, exposedAddress, tlsSupport, authValidator)
+// opts: optional features of the server
+func NewServer(meter metric.Meter, exposedAddress string, tlsSupport bool, authValidator auth.Validator, opts ...Option) (*Server, error) {
+	relay, err := NewRelay(meter, exposedAddress, tlsSupport, authValidator, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,3 +132,13 @@ func (r *Server) Shutdown(ctx context.Context) error {
 func (r *Server) InstanceURL() string {
 	return r.relay.instanceURL
 }
+
+// Peers returns the peers connected to the relay server
+func (r *Server) Peers() []admin.PeerInfo {
+	return r.relay.Peers()
+}
+
+// DisconnectPeer closes the connection of the peer with the hashed ID
+func (r *Server) DisconnectPeer(id string) bool {
+	return r.relay.DisconnectPeer(id)
+}
@@ -103,6 +103,36 @@ type Route struct {
 	Enabled             bool
 	Groups              []string `gorm:"serializer:json"`
 	AccessControlGroups []string `gorm:"serializer:json"`
+	ExitNode            ExitNode `gorm:"embedded;embeddedPrefix:exit_node_"`
+	// DNSUpstreams are the resolvers the routing peer relays the queries of the domains to.
+	// They are inherited from the network of a network resource and are not stored with the route
+	DNSUpstreams []string `gorm:"-"`
+}
+
+// ExitNode holds the settings of a default route that peers can explicitly choose as their exit node
+type ExitNode struct {
+	// Enabled marks the route as exit node
+	Enabled bool
+	// UserGroups limits the exit node to peers of users that belong to one of the groups. Empty allows all users
+	UserGroups []string `gorm:"serializer:json"`
+	// AllowLANAccess allows the peers using the exit node to access the local networks of the routing peer
+	AllowLANAccess bool
+}
+
+// Copy copies an exit node object
+func (e ExitNode) Copy() ExitNode {
+	return ExitNode{
+		Enabled:        e.Enabled,
+		UserGroups:     slices.Clone(e.UserGroups),
+		AllowLANAccess: e.AllowLANAccess,
+	}
+}
+
+// IsEqual compares one exit node with the other
+func (e ExitNode) IsEqual(other ExitNode) bool {
+	return e.Enabled == other.Enabled &&
+		slices.Equal(e.UserGroups, other.UserGroups) &&
+		e.AllowLANAccess == other.AllowLANAccess
 }
 
 // EventMeta returns activity event meta related to the route
@@ -128,6 +158,8 @@ func (r *Route) Copy() *Route {
 		Enabled:             r.Enabled,
 		Groups:              slices.Clone(r.Groups),
 		AccessControlGroups: slices.Clone(r.AccessControlGroups),
+		ExitNode:            r.ExitNode.Copy(),
+		DNSUpstreams:        slices.Clone(r.DNSUpstreams),
 	}
 	return route
 }
@@ -154,7 +186,14 @@ func (r *Route) IsEqual(other *Route) bool {
 		other.Enabled == r.Enabled &&
 		slices.Equal(r.Groups, other.Groups) &&
 		slices.Equal(r.PeerGroups, other.PeerGroups) &&
-		slices.Equal(r.AccessControlGroups, other.AccessControlGroups)
+		slices.Equal(r.AccessControlGroups, other.AccessControlGroups) &&
+		r.ExitNode.IsEqual(other.ExitNode) &&
+		slices.Equal(r.DNSUpstreams, other.DNSUpstreams)
+}
+
+// IsExitNode returns if the route is a default route marked as exit node
+func (r *Route) IsExitNode() bool {
+	return r.ExitNode.Enabled
 }
 
 // IsDynamic returns if the route is dynamic, i.e. has domains
@@ -0,0 +1,130 @@
+// Package admin provides the admin HTTP API of the signal and relay servers to inspect the connected peers and to
+// disconnect them.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TransportGRPC      = "grpc"
+	TransportWebSocket = "ws"
+	TransportQUIC      = "quic"
+)
+
+// PeerInfo describes a peer connected to the server
+type PeerInfo struct {
+	// ID is the hashed peer ID, see relay/messages.HashID
+	ID          string    `json:"id"`
+	ConnectedAt time.Time `json:"connected_at"`
+	// ConnectionAge is the connection age in seconds
+	ConnectionAge  int64  `json:"connection_age"`
+	BytesForwarded int64  `json:"bytes_forwarded"`
+	Transport      string `json:"transport"`
+	RemoteAddress  string `json:"remote_address"`
+}
+
+// Registry gives access to the peers connected to the server
+type Registry interface {
+	// Peers returns the connected peers
+	Peers() []PeerInfo
+	// DisconnectPeer closes the connection of the peer with the hashed ID. It returns false if the peer is not
+	// connected.
+	DisconnectPeer(id string) bool
+}
+
+// Server serves the admin API. Every request must carry the admin token as bearer token.
+type Server struct {
+	registry Registry
+	token    string
+
+	*http.Server
+}
+
+// NewServer creates the admin API server listening on the address
+func NewServer(address, token string, registry Registry) (*Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("admin token is required")
+	}
+
+	s := &Server{
+		registry: registry,
+		token:    token,
+	}
+
+	router := http.NewServeMux()
+	router.HandleFunc("GET /peers", s.listPeers)
+	router.HandleFunc("GET /peers/{id}", s.getPeer)
+	router.HandleFunc("DELETE /peers/{id}", s.disconnectPeer)
+
+	s.Server = &http.Server{
+		Addr:              address,
+		Handler:           s.authenticate(router),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s, nil
+}
+
+// Shutdown stops the admin server
+func (s *Server) Shutdown(ctx context.Context) error {
+	if err := s.Server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("http server: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) listPeers(w http.ResponseWriter, _ *http.Request) {
+	peers := s.registry.Peers()
+	slices.SortFunc(peers, func(a, b PeerInfo) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	writeJSON(w, peers)
+}
+
+func (s *Server) getPeer(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	for _, p := range s.registry.Peers() {
+		if p.ID == id {
+			writeJSON(w, p)
+			return
+		}
+	}
+	http.Error(w, "peer not found", http.StatusNotFound)
+}
+
+func (s *Server) disconnectPeer(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.registry.DisconnectPeer(id) {
+		http.Error(w, "peer not found", http.StatusNotFound)
+		return
+	}
+	log.Infof("peer %s disconnected via the admin API from %s", id, r.RemoteAddr)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("failed to write admin API response: %s", err)
+	}
+}
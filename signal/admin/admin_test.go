@@ -0,0 +1,91 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRegistry struct {
+	peers        []PeerInfo
+	disconnected []string
+}
+
+func (m *mockRegistry) Peers() []PeerInfo {
+	return m.peers
+}
+
+func (m *mockRegistry) DisconnectPeer(id string) bool {
+	for _, p := range m.peers {
+		if p.ID == id {
+			m.disconnected = append(m.disconnected, id)
+			return true
+		}
+	}
+	return false
+}
+
+func TestServer(t *testing.T) {
+	registry := &mockRegistry{
+		peers: []PeerInfo{
+			{ID: "sha-b/+=", Transport: TransportQUIC, BytesForwarded: 20},
+			{ID: "sha-a/+=", Transport: TransportWebSocket, BytesForwarded: 10},
+		},
+	}
+
+	_, err := NewServer("127.0.0.1:0", "", registry)
+	require.Error(t, err, "the token should be required")
+
+	srv, err := NewServer("127.0.0.1:0", "secret", registry)
+	require.NoError(t, err)
+
+	request := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "/peers", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "/peers", "wrong").Code)
+	})
+
+	t.Run("list peers", func(t *testing.T) {
+		rec := request(http.MethodGet, "/peers", "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var peers []PeerInfo
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+		require.Len(t, peers, 2)
+		assert.Equal(t, "sha-a/+=", peers[0].ID, "the peers should be sorted by ID")
+		assert.Equal(t, int64(10), peers[0].BytesForwarded)
+	})
+
+	t.Run("get peer", func(t *testing.T) {
+		rec := request(http.MethodGet, "/peers/"+url.PathEscape("sha-b/+="), "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var peer PeerInfo
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peer))
+		assert.Equal(t, TransportQUIC, peer.Transport)
+
+		assert.Equal(t, http.StatusNotFound, request(http.MethodGet, "/peers/unknown", "secret").Code)
+	})
+
+	t.Run("disconnect peer", func(t *testing.T) {
+		rec := request(http.MethodDelete, "/peers/"+url.PathEscape("sha-a/+="), "secret")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, []string{"sha-a/+="}, registry.disconnected)
+
+		assert.Equal(t, http.StatusNotFound, request(http.MethodDelete, "/peers/unknown", "secret").Code)
+	})
+}
@@ -0,0 +1,37 @@
+// Package bus routes signal messages between signal server instances that share a peer registry,
+// so a message can be delivered to a peer connected to any instance behind a load balancer.
+package bus
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// PresenceTTL is how long the presence of a peer is kept without a refresh of its instance.
+	// Peers of an instance that stops refreshing are considered offline once it expires.
+	PresenceTTL = 30 * time.Second
+	// presenceRefreshInterval is the interval in which an instance refreshes the presence of its peers
+	presenceRefreshInterval = PresenceTTL / 3
+)
+
+// ErrPresenceNotFound is returned when a peer isn't connected to any instance
+var ErrPresenceNotFound = errors.New("peer presence not found")
+
+// MessageBus is the transport shared by the signal instances. It tracks which instance a peer is connected to
+// and carries the messages between the instances.
+type MessageBus interface {
+	// SetPresence records that the peer is connected to the instance for the given duration
+	SetPresence(ctx context.Context, peerID, instanceID string, ttl time.Duration) error
+	// GetPresence returns the instance the peer is connected to or ErrPresenceNotFound
+	GetPresence(ctx context.Context, peerID string) (string, error)
+	// DeletePresence removes the presence of the peer if it is still recorded for the instance
+	DeletePresence(ctx context.Context, peerID, instanceID string) error
+	// Publish sends the data to the instance and reports whether a running instance received it
+	Publish(ctx context.Context, instanceID string, data []byte) (bool, error)
+	// Subscribe returns the data published to the instance until the context is done
+	Subscribe(ctx context.Context, instanceID string) (<-chan []byte, error)
+	// Close releases the resources of the bus
+	Close() error
+}
@@ -0,0 +1,108 @@
+package bus
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryPresence struct {
+	instanceID string
+	expiresAt  time.Time
+}
+
+// MemoryBus is an in-process MessageBus shared by the routers of a single process, mostly useful for tests
+type MemoryBus struct {
+	mu          sync.Mutex
+	presence    map[string]memoryPresence
+	subscribers map[string]chan []byte
+	now         func() time.Time
+}
+
+// NewMemoryBus creates a new in-process message bus
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		presence:    make(map[string]memoryPresence),
+		subscribers: make(map[string]chan []byte),
+		now:         time.Now,
+	}
+}
+
+// SetPresence records that the peer is connected to the instance for the given duration
+func (b *MemoryBus) SetPresence(_ context.Context, peerID, instanceID string, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.presence[peerID] = memoryPresence{instanceID: instanceID, expiresAt: b.now().Add(ttl)}
+	return nil
+}
+
+// GetPresence returns the instance the peer is connected to or ErrPresenceNotFound
+func (b *MemoryBus) GetPresence(_ context.Context, peerID string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	presence, ok := b.presence[peerID]
+	if !ok {
+		return "", ErrPresenceNotFound
+	}
+	if !b.now().Before(presence.expiresAt) {
+		delete(b.presence, peerID)
+		return "", ErrPresenceNotFound
+	}
+	return presence.instanceID, nil
+}
+
+// DeletePresence removes the presence of the peer if it is still recorded for the instance
+func (b *MemoryBus) DeletePresence(_ context.Context, peerID, instanceID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if presence, ok := b.presence[peerID]; ok && presence.instanceID == instanceID {
+		delete(b.presence, peerID)
+	}
+	return nil
+}
+
+// Publish sends the data to the instance and reports whether a running instance received it
+func (b *MemoryBus) Publish(ctx context.Context, instanceID string, data []byte) (bool, error) {
+	b.mu.Lock()
+	ch, ok := b.subscribers[instanceID]
+	b.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	select {
+	case ch <- data:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+// Subscribe returns the data published to the instance until the context is done
+func (b *MemoryBus) Subscribe(ctx context.Context, instanceID string) (<-chan []byte, error) {
+	ch := make(chan []byte, 100)
+
+	b.mu.Lock()
+	b.subscribers[instanceID] = ch
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		if b.subscribers[instanceID] == ch {
+			delete(b.subscribers, instanceID)
+		}
+		b.mu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// Close releases the resources of the bus
+func (b *MemoryBus) Close() error {
+	return nil
+}
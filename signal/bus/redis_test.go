@@ -0,0 +1,250 @@
+package bus
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// redisStandIn is a minimal Redis compatible server supporting the commands used by RedisBus
+type redisStandIn struct {
+	listener net.Listener
+
+	mu          sync.Mutex
+	values      map[string]redisStandInValue
+	subscribers map[string]map[*redisStandInConn]struct{}
+}
+
+type redisStandInValue struct {
+	value     string
+	expiresAt time.Time
+}
+
+type redisStandInConn struct {
+	mu       sync.Mutex
+	writer   *bufio.Writer
+	channels map[string]struct{}
+}
+
+func startRedisStandIn(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &redisStandIn{
+		listener:    listener,
+		values:      make(map[string]redisStandInValue),
+		subscribers: make(map[string]map[*redisStandInConn]struct{}),
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return "redis://" + listener.Addr().String() + "/0"
+}
+
+func (s *redisStandIn) serve(conn net.Conn) {
+	c := &redisStandInConn{writer: bufio.NewWriter(conn), channels: make(map[string]struct{})}
+	defer func() {
+		s.mu.Lock()
+		for channel := range c.channels {
+			delete(s.subscribers[channel], c)
+		}
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readRedisCommand(reader)
+		if err != nil {
+			return
+		}
+		s.handle(c, args)
+	}
+}
+
+func (s *redisStandIn) handle(c *redisStandInConn, args []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		if len(c.channels) > 0 {
+			c.write("*2\r\n$4\r\npong\r\n$0\r\n\r\n")
+			return
+		}
+		c.write("+PONG\r\n")
+	case "SET":
+		value := redisStandInValue{value: args[2]}
+		if len(args) == 5 {
+			ttl, _ := strconv.Atoi(args[4])
+			unit := time.Second
+			if strings.EqualFold(args[3], "PX") {
+				unit = time.Millisecond
+			}
+			value.expiresAt = time.Now().Add(time.Duration(ttl) * unit)
+		}
+		s.values[args[1]] = value
+		c.write("+OK\r\n")
+	case "GET":
+		value, ok := s.values[args[1]]
+		if !ok || (!value.expiresAt.IsZero() && time.Now().After(value.expiresAt)) {
+			c.write("$-1\r\n")
+			return
+		}
+		c.write(bulkString(value.value))
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := s.values[key]; ok {
+				delete(s.values, key)
+				deleted++
+			}
+		}
+		c.write(fmt.Sprintf(":%d\r\n", deleted))
+	case "EVALSHA":
+		// scripts are not cached, the client sends the source with EVAL
+		c.write("-NOSCRIPT No matching script. Please use EVAL.\r\n")
+	case "EVAL":
+		if args[1] != deletePresenceSource {
+			c.write("-ERR unsupported script\r\n")
+			return
+		}
+		// KEYS[1] follows the key count, ARGV[1] the keys
+		key, instanceID := args[3], args[4]
+		if value, ok := s.values[key]; ok && value.value == instanceID {
+			delete(s.values, key)
+			c.write(":1\r\n")
+			return
+		}
+		c.write(":0\r\n")
+	case "PUBLISH":
+		for subscriber := range s.subscribers[args[1]] {
+			subscriber.write("*3\r\n" + bulkString("message") + bulkString(args[1]) + bulkString(args[2]))
+		}
+		c.write(fmt.Sprintf(":%d\r\n", len(s.subscribers[args[1]])))
+	case "SUBSCRIBE":
+		for _, channel := range args[1:] {
+			if s.subscribers[channel] == nil {
+				s.subscribers[channel] = make(map[*redisStandInConn]struct{})
+			}
+			s.subscribers[channel][c] = struct{}{}
+			c.channels[channel] = struct{}{}
+			c.write(fmt.Sprintf("*3\r\n%s%s:%d\r\n", bulkString("subscribe"), bulkString(channel), len(c.channels)))
+		}
+	default:
+		c.write(fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0]))
+	}
+}
+
+func (c *redisStandInConn) write(reply string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, _ = c.writer.WriteString(reply)
+	_ = c.writer.Flush()
+}
+
+func bulkString(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+func readRedisCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected command %q", line)
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+	for range count {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		arg := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, arg); err != nil {
+			return nil, err
+		}
+		args = append(args, string(arg[:size]))
+	}
+	return args, nil
+}
+
+func TestRouter_RedisBus(t *testing.T) {
+	bus, err := NewRedisBus(context.Background(), startRedisStandIn(t))
+	require.NoError(t, err)
+	defer bus.Close()
+
+	testRouterDelivery(t, bus)
+}
+
+func TestRedisBus_PresenceExpires(t *testing.T) {
+	ctx := context.Background()
+
+	bus, err := NewRedisBus(ctx, startRedisStandIn(t))
+	require.NoError(t, err)
+	defer bus.Close()
+
+	require.NoError(t, bus.SetPresence(ctx, "peer", "instance", 100*time.Millisecond))
+	instanceID, err := bus.GetPresence(ctx, "peer")
+	require.NoError(t, err)
+	require.Equal(t, "instance", instanceID)
+
+	require.Eventually(t, func() bool {
+		_, err := bus.GetPresence(ctx, "peer")
+		return errors.Is(err, ErrPresenceNotFound)
+	}, 5*time.Second, 20*time.Millisecond, "peers of an instance that stopped refreshing should expire")
+}
+
+func TestRedisBus_DeletePresenceKeepsOtherInstance(t *testing.T) {
+	ctx := context.Background()
+
+	bus, err := NewRedisBus(ctx, startRedisStandIn(t))
+	require.NoError(t, err)
+	defer bus.Close()
+
+	// the peer reconnected to instance-b before instance-a removed its presence
+	require.NoError(t, bus.SetPresence(ctx, "peer", "instance-b", time.Minute))
+	require.NoError(t, bus.DeletePresence(ctx, "peer", "instance-a"))
+
+	instanceID, err := bus.GetPresence(ctx, "peer")
+	require.NoError(t, err)
+	require.Equal(t, "instance-b", instanceID)
+
+	require.NoError(t, bus.DeletePresence(ctx, "peer", "instance-b"))
+	_, err = bus.GetPresence(ctx, "peer")
+	require.ErrorIs(t, err, ErrPresenceNotFound)
+
+	require.NoError(t, bus.DeletePresence(ctx, "unknown", "instance-b"), "deleting a missing presence should succeed")
+}
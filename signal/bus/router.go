@@ -0,0 +1,206 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	gproto "google.golang.org/protobuf/proto"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+// MessageHandler delivers a message received from another instance to a locally connected peer
+type MessageHandler func(ctx context.Context, msg *proto.EncryptedMessage)
+
+// Router announces the peers connected to this instance on the message bus and routes the messages
+// of peers connected to other instances
+type Router struct {
+	bus        MessageBus
+	instanceID string
+	handler    MessageHandler
+
+	mu sync.Mutex
+	// peers connected to this instance whose presence is refreshed
+	peers map[string]struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRouter subscribes the instance to the message bus and starts refreshing the presence of its peers
+func NewRouter(ctx context.Context, bus MessageBus, instanceID string, handler MessageHandler) (*Router, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	messages, err := bus.Subscribe(ctx, instanceID)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("subscribe instance %s: %w", instanceID, err)
+	}
+
+	r := &Router{
+		bus:        bus,
+		instanceID: instanceID,
+		handler:    handler,
+		peers:      make(map[string]struct{}),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+
+	r.wg.Add(2)
+	go r.receive(messages)
+	go r.refreshPresence()
+
+	return r, nil
+}
+
+// InstanceID returns the ID of this instance on the message bus
+func (r *Router) InstanceID() string {
+	return r.instanceID
+}
+
+// Register announces that the peer is connected to this instance
+func (r *Router) Register(ctx context.Context, peerID string) error {
+	r.mu.Lock()
+	r.peers[peerID] = struct{}{}
+	r.mu.Unlock()
+
+	return r.bus.SetPresence(ctx, peerID, r.instanceID, PresenceTTL)
+}
+
+// Deregister removes the presence of a peer that disconnected from this instance
+func (r *Router) Deregister(ctx context.Context, peerID string) error {
+	r.mu.Lock()
+	delete(r.peers, peerID)
+	r.mu.Unlock()
+
+	return r.bus.DeletePresence(ctx, peerID, r.instanceID)
+}
+
+// ConnectedElsewhere reports whether the peer is connected to another instance
+func (r *Router) ConnectedElsewhere(ctx context.Context, peerID string) (bool, error) {
+	instanceID, err := r.bus.GetPresence(ctx, peerID)
+	if errors.Is(err, ErrPresenceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get presence of peer %s: %w", peerID, err)
+	}
+	return instanceID != r.instanceID, nil
+}
+
+// Send routes the message to the instance the remote peer is connected to.
+// It returns false if the peer isn't connected to another running instance.
+func (r *Router) Send(ctx context.Context, msg *proto.EncryptedMessage) (bool, error) {
+	instanceID, err := r.bus.GetPresence(ctx, msg.RemoteKey)
+	if errors.Is(err, ErrPresenceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get presence of peer %s: %w", msg.RemoteKey, err)
+	}
+
+	if instanceID == r.instanceID {
+		// the peer is recorded for this instance but is not in the local registry anymore
+		return false, nil
+	}
+
+	data, err := gproto.Marshal(msg)
+	if err != nil {
+		return false, fmt.Errorf("marshal message: %w", err)
+	}
+
+	delivered, err := r.bus.Publish(ctx, instanceID, data)
+	if err != nil {
+		return false, fmt.Errorf("publish message to instance %s: %w", instanceID, err)
+	}
+
+	if !delivered {
+		// the instance died without removing the presence of its peers, the peer will reconnect to another instance
+		log.Debugf("signal instance %s of peer [%s] is gone, removing the peer presence", instanceID, msg.RemoteKey)
+		if err := r.bus.DeletePresence(ctx, msg.RemoteKey, instanceID); err != nil {
+			log.Warnf("failed to remove the presence of peer [%s] on instance %s: %v", msg.RemoteKey, instanceID, err)
+		}
+	}
+
+	return delivered, nil
+}
+
+// Close stops the router and removes the presence of the peers connected to this instance
+func (r *Router) Close() error {
+	r.cancel()
+	r.wg.Wait()
+
+	r.mu.Lock()
+	peers := make([]string, 0, len(r.peers))
+	for peerID := range r.peers {
+		peers = append(peers, peerID)
+	}
+	r.peers = make(map[string]struct{})
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var errs []error
+	for _, peerID := range peers {
+		if err := r.bus.DeletePresence(ctx, peerID, r.instanceID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Router) receive(messages <-chan []byte) {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case data, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			msg := &proto.EncryptedMessage{}
+			if err := gproto.Unmarshal(data, msg); err != nil {
+				log.Warnf("failed to unmarshal a message received from the message bus: %v", err)
+				continue
+			}
+			r.handler(r.ctx, msg)
+		}
+	}
+}
+
+// refreshPresence keeps the presence of the local peers alive, so they expire only when this instance dies
+func (r *Router) refreshPresence() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(presenceRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			peers := make([]string, 0, len(r.peers))
+			for peerID := range r.peers {
+				peers = append(peers, peerID)
+			}
+			r.mu.Unlock()
+
+			for _, peerID := range peers {
+				if err := r.bus.SetPresence(r.ctx, peerID, r.instanceID, PresenceTTL); err != nil {
+					log.Warnf("failed to refresh the presence of peer [%s]: %v", peerID, err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package bus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func newTestRouter(t *testing.T, ctx context.Context, bus MessageBus, instanceID string) (*Router, chan *proto.EncryptedMessage) {
+	t.Helper()
+
+	received := make(chan *proto.EncryptedMessage, 10)
+	router, err := NewRouter(ctx, bus, instanceID, func(_ context.Context, msg *proto.EncryptedMessage) {
+		received <- msg
+	})
+	require.NoError(t, err)
+	return router, received
+}
+
+func testRouterDelivery(t *testing.T, bus MessageBus) {
+	t.Helper()
+	ctx := context.Background()
+
+	routerA, _ := newTestRouter(t, ctx, bus, "instance-a")
+	defer routerA.Close()
+
+	ctxB, killB := context.WithCancel(ctx)
+	defer killB()
+	routerB, receivedB := newTestRouter(t, ctxB, bus, "instance-b")
+
+	require.NoError(t, routerB.Register(ctx, "peer-b"))
+
+	msg := &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer")}
+	delivered, err := routerA.Send(ctx, msg)
+	require.NoError(t, err)
+	assert.True(t, delivered)
+
+	select {
+	case got := <-receivedB:
+		assert.Equal(t, msg.Key, got.Key)
+		assert.Equal(t, msg.Body, got.Body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the message was not delivered to the instance of the peer")
+	}
+
+	delivered, err = routerA.Send(ctx, &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "unknown"})
+	require.NoError(t, err)
+	assert.False(t, delivered, "messages to unknown peers should not be delivered")
+
+	delivered, err = routerB.Send(ctx, &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b"})
+	require.NoError(t, err)
+	assert.False(t, delivered, "messages to peers of the own instance should be delivered locally")
+
+	// the instance dies without removing the presence of its peers
+	killB()
+	routerB.wg.Wait()
+
+	require.Eventually(t, func() bool {
+		delivered, err := routerA.Send(ctx, msg)
+		return err == nil && !delivered
+	}, 5*time.Second, 50*time.Millisecond, "messages to a dead instance should not be delivered")
+
+	_, err = bus.GetPresence(ctx, "peer-b")
+	assert.ErrorIs(t, err, ErrPresenceNotFound, "the presence on a dead instance should be removed")
+
+	// the peer reconnects to the remaining instance
+	require.NoError(t, routerA.Register(ctx, "peer-b"))
+	instanceID, err := bus.GetPresence(ctx, "peer-b")
+	require.NoError(t, err)
+	assert.Equal(t, "instance-a", instanceID)
+}
+
+func TestRouter_MemoryBus(t *testing.T) {
+	testRouterDelivery(t, NewMemoryBus())
+}
+
+func TestRouter_Deregister(t *testing.T) {
+	ctx := context.Background()
+	bus := NewMemoryBus()
+
+	routerA, _ := newTestRouter(t, ctx, bus, "instance-a")
+	routerB, _ := newTestRouter(t, ctx, bus, "instance-b")
+	defer routerB.Close()
+
+	require.NoError(t, routerA.Register(ctx, "peer"))
+	require.NoError(t, routerB.Register(ctx, "peer"))
+
+	require.NoError(t, routerA.Deregister(ctx, "peer"))
+	instanceID, err := bus.GetPresence(ctx, "peer")
+	require.NoError(t, err)
+	assert.Equal(t, "instance-b", instanceID, "an instance should not remove the presence of a peer on another instance")
+
+	require.NoError(t, routerA.Register(ctx, "other"))
+	require.NoError(t, routerA.Close())
+	_, err = bus.GetPresence(ctx, "other")
+	assert.ErrorIs(t, err, ErrPresenceNotFound, "closing the router should remove the presence of its peers")
+}
+
+func TestMemoryBus_PresenceExpires(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	bus := NewMemoryBus()
+	bus.now = func() time.Time { return now }
+
+	require.NoError(t, bus.SetPresence(ctx, "peer", "instance", PresenceTTL))
+	instanceID, err := bus.GetPresence(ctx, "peer")
+	require.NoError(t, err)
+	assert.Equal(t, "instance", instanceID)
+
+	now = now.Add(PresenceTTL)
+	_, err = bus.GetPresence(ctx, "peer")
+	assert.ErrorIs(t, err, ErrPresenceNotFound, "peers of an instance that stopped refreshing should expire")
+}
@@ -252,7 +252,7 @@ func (c *GrpcClient) IsHealthy() bool {
 	defer cancel()
 	_, err := c.realClient.Send(ctx, &proto.EncryptedMessage{
 		Key:       c.key.PublicKey().String(),
-		RemoteKey: "dummy",
+		RemoteKey: proto.HealthCheckRemoteKey,
 		Body:      nil,
 	})
 	if err != nil {
@@ -342,6 +342,7 @@ func (c *GrpcClient) encryptMessage(msg *proto.Message) (*proto.EncryptedMessage
 		Key:       msg.GetKey(),
 		RemoteKey: msg.GetRemoteKey(),
 		Body:      encryptedBody,
+		Type:      msg.GetBody().GetType().Enum(),
 	}, nil
 }
 
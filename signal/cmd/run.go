@@ -14,6 +14,8 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/crypto/acme/autocert"
 
+	"github.com/netbirdio/netbird/signal/admin"
+	"github.com/netbirdio/netbird/signal/bus"
 	"github.com/netbirdio/netbird/signal/metrics"
 
 	"github.com/netbirdio/netbird/encryption"
@@ -22,6 +24,7 @@ import (
 	"github.com/netbirdio/netbird/util"
 	"github.com/netbirdio/netbird/version"
 
+	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -37,6 +40,10 @@ var (
 	defaultSignalSSLDir     string
 	signalCertFile          string
 	signalCertKey           string
+	messageBusURL           string
+	instanceID              string
+	adminAddress            string
+	adminToken              string
 
 	signalKaep = grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
 		MinTime:             5 * time.Second,
@@ -102,12 +109,40 @@ var (
 				}
 			}()
 
-			srv, err := server.NewServer(cmd.Context(), metricsServer.Meter)
+			var serverOpts []server.Option
+			if messageBusURL != "" {
+				messageBus, err := bus.NewRedisBus(cmd.Context(), messageBusURL)
+				if err != nil {
+					return fmt.Errorf("connecting to the message bus: %v", err)
+				}
+				defer messageBus.Close()
+
+				if instanceID == "" {
+					instanceID = xid.New().String()
+				}
+				serverOpts = append(serverOpts, server.WithMessageBus(messageBus, instanceID))
+			}
+
+			srv, err := server.NewServer(cmd.Context(), metricsServer.Meter, serverOpts...)
 			if err != nil {
 				return fmt.Errorf("creating signal server: %v", err)
 			}
 			proto.RegisterSignalExchangeServer(grpcServer, srv)
 
+			var adminServer *admin.Server
+			if adminAddress != "" {
+				adminServer, err = admin.NewServer(adminAddress, adminToken, srv)
+				if err != nil {
+					return fmt.Errorf("creating admin server: %v", err)
+				}
+				go func() {
+					log.Infof("running admin server: %s", adminServer.Addr)
+					if err := adminServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+						log.Fatalf("Failed to start admin server: %v", err)
+					}
+				}()
+			}
+
 			grpcRootHandler := grpcHandlerFunc(grpcServer)
 
 			if certManager != nil {
@@ -156,6 +191,10 @@ var (
 				log.Infof("stopped gRPC backward compatibility server")
 			}
 
+			if err := srv.Close(); err != nil {
+				log.Errorf("Failed to remove the peers from the message bus: %v", err)
+			}
+
 			ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 			defer cancel()
 			if err := metricsServer.Shutdown(ctx); err != nil {
@@ -163,6 +202,13 @@ var (
 			}
 			log.Infof("stopped metrics server")
 
+			if adminServer != nil {
+				if err := adminServer.Shutdown(ctx); err != nil {
+					log.Errorf("Failed to stop admin server: %v", err)
+				}
+				log.Infof("stopped admin server")
+			}
+
 			log.Infof("stopped Signal Service")
 
 			return nil
@@ -290,4 +336,8 @@ func init() {
 	runCmd.Flags().StringVar(&signalLetsencryptDomain, "letsencrypt-domain", "", "a domain to issue Let's Encrypt certificate for. Enables TLS using Let's Encrypt. Will fetch and renew certificate, and run the server with TLS")
 	runCmd.Flags().StringVar(&signalCertFile, "cert-file", "", "Location of your SSL certificate. Can be used when you have an existing certificate and don't want a new certificate be generated automatically. If letsencrypt-domain is specified this property has no effect")
 	runCmd.Flags().StringVar(&signalCertKey, "cert-key", "", "Location of your SSL certificate private key. Can be used when you have an existing certificate and don't want a new certificate be generated automatically. If letsencrypt-domain is specified this property has no effect")
+	runCmd.Flags().StringVar(&messageBusURL, "message-bus-url", "", "Redis URL of the message bus shared by the signal instances, e.g. redis://localhost:6379/0. Required to run more than one signal instance behind a load balancer")
+	runCmd.Flags().StringVar(&instanceID, "instance-id", "", "ID of this signal instance on the message bus, generated on startup if empty")
+	runCmd.Flags().StringVar(&adminAddress, "admin-address", "", "listen address of the admin API to list and disconnect the connected peers, e.g. 127.0.0.1:9091. Disabled if empty")
+	runCmd.Flags().StringVar(&adminToken, "admin-token", "", "bearer token required by the admin API")
 }
@@ -20,6 +20,12 @@ type AppMetrics struct {
 	MessagesForwarded      metric.Int64Counter
 	MessageForwardFailures metric.Int64Counter
 	MessageForwardLatency  metric.Float64Histogram
+
+	// MessagesBuffered counts the messages held back for a peer that is not connected. They are either delivered once
+	// the peer registers or expire.
+	MessagesBuffered          metric.Int64Counter
+	BufferedMessagesDelivered metric.Int64Counter
+	BufferedMessagesExpired   metric.Int64Counter
 }
 
 func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
@@ -77,6 +83,21 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		return nil, err
 	}
 
+	messagesBuffered, err := meter.Int64Counter("messages_buffered_total")
+	if err != nil {
+		return nil, err
+	}
+
+	bufferedMessagesDelivered, err := meter.Int64Counter("buffered_messages_delivered_total")
+	if err != nil {
+		return nil, err
+	}
+
+	bufferedMessagesExpired, err := meter.Int64Counter("buffered_messages_expired_total")
+	if err != nil {
+		return nil, err
+	}
+
 	return &AppMetrics{
 		Meter: meter,
 
@@ -92,6 +113,10 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		MessagesForwarded:      messagesForwarded,
 		MessageForwardFailures: messageForwardFailures,
 		MessageForwardLatency:  messageForwardLatency,
+
+		MessagesBuffered:          messagesBuffered,
+		BufferedMessagesDelivered: bufferedMessagesDelivered,
+		BufferedMessagesExpired:   bufferedMessagesExpired,
 	}, nil
 }
 
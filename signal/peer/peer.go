@@ -3,10 +3,15 @@ package peer
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	grpcpeer "google.golang.org/grpc/peer"
+	gproto "google.golang.org/protobuf/proto"
 
+	"github.com/netbirdio/netbird/relay/messages"
+	"github.com/netbirdio/netbird/signal/admin"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/signal/proto"
 )
@@ -23,6 +28,17 @@ type Peer struct {
 
 	// registration time
 	RegisteredAt time.Time
+
+	// the size of the messages forwarded to the Peer
+	bytesForwarded atomic.Int64
+	// closed when the Peer has to be disconnected
+	disconnect     chan struct{}
+	disconnectOnce sync.Once
+	// closed once the messages buffered before the registration are sent
+	ready     chan struct{}
+	readyOnce sync.Once
+	// sendMu serializes the messages sent on the stream
+	sendMu sync.Mutex
 }
 
 // NewPeer creates a new instance of a connected Peer
@@ -32,7 +48,81 @@ func NewPeer(id string, stream proto.SignalExchange_ConnectStreamServer) *Peer {
 		Stream:       stream,
 		StreamID:     time.Now().UnixNano(),
 		RegisteredAt: time.Now(),
+		disconnect:   make(chan struct{}),
+		ready:        make(chan struct{}),
+	}
+}
+
+// Send sends a message on the stream of the Peer. It waits until the messages buffered before the registration are
+// sent, so they are not overtaken.
+func (p *Peer) Send(msg *proto.EncryptedMessage) error {
+	select {
+	case <-p.ready:
+	case <-p.Stream.Context().Done():
+		return p.Stream.Context().Err()
 	}
+	return p.send(msg)
+}
+
+// SendPending sends the messages buffered before the registration and lets the other messages through. It returns
+// the number of messages sent.
+func (p *Peer) SendPending(messages []*proto.EncryptedMessage) (int, error) {
+	defer p.readyOnce.Do(func() {
+		close(p.ready)
+	})
+
+	for i, msg := range messages {
+		if err := p.send(msg); err != nil {
+			return i, err
+		}
+	}
+	return len(messages), nil
+}
+
+func (p *Peer) send(msg *proto.EncryptedMessage) error {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+
+	if err := p.Stream.Send(msg); err != nil {
+		return err
+	}
+	p.AddBytesForwarded(gproto.Size(msg))
+	return nil
+}
+
+// AddBytesForwarded records the size of a message forwarded to the Peer
+func (p *Peer) AddBytesForwarded(n int) {
+	p.bytesForwarded.Add(int64(n))
+}
+
+// Disconnect signals the stream of the Peer to close
+func (p *Peer) Disconnect() {
+	p.disconnectOnce.Do(func() {
+		close(p.disconnect)
+	})
+}
+
+// Disconnected returns a channel that is closed when the Peer has to be disconnected
+func (p *Peer) Disconnected() <-chan struct{} {
+	return p.disconnect
+}
+
+// Info returns the admin view of the Peer
+func (p *Peer) Info() admin.PeerInfo {
+	_, hashedID := messages.HashID(p.Id)
+	info := admin.PeerInfo{
+		ID:             hashedID,
+		ConnectedAt:    p.RegisteredAt,
+		ConnectionAge:  int64(time.Since(p.RegisteredAt).Seconds()),
+		BytesForwarded: p.bytesForwarded.Load(),
+		Transport:      admin.TransportGRPC,
+	}
+	if p.Stream != nil {
+		if remote, ok := grpcpeer.FromContext(p.Stream.Context()); ok {
+			info.RemoteAddress = remote.Addr.String()
+		}
+	}
+	return info
 }
 
 // Registry that holds all currently connected Peers
@@ -113,3 +203,28 @@ func (registry *Registry) Deregister(peer *Peer) {
 		registry.metrics.Deregistrations.Add(context.Background(), 1)
 	}
 }
+
+// PeerInfos returns the admin view of the registered Peers
+func (registry *Registry) PeerInfos() []admin.PeerInfo {
+	var infos []admin.PeerInfo
+	registry.Peers.Range(func(_, value any) bool {
+		infos = append(infos, value.(*Peer).Info())
+		return true
+	})
+	return infos
+}
+
+// DisconnectPeer disconnects the registered Peer with the hashed ID
+func (registry *Registry) DisconnectPeer(hashedID string) bool {
+	found := false
+	registry.Peers.Range(func(_, value any) bool {
+		p := value.(*Peer)
+		if _, id := messages.HashID(p.Id); id == hashedID {
+			p.Disconnect()
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
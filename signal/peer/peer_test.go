@@ -1,6 +1,8 @@
 package peer
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -8,7 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 
+	"github.com/netbirdio/netbird/relay/messages"
 	"github.com/netbirdio/netbird/signal/metrics"
+	"github.com/netbirdio/netbird/signal/proto"
 )
 
 func TestRegistry_ShouldNotDeregisterWhenHasNewerStreamRegistered(t *testing.T) {
@@ -94,3 +98,89 @@ func TestRegistry_Deregister(t *testing.T) {
 	}
 
 }
+
+func TestRegistry_DisconnectPeer(t *testing.T) {
+	metrics, err := metrics.NewAppMetrics(otel.Meter(""))
+	require.NoError(t, err)
+
+	r := NewRegistry(metrics)
+
+	peer1 := NewPeer("test_peer_1", nil)
+	peer2 := NewPeer("test_peer_2", nil)
+	r.Register(peer1)
+	r.Register(peer2)
+
+	infos := r.PeerInfos()
+	require.Len(t, infos, 2)
+
+	_, hashedID := messages.HashID(peer1.Id)
+	assert.False(t, r.DisconnectPeer("unknown"))
+	assert.True(t, r.DisconnectPeer(hashedID))
+
+	select {
+	case <-peer1.Disconnected():
+	default:
+		t.Errorf("expected test_peer_1 to be disconnected")
+	}
+
+	select {
+	case <-peer2.Disconnected():
+		t.Errorf("expected test_peer_2 to stay connected")
+	default:
+	}
+}
+
+type testStream struct {
+	proto.SignalExchange_ConnectStreamServer
+	ctx  context.Context
+	mu   sync.Mutex
+	sent []string
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) Send(msg *proto.EncryptedMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, string(msg.Body))
+	return nil
+}
+
+func (s *testStream) bodies() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.sent...)
+}
+
+func TestPeer_SendWaitsForPendingMessages(t *testing.T) {
+	stream := &testStream{ctx: context.Background()}
+	p := NewPeer("peer", stream)
+
+	sent := make(chan error, 1)
+	go func() {
+		sent <- p.Send(&proto.EncryptedMessage{Body: []byte("live")})
+	}()
+
+	select {
+	case <-sent:
+		t.Fatal("the message should wait for the pending messages")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	n, err := p.SendPending([]*proto.EncryptedMessage{{Body: []byte("pending-1")}, {Body: []byte("pending-2")}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	require.NoError(t, <-sent)
+	assert.Equal(t, []string{"pending-1", "pending-2", "live"}, stream.bodies())
+}
+
+func TestPeer_SendStopsWithTheStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := NewPeer("peer", &testStream{ctx: ctx})
+
+	cancel()
+	assert.ErrorIs(t, p.Send(&proto.EncryptedMessage{Body: []byte("live")}), context.Canceled)
+}
@@ -3,3 +3,6 @@ package proto
 // protocol constants, field names that can be used by both client and server
 const HeaderId = "x-wiretrustee-peer-id"
 const HeaderRegistered = "x-wiretrustee-peer-registered"
+
+// HealthCheckRemoteKey is the remote key of the messages the client sends to check the connection to the server
+const HealthCheckRemoteKey = "dummy"
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/netbirdio/netbird/signal/metrics"
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+const (
+	// bufferTTL is how long a message waits for its destination peer to register
+	bufferTTL = 5 * time.Second
+	// bufferMaxMessagesPerPeer bounds the messages buffered for a single destination peer, the oldest ones are dropped
+	bufferMaxMessagesPerPeer = 32
+	// bufferMaxPeers bounds the number of destination peers with buffered messages
+	bufferMaxPeers = 10000
+	// bufferRetryInterval is how often the buffered messages are delivered to the peers that connected meanwhile, to
+	// this or another signal instance
+	bufferRetryInterval = 500 * time.Millisecond
+
+	labelReason         = "reason"
+	labelReasonTTL      = "ttl"
+	labelReasonReplaced = "replaced"
+	labelReasonOverflow = "overflow"
+)
+
+type bufferedMessage struct {
+	msg       *proto.EncryptedMessage
+	expiresAt time.Time
+}
+
+// messageBuffer holds the messages to peers that are not connected for a short time, so an offer sent right before the
+// remote peer reconnects is not lost. Only the latest offer, answer and mode message of a sender is kept, the
+// candidates are kept all.
+type messageBuffer struct {
+	mu      sync.Mutex
+	pending map[string][]bufferedMessage
+	metrics *metrics.AppMetrics
+}
+
+func newMessageBuffer(metrics *metrics.AppMetrics) *messageBuffer {
+	return &messageBuffer{
+		pending: make(map[string][]bufferedMessage),
+		metrics: metrics,
+	}
+}
+
+// add buffers the message for its destination peer
+func (b *messageBuffer) add(ctx context.Context, msg *proto.EncryptedMessage) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	messages, ok := b.pending[msg.RemoteKey]
+	if !ok && len(b.pending) >= bufferMaxPeers {
+		log.Debugf("message buffer is full, dropping message from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
+		b.expired(ctx, 1, labelReasonOverflow)
+		return
+	}
+
+	if replaceable(msg) {
+		for i, m := range messages {
+			if m.msg.Key == msg.Key && m.msg.GetType() == msg.GetType() && replaceable(m.msg) {
+				messages = append(messages[:i], messages[i+1:]...)
+				b.expired(ctx, 1, labelReasonReplaced)
+				break
+			}
+		}
+	}
+
+	if len(messages) >= bufferMaxMessagesPerPeer {
+		messages = messages[1:]
+		b.expired(ctx, 1, labelReasonOverflow)
+	}
+
+	b.pending[msg.RemoteKey] = append(messages, bufferedMessage{
+		msg:       msg,
+		expiresAt: time.Now().Add(bufferTTL),
+	})
+	b.metrics.MessagesBuffered.Add(ctx, 1)
+	log.Debugf("buffered message from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
+}
+
+// take removes and returns the unexpired messages buffered for the peer in the order they were received
+func (b *messageBuffer) take(ctx context.Context, peerID string) []*proto.EncryptedMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	messages, ok := b.pending[peerID]
+	if !ok {
+		return nil
+	}
+	delete(b.pending, peerID)
+
+	now := time.Now()
+	result := make([]*proto.EncryptedMessage, 0, len(messages))
+	for _, m := range messages {
+		if now.After(m.expiresAt) {
+			b.expired(ctx, 1, labelReasonTTL)
+			continue
+		}
+		result = append(result, m.msg)
+	}
+	return result
+}
+
+// peers returns the destination peers with buffered messages
+func (b *messageBuffer) peers() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	peers := make([]string, 0, len(b.pending))
+	for peerID := range b.pending {
+		peers = append(peers, peerID)
+	}
+	return peers
+}
+
+// removeExpired drops the messages that outlived the TTL
+func (b *messageBuffer) removeExpired(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	for peerID, messages := range b.pending {
+		// the messages are ordered by their expiration
+		n := 0
+		for n < len(messages) && now.After(messages[n].expiresAt) {
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+
+		b.expired(ctx, int64(n), labelReasonTTL)
+		if n == len(messages) {
+			delete(b.pending, peerID)
+			continue
+		}
+		b.pending[peerID] = messages[n:]
+	}
+}
+
+func (b *messageBuffer) startCleanup(ctx context.Context) {
+	ticker := time.NewTicker(bufferTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.removeExpired(ctx)
+		}
+	}
+}
+
+func (b *messageBuffer) expired(ctx context.Context, n int64, reason string) {
+	b.metrics.BufferedMessagesExpired.Add(ctx, n, metric.WithAttributes(attribute.String(labelReason, reason)))
+}
+
+// replaceable reports whether a newer message of the same type from the same sender supersedes the message. The
+// candidates are complementary, and the type of the messages of old clients is unknown.
+func replaceable(msg *proto.EncryptedMessage) bool {
+	if msg.Type == nil {
+		return false
+	}
+	return msg.GetType() != proto.Body_CANDIDATE
+}
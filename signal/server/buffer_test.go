@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/netbirdio/netbird/signal/metrics"
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func newTestBuffer(t *testing.T) *messageBuffer {
+	t.Helper()
+
+	appMetrics, err := metrics.NewAppMetrics(otel.Meter(""))
+	require.NoError(t, err)
+	return newMessageBuffer(appMetrics)
+}
+
+func testMessage(key, remoteKey string, msgType *proto.Body_Type, body string) *proto.EncryptedMessage {
+	return &proto.EncryptedMessage{Key: key, RemoteKey: remoteKey, Type: msgType, Body: []byte(body)}
+}
+
+func bodies(messages []*proto.EncryptedMessage) []string {
+	result := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		result = append(result, string(msg.Body))
+	}
+	return result
+}
+
+func TestMessageBuffer_Deduplicate(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBuffer(t)
+
+	b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_OFFER.Enum(), "offer-a-1"))
+	b.add(ctx, testMessage("peer-c", "peer-b", proto.Body_OFFER.Enum(), "offer-c"))
+	b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_CANDIDATE.Enum(), "candidate-1"))
+	b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_CANDIDATE.Enum(), "candidate-2"))
+	b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_OFFER.Enum(), "offer-a-2"))
+	// the type of the messages of old clients is unknown, they are kept all
+	b.add(ctx, testMessage("peer-d", "peer-b", nil, "unknown-1"))
+	b.add(ctx, testMessage("peer-d", "peer-b", nil, "unknown-2"))
+
+	assert.Equal(t,
+		[]string{"offer-c", "candidate-1", "candidate-2", "offer-a-2", "unknown-1", "unknown-2"},
+		bodies(b.take(ctx, "peer-b")))
+	assert.Empty(t, b.take(ctx, "peer-b"), "the messages should be delivered once")
+}
+
+func TestMessageBuffer_Bounded(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBuffer(t)
+
+	for i := 0; i < bufferMaxMessagesPerPeer+2; i++ {
+		b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_CANDIDATE.Enum(), "candidate"))
+	}
+	assert.Len(t, b.take(ctx, "peer-b"), bufferMaxMessagesPerPeer)
+}
+
+func TestMessageBuffer_Expire(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBuffer(t)
+
+	b.add(ctx, testMessage("peer-a", "peer-b", proto.Body_OFFER.Enum(), "offer"))
+	b.add(ctx, testMessage("peer-a", "peer-c", proto.Body_OFFER.Enum(), "offer"))
+	b.add(ctx, testMessage("peer-a", "peer-c", proto.Body_CANDIDATE.Enum(), "candidate"))
+
+	b.pending["peer-b"][0].expiresAt = time.Now().Add(-time.Second)
+	b.pending["peer-c"][0].expiresAt = time.Now().Add(-time.Second)
+
+	b.removeExpired(ctx)
+	assert.NotContains(t, b.pending, "peer-b")
+	assert.Equal(t, []string{"candidate"}, bodies(b.take(ctx, "peer-c")))
+
+	b.add(ctx, testMessage("peer-a", "peer-d", proto.Body_OFFER.Enum(), "offer"))
+	b.pending["peer-d"][0].expiresAt = time.Now().Add(-time.Second)
+	assert.Empty(t, b.take(ctx, "peer-d"), "expired messages should not be delivered")
+}
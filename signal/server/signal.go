@@ -14,6 +14,8 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/netbirdio/netbird/signal/admin"
+	"github.com/netbirdio/netbird/signal/bus"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/signal/peer"
 	"github.com/netbirdio/netbird/signal/proto"
@@ -43,10 +45,31 @@ type Server struct {
 	proto.UnimplementedSignalExchangeServer
 	dispatcher *dispatcher.Dispatcher
 	metrics    *metrics.AppMetrics
+	// buffer holds the messages to peers that are not connected for a short time
+	buffer *messageBuffer
+	// router delivers messages to peers connected to other signal instances, nil when running a single instance
+	router *bus.Router
+
+	messageBus messageBusConfig
+}
+
+type messageBusConfig struct {
+	bus        bus.MessageBus
+	instanceID string
+}
+
+// Option configures the Signal server
+type Option func(*Server)
+
+// WithMessageBus shares the peer registry with the other signal instances connected to the message bus
+func WithMessageBus(messageBus bus.MessageBus, instanceID string) Option {
+	return func(s *Server) {
+		s.messageBus = messageBusConfig{bus: messageBus, instanceID: instanceID}
+	}
 }
 
 // NewServer creates a new Signal server
-func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
+func NewServer(ctx context.Context, meter metric.Meter, opts ...Option) (*Server, error) {
 	appMetrics, err := metrics.NewAppMetrics(meter)
 	if err != nil {
 		return nil, fmt.Errorf("creating app metrics: %v", err)
@@ -61,21 +84,44 @@ func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
 		dispatcher: dispatcher,
 		registry:   peer.NewRegistry(appMetrics),
 		metrics:    appMetrics,
+		buffer:     newMessageBuffer(appMetrics),
+	}
+	go s.buffer.startCleanup(ctx)
+
+	for _, opt := range opts {
+		opt(s)
 	}
 
+	if s.messageBus.bus != nil {
+		s.router, err = bus.NewRouter(ctx, s.messageBus.bus, s.messageBus.instanceID, s.forwardMessageToPeer)
+		if err != nil {
+			return nil, fmt.Errorf("creating message bus router: %v", err)
+		}
+		log.Infof("sharing the peer registry on the message bus as instance %s", s.messageBus.instanceID)
+	}
+	go s.retryBufferedMessages(ctx)
+
 	return s, nil
 }
 
+// Close removes the presence of the connected peers from the message bus
+func (s *Server) Close() error {
+	if s.router == nil {
+		return nil
+	}
+	return s.router.Close()
+}
+
 // Send forwards a message to the signal peer
 func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.EncryptedMessage, error) {
 	log.Debugf("received a new message to send from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
-	if _, found := s.registry.Get(msg.RemoteKey); found {
-		s.forwardMessageToPeer(ctx, msg)
-		return &proto.EncryptedMessage{}, nil
+	if msg.RemoteKey == proto.HealthCheckRemoteKey {
+		return s.dispatcher.SendMessage(context.Background(), msg)
 	}
 
-	return s.dispatcher.SendMessage(context.Background(), msg)
+	s.forwardMessageToPeer(ctx, msg)
+	return &proto.EncryptedMessage{}, nil
 }
 
 // ConnectStream connects to the exchange stream
@@ -97,25 +143,71 @@ func (s *Server) ConnectStream(stream proto.SignalExchange_ConnectStreamServer)
 
 	log.Debugf("peer connected [%s] [streamID %d] ", p.Id, p.StreamID)
 
+	s.flushBufferedMessages(p)
+
+	// the receiving stops with the handler, a message received after the peer was disconnected is dropped
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveMessages(ctx, stream)
+	}()
+
+	select {
+	case err := <-recvErr:
+		if err != nil {
+			return err
+		}
+	case <-p.Disconnected():
+		return status.Errorf(codes.Aborted, "peer has been disconnected by the administrator")
+	}
+
+	select {
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	case <-p.Disconnected():
+		return status.Errorf(codes.Aborted, "peer has been disconnected by the administrator")
+	}
+}
+
+func (s *Server) receiveMessages(ctx context.Context, stream proto.SignalExchange_ConnectStreamServer) error {
 	for {
 		// read incoming messages
 		msg, err := stream.Recv()
 		if err == io.EOF {
-			break
+			return nil
 		} else if err != nil {
 			return err
 		}
 
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		log.Debugf("Received a response from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
-		_, err = s.dispatcher.SendMessage(stream.Context(), msg)
+		// the messages to peers that are not connected to this instance are routed or buffered
+		if !s.registry.IsPeerRegistered(msg.RemoteKey) {
+			s.forwardMessageToPeer(ctx, msg)
+			continue
+		}
+
+		_, err = s.dispatcher.SendMessage(ctx, msg)
 		if err != nil {
 			log.Debugf("error while sending message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
 		}
 	}
+}
+
+// Peers returns the peers connected to this signal instance
+func (s *Server) Peers() []admin.PeerInfo {
+	return s.registry.PeerInfos()
+}
 
-	<-stream.Context().Done()
-	return stream.Context().Err()
+// DisconnectPeer closes the stream of the peer with the hashed ID
+func (s *Server) DisconnectPeer(id string) bool {
+	return s.registry.DisconnectPeer(id)
 }
 
 func (s *Server) RegisterPeer(stream proto.SignalExchange_ConnectStreamServer) (*peer.Peer, error) {
@@ -127,6 +219,12 @@ func (s *Server) RegisterPeer(stream proto.SignalExchange_ConnectStreamServer) (
 			s.registry.Register(p)
 			s.dispatcher.ListenForMessages(stream.Context(), p.Id, s.forwardMessageToPeer)
 
+			if s.router != nil {
+				if err := s.router.Register(stream.Context(), p.Id); err != nil {
+					log.Warnf("failed to announce peer [%s] on the message bus: %v", p.Id, err)
+				}
+			}
+
 			return p, nil
 		} else {
 			s.metrics.RegistrationFailures.Add(stream.Context(), 1, metric.WithAttributes(attribute.String(labelError, labelErrorMissingId)))
@@ -142,9 +240,32 @@ func (s *Server) DeregisterPeer(p *peer.Peer) {
 	log.Debugf("peer disconnected [%s] [streamID %d] ", p.Id, p.StreamID)
 	s.registry.Deregister(p)
 
+	// a newer stream of the peer may still be registered
+	if s.router != nil && !s.registry.IsPeerRegistered(p.Id) {
+		if err := s.router.Deregister(context.Background(), p.Id); err != nil {
+			log.Warnf("failed to remove peer [%s] from the message bus: %v", p.Id, err)
+		}
+	}
+
 	s.metrics.PeerConnectionDuration.Record(p.Stream.Context(), int64(time.Since(p.RegisteredAt).Seconds()))
 }
 
+// routeMessage sends the message to the signal instance the remote peer is connected to.
+// It returns false if the message bus is disabled or the peer isn't connected to another instance.
+func (s *Server) routeMessage(ctx context.Context, msg *proto.EncryptedMessage) bool {
+	if s.router == nil {
+		return false
+	}
+
+	delivered, err := s.router.Send(ctx, msg)
+	if err != nil {
+		log.Warnf("failed to route message from peer [%s] to peer [%s] over the message bus: %v", msg.Key, msg.RemoteKey, err)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeError)))
+		return false
+	}
+	return delivered
+}
+
 func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedMessage) {
 	log.Debugf("forwarding a new message from peer [%s] to peer [%s]", msg.Key, msg.RemoteKey)
 
@@ -155,7 +276,7 @@ func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedM
 		s.metrics.GetRegistrationDelay.Record(ctx, float64(time.Since(getRegistrationStart).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream), attribute.String(labelRegistrationStatus, labelRegistrationFound)))
 		start := time.Now()
 		// forward the message to the target peer
-		if err := dstPeer.Stream.Send(msg); err != nil {
+		if err := dstPeer.Send(msg); err != nil {
 			log.Warnf("error while forwarding message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
 			// todo respond to the sender?
 			s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeError)))
@@ -166,8 +287,75 @@ func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedM
 		}
 	} else {
 		s.metrics.GetRegistrationDelay.Record(ctx, float64(time.Since(getRegistrationStart).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeStream), attribute.String(labelRegistrationStatus, labelRegistrationNotFound)))
+		if s.routeMessage(ctx, msg) {
+			return
+		}
 		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeNotConnected)))
 		log.Debugf("message from peer [%s] can't be forwarded to peer [%s] because destination peer is not connected", msg.Key, msg.RemoteKey)
-		// todo respond to the sender?
+		s.bufferMessage(ctx, msg)
+	}
+}
+
+// bufferMessage holds the message back until the destination peer registers or the message expires. It is only called
+// once nothing delivered the message.
+func (s *Server) bufferMessage(ctx context.Context, msg *proto.EncryptedMessage) {
+	if msg.RemoteKey == proto.HealthCheckRemoteKey {
+		return
+	}
+	s.buffer.add(ctx, msg)
+}
+
+// flushBufferedMessages delivers the messages sent to the peer while it was not connected. The other messages to the
+// peer wait until they are delivered.
+func (s *Server) flushBufferedMessages(p *peer.Peer) {
+	ctx := p.Stream.Context()
+	messages := s.buffer.take(ctx, p.Id)
+
+	sent, err := p.SendPending(messages)
+	s.metrics.MessagesForwarded.Add(ctx, int64(sent))
+	s.metrics.BufferedMessagesDelivered.Add(ctx, int64(sent))
+	if err != nil {
+		msg := messages[sent]
+		log.Warnf("error while delivering buffered message from peer [%s] to peer [%s] %v", msg.Key, msg.RemoteKey, err)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeError)))
+	}
+}
+
+// retryBufferedMessages delivers the buffered messages of the peers that connected after the messages were buffered.
+// A peer may connect to another signal instance, or to this one while its messages were being buffered.
+func (s *Server) retryBufferedMessages(ctx context.Context) {
+	ticker := time.NewTicker(bufferRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, peerID := range s.buffer.peers() {
+				s.retryPeerMessages(ctx, peerID)
+			}
+		}
+	}
+}
+
+func (s *Server) retryPeerMessages(ctx context.Context, peerID string) {
+	if _, found := s.registry.Get(peerID); !found {
+		if s.router == nil {
+			return
+		}
+		connected, err := s.router.ConnectedElsewhere(ctx, peerID)
+		if err != nil {
+			log.Warnf("failed to look up the signal instance of peer [%s]: %v", peerID, err)
+			return
+		}
+		if !connected {
+			return
+		}
+	}
+
+	for _, msg := range s.buffer.take(ctx, peerID) {
+		s.metrics.BufferedMessagesDelivered.Add(ctx, 1)
+		s.forwardMessageToPeer(ctx, msg)
 	}
 }
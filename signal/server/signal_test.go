@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/netbirdio/netbird/signal/bus"
+	"github.com/netbirdio/netbird/signal/proto"
+)
+
+func startTestSignal(t *testing.T, opts ...Option) proto.SignalExchangeClient {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv, err := NewServer(context.Background(), otel.Meter(""), opts...)
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	proto.RegisterSignalExchangeServer(grpcServer, srv)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		grpcServer.Stop()
+		_ = srv.Close()
+	})
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return proto.NewSignalExchangeClient(conn)
+}
+
+func connectTestPeer(t *testing.T, ctx context.Context, client proto.SignalExchangeClient, peerID string) proto.SignalExchange_ConnectStreamClient {
+	t.Helper()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(proto.HeaderId, peerID))
+	stream, err := client.ConnectStream(ctx)
+	require.NoError(t, err)
+
+	// the header confirms the registration
+	_, err = stream.Header()
+	require.NoError(t, err)
+	return stream
+}
+
+func TestServer_MessageBus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messageBus := bus.NewMemoryBus()
+	clientA := startTestSignal(t, WithMessageBus(messageBus, "instance-a"))
+	clientB := startTestSignal(t, WithMessageBus(messageBus, "instance-b"))
+
+	streamA := connectTestPeer(t, ctx, clientA, "peer-a")
+	ctxB, disconnectB := context.WithCancel(ctx)
+	streamB := connectTestPeer(t, ctxB, clientB, "peer-b")
+
+	err := streamA.Send(&proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer")})
+	require.NoError(t, err)
+
+	msg, err := streamB.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "peer-a", msg.Key)
+	assert.Equal(t, []byte("offer"), msg.Body)
+
+	_, err = clientB.Send(ctx, &proto.EncryptedMessage{Key: "peer-b", RemoteKey: "peer-a", Body: []byte("answer")})
+	require.NoError(t, err)
+
+	msg, err = streamA.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "peer-b", msg.Key)
+	assert.Equal(t, []byte("answer"), msg.Body)
+
+	disconnectB()
+	require.Eventually(t, func() bool {
+		_, err := messageBus.GetPresence(ctx, "peer-b")
+		return err != nil
+	}, 5*time.Second, 20*time.Millisecond, "disconnected peers should be removed from the message bus")
+}
+
+func TestServer_BufferedMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := startTestSignal(t)
+	streamA := connectTestPeer(t, ctx, client, "peer-a")
+
+	send := func(msgType proto.Body_Type, body string) {
+		err := streamA.Send(&proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte(body), Type: msgType.Enum()})
+		require.NoError(t, err)
+	}
+	send(proto.Body_OFFER, "offer-1")
+	send(proto.Body_CANDIDATE, "candidate-1")
+	send(proto.Body_OFFER, "offer-2")
+	send(proto.Body_CANDIDATE, "candidate-2")
+	send(proto.Body_CANDIDATE, "candidate-3")
+
+	// wait for the server to receive and buffer the messages
+	time.Sleep(100 * time.Millisecond)
+
+	streamB := connectTestPeer(t, ctx, client, "peer-b")
+
+	var bodies []string
+	for range 4 {
+		msg, err := streamB.Recv()
+		require.NoError(t, err)
+		bodies = append(bodies, string(msg.Body))
+	}
+	assert.Equal(t, []string{"candidate-1", "offer-2", "candidate-2", "candidate-3"}, bodies, "the newer offer should replace the buffered one")
+}
+
+func TestServer_BufferedMessageDeliveredOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := startTestSignal(t)
+	streamA := connectTestPeer(t, ctx, client, "peer-a")
+
+	ctxB, disconnectB := context.WithCancel(ctx)
+	connectTestPeer(t, ctxB, client, "peer-b")
+	disconnectB()
+
+	// wait for the server to deregister the peer
+	time.Sleep(100 * time.Millisecond)
+
+	err := streamA.Send(&proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer"), Type: proto.Body_OFFER.Enum()})
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	_, err = client.Send(ctx, &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("candidate"), Type: proto.Body_CANDIDATE.Enum()})
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+
+	streamB := connectTestPeer(t, ctx, client, "peer-b")
+	received := make(chan string, 10)
+	go func() {
+		for {
+			msg, err := streamB.Recv()
+			if err != nil {
+				return
+			}
+			received <- string(msg.Body)
+		}
+	}()
+
+	var bodies []string
+	timeout := time.After(time.Second)
+	for len(bodies) < 2 {
+		select {
+		case body := <-received:
+			bodies = append(bodies, body)
+		case <-timeout:
+			t.Fatalf("received %v, expected the buffered messages", bodies)
+		}
+	}
+	assert.Equal(t, []string{"offer", "candidate"}, bodies)
+
+	select {
+	case body := <-received:
+		t.Fatalf("the buffered message %q was delivered twice", body)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestServer_BufferedMessagesOfPeerConnectedToAnotherInstance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messageBus := bus.NewMemoryBus()
+	clientA := startTestSignal(t, WithMessageBus(messageBus, "instance-a"))
+	clientB := startTestSignal(t, WithMessageBus(messageBus, "instance-b"))
+
+	streamA := connectTestPeer(t, ctx, clientA, "peer-a")
+	err := streamA.Send(&proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer"), Type: proto.Body_OFFER.Enum()})
+	require.NoError(t, err)
+
+	// wait for instance A to buffer the message
+	time.Sleep(100 * time.Millisecond)
+
+	streamB := connectTestPeer(t, ctx, clientB, "peer-b")
+	msg, err := streamB.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "peer-a", msg.Key)
+	assert.Equal(t, []byte("offer"), msg.Body)
+}
+
+type testRecvStream struct {
+	proto.SignalExchange_ConnectStreamServer
+	ctx      context.Context
+	messages chan *proto.EncryptedMessage
+}
+
+func (s *testRecvStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testRecvStream) Recv() (*proto.EncryptedMessage, error) {
+	msg, ok := <-s.messages
+	if !ok {
+		return nil, io.EOF
+	}
+	return msg, nil
+}
+
+func TestServer_ReceiveMessagesStopsWithTheHandler(t *testing.T) {
+	srv, err := NewServer(context.Background(), otel.Meter(""))
+	require.NoError(t, err)
+
+	stream := &testRecvStream{ctx: context.Background(), messages: make(chan *proto.EncryptedMessage, 1)}
+	stream.messages <- &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer")}
+
+	// the handler returned, e.g. since the administrator disconnected the peer, while the message was being received
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = srv.receiveMessages(ctx, stream)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, srv.buffer.peers(), "the message of a disconnected peer should be dropped")
+}